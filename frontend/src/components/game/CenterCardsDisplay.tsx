@@ -5,7 +5,8 @@
  * @module components/game/CenterCardsDisplay
  *
  * @description
- * Displays the 3 center cards in the middle of the PlayerCircle.
+ * Displays the center cards in the middle of the PlayerCircle, one per
+ * card in the room's center (three unless the room sets another count).
  * - Face-down by default
  * - Selectable during night actions (Seer, Drunk)
  * - Revealed in Results phase
@@ -13,7 +14,7 @@
  * @pattern Observer Pattern - Reacts to selection state
  */
 
-import { RoleName, ROLE_METADATA } from '@/types/game';
+import { RoleName, ROLE_METADATA, DEFAULT_CENTER_CARD_COUNT } from '@/types/game';
 import { cn } from '@/lib/utils';
 import { ROLE_ICONS } from './RoleCard';
 
@@ -24,6 +25,8 @@ interface CenterCard {
 
 interface CenterCardsDisplayProps {
   cards?: CenterCard[];
+  /** Number of center cards (defaults to cards.length, then three) */
+  count?: number;
   selectedIndices?: number[];
   onCardClick?: (index: number) => void;
   interactive?: boolean;
@@ -41,11 +44,8 @@ interface CenterCardsDisplayProps {
 }
 
 export function CenterCardsDisplay({
-  cards = [
-    { revealed: false },
-    { revealed: false },
-    { revealed: false }
-  ],
+  cards = [],
+  count,
   selectedIndices = [],
   onCardClick,
   interactive = false,
@@ -74,12 +74,13 @@ export function CenterCardsDisplay({
   const selectedCount = selectedIndices.length;
   const selectionComplete = selectedCount === expectedCount;
   const showInlineUI = inlineConfirmMode && selectedCount > 0;
+  const cardCount = count ?? (cards.length || DEFAULT_CENTER_CARD_COUNT);
 
   return (
     <div className={cn('relative flex flex-col items-center', className)}>
       {/* Cards row */}
       <div className="flex items-center justify-center gap-1">
-        {[0, 1, 2].map((index) => {
+        {Array.from({ length: cardCount }, (_, index) => {
           const card = cards[index] || { revealed: false };
           const isSelected = selectedIndices.includes(index);
           const canClick = interactive && onCardClick;
@@ -33,7 +33,8 @@ export function DayPhaseView() {
     roomState,
     playerIdMapping,
     submitStatement,
-    readyToVote
+    readyToVote,
+    requestExtension
   } = useGameStore();
 
   // Chat panel state
@@ -74,16 +75,26 @@ export function DayPhaseView() {
             allowSelfClick={true}
             showCenterCards={true}
             statements={gameView.statements}
+            revealedRoles={gameView.revealedCards}
             visibleBubblePlayerIds={visibleBubblePlayerIds}
             exitingBubblePlayerIds={exitingBubblePlayerIds}
             centerHeaderContent={
-              <Button
-                onClick={readyToVote}
-                variant="primary"
-                size="sm"
-              >
-                Ready to Vote
-              </Button>
+              <div className="flex gap-2">
+                <Button
+                  onClick={requestExtension}
+                  variant="secondary"
+                  size="sm"
+                >
+                  More Time
+                </Button>
+                <Button
+                  onClick={readyToVote}
+                  variant="primary"
+                  size="sm"
+                >
+                  Ready to Vote
+                </Button>
+              </div>
             }
           />
         }
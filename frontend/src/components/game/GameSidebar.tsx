@@ -52,7 +52,7 @@ export function GameSidebar({
   if (!gameView) return null;
 
   const getPlayerName = createPlayerNameResolver(playerIdMapping, roomState);
-  const roleMetadata = ROLE_METADATA[gameView.myStartingRole];
+  const roleMetadata = gameView.myStartingRole ? ROLE_METADATA[gameView.myStartingRole] : null;
   const hasFinalRoleChange = showFinalRole && finalRole && finalRole !== gameView.myStartingRole;
   const shouldShowFinalRole = showFinalRole && finalRole;
   const isNightPhase = gameView.phase === GamePhase.NIGHT;
@@ -200,7 +200,11 @@ export function GameSidebar({
           {/* Your Starting Role - or stolen role if Robber has acted */}
           <div>
             <h3 className="text-xs text-gray-400 mb-2 uppercase tracking-wide">
-              {stolenRole ? 'Your Role (Stolen)' : copiedRole ? 'Your Role (Copied)' : 'Your Role'}
+              {stolenRole
+                ? 'Your Role (Stolen)'
+                : copiedRole
+                  ? 'Your Role (Copied)'
+                  : gameView.myStartingRole === null ? 'Your Role (Hidden)' : 'Your Role'}
             </h3>
             {stolenRole ? (
               // Robber has stolen - show the stolen role
@@ -242,10 +246,14 @@ export function GameSidebar({
             )
           ) : !actionContext && (
             // Normal role - show own description only when no active action
-            roleMetadata.nightActionDescription && (
+            roleMetadata ? roleMetadata.nightActionDescription && (
               <div className="text-xs text-gray-400 leading-relaxed">
                 {roleMetadata.nightActionDescription}
               </div>
+            ) : (
+              <div className="text-xs text-gray-400 leading-relaxed">
+                A Curator placed an artifact on your card. Your role stays hidden until the game ends.
+              </div>
             )
           )}
 
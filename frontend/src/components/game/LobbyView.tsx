@@ -18,7 +18,7 @@ import { useDebugStore } from '@/stores/debugStore';
 import { DEBUG_PRESETS, DebugPreset } from '@/lib/debugPresets';
 import { Button, Card, CardContent, CardHeader, CardTitle } from '@/components/ui';
 import { RoleSelector } from '@/components/lobby';
-import { RoleName, ROLE_METADATA, Team } from '@/types/game';
+import { RoleName, ROLE_METADATA, Team, DEFAULT_CENTER_CARD_COUNT } from '@/types/game';
 import { cn } from '@/lib/utils';
 
 /** Debug mode default roles: all unique roles, no villagers (13 roles for 10 players + 3 center) */
@@ -89,7 +89,9 @@ export function LobbyView({ onLeave }: LobbyViewProps) {
   const isHost = roomState.hostId === playerId;
   const currentPlayer = roomState.players.find(p => p.id === playerId);
   const allReady = roomState.players.every(p => p.isReady || p.isHost);
-  const requiredRoles = roomState.config.maxPlayers + 3;
+  const setAsideCards = roomState.config.roles.includes(RoleName.ALPHA_WOLF) ? 1 : 0;
+  const centerCardCount = roomState.config.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT;
+  const requiredRoles = roomState.config.maxPlayers + centerCardCount + setAsideCards;
   const hasCorrectRoles = roomState.config.roles.length === requiredRoles;
   const canStart = isHost && roomState.players.length >= roomState.config.minPlayers && allReady && hasCorrectRoles;
 
@@ -139,6 +141,8 @@ export function LobbyView({ onLeave }: LobbyViewProps) {
         <h1 className="text-3xl font-bold text-white mb-2">Room {roomState.roomCode}</h1>
         <p className="text-gray-400">
           {roomState.players.length}/{roomState.config.maxPlayers} players
+          {' · '}
+          {roomState.readyCount}/{roomState.playerCount} ready
         </p>
       </div>
 
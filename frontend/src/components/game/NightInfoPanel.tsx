@@ -239,6 +239,12 @@ function CopiedRoleActionSection({ copyInfo, compact, selectedPlayers = [], sele
   const copiedRole = copyInfo.info.copied!.role;
   const hasResults = copyInfo.info.werewolves !== undefined ||
                      copyInfo.info.masons !== undefined ||
+                     copyInfo.info.seers !== undefined ||
+                     copyInfo.info.shielded !== undefined ||
+                     copyInfo.info.rotated !== undefined ||
+                     copyInfo.info.revealed !== undefined ||
+                     copyInfo.info.artifact !== undefined ||
+                     copyInfo.info.turned !== undefined ||
                      copyInfo.info.viewed ||
                      copyInfo.info.swapped;
 
@@ -458,8 +464,8 @@ function NightInfoItem({ info, compact, actingRole }: NightInfoItemProps) {
   const { info: actionInfo } = info;
   const myPlayerId = gameView?.myPlayerId;
 
-  // Determine if this is a Minion viewing werewolves (vs a Werewolf viewing teammates)
-  const isMinion = actingRole === RoleName.MINION;
+  // Determine if this is a Minion or Squire viewing werewolves (vs a Werewolf viewing teammates)
+  const isMinion = actingRole === RoleName.MINION || actingRole === RoleName.SQUIRE;
 
   const textSize = compact ? 'text-xs' : 'text-sm';
 
@@ -552,6 +558,86 @@ function NightInfoItem({ info, compact, actingRole }: NightInfoItemProps) {
         )
       )}
 
+      {/* Seer seen by the Beholder */}
+      {actionInfo.seers !== undefined && (
+        actionInfo.seers.length > 0 ? (
+          <div className="flex items-start gap-1.5">
+            <span className="text-gray-500">•</span>
+            <div className="flex flex-col">
+              <span className="text-gray-400">The Seer:</span>
+              {actionInfo.seers.map((id, idx) => (
+                <span key={idx} className="text-blue-400 font-medium ml-2">{getPlayerName(id)}</span>
+              ))}
+            </div>
+          </div>
+        ) : (
+          <p className="flex items-start gap-1.5">
+            <span className="text-gray-500">•</span>
+            <span>
+              <span className="text-blue-400 font-medium">No Seer</span>
+              <span className="text-gray-400"> — the Seer card is in the center</span>
+            </span>
+          </p>
+        )
+      )}
+
+      {/* Sentinel shield */}
+      {actionInfo.shielded && (
+        <p className="flex items-start gap-1.5">
+          <span className="text-gray-500">•</span>
+          <span>
+            <span className="text-gray-400">Shielded </span>
+            <span className="text-white font-medium">{getPlayerName(actionInfo.shielded)}</span>
+            <span className="text-gray-400">&apos;s card</span>
+          </span>
+        </p>
+      )}
+
+      {/* Village Idiot rotation */}
+      {actionInfo.rotated && (
+        <p className="flex items-start gap-1.5">
+          <span className="text-gray-500">•</span>
+          <span className="text-gray-400">
+            Moved every player&apos;s card one seat {actionInfo.rotated.direction === 'LEFT' ? 'left' : 'right'}
+          </span>
+        </p>
+      )}
+
+      {/* Revealer card left face up */}
+      {actionInfo.revealed && (
+        <p className="flex items-start gap-1.5">
+          <span className="text-gray-500">•</span>
+          <span>
+            <span className="text-gray-400">Left </span>
+            <span className="text-white font-medium">{getPlayerName(actionInfo.revealed)}</span>
+            <span className="text-gray-400">&apos;s card face up for the day</span>
+          </span>
+        </p>
+      )}
+
+      {/* Curator artifact */}
+      {actionInfo.artifact && (
+        <p className="flex items-start gap-1.5">
+          <span className="text-gray-500">•</span>
+          <span>
+            <span className="text-gray-400">Placed an artifact on </span>
+            <span className="text-white font-medium">{getPlayerName(actionInfo.artifact)}</span>
+            <span className="text-gray-400">&apos;s card</span>
+          </span>
+        </p>
+      )}
+
+      {/* Alpha Wolf gave out the center Werewolf card */}
+      {actionInfo.turned && (
+        <p className="flex items-start gap-1.5">
+          <span className="text-gray-500">•</span>
+          <span>
+            <span className="text-gray-400">Gave the center werewolf card to </span>
+            <span className="text-red-400 font-medium">{getPlayerName(actionInfo.turned)}</span>
+          </span>
+        </p>
+      )}
+
       {/* Swap info - shown BEFORE viewed cards (steal first, then see what you got) */}
       {actionInfo.swapped && (() => {
         const fromId = actionInfo.swapped.from.playerId;
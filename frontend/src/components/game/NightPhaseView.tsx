@@ -8,6 +8,7 @@
  * Displays the night phase UI with unified layout.
  * Players click on PlayerCircle to select targets for night actions.
  * Seer can click either players OR center cards (mutually exclusive).
+ * Village Idiot picks a direction (or none) with buttons.
  *
  * @pattern Observer Pattern - Subscribes to game store state changes
  * @pattern Composite Pattern - Composes layout, sidebar, and action components
@@ -22,6 +23,7 @@ import { PlayerCircle } from './PlayerCircle';
 import { ChatPanel } from './ChatPanel';
 import { FloatingChatButton } from './FloatingChatButton';
 import { DebugInfoPanel } from './DebugInfoPanel';
+import { Button } from '@/components/ui';
 import { toServerPlayerId, createPlayerNameResolver } from '@/lib/playerUtils';
 import { useSpeechBubbles } from '@/hooks/useSpeechBubbles';
 
@@ -84,6 +86,7 @@ export function NightPhaseView() {
   const isPlayerAction = actionType === 'selectPlayer' || actionType === 'selectTwoPlayers';
   const isCenterAction = actionType === 'selectCenter';
   const isSeerChoice = actionType === 'seerChoice';
+  const isRotationChoice = actionType === 'rotationChoice';
 
   // Max selections based on action type
   const maxPlayerSelections = actionType === 'selectTwoPlayers' ? 2 : 1;
@@ -265,6 +268,24 @@ export function NightPhaseView() {
         }
         footerContent={null}
       >
+        {/* Village Idiot: choose a direction, or leave the cards alone */}
+        {isRotationChoice && pendingActionRequest && (
+          <div className="flex flex-col items-center gap-2">
+            <p className="text-sm text-gray-300">Move every player&apos;s card one seat?</p>
+            <div className="flex gap-2">
+              <Button size="sm" onClick={() => sendActionResponse(pendingActionRequest.requestId, 'LEFT')}>
+                ← Left
+              </Button>
+              <Button size="sm" variant="secondary" onClick={() => sendActionResponse(pendingActionRequest.requestId, 'NONE')}>
+                Leave them
+              </Button>
+              <Button size="sm" onClick={() => sendActionResponse(pendingActionRequest.requestId, 'RIGHT')}>
+                Right →
+              </Button>
+            </div>
+          </div>
+        )}
+
         {/* Debug Info Panel (admin only) */}
         {gameView.debugInfo && (
           <div className="flex flex-col items-center">
@@ -13,7 +13,7 @@
  */
 
 import { useState, useRef, useEffect } from 'react';
-import { PublicPlayerInfo, RoleName, ROLE_METADATA, PlayerStatement, Team, TEAM_BG_COLORS } from '@/types/game';
+import { PublicPlayerInfo, RoleName, ROLE_METADATA, PlayerStatement, Team, TEAM_BG_COLORS, DEFAULT_CENTER_CARD_COUNT } from '@/types/game';
 import { useGameStore } from '@/stores/gameStore';
 import { useDebugStore } from '@/stores/debugStore';
 import { cn } from '@/lib/utils';
@@ -54,7 +54,7 @@ interface PlayerCircleProps {
   interactive?: boolean;
   showVoteStatus?: boolean;
   highlightedIds?: string[];
-  /** Show the 3 center cards in the middle */
+  /** Show the center cards in the middle */
   showCenterCards?: boolean;
   /** Center card data */
   centerCards?: CenterCard[];
@@ -331,6 +331,7 @@ export function PlayerCircle({
           <div ref={centerCardsRef}>
             <CenterCardsDisplay
               cards={centerCards}
+              count={roomState?.config.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT}
               selectedIndices={selectedCenterIndices}
               onCardClick={onCenterCardClick}
               interactive={centerCardsInteractive}
@@ -574,6 +575,9 @@ export function PlayerCircle({
                 {player.isAI && (
                   <span className="text-purple-400 text-xs">AI</span>
                 )}
+                {!player.isConnected && !player.isAI && (
+                  <span className="text-red-400 text-xs" title="Disconnected">Offline</span>
+                )}
               </div>
             </div>
 
@@ -15,7 +15,7 @@
 
 import { useState, useMemo } from 'react';
 import { useGameStore } from '@/stores/gameStore';
-import { GamePhase, RoleName, TEAM_BG_COLORS } from '@/types/game';
+import { GamePhase, RoleName, TEAM_BG_COLORS, DEFAULT_CENTER_CARD_COUNT } from '@/types/game';
 import { cn } from '@/lib/utils';
 import { GamePhaseLayout } from './GamePhaseLayout';
 import { GameSidebar } from './GameSidebar';
@@ -55,11 +55,10 @@ export function ResultsView({ onLeave }: ResultsViewProps) {
   const centerCards = debugCenterCards?.map(role => ({
     role: role as RoleName,
     revealed: true
-  })) || [
-    { revealed: false },
-    { revealed: false },
-    { revealed: false }
-  ];
+  })) || Array.from(
+    { length: roomState?.config.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT },
+    () => ({ revealed: false })
+  );
 
   // Calculate vote counts for display on player circle
   const voteCounts = useMemo(() => {
@@ -12,27 +12,39 @@
  * @pattern Strategy Pattern - Different display strategies for face-up/down
  */
 
-import { RoleName, ROLE_METADATA, Team, TEAM_COLORS, TEAM_BG_COLORS } from '@/types/game';
+import { RoleName, ROLE_METADATA, Team, TEAM_COLORS, TEAM_BG_COLORS, DEFAULT_CENTER_CARD_COUNT } from '@/types/game';
 import { cn } from '@/lib/utils';
 
 /** Role icons (emoji-based for simplicity, could be replaced with custom images) */
 export const ROLE_ICONS: Record<RoleName, string> = {
+  [RoleName.SENTINEL]: '🛡️',
   [RoleName.WEREWOLF]: '🐺',
+  [RoleName.ALPHA_WOLF]: '🐾',
+  [RoleName.MYSTIC_WOLF]: '🌙',
   [RoleName.MINION]: '👹',
+  [RoleName.SQUIRE]: '🗡️',
   [RoleName.SEER]: '🔮',
+  [RoleName.BEHOLDER]: '🧿',
+  [RoleName.APPRENTICE_SEER]: '🕯️',
   [RoleName.ROBBER]: '🦹',
   [RoleName.TROUBLEMAKER]: '🎭',
+  [RoleName.VILLAGE_IDIOT]: '🔄',
   [RoleName.DRUNK]: '🍺',
+  [RoleName.REVEALER]: '👁️',
+  [RoleName.CURATOR]: '🏺',
   [RoleName.INSOMNIAC]: '😳',
   [RoleName.MASON]: '🧱',
+  [RoleName.DREAM_WOLF]: '💤',
   [RoleName.VILLAGER]: '👨‍🌾',
   [RoleName.HUNTER]: '🏹',
+  [RoleName.PRINCE]: '👑',
   [RoleName.TANNER]: '🪶',
   [RoleName.DOPPELGANGER]: '👥'
 };
 
 interface RoleCardProps {
-  role: RoleName;
+  /** Null when the role is hidden (the card is always shown face down) */
+  role: RoleName | null;
   size?: 'sm' | 'md' | 'lg';
   faceDown?: boolean;
   onClick?: () => void;
@@ -51,12 +63,6 @@ export function RoleCard({
   className,
   copiedRole
 }: RoleCardProps) {
-  const metadata = ROLE_METADATA[role];
-  const copiedMetadata = copiedRole ? ROLE_METADATA[copiedRole] : null;
-
-  // For Doppelganger with copied role, use the copied role's team for styling
-  const effectiveTeam = copiedMetadata ? copiedMetadata.team : metadata.team;
-
   const sizes = {
     sm: 'w-20 h-28',
     md: 'w-28 h-40',
@@ -75,7 +81,7 @@ export function RoleCard({
     lg: 'text-5xl'
   };
 
-  if (faceDown) {
+  if (faceDown || role === null) {
     return (
       <div
         onClick={onClick}
@@ -93,6 +99,12 @@ export function RoleCard({
     );
   }
 
+  const metadata = ROLE_METADATA[role];
+  const copiedMetadata = copiedRole ? ROLE_METADATA[copiedRole] : null;
+
+  // For Doppelganger with copied role, use the copied role's team for styling
+  const effectiveTeam = copiedMetadata ? copiedMetadata.team : metadata.team;
+
   const teamColorClass = effectiveTeam === Team.WEREWOLF
     ? 'from-red-900 to-red-950 border-red-700'
     : effectiveTeam === Team.TANNER
@@ -149,6 +161,8 @@ export function RoleCard({
 
 interface CenterCardsProps {
   cards?: readonly RoleName[];
+  /** Number of center cards (defaults to cards.length, then three) */
+  count?: number;
   onCardClick?: (index: number) => void;
   selectedIndices?: number[];
   revealed?: boolean;
@@ -156,13 +170,16 @@ interface CenterCardsProps {
 
 export function CenterCards({
   cards,
+  count,
   onCardClick,
   selectedIndices = [],
   revealed = false
 }: CenterCardsProps) {
+  const cardCount = count ?? (cards?.length || DEFAULT_CENTER_CARD_COUNT);
+
   return (
     <div className="flex justify-center gap-4">
-      {[0, 1, 2].map((index) => (
+      {Array.from({ length: cardCount }, (_, index) => (
         <div key={index} className="text-center">
           <p className="text-gray-400 text-xs mb-1">Card {index + 1}</p>
           {revealed && cards ? (
@@ -12,9 +12,10 @@
  * @pattern State Pattern - Different UI states based on voting status
  */
 
-import { useState, useMemo } from 'react';
+import { useState, useMemo, useEffect } from 'react';
 import { useGameStore } from '@/stores/gameStore';
-import { GamePhase } from '@/types/game';
+import { GamePhase, ABSTAIN_VOTE } from '@/types/game';
+import { Button } from '@/components/ui';
 import { GamePhaseLayout } from './GamePhaseLayout';
 import { GameSidebar } from './GameSidebar';
 import { PlayerCircle } from './PlayerCircle';
@@ -38,6 +39,17 @@ export function VotingPhaseView() {
   const [selectedTarget, setSelectedTarget] = useState<string | null>(null);
   const [hasVoted, setHasVoted] = useState(false);
 
+  // A new vote request (a revote after a tie) means voting again
+  const voteRequestId = pendingActionRequest?.actionType === 'vote'
+    ? pendingActionRequest.requestId
+    : null;
+  useEffect(() => {
+    if (voteRequestId) {
+      setSelectedTarget(null);
+      setHasVoted(false);
+    }
+  }, [voteRequestId]);
+
   // Chat panel state
   const [chatPanelOpen, setChatPanelOpen] = useState(false);
 
@@ -60,14 +72,31 @@ export function VotingPhaseView() {
     }
   };
 
+  const handleAbstain = () => {
+    if (!hasVoted && pendingActionRequest?.requestId) {
+      sendActionResponse(pendingActionRequest.requestId, ABSTAIN_VOTE);
+      setSelectedTarget(null);
+      setHasVoted(true);
+    }
+  };
+
   const handleCancel = () => {
     setSelectedTarget(null);
   };
 
+  const canAbstain = !hasVoted && pendingActionRequest?.actionType === 'vote' &&
+    pendingActionRequest.canAbstain === true;
+
   const handlePlayerClick = (playerId: string) => {
-    if (!hasVoted && playerId !== gameView.myPlayerId) {
-      setSelectedTarget(playerId);
+    if (hasVoted || playerId === gameView.myPlayerId) {
+      return;
     }
+    // In a revote only the tied players can be chosen
+    if (pendingActionRequest?.actionType === 'vote' &&
+        !pendingActionRequest.eligibleTargets.includes(toServerPlayerId(playerId, playerIdMapping))) {
+      return;
+    }
+    setSelectedTarget(playerId);
   };
 
   // Calculate vote counts and voter details from visible votes
@@ -108,6 +137,7 @@ export function VotingPhaseView() {
             showVoteBadges={true}
             voteCounts={voteCounts}
             voteDetails={voteDetails}
+            revealedRoles={gameView.revealedCards}
             // Inline confirm for vote selection (same pattern as night phase)
             nightActionMode={!hasVoted && !!pendingActionRequest}
             nightSelectedIds={selectedTarget ? [selectedTarget] : []}
@@ -121,7 +151,13 @@ export function VotingPhaseView() {
             exitingBubblePlayerIds={exitingBubblePlayerIds}
           />
         }
-        footerContent={null}
+        footerContent={canAbstain ? (
+          <div className="flex justify-center">
+            <Button onClick={handleAbstain} size="sm" variant="secondary">
+              Abstain
+            </Button>
+          </div>
+        ) : null}
       >
         {/* Debug Info Panel (admin only) */}
         {gameView.debugInfo && (
@@ -105,7 +105,7 @@ interface RoomListItemProps {
  * @summary Individual room item in the public room list.
  */
 function RoomListItem({ room, onJoin }: RoomListItemProps) {
-  const isFull = room.playerCount >= room.maxPlayers;
+  const isFull = !room.joinable;
 
   // Count roles by team for preview
   const werewolfCount = room.roles.filter(
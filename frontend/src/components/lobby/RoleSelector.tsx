@@ -37,17 +37,28 @@ const ROLE_SELECTOR_DEFAULTS = {
  * All available roles in order of night action priority.
  */
 const AVAILABLE_ROLES: readonly RoleName[] = [
+  RoleName.SENTINEL,
   RoleName.DOPPELGANGER,
   RoleName.WEREWOLF,
+  RoleName.ALPHA_WOLF,
+  RoleName.MYSTIC_WOLF,
   RoleName.MINION,
+  RoleName.SQUIRE,
   RoleName.MASON,
   RoleName.SEER,
+  RoleName.BEHOLDER,
+  RoleName.APPRENTICE_SEER,
   RoleName.ROBBER,
   RoleName.TROUBLEMAKER,
+  RoleName.VILLAGE_IDIOT,
   RoleName.DRUNK,
+  RoleName.REVEALER,
+  RoleName.CURATOR,
   RoleName.INSOMNIAC,
+  RoleName.DREAM_WOLF,
   RoleName.VILLAGER,
   RoleName.HUNTER,
+  RoleName.PRINCE,
   RoleName.TANNER,
 ];
 
@@ -84,7 +95,9 @@ export function RoleSelector({
     initialRoles ?? getDefaultRoles(initialPlayerCount)
   );
 
-  const requiredRoles = playerCount + ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT;
+  // The Alpha Wolf sets one extra Werewolf card aside in the center
+  const setAsideCards = selectedRoles.includes(RoleName.ALPHA_WOLF) ? 1 : 0;
+  const requiredRoles = playerCount + ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT + setAsideCards;
   const currentRoleCount = selectedRoles.length;
   const rolesNeeded = requiredRoles - currentRoleCount;
 
@@ -141,7 +154,7 @@ export function RoleSelector({
     setPlayerCount(newCount);
 
     // Auto-adjust roles if needed
-    const newRequired = newCount + ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT;
+    const newRequired = newCount + ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT + setAsideCards;
     if (selectedRoles.length > newRequired) {
       // Remove roles from the end
       setSelectedRoles(prev => prev.slice(0, newRequired));
@@ -185,7 +198,7 @@ export function RoleSelector({
             </Button>
           </div>
           <p className="text-xs text-gray-500 mt-1">
-            {requiredRoles} roles needed ({playerCount} players + {ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT} center cards)
+            {requiredRoles} roles needed ({playerCount} players + {ROLE_SELECTOR_DEFAULTS.CENTER_CARD_COUNT} center cards{setAsideCards > 0 && ' + the Alpha Wolf\'s Werewolf'})
           </p>
         </div>
 
@@ -434,6 +447,7 @@ function getDefaultRoles(playerCount: number): RoleName[] {
     RoleName.MASON,
     RoleName.MINION,
     RoleName.HUNTER,
+    RoleName.APPRENTICE_SEER,
     RoleName.TANNER,
     RoleName.VILLAGER,
     RoleName.DOPPELGANGER,
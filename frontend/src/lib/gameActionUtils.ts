@@ -39,7 +39,7 @@ export function getRequestReason(request: ActionRequest): string | undefined {
  * night action. Handles special cases like Doppelganger multi-step actions.
  *
  * @param request - The pending action request, or null if none
- * @param startingRole - The player's starting role
+ * @param startingRole - The player's starting role, or null while an artifact hides it
  * @param nightInfo - Array of night action results for context
  * @returns Object with title and description, or null if no context needed
  *
@@ -53,7 +53,7 @@ export function getRequestReason(request: ActionRequest): string | undefined {
  */
 export function getActionContext(
   request: ActionRequest | null,
-  startingRole: RoleName,
+  startingRole: RoleName | null,
   nightInfo: readonly NightActionResult[]
 ): { title: string; description: string } | null {
   if (!request) return null;
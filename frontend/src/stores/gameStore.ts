@@ -87,7 +87,7 @@ interface GameStore {
   // Game actions
   createRoom: (config: Partial<RoomState['config']>) => void;
   joinRoom: (roomCode: string, playerName: string) => void;
-  listPublicRooms: () => void;
+  listPublicRooms: (minOpenSlots?: number) => void;
   leaveRoom: () => void;
   setReady: (ready: boolean) => void;
   addAI: () => void;
@@ -96,6 +96,7 @@ interface GameStore {
   submitStatement: (statement: string) => void;
   submitVote: (targetId: string) => void;
   readyToVote: () => void;
+  requestExtension: () => void;
   sendActionResponse: (requestId: string, response: unknown) => void;
   updateRoomConfig: (config: Partial<RoomState['config']>) => void;
 
@@ -289,9 +290,9 @@ export const useGameStore = create<GameStore>((set, get) => ({
     });
   },
 
-  listPublicRooms: () => {
+  listPublicRooms: (minOpenSlots?: number) => {
     const { ws } = get();
-    ws?.send({ type: 'listPublicRooms' });
+    ws?.send({ type: 'listPublicRooms', minOpenSlots });
   },
 
   leaveRoom: () => {
@@ -359,6 +360,11 @@ export const useGameStore = create<GameStore>((set, get) => ({
     ws?.send({ type: 'readyToVote' });
   },
 
+  requestExtension: () => {
+    const { ws } = get();
+    ws?.send({ type: 'requestExtension' });
+  },
+
   sendActionResponse: (requestId, response) => {
     const { ws } = get();
     ws?.send({
@@ -440,7 +446,9 @@ function handleServerMessage(
     case 'error':
       set({
         error: message.message as string,
-        isLoading: false
+        isLoading: false,
+        // A kicked player is no longer in the room they were shown
+        ...(message.code === 'REMOVED_FROM_ROOM' ? { roomState: null } : {})
       });
       break;
 
@@ -474,11 +482,17 @@ function handleServerMessage(
       const currentView = get().gameView;
       console.log('[Store] phaseChange received:', message.phase, 'timeRemaining:', message.timeRemaining);
       if (currentView) {
+        const artifacts = (message.artifacts as string[] | undefined) ?? currentView.artifacts;
         set({
           gameView: {
             ...currentView,
             phase: message.phase as GamePhase,
-            timeRemaining: message.timeRemaining as number | null
+            timeRemaining: message.timeRemaining as number | null,
+            phaseEndsAt: (message.phaseEndsAt as number | null | undefined) ?? null,
+            revealedCards: (message.revealedCards as Record<string, RoleName> | undefined) ?? currentView.revealedCards,
+            artifacts,
+            // A Curator's artifact on our card hides our own role until the game ends
+            myStartingRole: artifacts?.includes(currentView.myPlayerId) ? null : currentView.myStartingRole
           }
         });
       }
@@ -506,6 +520,22 @@ function handleServerMessage(
       break;
     }
 
+    case 'dawnSummary': {
+      // Authoritative list of our own night results; replaces anything
+      // accumulated from individual nightResult messages
+      const nightInfo = message.nightInfo as NightActionResult[];
+      const currentView = get().gameView;
+      if (currentView) {
+        set({
+          gameView: {
+            ...currentView,
+            myNightInfo: nightInfo
+          }
+        });
+      }
+      break;
+    }
+
     case 'statementMade': {
       const statement: PlayerStatement = {
         playerId: message.playerId as string,
@@ -516,6 +546,69 @@ function handleServerMessage(
       break;
     }
 
+    case 'playerDisconnected':
+    case 'playerReconnected': {
+      const currentView = get().gameView;
+      if (currentView) {
+        const isConnected = type === 'playerReconnected';
+        set({
+          gameView: {
+            ...currentView,
+            players: currentView.players.map(p =>
+              p.id === message.playerId ? { ...p, isConnected } : p
+            )
+          }
+        });
+      }
+      break;
+    }
+
+    case 'discussionExtended': {
+      // The table voted for more time; restart the countdown from the new deadline
+      const currentView = get().gameView;
+      if (currentView) {
+        set({
+          gameView: {
+            ...currentView,
+            timeRemaining: message.timeRemaining as number | null,
+            phaseEndsAt: (message.phaseEndsAt as number | null | undefined) ?? null
+          }
+        });
+      }
+      break;
+    }
+
+    case 'voteProgress': {
+      // A secret ballot leaves out the voter; there is nothing to mark then
+      const currentView = get().gameView;
+      if (currentView && message.voterId) {
+        set({
+          gameView: {
+            ...currentView,
+            players: currentView.players.map(p =>
+              p.id === message.voterId ? { ...p, hasVoted: true } : p
+            )
+          }
+        });
+      }
+      break;
+    }
+
+    case 'revoteStarted': {
+      // A tie is being voted on again; everyone votes anew before the new deadline
+      const currentView = get().gameView;
+      if (currentView) {
+        set({
+          gameView: {
+            ...currentView,
+            phaseEndsAt: (message.phaseEndsAt as number | null | undefined) ?? null,
+            players: currentView.players.map(p => ({ ...p, hasVoted: false }))
+          }
+        });
+      }
+      break;
+    }
+
     case 'votesRevealed': {
       const currentView = get().gameView;
       if (currentView) {
@@ -542,12 +635,22 @@ function handleServerMessage(
       break;
     }
 
-    case 'gameEnd':
+    case 'gameEnd': {
+      const result = message.result as SerializableGameResult;
+      const currentView = get().gameView;
       set({
-        gameResult: message.result as SerializableGameResult,
-        gameSummary: message.summary as GameSummary
+        gameResult: result,
+        gameSummary: message.summary as GameSummary,
+        // The game is over, so an artifact no longer hides our dealt role
+        ...(currentView && currentView.myStartingRole === null && {
+          gameView: {
+            ...currentView,
+            myStartingRole: result.originalRoles?.[currentView.myPlayerId] ?? null
+          }
+        })
       });
       break;
+    }
 
     case 'loginResponse':
       if (message.success) {
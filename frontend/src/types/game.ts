@@ -22,17 +22,28 @@ export enum Team {
 }
 
 export enum RoleName {
+  SENTINEL = 'SENTINEL',
   DOPPELGANGER = 'DOPPELGANGER',
   WEREWOLF = 'WEREWOLF',
+  ALPHA_WOLF = 'ALPHA_WOLF',
+  MYSTIC_WOLF = 'MYSTIC_WOLF',
   MINION = 'MINION',
+  SQUIRE = 'SQUIRE',
   MASON = 'MASON',
   SEER = 'SEER',
+  BEHOLDER = 'BEHOLDER',
+  APPRENTICE_SEER = 'APPRENTICE_SEER',
   ROBBER = 'ROBBER',
   TROUBLEMAKER = 'TROUBLEMAKER',
+  VILLAGE_IDIOT = 'VILLAGE_IDIOT',
   DRUNK = 'DRUNK',
+  REVEALER = 'REVEALER',
+  CURATOR = 'CURATOR',
   INSOMNIAC = 'INSOMNIAC',
+  DREAM_WOLF = 'DREAM_WOLF',
   VILLAGER = 'VILLAGER',
   HUNTER = 'HUNTER',
+  PRINCE = 'PRINCE',
   TANNER = 'TANNER'
 }
 
@@ -42,14 +53,25 @@ export enum RoleName {
 
 export type TimeoutStrategyType = 'casual' | 'competitive' | 'tournament';
 
+/** Center cards in a room that doesn't set RoomConfig.centerCardCount */
+export const DEFAULT_CENTER_CARD_COUNT = 3;
+
 export interface RoomConfig {
   readonly minPlayers: number;
   readonly maxPlayers: number;
   readonly roles: readonly RoleName[];
+  readonly centerCardCount?: number;
+  readonly nightOrder?: readonly RoleName[];
   readonly timeoutStrategy: TimeoutStrategyType;
   readonly isPrivate: boolean;
   readonly allowSpectators: boolean;
   readonly roomName?: string;
+  readonly revealEmphasis?: 'original' | 'final';
+  readonly allowAbstain?: boolean;
+  readonly tieBreak?: 'all-die' | 'no-kill' | 'revote';
+  readonly minVotesToKill?: number;
+  readonly anonymousVotes?: boolean;
+  readonly revealVotesAtEnd?: boolean;
 }
 
 export interface RoomPlayer {
@@ -66,9 +88,13 @@ export interface RoomState {
   readonly hostId: string;
   readonly config: RoomConfig;
   readonly players: readonly RoomPlayer[];
+  readonly readyCount: number;
+  readonly playerCount: number;
   readonly status: 'waiting' | 'starting' | 'inProgress' | 'playing' | 'ended';
   readonly gameId?: string;
   readonly createdAt: number;
+  readonly round: number;
+  readonly scores: Readonly<Record<string, number>>;
 }
 
 /**
@@ -80,6 +106,8 @@ export interface PublicRoomInfo {
   readonly hostName: string;
   readonly playerCount: number;
   readonly maxPlayers: number;
+  /** Still in the lobby with a seat free */
+  readonly joinable: boolean;
   readonly roles: readonly RoleName[];
 }
 
@@ -119,6 +147,12 @@ export interface NightActionInfo {
   copied?: { readonly fromPlayerId: string; readonly role: RoleName };
   werewolves?: readonly string[];
   masons?: readonly string[];
+  seers?: readonly string[];
+  shielded?: string;
+  rotated?: { readonly direction: 'LEFT' | 'RIGHT'; readonly playerIds: readonly string[] };
+  revealed?: string;
+  artifact?: string;
+  turned?: string;
 }
 
 export interface NightActionResult {
@@ -128,8 +162,20 @@ export interface NightActionResult {
   readonly success: boolean;
   readonly info: NightActionInfo;
   readonly error?: string;
+  /** Why the action did not succeed (only when success is false) */
+  readonly failureCode?: NightActionFailureCode;
+  /** Whether the input was rejected or the action failed under the rules */
+  readonly failureKind?: 'rejected' | 'failed';
 }
 
+export type NightActionFailureCode =
+  | 'WRONG_TARGET_COUNT'
+  | 'INVALID_TARGET'
+  | 'DUPLICATE_TARGET'
+  | 'SELF_TARGET'
+  | 'NO_VALID_TARGETS'
+  | 'TARGET_SHIELDED';
+
 /**
  * Debug information for admin testing.
  */
@@ -144,16 +190,23 @@ export interface SerializablePlayerGameView {
   readonly myPlayerId: string;
   readonly gameId: string;
   readonly phase: GamePhase;
-  readonly myStartingRole: RoleName;
+  /** Null while a Curator's artifact hides the player's own role */
+  readonly myStartingRole: RoleName | null;
   readonly myNightInfo: readonly NightActionResult[];
   readonly players: readonly PublicPlayerInfo[];
   readonly statements: readonly PlayerStatement[];
   readonly votes: Record<string, string> | null;
   readonly eliminatedPlayers: readonly string[] | null;
   readonly finalRoles: Record<string, RoleName> | null;
+  /** Cards the Revealer left face up (from day onward) */
+  readonly revealedCards?: Record<string, RoleName>;
+  /** Players whose cards carry a Curator's artifact (from day onward) */
+  readonly artifacts?: readonly string[];
   readonly winningTeams: readonly Team[] | null;
   readonly winningPlayers: readonly string[] | null;
   readonly timeRemaining: number | null;
+  /** Absolute phase deadline (epoch ms), or null if untimed */
+  readonly phaseEndsAt?: number | null;
   readonly isEliminated?: boolean;
   /** Debug information (only for admin players with debug mode enabled) */
   readonly debugInfo?: DebugInfo;
@@ -167,8 +220,31 @@ export interface SerializableGameResult {
   readonly winningTeams: readonly Team[];
   readonly winningPlayers: readonly string[];
   readonly eliminatedPlayers: readonly string[];
+  /** Role each player was dealt */
+  readonly originalRoles?: Record<string, RoleName>;
   readonly finalRoles: Record<string, RoleName>;
   readonly votes: Record<string, string>;
+  /** Every vote cast, with when it arrived */
+  readonly voteLog?: readonly { voterId: string; targetId: string; timestamp: number }[];
+  /** Who voted for whom (unless anonymous) and the votes each player received */
+  readonly voteBreakdown?: VoteBreakdown;
+  /** Which roles the reveal leads with */
+  readonly revealEmphasis?: 'original' | 'final';
+  /** Recommended order of reveal stages */
+  readonly revealSequence?: readonly ('originalRoles' | 'nightActions' | 'finalRoles')[];
+  /** Center cards as dealt */
+  readonly originalCenterCards?: readonly RoleName[];
+  /** Center cards at the end of the night */
+  readonly centerCards?: readonly RoleName[];
+  /** Every night action, in the order it happened */
+  readonly nightActions?: readonly NightActionSummary[];
+}
+
+export interface VoteBreakdown {
+  readonly anonymous: boolean;
+  readonly votes?: readonly { voterId: string; voterName: string; targetId: string; targetName: string }[];
+  readonly tally: readonly { playerId: string; playerName: string; votes: number }[];
+  readonly abstentions: number;
 }
 
 export interface NightActionSummary {
@@ -176,6 +252,7 @@ export interface NightActionSummary {
   readonly playerName: string;
   readonly roleName: RoleName;
   readonly description: string;
+  readonly timestamp?: number;
 }
 
 export interface SummaryStatement {
@@ -205,6 +282,13 @@ export interface RoleMetadata {
 }
 
 export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
+  [RoleName.SENTINEL]: {
+    name: RoleName.SENTINEL,
+    displayName: 'Sentinel',
+    team: Team.VILLAGE,
+    description: 'Shields one card so no one can move or look at it.',
+    nightActionDescription: 'Place a shield on another player\'s card.'
+  },
   [RoleName.DOPPELGANGER]: {
     name: RoleName.DOPPELGANGER,
     displayName: 'Doppelganger',
@@ -219,6 +303,20 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Sees other werewolves. If alone, may view one center card.',
     nightActionDescription: 'See other werewolves. If alone, view one center card.'
   },
+  [RoleName.ALPHA_WOLF]: {
+    name: RoleName.ALPHA_WOLF,
+    displayName: 'Alpha Wolf',
+    team: Team.WEREWOLF,
+    description: 'A werewolf who turns one other player into a werewolf.',
+    nightActionDescription: 'See the other werewolves, then give the center werewolf card to another player.'
+  },
+  [RoleName.MYSTIC_WOLF]: {
+    name: RoleName.MYSTIC_WOLF,
+    displayName: 'Mystic Wolf',
+    team: Team.WEREWOLF,
+    description: 'A werewolf who may also view one other player\'s card.',
+    nightActionDescription: 'See the other werewolves, then look at one other player\'s card.'
+  },
   [RoleName.MINION]: {
     name: RoleName.MINION,
     displayName: 'Minion',
@@ -226,6 +324,13 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Sees werewolves, but they don\'t see the minion.',
     nightActionDescription: 'See the werewolves. They don\'t know who you are.'
   },
+  [RoleName.SQUIRE]: {
+    name: RoleName.SQUIRE,
+    displayName: 'Squire',
+    team: Team.WEREWOLF,
+    description: 'Sees werewolves and wins only if none of them die.',
+    nightActionDescription: 'See the werewolves. They don\'t know who you are.'
+  },
   [RoleName.MASON]: {
     name: RoleName.MASON,
     displayName: 'Mason',
@@ -240,6 +345,20 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Views one player\'s card OR two center cards.',
     nightActionDescription: 'Look at one player\'s card, or two center cards.'
   },
+  [RoleName.BEHOLDER]: {
+    name: RoleName.BEHOLDER,
+    displayName: 'Beholder',
+    team: Team.VILLAGE,
+    description: 'Learns which player is the Seer.',
+    nightActionDescription: 'See which player is the Seer. The Seer doesn\'t see you.'
+  },
+  [RoleName.APPRENTICE_SEER]: {
+    name: RoleName.APPRENTICE_SEER,
+    displayName: 'Apprentice Seer',
+    team: Team.VILLAGE,
+    description: 'Views one center card.',
+    nightActionDescription: 'Look at one center card.'
+  },
   [RoleName.ROBBER]: {
     name: RoleName.ROBBER,
     displayName: 'Robber',
@@ -254,6 +373,13 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Swaps two other players\' cards without looking.',
     nightActionDescription: 'Swap two other players\' cards. You don\'t see what they are.'
   },
+  [RoleName.VILLAGE_IDIOT]: {
+    name: RoleName.VILLAGE_IDIOT,
+    displayName: 'Village Idiot',
+    team: Team.VILLAGE,
+    description: 'May move every player\'s card one seat left or right.',
+    nightActionDescription: 'Move every player\'s card one seat to the left or right, or leave them.'
+  },
   [RoleName.DRUNK]: {
     name: RoleName.DRUNK,
     displayName: 'Drunk',
@@ -261,6 +387,20 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Swaps card with center card without looking.',
     nightActionDescription: 'Swap your card with a center card. You don\'t know what you got.'
   },
+  [RoleName.REVEALER]: {
+    name: RoleName.REVEALER,
+    displayName: 'Revealer',
+    team: Team.VILLAGE,
+    description: 'Flips one player\'s card face up for the day.',
+    nightActionDescription: 'Flip another player\'s card. A Werewolf or Tanner goes back face down.'
+  },
+  [RoleName.CURATOR]: {
+    name: RoleName.CURATOR,
+    displayName: 'Curator',
+    team: Team.VILLAGE,
+    description: 'Places an artifact that hides one player\'s role until the end.',
+    nightActionDescription: 'Place an artifact on another player\'s card. Their role stays hidden until the end.'
+  },
   [RoleName.INSOMNIAC]: {
     name: RoleName.INSOMNIAC,
     displayName: 'Insomniac',
@@ -268,6 +408,12 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     description: 'Looks at own card at end of night.',
     nightActionDescription: 'Look at your card at the end of the night to see if it changed.'
   },
+  [RoleName.DREAM_WOLF]: {
+    name: RoleName.DREAM_WOLF,
+    displayName: 'Dream Wolf',
+    team: Team.WEREWOLF,
+    description: 'A werewolf who does not wake up. The other werewolves see them.'
+  },
   [RoleName.VILLAGER]: {
     name: RoleName.VILLAGER,
     displayName: 'Villager',
@@ -280,6 +426,12 @@ export const ROLE_METADATA: Record<RoleName, RoleMetadata> = {
     team: Team.VILLAGE,
     description: 'If killed, their vote target also dies.'
   },
+  [RoleName.PRINCE]: {
+    name: RoleName.PRINCE,
+    displayName: 'Prince',
+    team: Team.VILLAGE,
+    description: 'Cannot be killed by the vote. No one dies in their place.'
+  },
   [RoleName.TANNER]: {
     name: RoleName.TANNER,
     displayName: 'Tanner',
@@ -308,8 +460,12 @@ export interface ActionRequestBase {
   readonly requestId: string;
   readonly timeoutMs: number;
   readonly timestamp: number;
+  readonly canSkip?: boolean;
 }
 
+/** Night action response meaning "I pass", when the request allows it */
+export const SKIP_NIGHT_ACTION = 'SKIP';
+
 export interface SelectPlayerRequest extends ActionRequestBase {
   readonly actionType: 'selectPlayer';
   readonly options: readonly string[];
@@ -327,6 +483,12 @@ export interface SeerChoiceRequest extends ActionRequestBase {
   readonly options: readonly ('player' | 'center')[];
 }
 
+export interface RotationChoiceRequest extends ActionRequestBase {
+  readonly actionType: 'rotationChoice';
+  readonly options: readonly ('LEFT' | 'RIGHT' | 'NONE')[];
+  readonly reason: string;
+}
+
 export interface SelectTwoPlayersRequest extends ActionRequestBase {
   readonly actionType: 'selectTwoPlayers';
   readonly options: readonly string[];
@@ -337,11 +499,16 @@ export interface VoteRequest extends ActionRequestBase {
   readonly actionType: 'vote';
   readonly eligibleTargets: readonly string[];
   readonly allStatements: readonly PlayerStatement[];
+  readonly canAbstain?: boolean;
 }
 
+/** Vote response meaning "no one", when the request allows it */
+export const ABSTAIN_VOTE = 'ABSTAIN';
+
 export type ActionRequest =
   | SelectPlayerRequest
   | SelectCenterRequest
   | SeerChoiceRequest
+  | RotationChoiceRequest
   | SelectTwoPlayersRequest
   | VoteRequest;
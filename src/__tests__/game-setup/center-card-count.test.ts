@@ -0,0 +1,77 @@
+/**
+ * @fileoverview Configurable center card count tests.
+ * Some variants deal more or fewer than three cards to the center; the
+ * role list, dealing and center index checks all follow the setting.
+ */
+
+import { RoleName } from '../../enums';
+import { Game } from '../../core/Game';
+import { RoleFactory } from '../../patterns/factory';
+import { createTestGame, ROLE_CONFIGS } from '../setup/testUtils';
+
+const PLAYERS = ['Player1', 'Player2', 'Player3', 'Player4', 'Player5'];
+
+/** Five-player list with two center cards and no center-card roles */
+const TWO_CENTER_ROLES: RoleName[] = [
+  RoleName.WEREWOLF, RoleName.WEREWOLF,
+  RoleName.SEER, RoleName.ROBBER, RoleName.TROUBLEMAKER,
+  RoleName.VILLAGER, RoleName.VILLAGER
+];
+
+describe('Center card count', () => {
+  it('deals three center cards by default', () => {
+    const game = new Game({ players: PLAYERS, roles: ROLE_CONFIGS.STANDARD });
+
+    expect(game.getCenterCards()).toHaveLength(3);
+    expect(game.getCenterCardCount()).toBe(3);
+  });
+
+  it('plays a full game with two center cards', async () => {
+    const { game } = await createTestGame({
+      roles: TWO_CENTER_ROLES,
+      centerCardCount: 2,
+      defaultVoteTarget: 'player-2'
+    });
+
+    expect(game.getCenterCards()).toHaveLength(2);
+    expect(game.getAllNightResults().every(r => r.success)).toBe(true);
+  });
+
+  it('plays a full game with four center cards', async () => {
+    const { game } = await createTestGame({
+      roles: [...ROLE_CONFIGS.STANDARD, RoleName.VILLAGER],
+      centerCardCount: 4,
+      forcedRoles: new Map([[0, RoleName.DRUNK]]),
+      agentConfigs: new Map([[0, { selectCenterIndex: 3 }]]),
+      defaultVoteTarget: 'player-2'
+    });
+
+    expect(game.getCenterCards()).toHaveLength(4);
+    const drunkResult = game.getAllNightResults().find(r => r.roleName === RoleName.DRUNK);
+    expect(drunkResult?.success).toBe(true);
+  });
+
+  it('rejects a role list that does not match the setting', () => {
+    expect(() => new Game({
+      players: PLAYERS,
+      roles: ROLE_CONFIGS.STANDARD,
+      centerCardCount: 2
+    })).toThrow('Expected 7 roles for 5 players, got 8');
+  });
+
+  it('rejects a center card count below one', () => {
+    expect(() => new Game({
+      players: PLAYERS,
+      roles: ROLE_CONFIGS.STANDARD.slice(0, 5),
+      centerCardCount: 0
+    })).toThrow('Center card count must be a positive whole number, got 0');
+  });
+
+  it.each([2, 4])('sizes generated role lists for %i center cards', (centerCardCount) => {
+    const roles = RoleFactory.generateRoleList(5, centerCardCount);
+
+    expect(roles).toHaveLength(5 + centerCardCount);
+    expect(RoleFactory.validateSetup(roles, 5, centerCardCount).valid).toBe(true);
+    expect(RoleFactory.completeRoleSet([RoleName.SEER], 5, centerCardCount)).toHaveLength(5 + centerCardCount);
+  });
+});
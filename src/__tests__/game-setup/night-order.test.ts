@@ -0,0 +1,109 @@
+/**
+ * @fileoverview Custom night order tests.
+ * House variants move roles around the night; the host's order must
+ * still wake every night role once, and the game follows it.
+ */
+
+import { NIGHT_WAKE_ORDER, RoleName } from '../../enums';
+import { Game } from '../../core/Game';
+import { RoleFactory } from '../../patterns/factory';
+import { validateRoleConfig } from '../../server/Room';
+import { buildNightActionLog } from '../../server/NightActionLog';
+import { createTestGame, ROLE_CONFIGS } from '../setup/testUtils';
+
+const PLAYERS = ['Player1', 'Player2', 'Player3', 'Player4', 'Player5'];
+
+/** The standard order with the Robber moved ahead of the Seer */
+const ROBBER_FIRST: RoleName[] = [
+  RoleName.ROBBER,
+  ...NIGHT_WAKE_ORDER.filter(role => role !== RoleName.ROBBER)
+];
+
+/** Seer looks at the Robber, who robs a Villager */
+async function playSeerAndRobber(nightOrder?: RoleName[]) {
+  return createTestGame({
+    roles: ROLE_CONFIGS.STANDARD,
+    nightOrder,
+    forcedRoles: new Map([[0, RoleName.SEER], [1, RoleName.ROBBER], [2, RoleName.VILLAGER]]),
+    agentConfigs: new Map([
+      [0, { seerChoice: 'player' as const, selectPlayerTarget: 'player-2' }],
+      [1, { selectPlayerTarget: 'player-3' }]
+    ]),
+    defaultVoteTarget: 'player-4'
+  });
+}
+
+describe('Custom night order', () => {
+  it('wakes roles in the standard order by default', async () => {
+    const { game } = await playSeerAndRobber();
+
+    const seer = game.getAllNightResults().find(r => r.roleName === RoleName.SEER);
+    expect(game.getNightOrder()).toEqual(NIGHT_WAKE_ORDER);
+    expect(seer?.info.viewed).toEqual([{ playerId: 'player-2', role: RoleName.ROBBER }]);
+  });
+
+  it('wakes roles in the configured order', async () => {
+    const { game } = await playSeerAndRobber(ROBBER_FIRST);
+
+    // The Robber already took the Villager card when the Seer looks
+    const seer = game.getAllNightResults().find(r => r.roleName === RoleName.SEER);
+    expect(seer?.info.viewed).toEqual([{ playerId: 'player-2', role: RoleName.VILLAGER }]);
+  });
+
+  it('lists the night log in the configured order', async () => {
+    const { game } = await playSeerAndRobber(ROBBER_FIRST);
+
+    const log = buildNightActionLog(game.getAllNightResults(), new Map(), game.getNightOrder());
+    const roles = log.map(entry => entry.roleName);
+    expect(roles.indexOf(RoleName.ROBBER)).toBeLessThan(roles.indexOf(RoleName.SEER));
+  });
+
+  it('keeps the order through a snapshot', () => {
+    const game = new Game({ players: PLAYERS, roles: ROLE_CONFIGS.STANDARD, nightOrder: ROBBER_FIRST });
+
+    expect(Game.fromSnapshot(game.toSnapshot()).getNightOrder()).toEqual(ROBBER_FIRST);
+  });
+
+  describe('validation', () => {
+    it('accepts a reordering of the night roles', () => {
+      const mysticWolfLast = [...NIGHT_WAKE_ORDER.filter(role => role !== RoleName.MYSTIC_WOLF), RoleName.MYSTIC_WOLF];
+
+      expect(RoleFactory.validateNightOrder(mysticWolfLast)).toEqual({ valid: true, errors: [] });
+      expect(RoleFactory.validateNightOrder(ROBBER_FIRST)).toEqual({ valid: true, errors: [] });
+    });
+
+    it('wakes the Doppelganger before the Werewolves and Minion', () => {
+      const doppelgangerLast = [...NIGHT_WAKE_ORDER.filter(role => role !== RoleName.DOPPELGANGER), RoleName.DOPPELGANGER];
+
+      expect(RoleFactory.validateNightOrder(doppelgangerLast).errors)
+        .toEqual(['DOPPELGANGER must wake before WEREWOLF, ALPHA_WOLF, MYSTIC_WOLF, MINION, SQUIRE']);
+    });
+
+    it('rejects unknown roles', () => {
+      expect(RoleFactory.validateNightOrder([...NIGHT_WAKE_ORDER, 'WITCH']).errors)
+        .toEqual(['Unknown role WITCH in night order']);
+    });
+
+    it('rejects roles without a night action and repeats', () => {
+      expect(RoleFactory.validateNightOrder([...NIGHT_WAKE_ORDER, RoleName.VILLAGER, RoleName.SEER]).errors)
+        .toEqual([
+          'VILLAGER has no night action and cannot be in the night order',
+          'SEER appears more than once in the night order'
+        ]);
+    });
+
+    it('rejects an order that leaves out a night role', () => {
+      const order = NIGHT_WAKE_ORDER.filter(role => role !== RoleName.MYSTIC_WOLF && role !== RoleName.CURATOR);
+
+      expect(RoleFactory.validateNightOrder(order).errors)
+        .toEqual(['Night order is missing MYSTIC_WOLF, CURATOR']);
+      expect(() => new Game({ players: PLAYERS, roles: ROLE_CONFIGS.STANDARD, nightOrder: order }))
+        .toThrow('Night order is missing MYSTIC_WOLF, CURATOR');
+    });
+
+    it('checks the order in a room configuration', () => {
+      expect(validateRoleConfig({ roles: ROLE_CONFIGS.STANDARD, nightOrder: [RoleName.SEER] }))
+        .toEqual([`Night order is missing ${NIGHT_WAKE_ORDER.filter(r => r !== RoleName.SEER).join(', ')}`]);
+    });
+  });
+});
@@ -0,0 +1,43 @@
+/**
+ * @fileoverview Role catalog tests.
+ * The catalog must describe every role and agree with the wake order
+ * the night phase actually uses.
+ */
+
+import { NIGHT_WAKE_ORDER, RoleName, Team } from '../../enums';
+import { RoleFactory } from '../../patterns/factory';
+
+describe('Role Catalog', () => {
+  const catalog = RoleFactory.getRoleCatalog();
+
+  it('has one entry per role', () => {
+    expect(catalog.map(entry => entry.role).sort()).toEqual(RoleFactory.getAllRoleNames().sort());
+    for (const entry of catalog) {
+      expect(entry.displayName).not.toBe('');
+      expect(entry.description).not.toBe('');
+    }
+  });
+
+  it('orders night actions exactly as the night phase wakes roles', () => {
+    const waking = catalog
+      .filter(entry => entry.hasNightAction)
+      .sort((a, b) => a.nightOrder! - b.nightOrder!)
+      .map(entry => entry.role);
+
+    expect(waking).toEqual(NIGHT_WAKE_ORDER);
+  });
+
+  it('describes roles without a night action', () => {
+    const villager = catalog.find(entry => entry.role === RoleName.VILLAGER);
+
+    expect(villager).toMatchObject({ displayName: 'Villager', team: Team.VILLAGE, hasNightAction: false, nightOrder: null });
+  });
+
+  it('gives each role the team it wins with', () => {
+    const teamOf = (role: RoleName): Team | undefined => catalog.find(entry => entry.role === role)?.team;
+
+    expect(teamOf(RoleName.MINION)).toBe(Team.WEREWOLF);
+    expect(teamOf(RoleName.TANNER)).toBe(Team.TANNER);
+    expect(teamOf(RoleName.SEER)).toBe(Team.VILLAGE);
+  });
+});
@@ -0,0 +1,62 @@
+/**
+ * @fileoverview Partial role set completion tests.
+ * Covers the 'fill' role mode used when a host picks only some roles.
+ */
+
+import { RoleName } from '../../enums';
+import { RoleFactory } from '../../patterns/factory';
+import { createTestGame } from '../setup/testUtils';
+
+describe('Role Set Completion', () => {
+  describe('generateRoleList', () => {
+    it.each([3, 5, 7, 10])('returns players + 3 roles for %i players', (playerCount) => {
+      const roles = RoleFactory.generateRoleList(playerCount);
+      expect(roles).toHaveLength(playerCount + 3);
+      expect(RoleFactory.validateSetup(roles, playerCount).valid).toBe(true);
+    });
+  });
+
+  describe('completeRoleSet', () => {
+    it('fills a partial set to the correct size', () => {
+      const roles = RoleFactory.completeRoleSet([RoleName.SEER, RoleName.TANNER], 5);
+
+      expect(roles).toHaveLength(8);
+      expect(roles).toContain(RoleName.SEER);
+      expect(roles).toContain(RoleName.TANNER);
+      expect(RoleFactory.validateSetup(roles, 5).valid).toBe(true);
+    });
+
+    it('adds the standard werewolves when none were chosen', () => {
+      const roles = RoleFactory.completeRoleSet([RoleName.SEER], 5);
+      expect(roles.filter(r => r === RoleName.WEREWOLF)).toHaveLength(2);
+    });
+
+    it('keeps the host\'s choices instead of duplicating them', () => {
+      const roles = RoleFactory.completeRoleSet([RoleName.WEREWOLF, RoleName.SEER], 5);
+      expect(roles.filter(r => r === RoleName.WEREWOLF)).toHaveLength(2);
+      expect(roles.filter(r => r === RoleName.SEER)).toHaveLength(1);
+    });
+
+    it('completes a lone Mason with its partner', () => {
+      const roles = RoleFactory.completeRoleSet([RoleName.MASON], 5);
+      expect(roles.filter(r => r === RoleName.MASON)).toHaveLength(2);
+      expect(RoleFactory.validateSetup(roles, 5).valid).toBe(true);
+    });
+
+    it('leaves an already complete set unchanged', () => {
+      const full = RoleFactory.generateRoleList(5);
+      expect(RoleFactory.completeRoleSet(full, 5)).toEqual(full);
+    });
+
+    it('throws when the partial set is larger than the game', () => {
+      const tooMany = RoleFactory.generateRoleList(6);
+      expect(() => RoleFactory.completeRoleSet(tooMany, 5)).toThrow('Too many roles');
+    });
+
+    it('produces a set a game can be started with', async () => {
+      const roles = RoleFactory.completeRoleSet([RoleName.SEER, RoleName.ROBBER], 5);
+      const { result } = await createTestGame({ roles, defaultVoteTarget: 'player-1' });
+      expect(result.winningTeams.length).toBeGreaterThan(0);
+    });
+  });
+});
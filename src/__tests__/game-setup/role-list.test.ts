@@ -0,0 +1,61 @@
+/**
+ * @fileoverview Host role list validation tests.
+ * A custom role list must use known roles and a playable number of
+ * Werewolves, and match the player count once the game starts.
+ */
+
+import { RoleName } from '../../enums';
+import { RoleFactory } from '../../patterns/factory';
+
+/** Five-player list: 2 Werewolves and 6 village/neutral roles */
+const FIVE_PLAYER_ROLES: string[] = [
+  RoleName.WEREWOLF, RoleName.WEREWOLF,
+  RoleName.SEER, RoleName.ROBBER, RoleName.TROUBLEMAKER,
+  RoleName.VILLAGER, RoleName.VILLAGER, RoleName.TANNER
+];
+
+describe('Role List Validation', () => {
+  it('accepts a playable custom list, including a Tanner with no Minion', () => {
+    const result = RoleFactory.validateRoleList(FIVE_PLAYER_ROLES, { playerCount: 5 });
+
+    expect(result).toEqual({ valid: true, errors: [] });
+  });
+
+  it('names unknown roles', () => {
+    const { errors } = RoleFactory.validateRoleList([...FIVE_PLAYER_ROLES.slice(1), 'WITCH']);
+
+    expect(errors).toEqual(['Unknown role WITCH']);
+  });
+
+  it('requires at least one Werewolf', () => {
+    const noWolves = FIVE_PLAYER_ROLES.map(role => (role === RoleName.WEREWOLF ? RoleName.VILLAGER : role));
+
+    expect(RoleFactory.validateRoleList(noWolves).errors).toEqual([
+      'The role list needs at least one Werewolf'
+    ]);
+  });
+
+  it('caps the Werewolves at half the players, or two for small games', () => {
+    const threeWolves = [RoleName.WEREWOLF, ...FIVE_PLAYER_ROLES.slice(0, -1)];
+
+    expect(RoleFactory.validateRoleList(threeWolves, { playerCount: 5 }).errors).toEqual([
+      'Too many Werewolves for 5 players: 3 chosen, at most 2'
+    ]);
+
+    const tenPlayers = [...threeWolves, ...Array(5).fill(RoleName.VILLAGER)];
+    expect(RoleFactory.validateRoleList(tenPlayers, { playerCount: 10 }).valid).toBe(true);
+  });
+
+  it('requires exactly players + 3 roles once the player count is known', () => {
+    const { errors } = RoleFactory.validateRoleList(FIVE_PLAYER_ROLES, { playerCount: 4 });
+
+    expect(errors).toContain('Expected 7 roles for 4 players, got 8');
+  });
+
+  it('only checks role names for a partial list', () => {
+    expect(RoleFactory.validateRoleList([RoleName.TANNER], { partial: true }).valid).toBe(true);
+    expect(RoleFactory.validateRoleList([RoleName.TANNER, 'BODYGUARD'], { partial: true }).errors).toEqual([
+      'Unknown role BODYGUARD'
+    ]);
+  });
+});
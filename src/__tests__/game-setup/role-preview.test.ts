@@ -0,0 +1,42 @@
+/**
+ * @fileoverview Default role list preview tests.
+ * The preview must be exactly what a game of that size is dealt, and
+ * always a valid setup.
+ */
+
+import { RoleName } from '../../enums';
+import { RoleFactory } from '../../patterns/factory';
+
+describe('Role List Preview', () => {
+  it.each([3, 4, 5, 6, 7, 8, 9, 10, 12, 16])('previews a valid setup for %i players', (playerCount) => {
+    const preview = RoleFactory.previewRoleList(playerCount);
+
+    expect(preview.playerCount).toBe(playerCount);
+    expect(preview.roles).toHaveLength(playerCount + 3);
+    expect(RoleFactory.validateRoleList(preview.roles, { playerCount }).errors).toEqual([]);
+  });
+
+  it('matches what a room with no chosen roles deals', () => {
+    for (let playerCount = RoleFactory.MIN_PLAYERS; playerCount <= RoleFactory.MAX_PLAYERS; playerCount++) {
+      expect(RoleFactory.previewRoleList(playerCount).roles).toEqual(RoleFactory.completeRoleSet([], playerCount));
+    }
+  });
+
+  it('counts each role once, in the order it first appears', () => {
+    const { roles, counts } = RoleFactory.previewRoleList(5);
+
+    expect(counts[0]).toEqual({ role: RoleName.WEREWOLF, count: 2 });
+    expect(counts.reduce((total, c) => total + c.count, 0)).toBe(roles.length);
+    expect(new Set(counts.map(c => c.role)).size).toBe(counts.length);
+  });
+
+  it('never deals a lone Mason', () => {
+    const masons = RoleFactory.previewRoleList(6).roles.filter(r => r === RoleName.MASON);
+
+    expect([0, 2]).toContain(masons.length);
+  });
+
+  it.each([2, 17, 4.5, NaN])('rejects %p players', (playerCount) => {
+    expect(() => RoleFactory.previewRoleList(playerCount)).toThrow('Player count must be a whole number from 3 to 16');
+  });
+});
@@ -0,0 +1,86 @@
+/**
+ * @fileoverview Role table self-check tests.
+ * The startup check must pass for the shipped roles and catch a
+ * half-added, duplicated or mistyped role.
+ */
+
+import { RoleName, Team } from '../../enums';
+import { RoleFactory, RoleTable } from '../../patterns/factory';
+
+/** Copy of the live table with some entries replaced */
+function tableWith(overrides: Partial<RoleTable>): RoleTable {
+  return { ...RoleFactory.getRoleTable(), ...overrides };
+}
+
+describe('Role Table Validation', () => {
+  it('accepts the shipped role table', () => {
+    const result = RoleFactory.validateRoleTable();
+
+    expect(result.errors).toEqual([]);
+    expect(() => RoleFactory.assertValidRoleTable()).not.toThrow();
+  });
+
+  it('rejects duplicate and empty role constants', () => {
+    const table = tableWith({
+      roleNames: [...RoleFactory.getAllRoleNames(), RoleName.SEER, '']
+    });
+
+    const { errors } = RoleFactory.validateRoleTable(table);
+
+    expect(errors).toContain('Role SEER is defined more than once');
+    expect(errors).toContain('Role constant has an empty value');
+  });
+
+  it('rejects a half-added role with no team, night order or action', () => {
+    const table = tableWith({
+      roleNames: [...RoleFactory.getAllRoleNames(), 'WITCH']
+    });
+
+    const { errors } = RoleFactory.validateRoleTable(table);
+
+    expect(errors).toContain('Role WITCH has no valid team');
+    expect(errors).toContain('Role WITCH has no night order');
+  });
+
+  it('rejects a waking role missing from the wake order or registry', () => {
+    const base = RoleFactory.getRoleTable();
+    const table = tableWith({
+      wakeOrder: base.wakeOrder.filter(r => r !== RoleName.DRUNK),
+      actionRoles: base.actionRoles.filter(r => r !== RoleName.SEER)
+    });
+
+    const { errors } = RoleFactory.validateRoleTable(table);
+
+    expect(errors).toContain(
+      `Role DRUNK wakes at ${base.nightOrders[RoleName.DRUNK]} but appears 0 times in the wake order`
+    );
+    expect(errors).toContain('Role SEER wakes but has no registered night action');
+  });
+
+  it('rejects a mistyped role in the team table and an out-of-order wake list', () => {
+    const base = RoleFactory.getRoleTable();
+    const troublemakerOrder = base.nightOrders[RoleName.TROUBLEMAKER];
+    const table = tableWith({
+      teams: { ...base.teams, SEEER: Team.VILLAGE },
+      nightOrders: { ...base.nightOrders, [RoleName.ROBBER]: troublemakerOrder }
+    });
+
+    const { errors } = RoleFactory.validateRoleTable(table);
+
+    expect(errors).toContain('Unknown role SEEER in role table');
+    expect(errors).toContain(
+      `Wake order puts ROBBER (${troublemakerOrder}) before TROUBLEMAKER (${troublemakerOrder})`
+    );
+  });
+
+  it('fails fast with every problem listed', () => {
+    const table = tableWith({
+      roleNames: [...RoleFactory.getAllRoleNames(), RoleName.WEREWOLF],
+      teams: { ...RoleFactory.getRoleTable().teams, [RoleName.TANNER]: undefined }
+    });
+
+    expect(() => RoleFactory.assertValidRoleTable(table)).toThrow(/^Inconsistent role table/);
+    expect(() => RoleFactory.assertValidRoleTable(table)).toThrow('Role WEREWOLF is defined more than once');
+    expect(() => RoleFactory.assertValidRoleTable(table)).toThrow('Role TANNER has no valid team');
+  });
+});
@@ -0,0 +1,87 @@
+/**
+ * @fileoverview Server-side action deadline tests.
+ * An answer that arrives after the request's window has closed must be
+ * refused, even if the timeout timer has not fired yet.
+ */
+
+import { RoleName } from '../../enums';
+import { ErrorCodes } from '../../network/protocol';
+import { NetworkAgent } from '../../server/NetworkAgent';
+import { MockConnection } from '../setup/MockConnection';
+
+const NIGHT_CONTEXT = {
+  myPlayerId: 'player-1',
+  myStartingRole: RoleName.SEER,
+  allPlayerIds: ['player-1', 'player-2', 'player-3'],
+  rolesInGame: [RoleName.SEER, RoleName.WEREWOLF],
+  previousResults: [],
+  centerCardCount: 3
+};
+
+const WINDOW_MS = 60000;
+
+/** Sends a selectPlayer request and returns its pending answer and ID */
+function requestTarget(agent: NetworkAgent, connection: MockConnection): {
+  pending: Promise<string>;
+  requestId: string;
+} {
+  const pending = agent.selectPlayer(['player-2', 'player-3'], NIGHT_CONTEXT);
+  const [request] = connection.sentOfType('actionRequired');
+  return { pending, requestId: request.request.requestId };
+}
+
+function answer(connection: MockConnection, requestId: string): void {
+  connection.receive({ type: 'actionResponse', requestId, response: 'player-2', timestamp: Date.now() });
+}
+
+describe('Action deadlines', () => {
+  let connection: MockConnection;
+  let agent: NetworkAgent;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.setSystemTime(new Date('2024-01-01T00:00:00Z'));
+    connection = new MockConnection('conn-1');
+    agent = new NetworkAgent('player-1', connection);
+  });
+
+  afterEach(() => {
+    agent.dispose();
+    jest.useRealTimers();
+  });
+
+  it('accepts an answer inside the window', async () => {
+    const { pending, requestId } = requestTarget(agent, connection);
+
+    jest.setSystemTime(Date.now() + WINDOW_MS - 1);
+    answer(connection, requestId);
+
+    await expect(pending).resolves.toBe('player-2');
+    expect(connection.sentOfType('error')).toHaveLength(0);
+  });
+
+  it('refuses an answer just after the window closes, before the timer fires', async () => {
+    const { pending, requestId } = requestTarget(agent, connection);
+
+    // Clock moves past the deadline but the timeout callback hasn't run
+    jest.setSystemTime(Date.now() + WINDOW_MS + 1);
+    answer(connection, requestId);
+
+    await expect(pending).rejects.toThrow('timed out');
+    const [error] = connection.sentOfType('error');
+    expect(error.code).toBe(ErrorCodes.ACTION_TIMEOUT);
+    expect(error.message).toBe('Your turn has ended');
+  });
+
+  it('tells a player whose answer arrives after the timeout that their turn ended', async () => {
+    const { pending, requestId } = requestTarget(agent, connection);
+
+    jest.advanceTimersByTime(WINDOW_MS);
+    await expect(pending).rejects.toThrow('timed out');
+
+    answer(connection, requestId);
+
+    const [error] = connection.sentOfType('error');
+    expect(error.code).toBe(ErrorCodes.ACTION_TIMEOUT);
+  });
+});
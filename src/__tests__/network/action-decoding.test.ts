@@ -0,0 +1,118 @@
+/**
+ * @fileoverview Decode-time validation of action targets.
+ * Malformed multi-target payloads must be rejected before they reach a night action.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game } from '../../core/Game';
+import { CommandHandler } from '../../server/CommandHandler';
+import { NetworkAgent } from '../../server/NetworkAgent';
+import { NetworkCommandFactory, validateResponseShape, validateTargetList } from '../../patterns/command';
+import { MockConnection } from '../setup/MockConnection';
+
+const NIGHT_CONTEXT = {
+  myPlayerId: 'player-1',
+  myStartingRole: RoleName.TROUBLEMAKER,
+  allPlayerIds: ['player-1', 'player-2', 'player-3'],
+  rolesInGame: [RoleName.TROUBLEMAKER, RoleName.WEREWOLF],
+  previousResults: [],
+  centerCardCount: 3
+};
+
+describe('Action target decoding', () => {
+  describe('validateTargetList', () => {
+    it('accepts an array of player IDs', () => {
+      expect(validateTargetList(['player-2', 'player-3'], 2).valid).toBe(true);
+    });
+
+    it.each([
+      ['a string', 'player-2'],
+      ['an object', { 0: 'player-2', 1: 'player-3' }],
+      ['null', null],
+      ['undefined', undefined]
+    ])('rejects %s', (_label, value) => {
+      const result = validateTargetList(value, 2);
+      expect(result.valid).toBe(false);
+      expect(result.error).toMatch(/array/);
+    });
+
+    it('rejects non-string entries', () => {
+      expect(validateTargetList(['player-2', 3], 2).valid).toBe(false);
+    });
+  });
+
+  describe('validateResponseShape', () => {
+    it.each(['selectTwoCenter', 'selectTwoCenters'])('checks %s answers as two center indices', actionType => {
+      expect(validateResponseShape(actionType, [0, 2]).valid).toBe(true);
+      expect(validateResponseShape(actionType, 0).valid).toBe(false);
+    });
+
+    it('accepts only known rotation directions', () => {
+      expect(validateResponseShape('rotationChoice', 'LEFT').valid).toBe(true);
+      expect(validateResponseShape('rotationChoice', 'UP').valid).toBe(false);
+    });
+
+    it('leaves single-value actions to their commands', () => {
+      expect(validateResponseShape('selectPlayer', 'player-2').valid).toBe(true);
+    });
+  });
+
+  describe('CommandHandler (WebSocket decoder)', () => {
+    const game = { getPhase: () => GamePhase.NIGHT } as unknown as Game;
+
+    it('rejects a non-array targets response', () => {
+      const handler = new CommandHandler();
+      const result = handler.processActionResponse('player-1', 'selectTwoPlayers', 'player-2', game);
+
+      expect(result.success).toBe(false);
+      expect(result.error).toBe('Targets must be an array of player IDs.');
+    });
+
+    it('accepts a well-formed targets response', () => {
+      const handler = new CommandHandler();
+      const result = handler.processActionResponse(
+        'player-1', 'selectTwoPlayers', ['player-2', 'player-3'], game
+      );
+
+      expect(result.success).toBe(true);
+      expect(result.value).toEqual(['player-2', 'player-3']);
+    });
+  });
+
+  describe('NetworkCommandFactory (serialized command decoder)', () => {
+    it('throws on a non-array targets payload', () => {
+      expect(() => NetworkCommandFactory.deserialize({
+        type: 'selectTwoPlayers',
+        commandId: 'cmd-1',
+        playerId: 'player-1',
+        gameId: 'game-1',
+        timestamp: Date.now(),
+        payload: { targets: 'player-2' }
+      })).toThrow('Targets must be an array of player IDs.');
+    });
+  });
+
+  describe('NetworkAgent', () => {
+    it('replies with an error and keeps waiting when targets is not an array', async () => {
+      const connection = new MockConnection('conn-1');
+      const agent = new NetworkAgent('player-1', connection, true);
+
+      const pending = agent.selectTwoPlayers(['player-2', 'player-3'], NIGHT_CONTEXT);
+      const [request] = connection.sentOfType('actionRequired');
+      const requestId = request.request.requestId;
+
+      connection.receive({ type: 'actionResponse', requestId, response: 'player-2', timestamp: Date.now() });
+      expect(connection.sentOfType('error')).toHaveLength(1);
+
+      connection.receive({
+        type: 'actionResponse',
+        requestId,
+        response: ['player-2', 'player-3'],
+        timestamp: Date.now()
+      });
+      await expect(pending).resolves.toEqual(['player-2', 'player-3']);
+
+      agent.dispose();
+    });
+  });
+});
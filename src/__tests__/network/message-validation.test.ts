@@ -0,0 +1,98 @@
+/**
+ * @fileoverview Client message validation tests.
+ * A message the server can't use is answered with an INVALID_MESSAGE
+ * error saying what was wrong, rather than dropped silently, and never
+ * reaches the handlers.
+ */
+
+import { ErrorCodes, ServerMessage, validateClientMessage } from '../../network/protocol';
+import { WebSocketConnection } from '../../network/WebSocketConnection';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+
+describe('validateClientMessage', () => {
+  it('accepts a well-formed message', () => {
+    expect(validateClientMessage({ type: 'setReady', ready: true, timestamp: 1 })).toBeNull();
+    expect(validateClientMessage({ type: 'actionResponse', requestId: 'req-1', response: null })).toBeNull();
+  });
+
+  it.each([
+    [null, 'Message must be a JSON object'],
+    [['setReady'], 'Message must be a JSON object'],
+    [{ ready: true }, 'Message has no type'],
+    [{ type: 'castSpell' }, 'Unknown message type: castSpell'],
+    [{ type: 'setReady', ready: 'yes' }, 'Malformed setReady message: ready must be true or false'],
+    [{ type: 'actionResponse', requestId: 7, response: 'player-2' }, 'Malformed actionResponse message: requestId must be a string'],
+    [{ type: 'actionResponse', requestId: 'req-1' }, 'Malformed actionResponse message: response must be present'],
+    [{ type: 'createRoom', config: [] }, 'Malformed createRoom message: config must be an object']
+  ])('rejects %p', (message, problem) => {
+    expect(validateClientMessage(message)).toBe(problem);
+  });
+});
+
+describe('WebSocketConnection message validation', () => {
+  let socket: FakeWebSocket;
+  let connection: WebSocketConnection;
+  let handler: jest.Mock;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.spyOn(console, 'warn').mockImplementation(() => {});
+    socket = new FakeWebSocket();
+    connection = new WebSocketConnection('conn-1', socket);
+    handler = jest.fn();
+    connection.onMessage(handler);
+  });
+
+  afterEach(() => {
+    connection.close();
+    jest.useRealTimers();
+    jest.restoreAllMocks();
+  });
+
+  /** Errors the client has been sent so far */
+  function errors(): Extract<ServerMessage, { type: 'error' }>[] {
+    jest.advanceTimersByTime(0);
+    return socket.sent
+      .map(data => JSON.parse(data) as ServerMessage)
+      .filter((m): m is Extract<ServerMessage, { type: 'error' }> => m.type === 'error');
+  }
+
+  it('answers an unknown type with an error naming it', () => {
+    socket.receive({ type: 'castSpell', timestamp: Date.now() });
+
+    expect(handler).not.toHaveBeenCalled();
+    expect(errors()).toEqual([expect.objectContaining({
+      code: ErrorCodes.INVALID_MESSAGE,
+      message: 'Unknown message type: castSpell',
+      details: { type: 'castSpell' }
+    })]);
+  });
+
+  it('refuses an action response that is missing its request', () => {
+    socket.receive({ type: 'actionResponse', response: 'player-2', timestamp: Date.now() });
+
+    expect(handler).not.toHaveBeenCalled();
+    expect(errors()).toEqual([expect.objectContaining({
+      code: ErrorCodes.INVALID_MESSAGE,
+      message: 'Malformed actionResponse message: requestId must be a string',
+      details: { type: 'actionResponse' }
+    })]);
+  });
+
+  it('answers text that is not JSON', () => {
+    socket.receive('{"type": "setReady"');
+
+    expect(handler).not.toHaveBeenCalled();
+    expect(errors()).toEqual([expect.objectContaining({
+      code: ErrorCodes.INVALID_MESSAGE,
+      message: 'Message is not valid JSON'
+    })]);
+  });
+
+  it('passes a well-formed message on without an error', () => {
+    socket.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+
+    expect(handler).toHaveBeenCalledWith(expect.objectContaining({ type: 'setReady', ready: true }));
+    expect(errors()).toEqual([]);
+  });
+});
@@ -0,0 +1,132 @@
+/**
+ * @fileoverview Phase guard for night actions.
+ * A night action that arrives after the night has ended (e.g. a late or
+ * replayed response during voting) must be refused, not applied. Live
+ * answers reach the game through NetworkAgent, so the guard is tested
+ * there through a socket as well as on the command classes.
+ */
+
+import { GamePhase } from '../../enums';
+import { Game } from '../../core/Game';
+import { ErrorCodes, RoomConfig, ServerMessage } from '../../network/protocol';
+import { WebSocketConnection } from '../../network/WebSocketConnection';
+import { CommandHandler } from '../../server/CommandHandler';
+import { RoomManager } from '../../server/RoomManager';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+const PLAYERS = ['host', 'alice', 'bob', 'carol', 'dave'];
+
+/** Messages of one type the server has sent on a socket */
+function sentOfType<T extends ServerMessage['type']>(
+  socket: FakeWebSocket,
+  type: T
+): Extract<ServerMessage, { type: T }>[] {
+  return socket.sent
+    .map(data => JSON.parse(data) as ServerMessage)
+    .filter((message): message is Extract<ServerMessage, { type: T }> => message.type === type);
+}
+
+/** Minimal game stub reporting the given phase */
+function gameIn(phase: GamePhase): Game {
+  return { getPhase: () => phase } as unknown as Game;
+}
+
+describe('Night phase guard', () => {
+  it.each([
+    ['selectPlayer', 'player-2'],
+    ['selectCenter', 0],
+    ['selectTwoCenters', [0, 1]],
+    ['selectTwoPlayers', ['player-2', 'player-3']],
+    ['seerChoice', 'center']
+  ])('refuses %s during the Voting phase', (actionType, response) => {
+    const handler = new CommandHandler();
+
+    const result = handler.processActionResponse(
+      'player-1', actionType, response, gameIn(GamePhase.VOTING)
+    );
+
+    expect(result.success).toBe(false);
+    expect(result.error).toBe('Night actions can only be taken during the Night phase.');
+  });
+
+  it('accepts the same night action during the Night phase', () => {
+    const handler = new CommandHandler();
+
+    const result = handler.processActionResponse(
+      'player-1', 'selectPlayer', 'player-2', gameIn(GamePhase.NIGHT)
+    );
+
+    expect(result.success).toBe(true);
+    expect(result.value).toBe('player-2');
+  });
+});
+
+describe('Night phase guard on live answers', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.restoreAllMocks();
+  });
+
+  it('refuses a night answer sent over the socket during the Voting phase', async () => {
+    const room = manager.createRoom('host', CONFIG);
+    const sockets = new Map<string, FakeWebSocket>();
+    for (const id of PLAYERS) {
+      const socket = new FakeWebSocket();
+      sockets.set(id, socket);
+      room.addPlayer(id, id, new WebSocketConnection(`conn-${id}`, socket));
+      if (id !== 'host') {
+        room.setPlayerReady(id, true);
+      }
+    }
+    const game = room.startGame('host');
+
+    // Four night roles and only three center cards: someone is asked to act
+    let asked: { playerId: string; socket: FakeWebSocket; requestId: string; options: readonly unknown[] } | null = null;
+    for (let waited = 0; !asked && waited < 5000; waited += 10) {
+      await new Promise(resolve => setTimeout(resolve, 10));
+      for (const [playerId, socket] of sockets) {
+        const [message] = sentOfType(socket, 'actionRequired');
+        if (message) {
+          const options = 'options' in message.request ? (message.request.options as readonly unknown[]) : [];
+          asked = { playerId, socket, requestId: message.request.requestId, options };
+          break;
+        }
+      }
+    }
+    expect(asked).not.toBeNull();
+
+    jest.spyOn(game, 'getPhase').mockReturnValue(GamePhase.VOTING);
+    asked!.socket.receive({
+      type: 'actionResponse',
+      requestId: asked!.requestId,
+      response: asked!.options[0] ?? 0,
+      timestamp: Date.now()
+    });
+    await new Promise(resolve => setTimeout(resolve, 10));
+
+    const errors = sentOfType(asked!.socket, 'error');
+    expect(errors).toEqual([expect.objectContaining({
+      code: ErrorCodes.INVALID_PHASE,
+      message: 'Night actions can only be taken during the Night phase',
+      details: { requestId: asked!.requestId }
+    })]);
+    const actorId = room.getGamePlayerId(asked!.playerId);
+    expect(game.getAllNightResults().filter(r => r.actorId === actorId)).toEqual([]);
+  }, 10000);
+});
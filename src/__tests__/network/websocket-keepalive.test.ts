@@ -0,0 +1,66 @@
+/**
+ * @fileoverview WebSocket keepalive tests.
+ * A client that stops answering pings must be dropped once the read
+ * deadline passes, so a dead TCP connection can't hold up the game.
+ */
+
+import { WebSocketConnection } from '../../network/WebSocketConnection';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+
+const PING_MS = 1000;
+const PONG_MS = 500;
+
+describe('WebSocketConnection keepalive', () => {
+  let socket: FakeWebSocket;
+  let connection: WebSocketConnection;
+  let disconnectReason: string | null;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.spyOn(console, 'warn').mockImplementation(() => {});
+    socket = new FakeWebSocket();
+    connection = new WebSocketConnection('conn-1', socket, {
+      pingIntervalMs: PING_MS,
+      pongTimeoutMs: PONG_MS
+    });
+    disconnectReason = null;
+    connection.onDisconnect((reason) => { disconnectReason = reason; });
+  });
+
+  afterEach(() => {
+    connection.close();
+    jest.useRealTimers();
+    jest.restoreAllMocks();
+  });
+
+  it('sends a ping frame every interval', () => {
+    jest.advanceTimersByTime(PING_MS * 3);
+    expect(socket.pings).toBe(3);
+  });
+
+  it('drops a client that goes silent past the read deadline', () => {
+    jest.advanceTimersByTime(PING_MS + PONG_MS - 1);
+    expect(connection.isConnected()).toBe(true);
+
+    jest.advanceTimersByTime(1);
+    expect(connection.isConnected()).toBe(false);
+    expect(disconnectReason).toBe('Read deadline exceeded');
+  });
+
+  it('keeps a client that answers pings', () => {
+    for (let i = 0; i < 5; i++) {
+      jest.advanceTimersByTime(PING_MS);
+      socket.pong();
+    }
+    expect(connection.isConnected()).toBe(true);
+    expect(disconnectReason).toBeNull();
+  });
+
+  it('counts any client message as a sign of life', () => {
+    for (let i = 0; i < 5; i++) {
+      jest.advanceTimersByTime(PING_MS);
+      socket.receive({ type: 'pong', timestamp: Date.now() });
+    }
+    expect(connection.isConnected()).toBe(true);
+  });
+});
@@ -0,0 +1,119 @@
+/**
+ * @fileoverview WebSocket size, send queue and write deadline tests.
+ * Oversized client messages must be refused, and a client that stops
+ * reading must be dropped rather than left to pile up broadcasts.
+ */
+
+import { GamePhase } from '../../enums';
+import { PHASE_DESCRIPTIONS, ServerMessage } from '../../network/protocol';
+import { WebSocketConnection } from '../../network/WebSocketConnection';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+
+const WRITE_MS = 1000;
+
+function phaseChange(): ServerMessage {
+  return {
+    type: 'phaseChange',
+    phase: GamePhase.DAY,
+    description: PHASE_DESCRIPTIONS[GamePhase.DAY],
+    timeRemaining: null,
+    timestamp: Date.now()
+  };
+}
+
+describe('WebSocketConnection limits', () => {
+  let socket: FakeWebSocket;
+  let connection: WebSocketConnection;
+  let disconnectReason: string | null;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.spyOn(console, 'warn').mockImplementation(() => {});
+    socket = new FakeWebSocket();
+    connection = new WebSocketConnection('conn-1', socket, {
+      maxMessageSize: 100,
+      writeTimeoutMs: WRITE_MS,
+      sendBufferSize: 3
+    });
+    disconnectReason = null;
+    connection.onDisconnect((reason) => { disconnectReason = reason; });
+  });
+
+  afterEach(() => {
+    connection.close();
+    jest.useRealTimers();
+    jest.restoreAllMocks();
+  });
+
+  it('closes the connection on an oversized message', () => {
+    const handler = jest.fn();
+    connection.onMessage(handler);
+
+    socket.receive({ type: 'submitStatement', statement: 'x'.repeat(200), timestamp: Date.now() });
+
+    expect(handler).not.toHaveBeenCalled();
+    expect(disconnectReason).toBe('Message too large');
+  });
+
+  it('measures the message size in bytes, not characters', () => {
+    const handler = jest.fn();
+    connection.onMessage(handler);
+
+    // 15 three-byte characters: under 100 characters, over 100 bytes
+    socket.receive({ type: 'submitStatement', statement: '\u20ac'.repeat(15), timestamp: Date.now() });
+
+    expect(handler).not.toHaveBeenCalled();
+    expect(disconnectReason).toBe('Message too large');
+  });
+
+  it('drops a client whose queued data stops draining', () => {
+    socket.bufferedAmount = 500;
+    connection.send(phaseChange());
+
+    jest.advanceTimersByTime(WRITE_MS - 1);
+    expect(connection.isConnected()).toBe(true);
+
+    jest.advanceTimersByTime(1);
+    expect(disconnectReason).toBe('Write deadline exceeded');
+  });
+
+  it('keeps a client whose queued data is draining', () => {
+    socket.bufferedAmount = 500;
+    connection.send(phaseChange());
+
+    jest.advanceTimersByTime(WRITE_MS / 2);
+    socket.bufferedAmount = 200;
+    jest.advanceTimersByTime(WRITE_MS / 2);
+    socket.bufferedAmount = 0;
+    jest.advanceTimersByTime(WRITE_MS);
+
+    expect(connection.isConnected()).toBe(true);
+    expect(disconnectReason).toBeNull();
+  });
+
+  it('queues sends and writes them out afterwards', () => {
+    connection.send(phaseChange());
+    connection.send(phaseChange());
+    expect(socket.sent).toHaveLength(0);
+
+    jest.advanceTimersByTime(0);
+    expect(socket.sent).toHaveLength(2);
+  });
+
+  it('drops a client whose send queue fills up', () => {
+    socket.bufferedAmount = 500;
+    for (let i = 0; i < 4; i++) {
+      connection.send(phaseChange());
+    }
+
+    expect(disconnectReason).toBe('Send buffer full');
+    expect(socket.sent).toHaveLength(0);
+  });
+
+  it('writes out queued messages before closing', () => {
+    connection.send(phaseChange());
+    connection.close('Room closed');
+
+    expect(socket.sent).toHaveLength(1);
+  });
+});
@@ -0,0 +1,117 @@
+/**
+ * @fileoverview Night action failure code tests.
+ * Rejected input (bad target selection) must be distinguishable from
+ * actions that were valid but could not take effect.
+ */
+
+import { RoleName } from '../../enums';
+import { RobberAction, TroublemakerAction } from '../../patterns/strategy';
+import { getNightActionFailureKind } from '../../types';
+import {
+  createNightActionAgent,
+  createNightActionContext,
+  createNightActionGameState
+} from '../setup/nightActionFakes';
+
+describe('Night Action Failure Codes', () => {
+  it('rejects a Troublemaker selection with the wrong number of targets', async () => {
+    const gameState = createNightActionGameState();
+    const agent = createNightActionAgent({
+      selectTwoPlayers: async () => ['player-2'] as unknown as [string, string]
+    });
+
+    const result = await new TroublemakerAction().execute(
+      createNightActionContext(RoleName.TROUBLEMAKER),
+      agent,
+      gameState
+    );
+
+    expect(result.success).toBe(false);
+    expect(result.failureCode).toBe('WRONG_TARGET_COUNT');
+    expect(result.failureKind).toBe('rejected');
+    expect(gameState.swapCards).not.toHaveBeenCalled();
+  });
+
+  it('rejects a Troublemaker swapping their own card', async () => {
+    const agent = createNightActionAgent({
+      selectTwoPlayers: async () => ['player-1', 'player-2']
+    });
+
+    const result = await new TroublemakerAction().execute(
+      createNightActionContext(RoleName.TROUBLEMAKER),
+      agent,
+      createNightActionGameState()
+    );
+
+    expect(result.failureCode).toBe('SELF_TARGET');
+    expect(result.failureKind).toBe('rejected');
+  });
+
+  it('rejects a Troublemaker choosing the same player twice', async () => {
+    const gameState = createNightActionGameState();
+    const agent = createNightActionAgent({
+      selectTwoPlayers: async () => ['player-2', 'player-2']
+    });
+
+    const result = await new TroublemakerAction().execute(
+      createNightActionContext(RoleName.TROUBLEMAKER),
+      agent,
+      gameState
+    );
+
+    expect(result.success).toBe(false);
+    expect(result.failureCode).toBe('DUPLICATE_TARGET');
+    expect(result.failureKind).toBe('rejected');
+    expect(gameState.swapCards).not.toHaveBeenCalled();
+  });
+
+  it('swaps two different players chosen by a Troublemaker', async () => {
+    const gameState = createNightActionGameState();
+    const agent = createNightActionAgent({
+      selectTwoPlayers: async () => ['player-3', 'player-2']
+    });
+
+    const result = await new TroublemakerAction().execute(
+      createNightActionContext(RoleName.TROUBLEMAKER),
+      agent,
+      gameState
+    );
+
+    expect(result.success).toBe(true);
+    expect(result.info.swapped).toEqual({
+      from: { playerId: 'player-3' },
+      to: { playerId: 'player-2' }
+    });
+    expect(gameState.swapCards).toHaveBeenCalledTimes(1);
+    expect(gameState.swapCards).toHaveBeenCalledWith({ playerId: 'player-3' }, { playerId: 'player-2' });
+  });
+
+  it('reports a Robber with nobody to rob as failed, not rejected', async () => {
+    const result = await new RobberAction().execute(
+      createNightActionContext(RoleName.ROBBER, { allPlayerIds: ['player-1'] }),
+      createNightActionAgent(),
+      createNightActionGameState()
+    );
+
+    expect(result.success).toBe(false);
+    expect(result.failureCode).toBe('NO_VALID_TARGETS');
+    expect(result.failureKind).toBe('failed');
+  });
+
+  it('classifies a shielded target as a failure', () => {
+    expect(getNightActionFailureKind('TARGET_SHIELDED')).toBe('failed');
+    expect(getNightActionFailureKind('WRONG_TARGET_COUNT')).toBe('rejected');
+  });
+
+  it('leaves failure fields unset on success', async () => {
+    const result = await new TroublemakerAction().execute(
+      createNightActionContext(RoleName.TROUBLEMAKER),
+      createNightActionAgent(),
+      createNightActionGameState()
+    );
+
+    expect(result.success).toBe(true);
+    expect(result.failureCode).toBeUndefined();
+    expect(result.failureKind).toBeUndefined();
+  });
+});
@@ -0,0 +1,198 @@
+/**
+ * @fileoverview Alpha Wolf role tests.
+ * The Alpha Wolf gives the Werewolf card set aside in the center to a
+ * player outside the pack, who then wins and loses as a Werewolf
+ * without being told.
+ */
+
+import { RoleName, Team } from '../../enums';
+import { Game } from '../../core/Game';
+import { RoleFactory } from '../../patterns/factory';
+import {
+  createTestGame,
+  teamWon,
+  playerWon,
+  playerEliminated,
+  getFinalRole,
+} from '../setup/testUtils';
+
+describe('Alpha Wolf Role Tests', () => {
+  // 5 players + 3 center cards + the Werewolf set aside for the Alpha Wolf
+  const ALPHA_WOLF_ROLES = [
+    RoleName.ALPHA_WOLF, RoleName.WEREWOLF, RoleName.SEER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.ALPHA_WOLF],
+    [1, RoleName.SEER]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('AW1: Alpha Wolf should swap the center Werewolf onto the chosen player', async () => {
+      let alphaNightInfo: any = null;
+      let seerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-2',
+          onNightInfo: (info: any) => { alphaNightInfo = info; }
+        }],
+        [1, { onNightInfo: (info: any) => { seerNightInfo = info; } }]
+      ]);
+
+      const { game, result } = await createTestGame({
+        roles: ALPHA_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(alphaNightInfo.roleName).toBe(RoleName.ALPHA_WOLF);
+      expect(alphaNightInfo.success).toBe(true);
+      expect(alphaNightInfo.info.werewolves).toEqual([]);
+      expect(alphaNightInfo.info.turned).toBe('player-2');
+
+      expect(getFinalRole(result, 'player-2')).toBe(RoleName.WEREWOLF);
+      expect(game.getAlphaWolfCard()).toBe(RoleName.SEER);
+
+      // The Seer woke after being turned but still acted as the Seer
+      expect(seerNightInfo.roleName).toBe(RoleName.SEER);
+      expect(seerNightInfo.success).toBe(true);
+    });
+
+    it('AW2: Alpha Wolf should not be able to turn a member of the pack', async () => {
+      let alphaNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-2', // The other Werewolf - not offered
+          onNightInfo: (info: any) => { alphaNightInfo = info; }
+        }]
+      ]);
+
+      const roles = [
+        RoleName.ALPHA_WOLF, RoleName.WEREWOLF, RoleName.WEREWOLF,
+        RoleName.SEER, RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      const { result } = await createTestGame({
+        roles,
+        forcedRoles: new Map([
+          [0, RoleName.ALPHA_WOLF],
+          [1, RoleName.WEREWOLF]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(alphaNightInfo.info.werewolves).toEqual(['player-2']);
+      expect(alphaNightInfo.info.turned).toBe('player-3');
+      expect(getFinalRole(result, 'player-2')).toBe(RoleName.WEREWOLF);
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.WEREWOLF);
+    });
+
+    it('AW3: Alpha Wolf should not be able to turn a shielded player', async () => {
+      let alphaNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { alphaNightInfo = info; }
+        }]
+      ]);
+
+      const roles = [
+        RoleName.SENTINEL, RoleName.ALPHA_WOLF, RoleName.WEREWOLF,
+        RoleName.SEER, RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      const { game, result } = await createTestGame({
+        roles,
+        forcedRoles: new Map([
+          [0, RoleName.SENTINEL],
+          [1, RoleName.ALPHA_WOLF],
+          [2, RoleName.SEER]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(alphaNightInfo.success).toBe(false);
+      expect(alphaNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.SEER);
+      expect(game.getAlphaWolfCard()).toBe(RoleName.WEREWOLF);
+    });
+  });
+
+  describe('Win Condition Tests', () => {
+    const TURN_SEER = new Map([[0, { selectPlayerTarget: 'player-2' }]]);
+
+    it('AW4: Village should win when the turned player is eliminated', async () => {
+      const { result } = await createTestGame({
+        roles: ALPHA_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs: TURN_SEER,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(playerEliminated(result, 'player-2')).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(false);
+    });
+
+    it('AW5: The turned player should win with the Werewolf team', async () => {
+      const { result } = await createTestGame({
+        roles: ALPHA_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs: TURN_SEER,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(playerWon(result, 'player-1')).toBe(true);
+      expect(playerWon(result, 'player-2')).toBe(true);
+      expect(playerWon(result, 'player-5')).toBe(false);
+    });
+  });
+
+  describe('Setup Validation Tests', () => {
+    const PLAYERS = ['P1', 'P2', 'P3', 'P4', 'P5'];
+
+    it('AW6: An Alpha Wolf game should need one card more than players + center', () => {
+      expect(RoleFactory.getSetAsideCardCount(ALPHA_WOLF_ROLES)).toBe(1);
+      expect(RoleFactory.validateSetup(ALPHA_WOLF_ROLES, 5).errors).toEqual([]);
+
+      const tooFew = ALPHA_WOLF_ROLES.slice(0, -1);
+
+      expect(() => new Game({ players: PLAYERS, roles: tooFew }))
+        .toThrow('Expected 9 roles for 5 players, got 8');
+    });
+
+    it('AW7: An Alpha Wolf game should need a Werewolf card to set aside', () => {
+      const roles = [
+        RoleName.ALPHA_WOLF, RoleName.SEER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      expect(RoleFactory.validateSetup(roles, 5).errors)
+        .toEqual(['The Alpha Wolf needs a Werewolf card to set aside in the center']);
+      expect(() => new Game({ players: PLAYERS, roles })).toThrow('needs a Werewolf card');
+    });
+
+    it('AW8: The set-aside Werewolf should never be dealt', () => {
+      for (let i = 0; i < 20; i++) {
+        const game = new Game({ players: PLAYERS, roles: ALPHA_WOLF_ROLES });
+
+        expect(game.getAlphaWolfCard()).toBe(RoleName.WEREWOLF);
+        expect([...game.getCenterCards(), ...PLAYERS.map((_, p) => game.getPlayerRole(`player-${p + 1}`))])
+          .not.toContain(RoleName.WEREWOLF);
+      }
+    });
+  });
+});
@@ -0,0 +1,91 @@
+/**
+ * @fileoverview Apprentice Seer role tests.
+ * The Apprentice Seer views exactly one center card, wins with the
+ * village, and joins the default role list in larger games.
+ */
+
+import { RoleName, Team } from '../../enums';
+import { RoleFactory } from '../../patterns/factory';
+import { createTestGame, playerWon } from '../setup/testUtils';
+
+describe('Apprentice Seer Role Tests', () => {
+  // Every unforced card is a Villager, so whatever lands in the center is known
+  const APPRENTICE_SEER_ROLES = [
+    RoleName.APPRENTICE_SEER, RoleName.WEREWOLF,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.APPRENTICE_SEER],
+    [1, RoleName.WEREWOLF]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('AS1: Apprentice Seer should view the chosen center card', async () => {
+      let apprenticeNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectCenterIndex: 2,
+          onNightInfo: (info: any) => { apprenticeNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: APPRENTICE_SEER_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(apprenticeNightInfo.roleName).toBe(RoleName.APPRENTICE_SEER);
+      expect(apprenticeNightInfo.success).toBe(true);
+      expect(apprenticeNightInfo.info.viewed).toEqual([
+        { centerIndex: 2, role: RoleName.VILLAGER }
+      ]);
+    });
+
+    it.each([3, -1])('AS2: Apprentice Seer should fail on center index %i', async (centerIndex) => {
+      let apprenticeNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectCenterIndex: centerIndex,
+          onNightInfo: (info: any) => { apprenticeNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: APPRENTICE_SEER_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(apprenticeNightInfo.success).toBe(false);
+      expect(apprenticeNightInfo.failureCode).toBe('INVALID_TARGET');
+      expect(apprenticeNightInfo.info.viewed).toBeUndefined();
+    });
+  });
+
+  describe('Win Condition Tests', () => {
+    it('AS3: Apprentice Seer should win with the village', async () => {
+      const { result } = await createTestGame({
+        roles: APPRENTICE_SEER_ROLES,
+        forcedRoles: FORCED_ROLES,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(result.winningTeams).toContain(Team.VILLAGE);
+      expect(playerWon(result, 'player-1')).toBe(true);
+    });
+  });
+
+  describe('Default Role List', () => {
+    it('AS4: should be dealt only in larger games', () => {
+      expect(RoleFactory.generateRoleList(5)).not.toContain(RoleName.APPRENTICE_SEER);
+      expect(RoleFactory.generateRoleList(10)).toContain(RoleName.APPRENTICE_SEER);
+    });
+  });
+});
@@ -0,0 +1,64 @@
+/**
+ * @fileoverview Beholder role tests.
+ * The Beholder learns who the Seer is without making a choice. When the
+ * Seer card is in the center the Beholder is told so with an empty list.
+ */
+
+import { RoleName } from '../../enums';
+import { createTestGame } from '../setup/testUtils';
+
+describe('Beholder Role Tests', () => {
+  describe('Night Action Tests', () => {
+    it('BH1: Beholder should see who the Seer is', async () => {
+      let beholderNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { onNightInfo: (info: any) => { beholderNightInfo = info; } }]
+      ]);
+
+      await createTestGame({
+        roles: [
+          RoleName.BEHOLDER, RoleName.WEREWOLF, RoleName.SEER,
+          RoleName.VILLAGER, RoleName.VILLAGER,
+          RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+        ],
+        forcedRoles: new Map([
+          [0, RoleName.BEHOLDER],
+          [1, RoleName.WEREWOLF],
+          [2, RoleName.SEER]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(beholderNightInfo.roleName).toBe(RoleName.BEHOLDER);
+      expect(beholderNightInfo.success).toBe(true);
+      expect(beholderNightInfo.info.seers).toEqual(['player-3']);
+    });
+
+    it('BH2: Beholder should learn there is no Seer among players', async () => {
+      let beholderNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { onNightInfo: (info: any) => { beholderNightInfo = info; } }]
+      ]);
+
+      await createTestGame({
+        roles: [
+          RoleName.BEHOLDER, RoleName.WEREWOLF,
+          RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+          RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+        ],
+        forcedRoles: new Map([
+          [0, RoleName.BEHOLDER],
+          [1, RoleName.WEREWOLF]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(beholderNightInfo.success).toBe(true);
+      expect(beholderNightInfo.info.seers).toEqual([]);
+    });
+  });
+});
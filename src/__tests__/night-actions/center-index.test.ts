@@ -0,0 +1,175 @@
+/**
+ * @fileoverview Center card index validation tests.
+ * Every action that picks a center card checks the index against the
+ * cards actually in the center, rather than assuming there are three.
+ * The Drunk must swap, so a bad index or no answer gets them a random
+ * card instead, but a cancelled game still stops the action.
+ * Built-in agents pick from the same range, passed in their context.
+ */
+
+import { AIAgent, RandomAgent, RuleEnforcer, RuleViolationError } from '../../agents';
+import { GameCancelledError } from '../../core/Game';
+import { GamePhase, RoleName } from '../../enums';
+import { SelectCenterCommand, SelectTwoCentersCommand } from '../../patterns/command';
+import {
+  DrunkAction,
+  INightAction,
+  INightActionAgent,
+  INightActionGameState,
+  SeerAction,
+  WerewolfAction
+} from '../../patterns/strategy';
+import { TimeoutError } from '../../players';
+import {
+  createNightActionAgent,
+  createNightActionContext,
+  createNightActionGameState
+} from '../setup/nightActionFakes';
+
+function createAgent(centerIndex: number): INightActionAgent {
+  return createNightActionAgent({
+    selectCenterCard: async () => centerIndex,
+    selectTwoCenterCards: async () => [0, centerIndex]
+  });
+}
+
+function createGameState(centerCount: number = 3): INightActionGameState {
+  return createNightActionGameState({
+    getCenterCardCount: () => centerCount,
+    getPlayersWithStartingRole: (role) => (role === RoleName.WEREWOLF ? ['player-1'] : [])
+  });
+}
+
+const ACTIONS: Array<[string, RoleName, () => INightAction]> = [
+  ['Seer', RoleName.SEER, () => new SeerAction()],
+  ['lone Werewolf', RoleName.WEREWOLF, () => new WerewolfAction()]
+];
+
+const INVALID_INDICES: Array<[string, number]> = [
+  ['a negative index', -1],
+  ['an index past the last card', 3],
+  ['a fractional index', 1.5],
+  ['a non-numeric index', NaN]
+];
+
+describe('Center card index validation', () => {
+  describe.each(ACTIONS)('%s', (_label, role, createAction) => {
+    it.each(INVALID_INDICES)('rejects %s', async (_case, index) => {
+      const gameState = createGameState();
+
+      const result = await createAction().execute(createNightActionContext(role), createAgent(index), gameState);
+
+      expect(result.success).toBe(false);
+      expect(result.failureCode).toBe('INVALID_TARGET');
+      expect(result.error).toBe(`Invalid center card index: ${index}. Must be from 0 to 2.`);
+      expect(gameState.swapCards).not.toHaveBeenCalled();
+    });
+
+    it('accepts the last card of a larger center', async () => {
+      const result = await createAction().execute(createNightActionContext(role), createAgent(3), createGameState(4));
+
+      expect(result.success).toBe(true);
+    });
+  });
+
+  describe('Drunk', () => {
+    it.each(INVALID_INDICES)('swaps with a random center card for %s', async (_case, index) => {
+      const gameState = createGameState();
+
+      const result = await new DrunkAction().execute(createNightActionContext(RoleName.DRUNK), createAgent(index), gameState);
+
+      expect(result.success).toBe(true);
+      expect([0, 1, 2]).toContain(result.info.swapped?.to.centerIndex);
+      expect(gameState.swapCards).toHaveBeenCalledWith(
+        { playerId: 'player-1' },
+        { centerIndex: result.info.swapped?.to.centerIndex }
+      );
+    });
+
+    it('accepts the last card of a larger center', async () => {
+      const result = await new DrunkAction().execute(createNightActionContext(RoleName.DRUNK), createAgent(3), createGameState(4));
+
+      expect(result.success).toBe(true);
+      expect(result.info.swapped?.to.centerIndex).toBe(3);
+    });
+
+    it('swaps with a random center card when the agent never answers', async () => {
+      const agent = {
+        ...createAgent(0),
+        selectCenterCard: () => Promise.reject(new TimeoutError('player-1', 'selectCenter', 50))
+      };
+
+      const result = await new DrunkAction().execute(createNightActionContext(RoleName.DRUNK), agent, createGameState());
+
+      expect(result.success).toBe(true);
+      expect([0, 1, 2]).toContain(result.info.swapped?.to.centerIndex);
+    });
+
+    it('stops without swapping when the game is cancelled mid-request', async () => {
+      const agent = {
+        ...createAgent(0),
+        selectCenterCard: () => Promise.reject(new GameCancelledError('game-1', 'Room closed'))
+      };
+      const gameState = createGameState();
+
+      await expect(new DrunkAction().execute(createNightActionContext(RoleName.DRUNK), agent, gameState))
+        .rejects.toBeInstanceOf(GameCancelledError);
+      expect(gameState.swapCards).not.toHaveBeenCalled();
+    });
+  });
+
+  describe('Built-in agents', () => {
+    it.each([
+      ['RandomAgent', () => new RandomAgent('player-1')],
+      ['AIAgent', () => new AIAgent('player-1', RoleName.SEER)]
+    ])('%s picks from every card of a larger center', async (_label, createBuiltIn) => {
+      const agent = createBuiltIn();
+      const context = createNightActionContext(RoleName.SEER, { centerCardCount: 4 });
+      const picked = new Set<number>();
+
+      for (let i = 0; i < 200; i++) {
+        picked.add(await agent.selectCenterCard(context));
+        const [a, b] = await agent.selectTwoCenterCards(context);
+        expect(a).not.toBe(b);
+        picked.add(a).add(b);
+      }
+
+      expect([...picked].sort()).toEqual([0, 1, 2, 3]);
+    });
+
+    it('lets RuleEnforcer accept the last card of a larger center', async () => {
+      const enforcer = new RuleEnforcer(new RandomAgent('player-1'));
+      jest.spyOn(RandomAgent.prototype, 'selectCenterCard').mockResolvedValue(3);
+
+      const withFour = createNightActionContext(RoleName.SEER, { centerCardCount: 4 });
+      const withThree = createNightActionContext(RoleName.SEER, { centerCardCount: 3 });
+
+      await expect(enforcer.selectCenterCard(withFour)).resolves.toBe(3);
+      await expect(enforcer.selectCenterCard(withThree))
+        .rejects.toBeInstanceOf(RuleViolationError);
+
+      jest.restoreAllMocks();
+    });
+  });
+
+  describe('Network commands', () => {
+    const context = (centerCardCount: number) => ({
+      phase: GamePhase.NIGHT,
+      playerIds: ['player-1', 'player-2', 'player-3'],
+      centerCardCount
+    });
+
+    it('accept the last card of a larger center', () => {
+      expect(new SelectCenterCommand('player-1', 'game-1', 3).validate(context(4)).valid).toBe(true);
+      expect(new SelectTwoCentersCommand('player-1', 'game-1', [0, 3]).validate(context(4)).valid).toBe(true);
+    });
+
+    it('reject an index past the last card', () => {
+      expect(new SelectCenterCommand('player-1', 'game-1', 3).validate(context(3))).toEqual({
+        valid: false,
+        error: 'Invalid center index: 3. Must be from 0 to 2.'
+      });
+      expect(new SelectTwoCentersCommand('player-1', 'game-1', [0, 3]).validate(context(3)).valid).toBe(false);
+    });
+  });
+});
@@ -0,0 +1,90 @@
+/**
+ * @fileoverview Curator role tests.
+ * The Curator's artifact moves no card; it only hides the artifacted
+ * player's role, so these tests look at the views built at dawn rather
+ * than at the final roles.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game, IGameAgent } from '../../core/Game';
+import { PlayerViewFactory } from '../../players/PlayerView';
+import { SerializablePlayerGameView } from '../../network/protocol';
+import { NightActionResult } from '../../types';
+import { TestAgent } from '../setup/TestAgent';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+/** Runs a game where the Curator targets `target` and captures dawn and end views */
+async function runCuratorGame(target: string): Promise<{
+  dawnViews: Map<string, SerializablePlayerGameView>;
+  endViews: Map<string, SerializablePlayerGameView>;
+  curatorInfo: NightActionResult | null;
+}> {
+  const game = new Game({
+    players: PLAYER_IDS.map((_, i) => `Player${i + 1}`),
+    roles: [
+      RoleName.CURATOR, RoleName.WEREWOLF, RoleName.SEER,
+      RoleName.VILLAGER, RoleName.VILLAGER,
+      RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+    ],
+    forcedRoles: new Map([
+      [0, RoleName.CURATOR],
+      [1, RoleName.WEREWOLF],
+      [2, RoleName.SEER]
+    ]),
+    auditLevel: 'minimal'
+  });
+
+  let curatorInfo: NightActionResult | null = null;
+  const agents = new Map<string, TestAgent>();
+  for (const id of PLAYER_IDS) {
+    agents.set(id, new TestAgent(id, {
+      selectPlayerTarget: id === 'player-1' ? target : 'player-2',
+      voteTarget: 'player-2',
+      onNightInfo: id === 'player-1' ? (info) => { curatorInfo = info; } : undefined
+    }));
+  }
+  game.registerAgents(agents as Map<string, IGameAgent>);
+
+  const dawnViews = new Map<string, SerializablePlayerGameView>();
+  game.addObserver({
+    onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+      if (event.type === 'PHASE_CHANGED' && event.data?.to === GamePhase.DAY) {
+        for (const id of PLAYER_IDS) {
+          dawnViews.set(id, PlayerViewFactory.createView(game, id));
+        }
+      }
+    }
+  });
+
+  await game.run();
+
+  const endViews = new Map(PLAYER_IDS.map(id => [id, PlayerViewFactory.createView(game, id)]));
+  return { dawnViews, endViews, curatorInfo };
+}
+
+describe('Curator Role Tests', () => {
+  it('CU1: Curator should place an artifact on the chosen player', async () => {
+    const { dawnViews, curatorInfo } = await runCuratorGame('player-3');
+
+    expect(curatorInfo!.success).toBe(true);
+    expect(curatorInfo!.info.artifact).toBe('player-3');
+    expect(curatorInfo!.info.viewed).toBeUndefined();
+    for (const view of dawnViews.values()) {
+      expect(view.artifacts).toEqual(['player-3']);
+    }
+  });
+
+  it('CU2: an artifacted player should not see their own role', async () => {
+    const { dawnViews } = await runCuratorGame('player-3');
+
+    expect(dawnViews.get('player-3')!.myStartingRole).toBeNull();
+    expect(dawnViews.get('player-4')!.myStartingRole).toBe(RoleName.VILLAGER);
+  });
+
+  it('CU3: the role should be visible again once the game ends', async () => {
+    const { endViews } = await runCuratorGame('player-3');
+
+    expect(endViews.get('player-3')!.myStartingRole).toBe(RoleName.SEER);
+  });
+});
@@ -1,6 +1,8 @@
 /**
  * @fileoverview Doppelganger role tests.
- * Tests D1-D32 from the test checklist.
+ * Tests D1-D32 from the test checklist, plus D33-D36 on the copied
+ * action's targeting rules and D37-D39 on the Doppel-Werewolf's place
+ * in the pack.
  *
  * Doppelganger is the most complex role - copies another player's role
  * and performs their action immediately.
@@ -918,7 +920,7 @@ describe('Doppelganger Role Tests', () => {
         agentConfigs
       });
 
-      // Doppel acts first (order 1), then Troublemaker (order 7)
+      // Doppel acts early, then Troublemaker
       // Doppel-TM swaps player-3 (Werewolf) and player-4 (Villager)
       // After Doppel-TM: player-3 has Villager, player-4 has Werewolf
       // Then regular TM swaps player-4 (now Werewolf) and player-5 (Villager)
@@ -977,7 +979,7 @@ describe('Doppelganger Role Tests', () => {
 
     it('D26: Doppelganger copies swapped role correctly', async () => {
       // This tests that Doppelganger sees the CURRENT card at copy time
-      // Since Doppelganger acts first (order 1), they see original cards
+      // Since Doppelganger acts early, they see original cards
       const DOPPEL_ROLES = [
         RoleName.DOPPELGANGER, RoleName.VILLAGER, RoleName.WEREWOLF,
         RoleName.VILLAGER, RoleName.VILLAGER,
@@ -1231,4 +1233,226 @@ describe('Doppelganger Role Tests', () => {
       expect(teamWon(result, Team.VILLAGE)).toBe(true);
     });
   });
+
+  describe('Copied Action Targeting Tests', () => {
+    const TARGETING_ROLES = [
+      RoleName.DOPPELGANGER, RoleName.ROBBER, RoleName.WEREWOLF,
+      RoleName.SEER, RoleName.DRUNK,
+      RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+    ];
+
+    const TARGETING_FORCED = new Map([
+      [0, RoleName.DOPPELGANGER],
+      [1, RoleName.ROBBER],
+      [2, RoleName.WEREWOLF],
+      [3, RoleName.SEER],
+      [4, RoleName.DRUNK]
+    ]);
+
+    it('D33: Doppel-Robber swap persists to the end of the game', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-2', // Copy Robber, then rob the Robber
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }],
+        [1, { selectPlayerTarget: 'player-3' }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: TARGETING_ROLES,
+        forcedRoles: TARGETING_FORCED,
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(doppelNightInfo.success).toBe(true);
+      expect(doppelNightInfo.info.swapped).toBeDefined();
+      expect(getFinalRole(result, 'player-1')).toBe(RoleName.ROBBER);
+      // The real Robber wakes later holding the Doppelganger card and robs player-3
+      expect(getFinalRole(result, 'player-2')).toBe(RoleName.WEREWOLF);
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.DOPPELGANGER);
+    });
+
+    it('D34: Doppel-Seer viewing the center gets both cards back', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-4',
+          seerChoice: 'center' as const,
+          selectTwoCenterIndices: [0, 2] as [number, number],
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: TARGETING_ROLES,
+        forcedRoles: TARGETING_FORCED,
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(doppelNightInfo.success).toBe(true);
+      expect(doppelNightInfo.info.copied.role).toBe(RoleName.SEER);
+      expect(doppelNightInfo.info.viewed).toEqual([
+        { centerIndex: 0, role: RoleName.VILLAGER },
+        { centerIndex: 2, role: RoleName.VILLAGER }
+      ]);
+    });
+
+    it('D35: Doppel-Seer picking the same center card twice is rejected', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-4',
+          seerChoice: 'center' as const,
+          selectTwoCenterIndices: [1, 1] as [number, number],
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: TARGETING_ROLES,
+        forcedRoles: TARGETING_FORCED,
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(doppelNightInfo.success).toBe(false);
+      expect(doppelNightInfo.failureCode).toBe('DUPLICATE_TARGET');
+      expect(doppelNightInfo.info.viewed).toBeUndefined();
+    });
+
+    it('D36: Doppel-Drunk with an out-of-range center index keeps its card', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-5',
+          selectCenterIndex: 3,
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }],
+        [1, { selectPlayerTarget: 'player-3' }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: TARGETING_ROLES,
+        forcedRoles: TARGETING_FORCED,
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(doppelNightInfo.success).toBe(false);
+      expect(doppelNightInfo.failureCode).toBe('INVALID_TARGET');
+      expect(getFinalRole(result, 'player-1')).toBe(RoleName.DOPPELGANGER);
+    });
+  });
+
+  describe('Doppel-Werewolf Pack Tests', () => {
+    it('D37: Doppel-Werewolf is known to the pack and wins with it', async () => {
+      const DOPPEL_ROLES = [
+        RoleName.DOPPELGANGER, RoleName.WEREWOLF, RoleName.MINION,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      let werewolfInfo: any = null;
+      let minionInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2', voteTarget: 'player-4' }], // Copy Werewolf
+        [1, { onNightInfo: (info: any) => { werewolfInfo = info; }, voteTarget: 'player-4' }],
+        [2, { onNightInfo: (info: any) => { minionInfo = info; }, voteTarget: 'player-4' }],
+        [3, { voteTarget: 'player-5' }],
+        [4, { voteTarget: 'player-4' }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: DOPPEL_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.DOPPELGANGER],
+          [1, RoleName.WEREWOLF],
+          [2, RoleName.MINION]
+        ]),
+        agentConfigs
+      });
+
+      expect(werewolfInfo.info.werewolves).toEqual(['player-1']);
+      expect(minionInfo.info.werewolves).toEqual(expect.arrayContaining(['player-1', 'player-2']));
+
+      expect(playerEliminated(result, 'player-4')).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(result.winningPlayers).toEqual(expect.arrayContaining(['player-1', 'player-2', 'player-3']));
+    });
+
+    it('D38: Killing the Doppel-Werewolf is a Village win', async () => {
+      const DOPPEL_ROLES = [
+        RoleName.DOPPELGANGER, RoleName.WEREWOLF, RoleName.ROBBER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2', voteTarget: 'player-4' }], // Copy Werewolf
+        [1, { voteTarget: 'player-1' }],
+        [2, { selectPlayerTarget: 'player-2', voteTarget: 'player-1' }], // Rob the Werewolf
+        [3, { voteTarget: 'player-1' }],
+        [4, { voteTarget: 'player-1' }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: DOPPEL_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.DOPPELGANGER],
+          [1, RoleName.WEREWOLF],
+          [2, RoleName.ROBBER]
+        ]),
+        agentConfigs
+      });
+
+      // The Doppel-Werewolf dies while the Robber's stolen Werewolf survives
+      expect(playerEliminated(result, 'player-1')).toBe(true);
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.WEREWOLF);
+      expect(teamWon(result, Team.VILLAGE)).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(false);
+    });
+
+    it('D39: Doppel-Werewolf whose card is swapped away plays for their new card', async () => {
+      const DOPPEL_ROLES = [
+        RoleName.DOPPELGANGER, RoleName.WEREWOLF, RoleName.TROUBLEMAKER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2', voteTarget: 'player-2' }], // Copy Werewolf
+        [1, { voteTarget: 'player-3' }],
+        [2, {
+          selectTwoPlayersTargets: ['player-1', 'player-4'] as [string, string],
+          voteTarget: 'player-2'
+        }],
+        [3, { voteTarget: 'player-2' }],
+        [4, { voteTarget: 'player-2' }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: DOPPEL_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.DOPPELGANGER],
+          [1, RoleName.WEREWOLF],
+          [2, RoleName.TROUBLEMAKER],
+          [3, RoleName.VILLAGER]
+        ]),
+        agentConfigs
+      });
+
+      expect(getFinalRole(result, 'player-1')).toBe(RoleName.VILLAGER);
+      expect(playerEliminated(result, 'player-2')).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(true);
+      expect(result.winningPlayers).toContain('player-1');
+    });
+  });
 });
@@ -0,0 +1,122 @@
+/**
+ * @fileoverview Dream Wolf role tests.
+ * The Dream Wolf never wakes: the pack and the Minion see them, but they
+ * learn nothing themselves. They still win and lose as a Werewolf.
+ */
+
+import { RoleName, Team } from '../../enums';
+import {
+  createTestGame,
+  teamWon,
+  playerWon,
+  playerEliminated,
+} from '../setup/testUtils';
+
+describe('Dream Wolf Role Tests', () => {
+  const DREAM_WOLF_ROLES = [
+    RoleName.WEREWOLF, RoleName.DREAM_WOLF, RoleName.MINION,
+    RoleName.SEER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.WEREWOLF],
+    [1, RoleName.DREAM_WOLF],
+    [2, RoleName.MINION],
+    [3, RoleName.SEER]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('DW1: Werewolf and Minion should see the Dream Wolf', async () => {
+      let werewolfNightInfo: any = null;
+      let minionNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { onNightInfo: (info: any) => { werewolfNightInfo = info; } }],
+        [2, { onNightInfo: (info: any) => { minionNightInfo = info; } }]
+      ]);
+
+      await createTestGame({
+        roles: DREAM_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(werewolfNightInfo.info.werewolves).toEqual(['player-2']);
+      expect(werewolfNightInfo.info.viewed).toBeUndefined(); // Not a lone wolf
+      expect(minionNightInfo.info.werewolves).toEqual(expect.arrayContaining(['player-1', 'player-2']));
+    });
+
+    it('DW2: Dream Wolf should not wake or learn anything', async () => {
+      const dreamWolfNightInfo: any[] = [];
+
+      const agentConfigs = new Map([
+        [1, { onNightInfo: (info: any) => { dreamWolfNightInfo.push(info); } }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: DREAM_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(dreamWolfNightInfo).toEqual([]);
+      expect(game.getPlayerNightInfo('player-2')).toEqual([]);
+    });
+
+    it('DW3: Doppel-Dream Wolf should be seen by the pack', async () => {
+      let werewolfNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2' }], // Copy the Dream Wolf
+        [2, { onNightInfo: (info: any) => { werewolfNightInfo = info; } }]
+      ]);
+
+      const roles = [
+        RoleName.DOPPELGANGER, RoleName.DREAM_WOLF, RoleName.WEREWOLF,
+        RoleName.SEER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      await createTestGame({
+        roles,
+        forcedRoles: new Map([
+          [0, RoleName.DOPPELGANGER],
+          [1, RoleName.DREAM_WOLF],
+          [2, RoleName.WEREWOLF]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(werewolfNightInfo.info.werewolves).toEqual(expect.arrayContaining(['player-1', 'player-2']));
+    });
+  });
+
+  describe('Win Condition Tests', () => {
+    it('DW4: Village should win when the Dream Wolf is eliminated', async () => {
+      const { result } = await createTestGame({
+        roles: DREAM_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(playerEliminated(result, 'player-2')).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(false);
+    });
+
+    it('DW5: Dream Wolf should win with the Werewolf team', async () => {
+      const { result } = await createTestGame({
+        roles: DREAM_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(playerWon(result, 'player-2')).toBe(true);
+    });
+  });
+});
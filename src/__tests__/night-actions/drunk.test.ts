@@ -1,6 +1,6 @@
 /**
  * @fileoverview Drunk role tests.
- * Tests DR1-DR5 from the test checklist.
+ * Tests DR1-DR5 from the test checklist, plus DR6 for the mandatory swap.
  */
 
 import { RoleName, Team } from '../../enums';
@@ -119,6 +119,42 @@ describe('Drunk Role Tests', () => {
     });
   });
 
+  describe('Mandatory Swap Tests', () => {
+    it('DR6: Drunk should still swap when choosing an invalid center card', async () => {
+      const DRUNK_ROLES = [
+        RoleName.DRUNK, RoleName.WEREWOLF, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.SEER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      let drunkNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectCenterIndex: 7, // No such center card
+          onNightInfo: (info: any) => { drunkNightInfo = info; }
+        }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: DRUNK_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.DRUNK],
+          [1, RoleName.WEREWOLF]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(drunkNightInfo.success).toBe(true);
+      expect(drunkNightInfo.info.viewed).toBeUndefined();
+
+      const centerIndex = drunkNightInfo.info.swapped.to.centerIndex;
+      expect([0, 1, 2]).toContain(centerIndex);
+      expect(game.getCenterCards()[centerIndex]).toBe(RoleName.DRUNK);
+    });
+  });
+
   describe('Win Condition Tests', () => {
     it('DR4: Drunk-turned-Werewolf should win when no werewolf is eliminated', async () => {
       // Force werewolves to center so Drunk can swap into one
@@ -0,0 +1,98 @@
+/**
+ * @fileoverview Lone werewolf center peek privacy tests.
+ * The peeked center card may only reach the lone wolf: in their dawn
+ * summary and when they reconnect, never in another player's view.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game, IGameAgent } from '../../core/Game';
+import { PlayerViewFactory } from '../../players/PlayerView';
+import { SerializablePlayerGameView } from '../../network/protocol';
+import { NightActionResult } from '../../types';
+import { TestAgent } from '../setup/TestAgent';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+const WOLF_ID = 'player-1';
+
+const LONE_WOLF_ROLES = [
+  RoleName.WEREWOLF, RoleName.WEREWOLF,
+  RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+  RoleName.VILLAGER, RoleName.VILLAGER, RoleName.TANNER
+];
+
+interface DawnCapture {
+  game: Game;
+  views: Map<string, SerializablePlayerGameView>;
+  summaries: Map<string, NightActionResult[]>;
+}
+
+/** Runs a lone-wolf night and captures every player's view at dawn */
+async function runToDawn(): Promise<DawnCapture> {
+  const game = new Game({
+    players: PLAYER_IDS.map((_, i) => `Player${i + 1}`),
+    roles: LONE_WOLF_ROLES,
+    forcedRoles: new Map([
+      [0, RoleName.WEREWOLF],
+      [1, RoleName.VILLAGER],
+      [2, RoleName.VILLAGER],
+      [3, RoleName.VILLAGER],
+      [4, RoleName.VILLAGER]
+    ]),
+    auditLevel: 'minimal'
+  });
+
+  const agents = new Map<string, TestAgent>();
+  for (const id of PLAYER_IDS) {
+    agents.set(id, new TestAgent(id, { selectCenterIndex: 1, voteTarget: WOLF_ID }));
+  }
+  game.registerAgents(agents as Map<string, IGameAgent>);
+
+  const views = new Map<string, SerializablePlayerGameView>();
+  const summaries = new Map<string, NightActionResult[]>();
+  game.addObserver({
+    onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+      if (event.type === 'PHASE_CHANGED' && event.data?.to === GamePhase.DAY) {
+        for (const id of PLAYER_IDS) {
+          views.set(id, PlayerViewFactory.createView(game, id));
+          summaries.set(id, PlayerViewFactory.createDawnSummary(game, id));
+        }
+      }
+    }
+  });
+
+  await game.run();
+  return { game, views, summaries };
+}
+
+function peekOf(results: readonly NightActionResult[]): { centerIndex?: number; role: RoleName } | undefined {
+  return results.flatMap(r => r.info.viewed ?? []).find(v => v.centerIndex !== undefined);
+}
+
+describe('Lone Werewolf Center Peek Privacy', () => {
+  it('includes the peeked card in the lone wolf\'s dawn summary', async () => {
+    const { game, summaries } = await runToDawn();
+
+    const peek = peekOf(summaries.get(WOLF_ID)!);
+    expect(peek).toEqual({ centerIndex: 1, role: game.getCenterCards()[1] });
+  });
+
+  it('keeps the peeked card out of every other player\'s view and summary', async () => {
+    const { views, summaries } = await runToDawn();
+
+    for (const id of PLAYER_IDS.filter(p => p !== WOLF_ID)) {
+      expect(summaries.get(id)).toEqual([]);
+      expect(views.get(id)!.myNightInfo).toEqual([]);
+      expect(JSON.stringify(views.get(id))).not.toContain('centerIndex');
+    }
+  });
+
+  it('redelivers the peek only to the lone wolf on reconnect', async () => {
+    const { game } = await runToDawn();
+
+    const wolfView = PlayerViewFactory.createReconnectionView(game, WOLF_ID, []);
+    expect(peekOf(wolfView.myNightInfo)?.centerIndex).toBe(1);
+
+    const villagerView = PlayerViewFactory.createReconnectionView(game, 'player-2', []);
+    expect(peekOf(villagerView.myNightInfo)).toBeUndefined();
+  });
+});
@@ -77,8 +77,8 @@ describe('Mason Role Tests', () => {
     });
 
     it('MA3: Masons should see initial assignments (before swaps)', async () => {
-      // Masons act at order 4, which is early in the night
-      // Robber acts at order 6, after Masons
+      // Masons act early in the night
+      // Robber acts after Masons
       const MASON_ROBBER_ROLES = [
         RoleName.MASON, RoleName.MASON, RoleName.ROBBER,
         RoleName.WEREWOLF, RoleName.VILLAGER,
@@ -0,0 +1,155 @@
+/**
+ * @fileoverview Mystic Wolf role tests.
+ * The Mystic Wolf sees the pack and views one other player's card, and
+ * counts as a Werewolf for the other Werewolves, the Minion and the
+ * win conditions.
+ */
+
+import { RoleName, Team } from '../../enums';
+import {
+  createTestGame,
+  teamWon,
+  playerWon,
+  playerEliminated,
+} from '../setup/testUtils';
+
+describe('Mystic Wolf Role Tests', () => {
+  const MYSTIC_WOLF_ROLES = [
+    RoleName.WEREWOLF, RoleName.MYSTIC_WOLF, RoleName.MINION,
+    RoleName.SEER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.WEREWOLF],
+    [1, RoleName.MYSTIC_WOLF],
+    [2, RoleName.MINION],
+    [3, RoleName.SEER]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('MW1: Mystic Wolf should see the pack and view the chosen player\'s card', async () => {
+      let mysticWolfNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [1, {
+          selectPlayerTarget: 'player-4', // View the Seer
+          onNightInfo: (info: any) => { mysticWolfNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: MYSTIC_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(mysticWolfNightInfo).not.toBeNull();
+      expect(mysticWolfNightInfo.roleName).toBe(RoleName.MYSTIC_WOLF);
+      expect(mysticWolfNightInfo.success).toBe(true);
+      expect(mysticWolfNightInfo.info.werewolves).toEqual(['player-1']);
+      expect(mysticWolfNightInfo.info.viewed).toEqual([
+        { playerId: 'player-4', role: RoleName.SEER }
+      ]);
+    });
+
+    it('MW2: Werewolf and Minion should see the Mystic Wolf as a Werewolf', async () => {
+      let werewolfNightInfo: any = null;
+      let minionNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { onNightInfo: (info: any) => { werewolfNightInfo = info; } }],
+        [2, { onNightInfo: (info: any) => { minionNightInfo = info; } }]
+      ]);
+
+      await createTestGame({
+        roles: MYSTIC_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(werewolfNightInfo.info.werewolves).toEqual(['player-2']);
+      expect(werewolfNightInfo.info.viewed).toBeUndefined(); // Not a lone wolf
+      expect(minionNightInfo.info.werewolves).toEqual(expect.arrayContaining(['player-1', 'player-2']));
+    });
+
+    it('MW3: Doppel-Mystic Wolf should view a player\'s card', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-2', // Copy the Mystic Wolf, then view their card
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }]
+      ]);
+
+      const roles = [
+        RoleName.DOPPELGANGER, RoleName.MYSTIC_WOLF, RoleName.SEER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      await createTestGame({
+        roles,
+        forcedRoles: new Map([
+          [0, RoleName.DOPPELGANGER],
+          [1, RoleName.MYSTIC_WOLF],
+          [2, RoleName.SEER]
+        ]),
+        agentConfigs,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(doppelNightInfo.info.copied.role).toBe(RoleName.MYSTIC_WOLF);
+      expect(doppelNightInfo.info.werewolves).toEqual(['player-2']);
+      expect(doppelNightInfo.info.viewed).toEqual([
+        { playerId: 'player-2', role: RoleName.MYSTIC_WOLF }
+      ]);
+    });
+  });
+
+  describe('Win Condition Tests', () => {
+    it('MW4: Village should win when the Mystic Wolf is eliminated', async () => {
+      const { result } = await createTestGame({
+        roles: MYSTIC_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(playerEliminated(result, 'player-2')).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(false);
+    });
+
+    it('MW5: Mystic Wolf should win with the Werewolf team', async () => {
+      const { result } = await createTestGame({
+        roles: MYSTIC_WOLF_ROLES,
+        forcedRoles: FORCED_ROLES,
+        defaultVoteTarget: 'player-5'
+      });
+
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(playerWon(result, 'player-2')).toBe(true);
+    });
+
+    it('MW6: Werewolves should exist among players when only a Mystic Wolf was dealt', async () => {
+      const roles = [
+        RoleName.MYSTIC_WOLF, RoleName.SEER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+      ];
+
+      const { result } = await createTestGame({
+        roles,
+        forcedRoles: new Map([[0, RoleName.MYSTIC_WOLF]]),
+        defaultVoteTarget: 'player-3'
+      });
+
+      // A village player died while a Werewolf was among the players
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(false);
+    });
+  });
+});
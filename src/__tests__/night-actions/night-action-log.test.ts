@@ -0,0 +1,68 @@
+/**
+ * @fileoverview Post-game night action log tests.
+ * Once the game is over every action is revealed, in the order it happened.
+ */
+
+import { RoleName } from '../../enums';
+import { buildNightActionLog, NightLogPlayer } from '../../server/NightActionLog';
+import { createTestGame } from '../setup/testUtils';
+
+const LOG_ROLES = [
+  RoleName.SEER, RoleName.ROBBER, RoleName.WEREWOLF, RoleName.VILLAGER,
+  RoleName.TROUBLEMAKER, RoleName.WEREWOLF, RoleName.DRUNK, RoleName.TANNER
+];
+
+const NAMES = ['Alice', 'Bob', 'Carol', 'Dave', 'Erin'];
+
+function playersByGameId(): Map<string, NightLogPlayer> {
+  return new Map(NAMES.map((name, i) => [`player-${i + 1}`, { id: `room-${i + 1}`, name }]));
+}
+
+describe('Night Action Log', () => {
+  it('includes both a Robber swap and a Seer view in the final log', async () => {
+    const { game } = await createTestGame({
+      roles: LOG_ROLES,
+      forcedRoles: new Map([
+        [0, RoleName.SEER],
+        [1, RoleName.ROBBER],
+        [2, RoleName.WEREWOLF],
+        [3, RoleName.VILLAGER],
+        [4, RoleName.TROUBLEMAKER]
+      ]),
+      agentConfigs: new Map([
+        [0, { selectPlayerTarget: 'player-3' }],  // Seer views Carol
+        [1, { selectPlayerTarget: 'player-3' }],  // Robber robs Carol
+        [4, { selectTwoPlayersTargets: ['player-1', 'player-4'] as [string, string] }]
+      ]),
+      defaultVoteTarget: 'player-2'
+    });
+
+    const log = buildNightActionLog(game.getAllNightResults(), playersByGameId());
+    const descriptions = log.map(entry => `${entry.playerName} (${entry.roleName}): ${entry.description}`);
+
+    expect(descriptions).toContain('Alice (SEER): Viewed Carol\'s card: WEREWOLF');
+    expect(descriptions).toContain('Bob (ROBBER): Robbed Carol and became WEREWOLF');
+    expect(descriptions).toContain('Erin (TROUBLEMAKER): Swapped Alice and Dave\'s cards');
+  });
+
+  it('lists actions in the order they happened with room player IDs', async () => {
+    const { game } = await createTestGame({
+      roles: LOG_ROLES,
+      forcedRoles: new Map([
+        [0, RoleName.TROUBLEMAKER],
+        [1, RoleName.ROBBER],
+        [2, RoleName.SEER],
+        [3, RoleName.WEREWOLF],
+        [4, RoleName.VILLAGER]
+      ]),
+      defaultVoteTarget: 'player-1'
+    });
+
+    const log = buildNightActionLog(game.getAllNightResults(), playersByGameId());
+    const roles = log.map(entry => entry.roleName);
+
+    expect(roles.indexOf(RoleName.SEER)).toBeLessThan(roles.indexOf(RoleName.ROBBER));
+    expect(roles.indexOf(RoleName.ROBBER)).toBeLessThan(roles.indexOf(RoleName.TROUBLEMAKER));
+    expect(log.find(entry => entry.roleName === RoleName.SEER)?.playerId).toBe('room-3');
+  });
+});
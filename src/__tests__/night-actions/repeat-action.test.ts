@@ -0,0 +1,120 @@
+/**
+ * @fileoverview Repeated night action tests.
+ * A player acts once per night: the engine won't wake a player who has
+ * already acted, and a second, different answer to the same request is
+ * refused. A client retrying the answer it already gave is acknowledged.
+ */
+
+import { RoleName } from '../../enums';
+import { Game, IGameAgent } from '../../core/Game';
+import { ErrorCodes } from '../../network/protocol';
+import { SeerAction } from '../../patterns/strategy';
+import { MAX_REMEMBERED_REQUESTS, NetworkAgent } from '../../server/NetworkAgent';
+import { MockConnection } from '../setup/MockConnection';
+import { createNightActionContext } from '../setup/nightActionFakes';
+import { TestAgent } from '../setup/TestAgent';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+describe('Repeated night actions', () => {
+  it('does not wake the Seer a second time in one night', async () => {
+    const game = new Game({
+      players: ['Player1', 'Player2', 'Player3', 'Player4', 'Player5'],
+      roles: ROLE_CONFIGS.STANDARD,
+      forcedRoles: new Map([[0, RoleName.SEER]]),
+      auditLevel: 'minimal'
+    });
+    const onNightInfo = jest.fn();
+    const seer = new TestAgent('player-1', {
+      seerChoice: 'player',
+      selectPlayerTarget: 'player-2',
+      onNightInfo
+    });
+    game.registerAgents(new Map(
+      ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'].map(id =>
+        [id, (id === 'player-1' ? seer : new TestAgent(id, {})) as IGameAgent]
+      )
+    ));
+
+    const seerOrder = new SeerAction().getNightOrder();
+    await game.executeNightActionsForRole(seerOrder);
+    await game.executeNightActionsForRole(seerOrder);
+
+    expect(game.getAllNightResults().filter(r => r.actorId === 'player-1')).toHaveLength(1);
+    expect(onNightInfo).toHaveBeenCalledTimes(1);
+  });
+
+  it('refuses a second answer to the same request', async () => {
+    const connection = new MockConnection('conn-1');
+    const agent = new NetworkAgent('player-1', connection, true);
+    const context = {
+      myPlayerId: 'player-1',
+      myStartingRole: RoleName.SEER,
+      allPlayerIds: ['player-1', 'player-2', 'player-3'],
+      rolesInGame: [RoleName.SEER, RoleName.WEREWOLF],
+      previousResults: [],
+      centerCardCount: 3
+    };
+
+    const pending = agent.selectPlayer(['player-2', 'player-3'], context);
+    const [{ request }] = connection.sentOfType('actionRequired');
+    connection.receive({ type: 'actionResponse', requestId: request.requestId, response: 'player-2', timestamp: Date.now() });
+    connection.receive({ type: 'actionResponse', requestId: request.requestId, response: 'player-3', timestamp: Date.now() });
+
+    await expect(pending).resolves.toBe('player-2');
+    const [error] = connection.sentOfType('error');
+    expect(error.code).toBe(ErrorCodes.INVALID_ACTION);
+    expect(error.message).toBe('You have already answered this request');
+
+    agent.dispose();
+  });
+
+  it('acknowledges a repeated identical vote', async () => {
+    const connection = new MockConnection('conn-1');
+    const agent = new NetworkAgent('player-1', connection, true);
+
+    const pending = agent.vote({
+      myPlayerId: 'player-1',
+      myStartingRole: RoleName.VILLAGER,
+      rolesInGame: [RoleName.VILLAGER, RoleName.WEREWOLF],
+      myNightInfo: null,
+      allStatements: [],
+      eligibleTargets: ['player-2', 'player-3']
+    });
+    const [{ request }] = connection.sentOfType('actionRequired');
+    connection.receive({ type: 'actionResponse', requestId: request.requestId, response: 'player-2', timestamp: Date.now() });
+    connection.receive({ type: 'actionResponse', requestId: request.requestId, response: 'player-2', timestamp: Date.now() });
+
+    await expect(pending).resolves.toBe('player-2');
+    expect(connection.sentOfType('error')).toEqual([]);
+    const acknowledged = connection.sentOfType('actionAcknowledged');
+    expect(acknowledged).toHaveLength(1);
+    expect(acknowledged[0].requestId).toBe(request.requestId);
+
+    agent.dispose();
+  });
+
+  it('only remembers the most recent answered requests', async () => {
+    const connection = new MockConnection('conn-1');
+    const agent = new NetworkAgent('player-1', connection, true);
+    const context = createNightActionContext(RoleName.SEER);
+
+    for (let i = 0; i <= MAX_REMEMBERED_REQUESTS; i++) {
+      const pending = agent.selectPlayer(['player-2', 'player-3'], context);
+      const requests = connection.sentOfType('actionRequired');
+      const { requestId } = requests[requests.length - 1].request;
+      connection.receive({ type: 'actionResponse', requestId, response: 'player-2', timestamp: Date.now() });
+      await pending;
+    }
+
+    const requestIds = connection.sentOfType('actionRequired').map(m => m.request.requestId);
+    for (const requestId of [requestIds[0], requestIds[requestIds.length - 1]]) {
+      connection.receive({ type: 'actionResponse', requestId, response: 'player-2', timestamp: Date.now() });
+    }
+
+    // The oldest answer was forgotten, so only the newest retry is acknowledged
+    expect(connection.sentOfType('actionAcknowledged').map(m => m.requestId))
+      .toEqual([requestIds[requestIds.length - 1]]);
+
+    agent.dispose();
+  });
+});
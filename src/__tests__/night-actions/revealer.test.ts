@@ -0,0 +1,80 @@
+/**
+ * @fileoverview Revealer role tests.
+ * The Revealer flips one other player's card. A village card stays face up
+ * for the day and shows in everyone's view; a Werewolf or Tanner goes back
+ * face down and only the Revealer learns what it was.
+ */
+
+import { RoleName } from '../../enums';
+import { PlayerViewFactory } from '../../players/PlayerView';
+import { createTestGame } from '../setup/testUtils';
+
+describe('Revealer Role Tests', () => {
+  const REVEALER_ROLES = [
+    RoleName.REVEALER, RoleName.WEREWOLF, RoleName.SEER,
+    RoleName.VILLAGER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.REVEALER],
+    [1, RoleName.WEREWOLF],
+    [2, RoleName.SEER]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('RV1: a Seer card should stay face up for the day', async () => {
+      let revealerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { revealerNightInfo = info; }
+        }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: REVEALER_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(revealerNightInfo.success).toBe(true);
+      expect(revealerNightInfo.info.viewed).toEqual([
+        { playerId: 'player-3', role: RoleName.SEER }
+      ]);
+      expect(revealerNightInfo.info.revealed).toBe('player-3');
+      expect(game.getRevealedCards().get('player-3')).toBe(RoleName.SEER);
+
+      // Every player sees the revealed card, not just the Revealer
+      const otherView = PlayerViewFactory.createView(game, 'player-4');
+      expect(otherView.revealedCards).toEqual({ 'player-3': RoleName.SEER });
+    });
+
+    it('RV2: a Werewolf card should go back face down', async () => {
+      let revealerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-2',
+          onNightInfo: (info: any) => { revealerNightInfo = info; }
+        }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: REVEALER_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(revealerNightInfo.success).toBe(true);
+      expect(revealerNightInfo.info.viewed).toEqual([
+        { playerId: 'player-2', role: RoleName.WEREWOLF }
+      ]);
+      expect(revealerNightInfo.info.revealed).toBeUndefined();
+      expect(game.getRevealedCards().size).toBe(0);
+    });
+  });
+});
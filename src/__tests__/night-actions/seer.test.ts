@@ -1,6 +1,6 @@
 /**
  * @fileoverview Seer role tests.
- * Tests S1-S5 from the test checklist.
+ * Tests S1-S5 from the test checklist, plus S6-S7 for the two center cards.
  */
 
 import { RoleName, Team } from '../../enums';
@@ -118,11 +118,60 @@ describe('Seer Role Tests', () => {
       });
 
       expect(seerNightInfo).not.toBeNull();
-      // The exact role depends on night order - Robber acts at order 6, Seer at order 5
-      // So Seer sees BEFORE swap in standard order
-      // Actually, Seer (order 5) acts BEFORE Robber (order 6), so Seer sees original Villager
+      // The exact role depends on night order - Seer acts BEFORE Robber,
+      // so Seer sees the original Villager
       expect(seerNightInfo.info.viewed[0].playerId).toBe('player-2');
     });
+
+    it('S6: Seer picking the same center card twice is rejected', async () => {
+      let seerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [2, {
+          seerChoice: 'center' as const,
+          selectTwoCenterIndices: [1, 1] as [number, number],
+          onNightInfo: (info: any) => { seerNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: SEER_ROLES,
+        forcedRoles: new Map([[2, RoleName.SEER]]),
+        agentConfigs,
+        defaultVoteTarget: 'player-4'
+      });
+
+      expect(seerNightInfo.success).toBe(false);
+      expect(seerNightInfo.failureCode).toBe('DUPLICATE_TARGET');
+      expect(seerNightInfo.error).toBe('Must select two different center cards');
+      expect(seerNightInfo.info.viewed).toBeUndefined();
+    });
+
+    it('S7: Seer should see the two distinct center cards picked, in order', async () => {
+      let seerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [2, {
+          seerChoice: 'center' as const,
+          selectTwoCenterIndices: [2, 0] as [number, number],
+          onNightInfo: (info: any) => { seerNightInfo = info; }
+        }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: SEER_ROLES,
+        forcedRoles: new Map([[2, RoleName.SEER]]),
+        agentConfigs,
+        defaultVoteTarget: 'player-4'
+      });
+
+      const center = game.getCenterCards();
+      expect(seerNightInfo.success).toBe(true);
+      expect(seerNightInfo.info.viewed).toEqual([
+        { centerIndex: 2, role: center[2] },
+        { centerIndex: 0, role: center[0] }
+      ]);
+    });
   });
 
   describe('Win Condition Tests', () => {
@@ -0,0 +1,223 @@
+/**
+ * @fileoverview Sentinel role tests.
+ * The Sentinel shields one other player's card before anyone else wakes;
+ * later roles can neither move nor look at it, and their action fails
+ * with TARGET_SHIELDED instead.
+ */
+
+import { RoleName } from '../../enums';
+import { createTestGame, getFinalRole } from '../setup/testUtils';
+
+describe('Sentinel Role Tests', () => {
+  const rolesWith = (second: RoleName): RoleName[] => [
+    RoleName.SENTINEL, second, RoleName.WEREWOLF,
+    RoleName.VILLAGER, RoleName.VILLAGER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const forcedWith = (second: RoleName): Map<number, RoleName> => new Map([
+    [0, RoleName.SENTINEL],
+    [1, second],
+    [2, RoleName.WEREWOLF]
+  ]);
+
+  describe('Night Action Tests', () => {
+    it('SN1: Sentinel should shield the chosen player', async () => {
+      let sentinelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { sentinelNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: rolesWith(RoleName.VILLAGER),
+        forcedRoles: forcedWith(RoleName.VILLAGER),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(sentinelNightInfo.roleName).toBe(RoleName.SENTINEL);
+      expect(sentinelNightInfo.success).toBe(true);
+      expect(sentinelNightInfo.info.shielded).toBe('player-3');
+    });
+
+    it('SN2: Robber should not steal a shielded card', async () => {
+      let robberNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { robberNightInfo = info; }
+        }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: rolesWith(RoleName.ROBBER),
+        forcedRoles: forcedWith(RoleName.ROBBER),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(robberNightInfo.success).toBe(false);
+      expect(robberNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(getFinalRole(result, 'player-2')).toBe(RoleName.ROBBER);
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.WEREWOLF);
+    });
+
+    it('SN3: Seer should not view a shielded card', async () => {
+      let seerNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, {
+          seerChoice: 'player' as const,
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { seerNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: rolesWith(RoleName.SEER),
+        forcedRoles: forcedWith(RoleName.SEER),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(seerNightInfo.success).toBe(false);
+      expect(seerNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(seerNightInfo.info.viewed).toBeUndefined();
+    });
+
+    it('SN4: Troublemaker should not swap when either card is shielded', async () => {
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, { selectTwoPlayersTargets: ['player-3', 'player-4'] as [string, string] }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: rolesWith(RoleName.TROUBLEMAKER),
+        forcedRoles: forcedWith(RoleName.TROUBLEMAKER),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(getFinalRole(result, 'player-3')).toBe(RoleName.WEREWOLF);
+      expect(getFinalRole(result, 'player-4')).toBe(RoleName.VILLAGER);
+    });
+
+    it('SN5: Drunk should keep a shielded card', async () => {
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2' }],
+        [1, { selectCenterIndex: 0 }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: rolesWith(RoleName.DRUNK),
+        forcedRoles: forcedWith(RoleName.DRUNK),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(getFinalRole(result, 'player-2')).toBe(RoleName.DRUNK);
+    });
+
+    it('SN6: Doppelganger should not copy a shielded card', async () => {
+      let doppelNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { doppelNightInfo = info; }
+        }]
+      ]);
+
+      const { game } = await createTestGame({
+        roles: rolesWith(RoleName.DOPPELGANGER),
+        forcedRoles: forcedWith(RoleName.DOPPELGANGER),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(doppelNightInfo.success).toBe(false);
+      expect(doppelNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(doppelNightInfo.info.copied).toBeUndefined();
+      expect(game.getDoppelgangersWhoCopied(RoleName.WEREWOLF)).toEqual([]);
+    });
+
+    it('SN7: Mystic Wolf should not view a shielded card', async () => {
+      let mysticNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-3' }],
+        [1, {
+          selectPlayerTarget: 'player-3',
+          onNightInfo: (info: any) => { mysticNightInfo = info; }
+        }]
+      ]);
+
+      await createTestGame({
+        roles: rolesWith(RoleName.MYSTIC_WOLF),
+        forcedRoles: forcedWith(RoleName.MYSTIC_WOLF),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(mysticNightInfo.success).toBe(false);
+      expect(mysticNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(mysticNightInfo.info.viewed).toBeUndefined();
+    });
+
+    it('SN9: Insomniac should not view their own shielded card', async () => {
+      let insomniacNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, { selectPlayerTarget: 'player-2' }],
+        [1, { onNightInfo: (info: any) => { insomniacNightInfo = info; } }]
+      ]);
+
+      await createTestGame({
+        roles: rolesWith(RoleName.INSOMNIAC),
+        forcedRoles: forcedWith(RoleName.INSOMNIAC),
+        agentConfigs,
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(insomniacNightInfo.success).toBe(false);
+      expect(insomniacNightInfo.failureCode).toBe('TARGET_SHIELDED');
+      expect(insomniacNightInfo.info.viewed).toBeUndefined();
+    });
+  });
+
+  describe('Engine Guard', () => {
+    it('SN8: Game should refuse to swap a shielded card', async () => {
+      const { game } = await createTestGame({
+        roles: rolesWith(RoleName.VILLAGER),
+        forcedRoles: forcedWith(RoleName.VILLAGER),
+        agentConfigs: new Map([[0, { selectPlayerTarget: 'player-3' }]]),
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(() => game.swapCards({ playerId: 'player-4' }, { playerId: 'player-3' }))
+        .toThrow("player-3's card: it is shielded by the Sentinel");
+      expect(game.getPlayerRole('player-3')).toBe(RoleName.WEREWOLF);
+    });
+
+    it('SN10: Game should refuse to show a shielded card to a night action', async () => {
+      const { game } = await createTestGame({
+        roles: rolesWith(RoleName.VILLAGER),
+        forcedRoles: forcedWith(RoleName.VILLAGER),
+        agentConfigs: new Map([[0, { selectPlayerTarget: 'player-3' }]]),
+        defaultVoteTarget: 'player-3'
+      });
+
+      expect(() => game.viewPlayerCard('player-3'))
+        .toThrow("Cannot view player-3's card: it is shielded by the Sentinel");
+      expect(game.viewPlayerCard('player-4')).toBe(RoleName.VILLAGER);
+    });
+  });
+});
@@ -0,0 +1,93 @@
+/**
+ * @fileoverview Passing on an optional night action.
+ * A player who answers SKIP to an optional action ends their turn with a
+ * recorded no-op instead of waiting out the timer; mandatory actions
+ * refuse it and keep the request open.
+ */
+
+import { RoleName } from '../../enums';
+import { ErrorCodes } from '../../network/protocol';
+import {
+  INightActionAgent,
+  NightActionSkippedError,
+  RobberAction,
+  TroublemakerAction
+} from '../../patterns/strategy';
+import { NetworkAgent } from '../../server/NetworkAgent';
+import { SKIP_NIGHT_ACTION } from '../../types';
+import { MockConnection } from '../setup/MockConnection';
+import { createNightActionContext, createNightActionGameState } from '../setup/nightActionFakes';
+
+function respond(connection: MockConnection, requestId: string, response: unknown): void {
+  connection.receive({ type: 'actionResponse', requestId, response, timestamp: Date.now() });
+}
+
+describe('Skipping a night action', () => {
+  let connection: MockConnection;
+  let agent: NetworkAgent;
+
+  beforeEach(() => {
+    connection = new MockConnection('conn-1');
+    agent = new NetworkAgent('player-1', connection, true);
+  });
+
+  afterEach(() => {
+    agent.dispose();
+  });
+
+  it('lets a Troublemaker pass without swapping', async () => {
+    const action = new TroublemakerAction();
+    const gameState = createNightActionGameState();
+
+    const pending = action.execute(createNightActionContext(RoleName.TROUBLEMAKER, { canSkip: action.isOptional() }), agent, gameState);
+    const [{ request }] = connection.sentOfType('actionRequired');
+    expect(request.canSkip).toBe(true);
+    respond(connection, request.requestId, SKIP_NIGHT_ACTION);
+
+    const result = await pending;
+    expect(result.success).toBe(true);
+    expect(result.actionType).toBe('NONE');
+    expect(result.info).toEqual({ skipped: true });
+    expect(gameState.swapCards).not.toHaveBeenCalled();
+    expect(connection.sentOfType('error')).toHaveLength(0);
+  });
+
+  it('refuses a skip from the Robber and keeps the request open', async () => {
+    const action = new RobberAction();
+    const gameState = createNightActionGameState();
+
+    const pending = action.execute(createNightActionContext(RoleName.ROBBER, { canSkip: action.isOptional() }), agent, gameState);
+    const [{ request }] = connection.sentOfType('actionRequired');
+    expect(request.canSkip).toBe(false);
+    respond(connection, request.requestId, SKIP_NIGHT_ACTION);
+
+    const [error] = connection.sentOfType('error');
+    expect(error.code).toBe(ErrorCodes.INVALID_ACTION);
+    expect(error.message).toBe('This night action cannot be skipped');
+
+    respond(connection, request.requestId, 'player-2');
+    const result = await pending;
+    expect(result.success).toBe(true);
+    expect(gameState.swapCards).toHaveBeenCalledTimes(1);
+  });
+
+  it('does not turn a skip into a no-op for a mandatory action', async () => {
+    const action = new RobberAction();
+    const skip = async (): Promise<never> => {
+      throw new NightActionSkippedError('player-1');
+    };
+    const skipper: INightActionAgent = {
+      selectPlayer: skip,
+      selectCenterCard: skip,
+      selectTwoCenterCards: skip,
+      chooseSeerOption: skip,
+      chooseRotation: skip,
+      selectTwoPlayers: skip,
+      receiveNightInfo: () => {}
+    };
+
+    await expect(
+      action.execute(createNightActionContext(RoleName.ROBBER, { canSkip: action.isOptional() }), skipper, createNightActionGameState())
+    ).rejects.toThrow(NightActionSkippedError);
+  });
+});
@@ -124,7 +124,7 @@ describe('Troublemaker Role Tests', () => {
     });
 
     it('T4: Troublemaker swap after Robber should swap already-swapped cards', async () => {
-      // Robber acts at order 6, Troublemaker at order 7
+      // Robber wakes before Troublemaker
       const COMBO_ROLES = [
         RoleName.TROUBLEMAKER, RoleName.ROBBER, RoleName.WEREWOLF,
         RoleName.VILLAGER, RoleName.VILLAGER,
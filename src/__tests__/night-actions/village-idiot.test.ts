@@ -0,0 +1,116 @@
+/**
+ * @fileoverview Village Idiot role tests.
+ * The Village Idiot moves every player's card one seat left or right, or
+ * leaves them alone. A rotation only moves cards between players, so the
+ * roles in play never change.
+ */
+
+import { RoleName } from '../../enums';
+import { createTestGame, getFinalRole } from '../setup/testUtils';
+
+describe('Village Idiot Role Tests', () => {
+  const VILLAGE_IDIOT_ROLES = [
+    RoleName.VILLAGE_IDIOT, RoleName.WEREWOLF, RoleName.SEER,
+    RoleName.TANNER, RoleName.HUNTER,
+    RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED_ROLES = new Map([
+    [0, RoleName.VILLAGE_IDIOT],
+    [1, RoleName.WEREWOLF],
+    [2, RoleName.SEER],
+    [3, RoleName.TANNER],
+    [4, RoleName.HUNTER]
+  ]);
+
+  const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+  describe('Night Action Tests', () => {
+    it('VI1: LEFT should pass each card to the previous player', async () => {
+      let idiotNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          rotationChoice: 'LEFT' as const,
+          onNightInfo: (info: any) => { idiotNightInfo = info; }
+        }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: VILLAGE_IDIOT_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(idiotNightInfo.success).toBe(true);
+      expect(idiotNightInfo.info.rotated).toEqual({ direction: 'LEFT', playerIds: PLAYER_IDS });
+      expect(PLAYER_IDS.map(id => getFinalRole(result, id))).toEqual([
+        RoleName.WEREWOLF, RoleName.SEER, RoleName.TANNER, RoleName.HUNTER, RoleName.VILLAGE_IDIOT
+      ]);
+    });
+
+    it('VI2: RIGHT should keep the same roles in play', async () => {
+      const agentConfigs = new Map([
+        [0, { rotationChoice: 'RIGHT' as const }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: VILLAGE_IDIOT_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      const finalRoles = PLAYER_IDS.map(id => getFinalRole(result, id));
+      expect(finalRoles).toEqual([
+        RoleName.HUNTER, RoleName.VILLAGE_IDIOT, RoleName.WEREWOLF, RoleName.SEER, RoleName.TANNER
+      ]);
+      expect([...finalRoles].sort()).toEqual([...FORCED_ROLES.values()].sort());
+    });
+
+    it('VI3: Village Idiot may leave the cards alone', async () => {
+      let idiotNightInfo: any = null;
+
+      const agentConfigs = new Map([
+        [0, {
+          rotationChoice: 'NONE' as const,
+          onNightInfo: (info: any) => { idiotNightInfo = info; }
+        }]
+      ]);
+
+      const { result } = await createTestGame({
+        roles: VILLAGE_IDIOT_ROLES,
+        forcedRoles: FORCED_ROLES,
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(idiotNightInfo.success).toBe(true);
+      expect(idiotNightInfo.info.rotated).toBeUndefined();
+      expect(PLAYER_IDS.map(id => getFinalRole(result, id))).toEqual([...FORCED_ROLES.values()]);
+    });
+
+    it('VI4: a shielded card should stay put while the others rotate', async () => {
+      const agentConfigs = new Map([
+        [0, { rotationChoice: 'LEFT' as const }],
+        [2, { selectPlayerTarget: 'player-4' }] // Sentinel shields the Tanner
+      ]);
+
+      const { result } = await createTestGame({
+        roles: [
+          RoleName.VILLAGE_IDIOT, RoleName.WEREWOLF, RoleName.SENTINEL,
+          RoleName.TANNER, RoleName.HUNTER,
+          RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+        ],
+        forcedRoles: new Map([...FORCED_ROLES, [2, RoleName.SENTINEL]]),
+        agentConfigs,
+        defaultVoteTarget: 'player-2'
+      });
+
+      expect(PLAYER_IDS.map(id => getFinalRole(result, id))).toEqual([
+        RoleName.WEREWOLF, RoleName.SENTINEL, RoleName.HUNTER, RoleName.TANNER, RoleName.VILLAGE_IDIOT
+      ]);
+    });
+  });
+});
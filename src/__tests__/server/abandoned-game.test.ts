@@ -0,0 +1,94 @@
+/**
+ * @fileoverview Games everyone walks away from.
+ * If every player drops mid-vote, the votes can never all arrive, so the
+ * game is cancelled and its room removed once nobody comes back in time.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { RoomStatus } from '../../server/Room';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+const ABANDON_MS = 50;
+
+describe('Abandoned games', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0, abandonedGameTimeoutMs: ABANDON_MS });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient({ votes: false });
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /** Plays a five-player game until the vote is requested */
+  async function playUntilVoting(): Promise<{ roomCode: string; clients: ScriptedClient[] }> {
+    const host = await connect('host');
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    const clients = [host];
+    for (const id of ['alice', 'bob', 'carol', 'dave']) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      clients.push(guest);
+    }
+
+    await host.waitFor('roomUpdate', message => message.state.readyCount === 5);
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    await Promise.all(clients.map(client =>
+      client.waitFor('actionRequired', message => message.request.actionType === 'vote')
+    ));
+    return { roomCode, clients };
+  }
+
+  it('cancels a game everyone left during voting and removes its room', async () => {
+    const { roomCode, clients } = await playUntilVoting();
+    const manager = server.getRoomManager();
+    const room = manager.getRoom(roomCode)!;
+    expect(room.getGame()!.getPhase()).toBe(GamePhase.VOTING);
+
+    for (const client of clients) {
+      client.drop();
+    }
+    expect(room.isAbandoned()).toBe(true);
+
+    for (let waited = 0; manager.hasRoom(roomCode) && waited < 5000; waited += 10) {
+      await new Promise(resolve => setTimeout(resolve, 10));
+    }
+
+    expect(manager.hasRoom(roomCode)).toBe(false);
+    expect(room.getStatus()).toBe(RoomStatus.CLOSED);
+    expect(room.getFinalResult()).toBeNull();
+    for (const client of clients) {
+      expect(client.received.some(m => m.type === 'gameEnd')).toBe(false);
+    }
+  }, 20000);
+});
@@ -0,0 +1,189 @@
+/**
+ * @fileoverview Admin room list tests.
+ * Operators need to see every room, not just the joinable ones, to find
+ * stuck or long-running games; the list is only served with the token.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { ErrorCodes, RoomConfig } from '../../network/protocol';
+import { ApiHandler } from '../../server/ApiHandler';
+import { RoomStatus } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { AuthService, IOAuthService } from '../../services';
+import {
+  IGameRepository,
+  IReplayRepository,
+  IStatisticsRepository,
+  IUserRepository
+} from '../../database/repositories';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+const TOKEN = 'admin-secret';
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  headers: Record<string, string> = {};
+  body = '';
+
+  setHeader(name: string, value: string): void {
+    this.headers[name] = value;
+  }
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = { ...this.headers, ...headers };
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+
+  json(): Record<string, any> {
+    return JSON.parse(this.body);
+  }
+}
+
+/** Creates a handler with no database behind it */
+function createHandler(adminToken?: string): ApiHandler {
+  return new ApiHandler({
+    authService: {} as AuthService,
+    oauthService: {} as IOAuthService,
+    userRepo: {} as IUserRepository,
+    statsRepo: {} as IStatisticsRepository,
+    replayRepo: {} as IReplayRepository,
+    gameRepo: {} as IGameRepository,
+    adminToken
+  });
+}
+
+async function getAdminGames(handler: ApiHandler, token?: string): Promise<FakeResponse> {
+  const res = new FakeResponse();
+  const headers = token ? { authorization: `Bearer ${token}` } : {};
+  const req = { method: 'GET', url: '/api/admin/games', headers } as unknown as IncomingMessage;
+  await handler.handleRequest(req, res as unknown as ServerResponse);
+  return res;
+}
+
+describe('RoomManager.getAllRoomSummaries', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  it('lists rooms in every status, private ones included, oldest first', () => {
+    const lobby = manager.createRoom('host-1', { ...CONFIG, isPrivate: true });
+    lobby.addPlayer('host-1', 'Alice', new MockConnection('conn-1'));
+
+    jest.advanceTimersByTime(1000);
+    const playing = manager.createRoom('host', CONFIG);
+    playing.addPlayer('host', 'Bob', new MockConnection('conn-2'));
+    manager.addBots(playing.getCode(), 'host', 4);
+    playing.startGame('host');
+
+    const summaries = manager.getAllRoomSummaries(playing.getCreatedAt() + 5000);
+
+    expect(summaries.map(s => s.roomCode)).toEqual([lobby.getCode(), playing.getCode()]);
+    expect(summaries[0]).toEqual({
+      roomCode: lobby.getCode(),
+      status: RoomStatus.WAITING,
+      hostName: 'Alice',
+      playerCount: 1,
+      connectedPlayers: 1,
+      maxPlayers: 5,
+      isPrivate: true,
+      phase: null,
+      timeRemaining: null,
+      createdAt: lobby.getCreatedAt(),
+      ageMs: 6000
+    });
+    expect(summaries[1].status).toBe(RoomStatus.PLAYING);
+    expect(summaries[1].playerCount).toBe(5);
+    expect(summaries[1].connectedPlayers).toBe(1);
+    expect(summaries[1].phase).not.toBeNull();
+    expect(summaries[1].ageMs).toBe(5000);
+  });
+
+  it('leaves the public list to waiting rooms', () => {
+    const playing = manager.createRoom('host', CONFIG);
+    playing.addPlayer('host', 'Bob', new MockConnection('conn-1'));
+    manager.addBots(playing.getCode(), 'host', 4);
+    playing.startGame('host');
+
+    expect(manager.getRoomSummaries()).toEqual([]);
+    expect(manager.getAllRoomSummaries()).toHaveLength(1);
+  });
+});
+
+describe('GET /api/admin/games', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  it('returns every room to a caller with the admin token', async () => {
+    const handler = createHandler(TOKEN);
+    handler.attachRoomManager(manager);
+    const room = manager.createRoom('host', CONFIG);
+
+    const res = await getAdminGames(handler, TOKEN);
+
+    expect(res.statusCode).toBe(200);
+    expect(res.json().data.map((s: { roomCode: string }) => s.roomCode)).toEqual([room.getCode()]);
+  });
+
+  it('answers 401 AUTH_REQUIRED without a token', async () => {
+    const res = await getAdminGames(createHandler(TOKEN));
+
+    expect(res.statusCode).toBe(401);
+    expect(res.json().code).toBe(ErrorCodes.AUTH_REQUIRED);
+  });
+
+  it('answers 403 AUTH_INVALID for the wrong token', async () => {
+    const res = await getAdminGames(createHandler(TOKEN), 'admin-secreT');
+
+    expect(res.statusCode).toBe(403);
+    expect(res.json().code).toBe(ErrorCodes.AUTH_INVALID);
+  });
+
+  it('does not exist when no admin token is configured', async () => {
+    const res = await getAdminGames(createHandler(), TOKEN);
+
+    expect(res.statusCode).toBe(404);
+    expect(res.json().code).toBe(ErrorCodes.ENDPOINT_NOT_FOUND);
+  });
+
+  it('answers 503 before the game server is attached', async () => {
+    const res = await getAdminGames(createHandler(TOKEN), TOKEN);
+
+    expect(res.statusCode).toBe(503);
+    expect(res.json().code).toBe(ErrorCodes.SERVICE_UNAVAILABLE);
+  });
+});
@@ -0,0 +1,105 @@
+/**
+ * @fileoverview Secret ballot while voting is open.
+ * With anonymous voting the table sees how many votes are in but not
+ * who cast them, and no one's view shows another player's vote.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Anonymous voting', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient({ votes: playerId === 'host' });
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /** Plays a five-player game until the host's vote is in, returning each client */
+  async function playUntilHostVotes(config: RoomConfig): Promise<Map<string, ScriptedClient>> {
+    const clients = new Map<string, ScriptedClient>();
+    const host = await connect('host');
+    clients.set('host', host);
+    host.receive({ type: 'createRoom', config, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    for (const id of ['alice', 'bob', 'carol', 'dave']) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      clients.set(id, guest);
+    }
+
+    await host.waitFor('roomUpdate', message => message.state.readyCount === 5);
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    await clients.get('alice')!.waitFor('voteProgress');
+    return clients;
+  }
+
+  /** Asks for a player's current game view */
+  async function viewOf(client: ScriptedClient) {
+    const states = () => client.received.filter(m => m.type === 'gameState');
+    const seen = states().length;
+    client.receive({ type: 'getState', timestamp: Date.now() });
+    const state = await client.waitFor('gameState', message => states().indexOf(message) >= seen);
+    return state.view;
+  }
+
+  it('only counts votes in the public broadcast', async () => {
+    const clients = await playUntilHostVotes({ ...CONFIG, anonymousVotes: true });
+
+    const progress = await clients.get('alice')!.waitFor('voteProgress');
+    expect(progress.votedCount).toBe(1);
+    expect(progress.totalVoters).toBe(5);
+    expect(progress).not.toHaveProperty('voterId');
+  }, 20000);
+
+  it("hides a voter's choice from everyone else mid-vote", async () => {
+    const clients = await playUntilHostVotes({ ...CONFIG, anonymousVotes: true });
+
+    const aliceView = await viewOf(clients.get('alice')!);
+    expect(aliceView.phase).toBe(GamePhase.VOTING);
+    expect(aliceView.votes).toBeNull();
+    expect(aliceView.players.some(p => p.hasVoted)).toBe(false);
+
+    const hostView = await viewOf(clients.get('host')!);
+    expect(hostView.players.filter(p => p.hasVoted)).toHaveLength(1);
+  }, 20000);
+
+  it('names the voter when the ballot is not secret', async () => {
+    const clients = await playUntilHostVotes(CONFIG);
+
+    const progress = await clients.get('alice')!.waitFor('voteProgress');
+    expect(progress.voterId).toBe('host');
+    expect(progress.votedCount).toBe(1);
+  }, 20000);
+});
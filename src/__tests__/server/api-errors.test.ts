@@ -0,0 +1,187 @@
+/**
+ * @fileoverview REST API error response tests.
+ * Every failed request answers with { success: false, code, error } so
+ * clients can tell "game not found" from "not the host" by code alone.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { ErrorCodes, RoomConfig } from '../../network/protocol';
+import { ApiHandler } from '../../server/ApiHandler';
+import { RoomManager } from '../../server/RoomManager';
+import { AuthService, IOAuthService } from '../../services';
+import {
+  IGameRepository,
+  IReplayRepository,
+  IStatisticsRepository,
+  IUserRepository
+} from '../../database/repositories';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  headers: Record<string, string> = {};
+  body = '';
+
+  setHeader(name: string, value: string): void {
+    this.headers[name] = value;
+  }
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = { ...this.headers, ...headers };
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+
+  json(): Record<string, unknown> {
+    return JSON.parse(this.body);
+  }
+}
+
+/** Creates a handler with no database behind it */
+function createHandler(): ApiHandler {
+  return new ApiHandler({
+    authService: {} as AuthService,
+    oauthService: {} as IOAuthService,
+    userRepo: {} as IUserRepository,
+    statsRepo: {} as IStatisticsRepository,
+    replayRepo: {} as IReplayRepository,
+    gameRepo: {} as IGameRepository
+  });
+}
+
+async function send(
+  handler: ApiHandler,
+  method: string,
+  url: string,
+  headers: Record<string, string> = {}
+): Promise<FakeResponse> {
+  const res = new FakeResponse();
+  const req = { method, url, headers } as unknown as IncomingMessage;
+  await handler.handleRequest(req, res as unknown as ServerResponse);
+  return res;
+}
+
+describe('API error responses', () => {
+  beforeEach(() => {
+    jest.useFakeTimers();
+  });
+
+  afterEach(() => {
+    jest.useRealTimers();
+  });
+
+  it('answers an unknown endpoint with 404 ENDPOINT_NOT_FOUND', async () => {
+    const res = await send(createHandler(), 'GET', '/api/nowhere');
+
+    expect(res.statusCode).toBe(404);
+    expect(res.headers['Content-Type']).toBe('application/json');
+    expect(res.json()).toEqual({
+      success: false,
+      code: ErrorCodes.ENDPOINT_NOT_FOUND,
+      error: 'Endpoint not found'
+    });
+  });
+
+  it('answers 503 SERVICE_UNAVAILABLE before the game server is attached', async () => {
+    const res = await send(createHandler(), 'POST', '/api/games/ABCD/players/alice/leave');
+
+    expect(res.statusCode).toBe(503);
+    expect(res.json().code).toBe(ErrorCodes.SERVICE_UNAVAILABLE);
+  });
+
+  it('answers 400 INVALID_REQUEST for a bad role preview size', async () => {
+    const res = await send(createHandler(), 'GET', '/api/roles/preview?players=99');
+
+    expect(res.statusCode).toBe(400);
+    expect(res.json().code).toBe(ErrorCodes.INVALID_REQUEST);
+  });
+
+  describe('live games', () => {
+    let handler: ApiHandler;
+    let manager: RoomManager;
+
+    beforeEach(() => {
+      handler = createHandler();
+      manager = new RoomManager();
+      handler.attachRoomManager(manager);
+    });
+
+    it('answers 404 GAME_NOT_FOUND for an unknown room', async () => {
+      const res = await send(handler, 'GET', '/api/games/ZZZZ/result');
+
+      expect(res.statusCode).toBe(404);
+      expect(res.json()).toEqual({
+        success: false,
+        code: ErrorCodes.GAME_NOT_FOUND,
+        error: 'Game not found'
+      });
+    });
+
+    it('answers 403 NOT_HOST when someone else deletes the game', async () => {
+      const room = manager.createRoom('host', CONFIG);
+      room.addPlayer('host', 'host', new MockConnection('conn-host'));
+      room.addPlayer('alice', 'alice', new MockConnection('conn-alice'));
+
+      const res = await send(handler, 'DELETE', `/api/games/${room.getCode()}`, {
+        'x-player-token': room.getPlayer('alice')!.token
+      });
+
+      expect(res.statusCode).toBe(403);
+      expect(res.json().code).toBe(ErrorCodes.NOT_HOST);
+    });
+
+    it('answers 401 AUTH_REQUIRED to a request that only names a player', async () => {
+      const room = manager.createRoom('host', CONFIG);
+      room.addPlayer('host', 'host', new MockConnection('conn-host'));
+
+      const res = await send(handler, 'DELETE', `/api/games/${room.getCode()}`, { 'x-player-id': 'host' });
+
+      expect(res.statusCode).toBe(401);
+      expect(res.json().code).toBe(ErrorCodes.AUTH_REQUIRED);
+      expect(manager.hasRoom(room.getCode())).toBe(true);
+    });
+
+    it('answers 401 AUTH_INVALID to a token the room never issued', async () => {
+      const room = manager.createRoom('host', CONFIG);
+      room.addPlayer('host', 'host', new MockConnection('conn-host'));
+
+      const res = await send(handler, 'POST', `/api/games/${room.getCode()}/players/host/leave`, {
+        'x-player-token': 'guessed'
+      });
+
+      expect(res.statusCode).toBe(401);
+      expect(res.json().code).toBe(ErrorCodes.AUTH_INVALID);
+    });
+
+    it('answers 403 AUTH_INVALID when a player leaves for someone else', async () => {
+      const room = manager.createRoom('host', CONFIG);
+      room.addPlayer('host', 'host', new MockConnection('conn-host'));
+      room.addPlayer('alice', 'alice', new MockConnection('conn-alice'));
+
+      const res = await send(handler, 'POST', `/api/games/${room.getCode()}/players/host/leave`, {
+        'x-player-token': room.getPlayer('alice')!.token
+      });
+
+      expect(res.statusCode).toBe(403);
+      expect(res.json().code).toBe(ErrorCodes.AUTH_INVALID);
+      expect(room.getPlayer('host')).toBeDefined();
+    });
+  });
+});
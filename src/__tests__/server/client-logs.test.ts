@@ -0,0 +1,86 @@
+/**
+ * @fileoverview Client log upload tests.
+ * Single entries and batches are both accepted; messages cannot forge
+ * extra log lines, oversized batches are refused, and each IP is rate
+ * limited.
+ */
+
+import {
+  ClientLogRateLimiter,
+  parseClientLogs,
+  sanitizeLogMessage
+} from '../../server/ClientLogs';
+import { LogLevel } from '../../utils/logger';
+
+describe('Client logs', () => {
+  describe('parseClientLogs', () => {
+    it('accepts a single entry object', () => {
+      const parsed = parseClientLogs({ level: 'error', message: 'Socket closed', source: 'GameBoard' });
+
+      expect(parsed).toEqual({
+        valid: true,
+        entries: [{ level: LogLevel.ERROR, message: 'Socket closed', source: 'GameBoard' }]
+      });
+    });
+
+    it('accepts an array of entries, defaulting unknown levels to info', () => {
+      const parsed = parseClientLogs([
+        { level: 'warn', message: 'Slow render' },
+        { level: 'fatal', message: 'Unknown level' }
+      ]);
+
+      expect(parsed.valid && parsed.entries.map(e => e.level)).toEqual([LogLevel.WARN, LogLevel.INFO]);
+    });
+
+    it('rejects entries without a string message', () => {
+      expect(parseClientLogs([{ message: 'ok' }, { level: 'info' }])).toMatchObject({
+        valid: false,
+        error: 'Log entry 1 needs a message',
+        tooLarge: false
+      });
+      expect(parseClientLogs(null).valid).toBe(false);
+      expect(parseClientLogs([]).valid).toBe(false);
+    });
+
+    it('refuses batches over the size limit', () => {
+      const batch = Array.from({ length: 6 }, (_, i) => ({ message: `entry ${i}` }));
+
+      expect(parseClientLogs(batch, { maxBatchSize: 5 })).toMatchObject({ valid: false, tooLarge: true });
+      expect(parseClientLogs(batch.slice(0, 5), { maxBatchSize: 5 }).valid).toBe(true);
+    });
+  });
+
+  describe('sanitizeLogMessage', () => {
+    it('keeps a message on one line', () => {
+      const forged = 'hello\n2024-01-01T00:00:00.000Z ERROR Fake server line\r\u0007\u2028end';
+
+      expect(sanitizeLogMessage(forged, 1000)).toBe('hello 2024-01-01T00:00:00.000Z ERROR Fake server line end');
+    });
+
+    it('truncates long messages', () => {
+      const result = sanitizeLogMessage('x'.repeat(50), 10);
+
+      expect(result).toBe('xxxxxxx...');
+      expect(result).toHaveLength(10);
+    });
+  });
+
+  describe('ClientLogRateLimiter', () => {
+    it('limits each IP per window, counting every entry', () => {
+      const limiter = new ClientLogRateLimiter({ maxEntriesPerWindow: 10, windowMs: 60000 });
+
+      expect(limiter.tryConsume('1.2.3.4', 8, 0)).toBe(true);
+      expect(limiter.tryConsume('1.2.3.4', 3, 1000)).toBe(false);
+      expect(limiter.tryConsume('1.2.3.4', 2, 2000)).toBe(true);
+      expect(limiter.tryConsume('5.6.7.8', 10, 2000)).toBe(true);
+    });
+
+    it('starts a new window once the old one ends', () => {
+      const limiter = new ClientLogRateLimiter({ maxEntriesPerWindow: 10, windowMs: 60000 });
+
+      limiter.tryConsume('1.2.3.4', 10, 0);
+      expect(limiter.tryConsume('1.2.3.4', 1, 59999)).toBe(false);
+      expect(limiter.tryConsume('1.2.3.4', 1, 60000)).toBe(true);
+    });
+  });
+});
@@ -0,0 +1,146 @@
+/**
+ * @fileoverview Extending the day discussion.
+ * A table that wants to keep talking can push the deadline out once the
+ * host or a majority of the connected humans asks, but only a fixed
+ * number of times per day.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { PhaseClock } from '../../server/PhaseClock';
+import { DISCUSSION_EXTENSION_MS, MAX_DISCUSSION_EXTENSIONS } from '../../server/Room';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Discussion extensions', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient({ readyToVote: false, votes: false });
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /**
+   * Plays a five-player game into the day, returning each client and the
+   * day deadline. Seats the guests not given are filled with AI players.
+   */
+  async function playUntilDay(
+    guests: string[] = ['alice', 'bob', 'carol', 'dave']
+  ): Promise<{ clients: Map<string, ScriptedClient>; endsAt: number }> {
+    const clients = new Map<string, ScriptedClient>();
+    const host = await connect('host');
+    clients.set('host', host);
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    for (const id of guests) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      clients.set(id, guest);
+    }
+    for (let i = guests.length; i < 4; i++) {
+      host.receive({ type: 'addAI', timestamp: Date.now() });
+    }
+
+    await host.waitFor('roomUpdate', message => message.state.readyCount === 5);
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    const day = await host.waitFor('phaseChange', message => message.phase === GamePhase.DAY);
+    return { clients, endsAt: day.phaseEndsAt! };
+  }
+
+  it('extends the deadline once a majority asks', async () => {
+    const { clients, endsAt } = await playUntilDay();
+    const host = clients.get('host')!;
+
+    clients.get('alice')!.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const first = await host.waitFor('extensionRequested');
+    expect(first).toMatchObject({ playerId: 'alice', votes: 1, votesNeeded: 3 });
+
+    clients.get('bob')!.receive({ type: 'requestExtension', timestamp: Date.now() });
+    clients.get('carol')!.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const extended = await host.waitFor('discussionExtended');
+
+    expect(extended.phaseEndsAt).toBe(endsAt + DISCUSSION_EXTENSION_MS);
+    expect(extended.extensionsLeft).toBe(MAX_DISCUSSION_EXTENSIONS - 1);
+  }, 20000);
+
+  it('counts only connected humans toward the majority', async () => {
+    const { clients, endsAt } = await playUntilDay(['alice', 'bob', 'carol']);
+    const host = clients.get('host')!;
+    clients.get('carol')!.drop();
+
+    clients.get('alice')!.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const first = await host.waitFor('extensionRequested');
+    expect(first).toMatchObject({ playerId: 'alice', votes: 1, votesNeeded: 2 });
+
+    clients.get('bob')!.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const extended = await host.waitFor('discussionExtended');
+
+    expect(extended.phaseEndsAt).toBe(endsAt + DISCUSSION_EXTENSION_MS);
+  }, 20000);
+
+  it('lets the host extend alone, up to the cap', async () => {
+    const { clients, endsAt } = await playUntilDay();
+    const host = clients.get('host')!;
+
+    for (let i = 0; i < MAX_DISCUSSION_EXTENSIONS; i++) {
+      host.receive({ type: 'requestExtension', timestamp: Date.now() });
+    }
+    await host.waitFor('discussionExtended', message => message.extensionsLeft === 0);
+
+    host.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const error = await host.waitFor('error');
+
+    expect(error.message).toBe('No discussion extensions left');
+    const extensions = host.received.filter(m => m.type === 'discussionExtended');
+    expect(extensions).toHaveLength(MAX_DISCUSSION_EXTENSIONS);
+    expect(extensions[extensions.length - 1]).toMatchObject({
+      phaseEndsAt: endsAt + MAX_DISCUSSION_EXTENSIONS * DISCUSSION_EXTENSION_MS
+    });
+  }, 20000);
+
+  it('refuses to extend a day with no time limit', async () => {
+    const start = PhaseClock.prototype.start;
+    jest.spyOn(PhaseClock.prototype, 'start').mockImplementation(function (this: PhaseClock) {
+      start.call(this, null);
+    });
+
+    const { clients } = await playUntilDay();
+    const host = clients.get('host')!;
+
+    host.receive({ type: 'requestExtension', timestamp: Date.now() });
+    const error = await host.waitFor('error');
+
+    expect(error.message).toBe('This day has no time limit to extend');
+    expect(host.received.some(m => m.type === 'discussionExtended')).toBe(false);
+    jest.restoreAllMocks();
+  }, 20000);
+});
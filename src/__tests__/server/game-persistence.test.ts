@@ -0,0 +1,191 @@
+/**
+ * @fileoverview In-progress game persistence tests.
+ * Snapshots must round-trip through JSON and resume a game where it left off.
+ */
+
+import * as fs from 'fs';
+import * as os from 'os';
+import * as path from 'path';
+import { GamePhase, RoleName } from '../../enums';
+import { Game, GameSnapshot, IGameAgent } from '../../core/Game';
+import { JsonFileGameSnapshotStore } from '../../server/GameSnapshotStore';
+import { RoomSnapshot } from '../../server/Room';
+import { TestAgent } from '../setup/TestAgent';
+import { ROLE_CONFIGS, setupTestGame } from '../setup/testUtils';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+function createAgents(): Map<string, IGameAgent> {
+  const agents = new Map<string, TestAgent>();
+  for (const id of PLAYER_IDS) {
+    agents.set(id, new TestAgent(id, { voteTarget: 'player-1' }));
+  }
+  return agents as Map<string, IGameAgent>;
+}
+
+/** Runs a game and captures a JSON round-tripped snapshot on entering a phase */
+async function runAndCapture(phase: GamePhase): Promise<GameSnapshot> {
+  const { game } = setupTestGame({ roles: ROLE_CONFIGS.STANDARD, defaultVoteTarget: 'player-1' });
+  let captured = null as GameSnapshot | null;
+  game.addObserver({
+    onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+      if (event.type === 'PHASE_CHANGED' && event.data?.to === phase) {
+        captured = JSON.parse(JSON.stringify(game.toSnapshot()));
+      }
+    }
+  });
+
+  await game.run();
+
+  if (!captured) {
+    throw new Error(`Game never entered ${phase}`);
+  }
+  return captured;
+}
+
+describe('Game persistence', () => {
+  describe('Game snapshots', () => {
+    it('records the phase being entered', async () => {
+      const snapshot = await runAndCapture(GamePhase.DAY);
+      expect(snapshot.phase).toBe(GamePhase.DAY);
+    });
+
+    it('restores cards, players and night results exactly', async () => {
+      const snapshot = await runAndCapture(GamePhase.DAY);
+      const restored = Game.fromSnapshot(snapshot);
+
+      expect(restored.getId()).toBe(snapshot.gameId);
+      expect(restored.getPhase()).toBe(GamePhase.DAY);
+      expect(restored.toSnapshot()).toEqual(snapshot);
+    });
+
+    it('keeps shields, face-up cards and artifacts across a restore', async () => {
+      const game = Game.fromSnapshot(await runAndCapture(GamePhase.DAY));
+      game.shieldPlayer('player-2');
+      game.revealCard('player-3');
+      game.placeArtifact('player-4');
+      const revealedRole = game.getPlayerRole('player-3');
+
+      const snapshot: GameSnapshot = JSON.parse(JSON.stringify(game.toSnapshot()));
+      const restored = Game.fromSnapshot(snapshot);
+
+      expect(restored.isShielded('player-2')).toBe(true);
+      expect(restored.getRevealedCards()).toEqual(new Map([['player-3', revealedRole]]));
+      expect(restored.hasArtifact('player-4')).toBe(true);
+      expect(restored.toSnapshot()).toEqual(snapshot);
+    });
+
+    it('restores snapshots saved before shields, reveals and artifacts were kept', async () => {
+      const older = await runAndCapture(GamePhase.DAY);
+      delete older.shieldedPlayers;
+      delete older.revealedCards;
+      delete older.artifacts;
+
+      const restored = Game.fromSnapshot(older);
+
+      expect(restored.getRevealedCards().size).toBe(0);
+      expect(restored.getArtifactPlayerIds()).toEqual([]);
+      expect(PLAYER_IDS.some(id => restored.isShielded(id))).toBe(false);
+    });
+
+    it('resumes from the saved phase without re-running the night', async () => {
+      const snapshot = await runAndCapture(GamePhase.DAY);
+      const finalRoles = new Map(snapshot.players.map(p => [p.id, p.currentRole]));
+
+      const restored = Game.fromSnapshot(snapshot);
+      restored.registerAgents(createAgents());
+      const result = await restored.run();
+
+      expect(result.finalRoles).toEqual(finalRoles);
+      expect(restored.getAllNightResults()).toHaveLength(
+        snapshot.players.reduce((sum, p) => sum + p.nightResults.length, 0)
+      );
+    });
+  });
+
+  describe('JsonFileGameSnapshotStore', () => {
+    let directory: string;
+
+    beforeEach(() => {
+      directory = fs.mkdtempSync(path.join(os.tmpdir(), 'onuw-games-'));
+    });
+
+    afterEach(() => {
+      fs.rmSync(directory, { recursive: true, force: true });
+    });
+
+    async function roomSnapshot(code: string): Promise<RoomSnapshot> {
+      const game = await runAndCapture(GamePhase.VOTING);
+      return {
+        code,
+        hostId: 'host',
+        config: {
+          minPlayers: 3,
+          maxPlayers: 10,
+          roles: ROLE_CONFIGS.STANDARD,
+          timeoutStrategy: 'casual',
+          isPrivate: false,
+          allowSpectators: false
+        },
+        createdAt: 1,
+        gameStartedAt: 2,
+        players: PLAYER_IDS.map((id, i) => ({
+          id: `room-${id}`,
+          name: `Player${i + 1}`,
+          isAI: i > 0,
+          joinedAt: 1,
+          gamePlayerId: id
+        })),
+        phaseEndsAt: null,
+        dbGameId: null,
+        dbPlayerIds: {},
+        game,
+        savedAt: 3
+      };
+    }
+
+    it('loads what was saved', async () => {
+      const store = new JsonFileGameSnapshotStore(directory);
+      const snapshot = await roomSnapshot('ABC234');
+
+      store.saveGame(snapshot);
+
+      expect(new JsonFileGameSnapshotStore(directory).loadGames()).toEqual([snapshot]);
+    });
+
+    it('replaces an earlier snapshot of the same room', async () => {
+      const store = new JsonFileGameSnapshotStore(directory);
+      const first = await roomSnapshot('ABC234');
+      store.saveGame(first);
+      store.saveGame({ ...first, savedAt: 99 });
+
+      const loaded = store.loadGames();
+      expect(loaded).toHaveLength(1);
+      expect(loaded[0].savedAt).toBe(99);
+    });
+
+    it('forgets deleted rooms', async () => {
+      const store = new JsonFileGameSnapshotStore(directory);
+      store.saveGame(await roomSnapshot('ABC234'));
+      store.deleteGame('ABC234');
+
+      expect(store.loadGames()).toEqual([]);
+    });
+
+    it('skips unreadable files', async () => {
+      const store = new JsonFileGameSnapshotStore(directory);
+      store.saveGame(await roomSnapshot('ABC234'));
+      fs.writeFileSync(path.join(directory, 'BROKEN.json'), '{ not json');
+      const errorSpy = jest.spyOn(console, 'error').mockImplementation(() => {});
+
+      expect(store.loadGames().map(s => s.code)).toEqual(['ABC234']);
+      errorSpy.mockRestore();
+    });
+  });
+
+  it('keeps role names as plain strings in the snapshot', async () => {
+    const snapshot = await runAndCapture(GamePhase.NIGHT);
+    expect(snapshot.players.every(p => Object.values(RoleName).includes(p.startingRole))).toBe(true);
+    expect(snapshot.players.every(p => p.nightResults.length === 0)).toBe(true);
+  });
+});
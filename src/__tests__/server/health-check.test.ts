@@ -0,0 +1,96 @@
+/**
+ * @fileoverview Load balancer probe tests.
+ * /healthz must answer whenever the process is up; /readyz only while
+ * the game server accepts connections. Neither needs a game to exist.
+ */
+
+import { IncomingMessage, ServerResponse } from 'http';
+import { handleHealthCheck, HealthSource } from '../../server/HealthCheck';
+import { RoomManager } from '../../server/RoomManager';
+import { RoomConfig } from '../../network/protocol';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse {
+  statusCode = 0;
+  headers: Record<string, string> = {};
+  body = '';
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = headers;
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+}
+
+function probe(
+  url: string,
+  source: HealthSource,
+  method: string = 'GET'
+): { handled: boolean; res: FakeResponse } {
+  const res = new FakeResponse();
+  const req = { method, url, headers: {} } as IncomingMessage;
+  const handled = handleHealthCheck(req, res as unknown as ServerResponse, source);
+  return { handled, res };
+}
+
+describe('handleHealthCheck', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    manager = new RoomManager();
+  });
+
+  function source(isRunning: boolean): HealthSource {
+    return { isRunning, getRoomManager: () => manager };
+  }
+
+  it('answers /healthz with the room count and no games', () => {
+    const { handled, res } = probe('/healthz', source(true));
+
+    expect(handled).toBe(true);
+    expect(res.statusCode).toBe(200);
+    expect(res.headers['Content-Type']).toBe('application/json');
+    expect(JSON.parse(res.body)).toEqual({ status: 'ok', games: 0 });
+  });
+
+  it('counts the rooms held', () => {
+    manager.createRoom('host-1', CONFIG);
+    manager.createRoom('host-2', CONFIG);
+
+    const { res } = probe('/healthz?verbose=1', source(true));
+
+    expect(JSON.parse(res.body).games).toBe(2);
+  });
+
+  it('answers /healthz even while the game server is stopped', () => {
+    expect(probe('/healthz', source(false)).res.statusCode).toBe(200);
+  });
+
+  it('answers /readyz with 200 only while the game server runs', () => {
+    expect(probe('/readyz', source(true)).res.statusCode).toBe(200);
+
+    const { res } = probe('/readyz', source(false));
+    expect(res.statusCode).toBe(503);
+    expect(JSON.parse(res.body).status).toBe('unavailable');
+  });
+
+  it('leaves other paths and methods to the caller', () => {
+    expect(probe('/api/stats', source(true)).handled).toBe(false);
+    expect(probe('/healthz', source(true), 'POST').handled).toBe(false);
+  });
+});
@@ -0,0 +1,181 @@
+/**
+ * @fileoverview HTTP middleware tests.
+ * A handler that throws must answer 500 with a generic JSON error and
+ * log the stack, instead of taking the server down. API requests are
+ * logged with their method, path, status and duration. Client IPs only
+ * come from X-Forwarded-For when the server is told it is behind a proxy.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { getClientIp, withRecovery, withRequestLogging } from '../../server/HttpMiddleware';
+import { createBufferedLogger } from '../../utils/logger';
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  headersSent = false;
+  headers: Record<string, string> = {};
+  body = '';
+  ended = false;
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = { ...this.headers, ...headers };
+    this.headersSent = true;
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    this.ended = true;
+    this.emit('finish');
+    return this;
+  }
+}
+
+function request(method: string, url: string): IncomingMessage {
+  return { method, url, headers: {} } as IncomingMessage;
+}
+
+describe('withRecovery', () => {
+  it('answers 500 with a generic JSON error when the handler throws', async () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'json' });
+    const res = new FakeResponse();
+
+    const handler = withRecovery(async () => {
+      throw new Error('secret internal detail');
+    }, logger);
+    await handler(request('GET', '/api/games/ABCD'), res as unknown as ServerResponse);
+
+    expect(res.statusCode).toBe(500);
+    expect(res.headers['Content-Type']).toBe('application/json');
+    expect(JSON.parse(res.body)).toEqual({ success: false, error: 'Internal server error' });
+    expect(res.body).not.toContain('secret');
+
+    const [entry] = buffer.lines().map(line => JSON.parse(line));
+    expect(entry).toMatchObject({ level: 'error', method: 'GET', path: '/api/games/ABCD' });
+    expect(entry.error).toContain('secret internal detail');
+    expect(entry.error).toContain('http-middleware.test');
+  });
+
+  it('recovers from a synchronous throw', async () => {
+    const { logger } = createBufferedLogger();
+    const res = new FakeResponse();
+
+    const handler = withRecovery(() => {
+      throw new TypeError('Cannot read properties of undefined');
+    }, logger);
+    await handler(request('POST', '/api/games'), res as unknown as ServerResponse);
+
+    expect(res.statusCode).toBe(500);
+  });
+
+  it('ends a response that was already started', async () => {
+    const { logger } = createBufferedLogger();
+    const res = new FakeResponse();
+
+    const handler = withRecovery((_req, r) => {
+      r.writeHead(200, { 'Content-Type': 'text/plain' });
+      throw new Error('late failure');
+    }, logger);
+    await handler(request('GET', '/api/stats'), res as unknown as ServerResponse);
+
+    expect(res.statusCode).toBe(200);
+    expect(res.ended).toBe(true);
+  });
+
+  it('leaves successful responses alone', async () => {
+    const { logger, buffer } = createBufferedLogger();
+    const res = new FakeResponse();
+
+    const handler = withRecovery((_req, r) => {
+      r.writeHead(204);
+      r.end();
+    }, logger);
+    await handler(request('OPTIONS', '/api/games'), res as unknown as ServerResponse);
+
+    expect(res.statusCode).toBe(204);
+    expect(buffer.lines()).toEqual([]);
+  });
+});
+
+describe('withRequestLogging', () => {
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.setSystemTime(new Date('2024-01-01T00:00:00Z'));
+  });
+
+  afterEach(() => {
+    jest.useRealTimers();
+  });
+
+  it('logs method, path, status and duration once the response finishes', async () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'json' });
+    const res = new FakeResponse();
+
+    const handler = withRequestLogging(async (_req, r) => {
+      jest.advanceTimersByTime(42);
+      r.writeHead(404);
+      r.end('{}');
+    }, {}, logger);
+    await handler(request('GET', '/api/games/ABCD?include=players'), res as unknown as ServerResponse);
+
+    expect(buffer.lines().map(line => JSON.parse(line))).toEqual([
+      expect.objectContaining({
+        level: 'info',
+        method: 'GET',
+        path: '/api/games/ABCD',
+        status: 404,
+        durationMs: 42
+      })
+    ]);
+  });
+
+  it('records the 500 written after a handler throws', async () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'json' });
+    const res = new FakeResponse();
+
+    const handler = withRequestLogging(withRecovery(() => {
+      throw new Error('boom');
+    }, logger), {}, logger);
+    await handler(request('DELETE', '/api/games/ABCD'), res as unknown as ServerResponse);
+
+    const access = buffer.lines().map(line => JSON.parse(line)).find(e => e.level === 'info');
+    expect(access).toMatchObject({ method: 'DELETE', status: 500 });
+  });
+
+  it('skips non-API paths and the client log endpoint', async () => {
+    const { logger, buffer } = createBufferedLogger();
+    const handler = withRequestLogging((_req, r) => {
+      r.writeHead(200);
+      r.end();
+    }, {}, logger);
+
+    await handler(request('GET', '/'), new FakeResponse() as unknown as ServerResponse);
+    await handler(request('POST', '/api/logs'), new FakeResponse() as unknown as ServerResponse);
+
+    expect(buffer.lines()).toEqual([]);
+  });
+});
+
+describe('getClientIp', () => {
+  const forwardedRequest = {
+    headers: { 'x-forwarded-for': '203.0.113.9, 198.51.100.4' },
+    socket: { remoteAddress: '10.0.0.2' }
+  } as unknown as IncomingMessage;
+
+  it('uses the socket address by default, ignoring X-Forwarded-For', () => {
+    expect(getClientIp(forwardedRequest)).toBe('10.0.0.2');
+  });
+
+  it('uses the last X-Forwarded-For entry behind a trusted proxy', () => {
+    expect(getClientIp(forwardedRequest, true)).toBe('198.51.100.4');
+  });
+
+  it('falls back to the socket address when a trusted proxy sends no header', () => {
+    const req = { headers: {}, socket: { remoteAddress: '10.0.0.2' } } as unknown as IncomingMessage;
+
+    expect(getClientIp(req, true)).toBe('10.0.0.2');
+  });
+});
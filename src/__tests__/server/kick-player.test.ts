@@ -0,0 +1,197 @@
+/**
+ * @fileoverview Kicking players from the lobby.
+ * A host needs a way to get rid of a troll before the game starts: the
+ * player is told they were removed and disconnected, and only the host
+ * may do it.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { Readable } from 'stream';
+import { CloseCodes, ErrorCodes, RoomConfig } from '../../network/protocol';
+import { WebSocketConnection } from '../../network/WebSocketConnection';
+import { ApiHandler } from '../../server/ApiHandler';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { AuthService, IOAuthService } from '../../services';
+import {
+  IGameRepository,
+  IReplayRepository,
+  IStatisticsRepository,
+  IUserRepository
+} from '../../database/repositories';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  headers: Record<string, string> = {};
+  body = '';
+
+  setHeader(name: string, value: string): void {
+    this.headers[name] = value;
+  }
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = { ...this.headers, ...headers };
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+
+  json(): Record<string, any> {
+    return JSON.parse(this.body);
+  }
+}
+
+/** Creates a handler with no database behind it */
+function createHandler(manager: RoomManager): ApiHandler {
+  const handler = new ApiHandler({
+    authService: {} as AuthService,
+    oauthService: {} as IOAuthService,
+    userRepo: {} as IUserRepository,
+    statsRepo: {} as IStatisticsRepository,
+    replayRepo: {} as IReplayRepository,
+    gameRepo: {} as IGameRepository
+  });
+  handler.attachRoomManager(manager);
+  return handler;
+}
+
+async function postKick(
+  handler: ApiHandler,
+  url: string,
+  headers: Record<string, string>,
+  body: object = {}
+): Promise<FakeResponse> {
+  const req = Object.assign(Readable.from([JSON.stringify(body)]), {
+    method: 'POST',
+    url,
+    headers: { 'content-type': 'application/json', ...headers }
+  }) as unknown as IncomingMessage;
+  const res = new FakeResponse();
+  await handler.handleRequest(req, res as unknown as ServerResponse);
+  return res;
+}
+
+describe('Kicking players', () => {
+  let manager: RoomManager;
+  let room: Room;
+  let hostConnection: MockConnection;
+  let trollConnection: MockConnection;
+
+  beforeEach(() => {
+    // Request bodies are read from a stream, which needs the real nextTick
+    jest.useFakeTimers({ doNotFake: ['nextTick', 'setImmediate'] });
+    manager = new RoomManager();
+    room = manager.createRoom('host', CONFIG);
+    hostConnection = new MockConnection('conn-host');
+    trollConnection = new MockConnection('conn-troll');
+    room.addPlayer('host', 'host', hostConnection);
+    room.addPlayer('alice', 'alice', new MockConnection('conn-alice'));
+    room.addPlayer('troll', 'troll', trollConnection);
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  describe('RoomManager.kickPlayer', () => {
+    it('removes the player, tells them why and disconnects them', () => {
+      expect(manager.kickPlayer(room.getCode(), 'host', 'troll')).toBe('kicked');
+
+      expect(room.getPlayer('troll')).toBeUndefined();
+      const [error] = trollConnection.sentOfType('error');
+      expect(error.code).toBe(ErrorCodes.REMOVED_FROM_ROOM);
+      expect(error.message).toBe('You were removed from the room');
+      expect(trollConnection.isConnected()).toBe(false);
+
+      const updates = hostConnection.sentOfType('roomUpdate');
+      expect(updates[updates.length - 1].state.players.map(p => p.id)).toEqual(['host', 'alice']);
+    });
+
+    it('closes the socket with the KICKED close code', () => {
+      const socket = new FakeWebSocket();
+      room.addPlayer('bob', 'bob', new WebSocketConnection('conn-bob', socket));
+
+      manager.kickPlayer(room.getCode(), 'host', 'bob');
+
+      expect(socket.closeCode).toBe(CloseCodes.KICKED);
+      expect(trollConnection.closeCode).toBeNull();
+    });
+
+    it('refuses anyone but the host', () => {
+      expect(manager.kickPlayer(room.getCode(), 'alice', 'troll')).toBe('notHost');
+
+      expect(room.getPlayer('troll')).toBeDefined();
+      expect(trollConnection.isConnected()).toBe(true);
+      expect(trollConnection.sentOfType('error')).toEqual([]);
+    });
+
+    it('refuses once the game has started', () => {
+      room.setPlayerReady('alice', true);
+      room.setPlayerReady('troll', true);
+      manager.addBots(room.getCode(), 'host', 2);
+      room.startGame('host');
+
+      expect(manager.kickPlayer(room.getCode(), 'host', 'troll')).toBe('notWaiting');
+      expect(room.getPlayer('troll')).toBeDefined();
+    });
+
+    it('does not let the host kick themselves', () => {
+      expect(manager.kickPlayer(room.getCode(), 'host', 'host')).toBe('invalidTarget');
+      expect(manager.kickPlayer(room.getCode(), 'host', 'nobody')).toBe('notInRoom');
+    });
+  });
+
+  describe('POST /api/games/:code/players/:playerId/kick', () => {
+    it('kicks the player for the host', async () => {
+      const res = await postKick(createHandler(manager), `/api/games/${room.getCode()}/players/troll/kick`, {
+        'x-player-token': room.getPlayer('host')!.token
+      });
+
+      expect(res.statusCode).toBe(200);
+      expect(res.json()).toEqual({ success: true });
+      expect(room.getPlayer('troll')).toBeUndefined();
+    });
+
+    it('answers 403 NOT_HOST to anyone else', async () => {
+      const res = await postKick(createHandler(manager), `/api/games/${room.getCode()}/players/troll/kick`, {
+        'x-player-token': room.getPlayer('alice')!.token
+      });
+
+      expect(res.statusCode).toBe(403);
+      expect(res.json().code).toBe(ErrorCodes.NOT_HOST);
+      expect(room.getPlayer('troll')).toBeDefined();
+    });
+
+    it('does not take the host\'s public ID as proof', async () => {
+      const res = await postKick(
+        createHandler(manager),
+        `/api/games/${room.getCode()}/players/alice/kick`,
+        { 'x-player-id': 'host' },
+        { hostId: 'host' }
+      );
+
+      expect(res.statusCode).toBe(401);
+      expect(res.json().code).toBe(ErrorCodes.AUTH_REQUIRED);
+      expect(room.getPlayer('alice')).toBeDefined();
+    });
+  });
+});
@@ -0,0 +1,78 @@
+/**
+ * @fileoverview Listen address and timeout tests.
+ * Deployments move the server off port 8080 with a flag or environment
+ * variable, so the precedence between them must be predictable.
+ */
+
+import {
+  DEFAULT_HEADERS_TIMEOUT_MS,
+  DEFAULT_LISTEN_CONFIG,
+  getHeadersTimeoutMs,
+  parseListenAddress,
+  parseListenConfig
+} from '../../server/ListenConfig';
+
+describe('parseListenAddress', () => {
+  it('reads host and port, keeping the default host for :port', () => {
+    expect(parseListenAddress('127.0.0.1:9000')).toEqual({ host: '127.0.0.1', port: 9000 });
+    expect(parseListenAddress(':9000')).toEqual({ host: '0.0.0.0', port: 9000 });
+    expect(parseListenAddress('[::1]:9000')).toEqual({ host: '::1', port: 9000 });
+  });
+
+  it.each(['9000', 'localhost:', ':http', ':70000'])('rejects %p', (addr) => {
+    expect(() => parseListenAddress(addr)).toThrow(addr);
+  });
+});
+
+describe('parseListenConfig', () => {
+  it('defaults to 0.0.0.0:8080 with Node\'s timeouts', () => {
+    expect(parseListenConfig([], {})).toEqual(DEFAULT_LISTEN_CONFIG);
+  });
+
+  it('uses HOST and PORT when no address is given', () => {
+    expect(parseListenConfig([], { HOST: '127.0.0.1', PORT: '3001' }))
+      .toMatchObject({ host: '127.0.0.1', port: 3001 });
+  });
+
+  it('prefers the --addr flag over ADDR, and ADDR over PORT', () => {
+    const env = { ADDR: ':7000', PORT: '3001' };
+
+    expect(parseListenConfig([], env).port).toBe(7000);
+    expect(parseListenConfig(['--addr', ':9000'], env).port).toBe(9000);
+    expect(parseListenConfig(['-addr=localhost:9001'], env)).toMatchObject({ host: 'localhost', port: 9001 });
+  });
+
+  it('reads timeouts from flags before the environment', () => {
+    const config = parseListenConfig(
+      ['--read-timeout=5000', '--write-timeout', '0'],
+      { READ_TIMEOUT_MS: '1000', WRITE_TIMEOUT_MS: '2000' }
+    );
+
+    expect(config).toMatchObject({ readTimeoutMs: 5000, writeTimeoutMs: 0 });
+    expect(parseListenConfig([], { WRITE_TIMEOUT_MS: '2000' }).writeTimeoutMs).toBe(2000);
+  });
+
+  it('serves TLS only with both a certificate and a key', () => {
+    expect(parseListenConfig(['--tls-cert', 'cert.pem', '--tls-key=key.pem'], {}))
+      .toMatchObject({ tlsCertFile: 'cert.pem', tlsKeyFile: 'key.pem' });
+    expect(parseListenConfig([], { TLS_CERT_FILE: 'cert.pem', TLS_KEY_FILE: 'key.pem' }))
+      .toMatchObject({ tlsCertFile: 'cert.pem', tlsKeyFile: 'key.pem' });
+    expect(() => parseListenConfig([], { TLS_CERT_FILE: 'cert.pem' }))
+      .toThrow('TLS needs both a certificate (--tls-cert) and a key (--tls-key)');
+  });
+
+  it('leaves other flags alone and refuses bad values', () => {
+    expect(parseListenConfig(['--inspect', 'server.js'], {})).toEqual(DEFAULT_LISTEN_CONFIG);
+    expect(() => parseListenConfig(['--read-timeout=5s'], {})).toThrow('Invalid read timeout "5s"');
+    expect(() => parseListenConfig(['--addr'], {})).toThrow('Flag --addr needs a value');
+    expect(() => parseListenConfig([], { PORT: 'eighty' })).toThrow('Invalid port in PORT');
+  });
+});
+
+describe('getHeadersTimeoutMs', () => {
+  it('keeps Node\'s headers limit unless the read timeout is shorter', () => {
+    expect(getHeadersTimeoutMs(DEFAULT_LISTEN_CONFIG)).toBe(DEFAULT_HEADERS_TIMEOUT_MS);
+    expect(getHeadersTimeoutMs({ ...DEFAULT_LISTEN_CONFIG, readTimeoutMs: 0 })).toBe(DEFAULT_HEADERS_TIMEOUT_MS);
+    expect(getHeadersTimeoutMs({ ...DEFAULT_LISTEN_CONFIG, readTimeoutMs: 10000 })).toBe(10000);
+  });
+});
@@ -0,0 +1,94 @@
+/**
+ * @fileoverview Live lobby updates.
+ * Everyone in the lobby sees players join, leave and toggle ready as it
+ * happens, with a running count of how many are ready to start.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Lobby updates', () => {
+  let manager: RoomManager;
+  let room: Room;
+  let hostConnection: MockConnection;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+    room = manager.createRoom('host', CONFIG);
+    hostConnection = new MockConnection('conn-host');
+    room.addPlayer('host', 'host', hostConnection);
+    room.addPlayer('alice', 'alice', new MockConnection('conn-alice'));
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  /** The latest lobby state the host was sent */
+  function lastUpdate() {
+    const updates = hostConnection.sentOfType('roomUpdate');
+    return updates[updates.length - 1].state;
+  }
+
+  it('broadcasts every readiness change with the ready count', () => {
+    const before = hostConnection.sentOfType('roomUpdate').length;
+
+    room.setPlayerReady('alice', true);
+    expect(hostConnection.sentOfType('roomUpdate')).toHaveLength(before + 1);
+    expect(lastUpdate()).toMatchObject({ readyCount: 2, playerCount: 2 });
+
+    room.setPlayerReady('alice', false);
+    expect(hostConnection.sentOfType('roomUpdate')).toHaveLength(before + 2);
+    expect(lastUpdate()).toMatchObject({ readyCount: 1, playerCount: 2 });
+  });
+
+  it('ignores a ready toggle to the status the player already has', () => {
+    room.setPlayerReady('alice', true);
+    const before = hostConnection.sentOfType('roomUpdate').length;
+
+    room.setPlayerReady('alice', true);
+
+    expect(hostConnection.sentOfType('roomUpdate')).toHaveLength(before);
+    expect(lastUpdate()).toMatchObject({ readyCount: 2 });
+  });
+
+  it('accepts a retried ready once the game has started', () => {
+    room.setPlayerReady('alice', true);
+    manager.addBots(room.getCode(), 'host', 3);
+    room.startGame('host');
+
+    expect(() => room.setPlayerReady('alice', true)).not.toThrow();
+    expect(() => room.setPlayerReady('alice', false)).toThrow('Cannot change ready status after game has started');
+  });
+
+  it('updates the counts as players join and leave', () => {
+    room.addPlayer('bob', 'bob', new MockConnection('conn-bob'));
+    expect(lastUpdate()).toMatchObject({ readyCount: 1, playerCount: 3 });
+
+    room.setPlayerReady('bob', true);
+    manager.leaveRoom(room.getCode(), 'bob');
+    expect(lastUpdate()).toMatchObject({ readyCount: 1, playerCount: 2 });
+  });
+
+  it('carries no roles in the lobby state', () => {
+    room.setPlayerReady('alice', true);
+
+    for (const player of lastUpdate().players) {
+      expect(Object.keys(player).sort()).toEqual(['id', 'isAI', 'isConnected', 'isHost', 'isReady', 'name']);
+    }
+  });
+});
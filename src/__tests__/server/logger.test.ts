@@ -0,0 +1,142 @@
+/**
+ * @fileoverview Logger output format tests.
+ * Text stays the default; JSON mode writes one parseable object per
+ * entry, built from the same data as the text line. Entries below the
+ * minimum level never reach the output. Child loggers tag every line
+ * with their room and player.
+ */
+
+import { createBufferedLogger, Logger, LogLevel, parseLogFormat, parseLogLevel } from '../../utils/logger';
+
+const TIMESTAMP = new Date('2024-01-01T00:00:00Z');
+
+describe('Logger', () => {
+  it('defaults to text unless LOG_FORMAT asks for json', () => {
+    expect(parseLogFormat(undefined)).toBe('text');
+    expect(parseLogFormat('pretty')).toBe('text');
+    expect(parseLogFormat('JSON')).toBe('json');
+  });
+
+  it('encodes a text line with level, message and fields', () => {
+    const logger = new Logger({ format: 'text' });
+    const line = logger.encode({
+      level: LogLevel.INFO,
+      timestamp: TIMESTAMP,
+      message: 'Room created',
+      fields: { roomCode: 'ABCD', players: 4, name: 'Friday game' }
+    });
+
+    expect(line).toBe('2024-01-01T00:00:00.000Z INFO  Room created roomCode=ABCD players=4 name="Friday game"');
+  });
+
+  it('encodes a single JSON object in json mode', () => {
+    const logger = new Logger({ format: 'json' });
+    const line = logger.encode({
+      level: LogLevel.WARN,
+      timestamp: TIMESTAMP,
+      message: 'Slow agent',
+      fields: { roomCode: 'ABCD' }
+    });
+
+    expect(JSON.parse(line)).toEqual({
+      level: 'warn',
+      timestamp: '2024-01-01T00:00:00.000Z',
+      message: 'Slow agent',
+      roomCode: 'ABCD'
+    });
+  });
+
+  it('keeps the entry\'s level, timestamp and message over same-named fields', () => {
+    const logger = new Logger({ format: 'json' });
+    const line = logger.encode({
+      level: LogLevel.ERROR,
+      timestamp: TIMESTAMP,
+      message: 'Save failed',
+      fields: { level: 'debug', timestamp: 'never', message: 'spoofed', roomCode: 'ABCD' }
+    });
+
+    expect(JSON.parse(line)).toEqual({
+      level: 'error',
+      timestamp: '2024-01-01T00:00:00.000Z',
+      message: 'Save failed',
+      roomCode: 'ABCD'
+    });
+    expect(Object.keys(JSON.parse(line))).toEqual(['level', 'timestamp', 'message', 'roomCode']);
+  });
+
+  it('routes every level through the current format', () => {
+    const { logger, buffer } = createBufferedLogger({ level: LogLevel.DEBUG });
+    logger.setFormat('json');
+
+    logger.debug('d');
+    logger.info('i');
+    logger.warn('w');
+    logger.error('e', { error: new Error('boom') });
+
+    const entries = buffer.lines().map(line => JSON.parse(line));
+    expect(entries.map(e => e.level)).toEqual(['debug', 'info', 'warn', 'error']);
+    expect(entries[3].error).toContain('boom');
+  });
+
+  it('drops entries below the minimum level', () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'json', level: LogLevel.WARN });
+
+    logger.debug('d');
+    logger.info('i');
+    logger.warn('w');
+    logger.error('e');
+
+    expect(buffer.lines().map(line => JSON.parse(line).message)).toEqual(['w', 'e']);
+
+    logger.setLevel(LogLevel.INFO);
+    logger.info('later');
+    expect(buffer.lines()).toHaveLength(3);
+  });
+
+  it('reads LOG_LEVEL, falling back to debug', () => {
+    expect(parseLogLevel('warn')).toBe(LogLevel.WARN);
+    expect(parseLogLevel('ERROR')).toBe(LogLevel.ERROR);
+    expect(parseLogLevel('verbose')).toBe(LogLevel.DEBUG);
+    expect(parseLogLevel(undefined)).toBe(LogLevel.DEBUG);
+  });
+
+  it('redirects every level to the configured output', () => {
+    const logger = new Logger({ format: 'text', level: LogLevel.DEBUG });
+    const lines: string[] = [];
+    logger.setOutput({ write: (chunk: string) => lines.push(chunk) });
+
+    logger.info('to output');
+    logger.error('also to output');
+
+    expect(lines).toHaveLength(2);
+    expect(lines.every(line => line.endsWith('\n'))).toBe(true);
+  });
+
+  it('adds a child logger\'s fields to every entry', () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'json' });
+    const roomLog = logger.with({ roomCode: 'ABCD' });
+    const playerLog = roomLog.with({ playerId: 'p1' });
+
+    roomLog.info('Phase changed', { phase: 'DAY' });
+    playerLog.warn('Slow response', { roomCode: 'WXYZ' });
+    logger.info('Unrelated');
+
+    const [room, player, plain] = buffer.lines().map(line => JSON.parse(line));
+    expect(room).toMatchObject({ roomCode: 'ABCD', phase: 'DAY' });
+    expect(player).toMatchObject({ roomCode: 'WXYZ', playerId: 'p1' });
+    expect(plain.roomCode).toBeUndefined();
+  });
+
+  it('shares settings between a logger and its children', () => {
+    const { logger, buffer } = createBufferedLogger({ format: 'text', level: LogLevel.DEBUG });
+    const child = logger.with({ roomCode: 'ABCD' });
+
+    logger.setFormat('json');
+    logger.setLevel(LogLevel.WARN);
+    child.info('dropped');
+    child.warn('kept');
+
+    expect(buffer.lines()).toHaveLength(1);
+    expect(JSON.parse(buffer.lines()[0])).toMatchObject({ message: 'kept', roomCode: 'ABCD' });
+  });
+});
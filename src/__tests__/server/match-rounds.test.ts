@@ -0,0 +1,116 @@
+/**
+ * @fileoverview Multi-round matches in one room.
+ * After a game ends the host can deal the next round to the same players;
+ * the round number goes up and every win adds a point to the scoreboard.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+const PLAYERS = ['host', 'alice', 'bob', 'carol', 'dave'];
+
+describe('Multi-round matches', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient();
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /** Seats every player in a new room and returns the room code */
+  async function seatPlayers(clients: ScriptedClient[]): Promise<string> {
+    const [host, ...guests] = clients;
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    for (const [i, guest] of guests.entries()) {
+      guest.receive({ type: 'joinRoom', roomCode, playerName: PLAYERS[i + 1], timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+    }
+
+    await host.waitFor('roomUpdate', message =>
+      message.state.players.length === PLAYERS.length &&
+      message.state.players.every(p => p.isReady || p.isHost)
+    );
+    return roomCode;
+  }
+
+  it('keeps the players and adds up wins across rounds', async () => {
+    const clients: ScriptedClient[] = [];
+    for (const id of PLAYERS) {
+      clients.push(await connect(id));
+    }
+    const [host] = clients;
+    const roomCode = await seatPlayers(clients);
+
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    const first = await host.waitFor('gameEnd');
+    const afterFirst = await host.waitFor('roomUpdate', message => message.state.status === 'ended');
+
+    expect(afterFirst.state.round).toBe(1);
+    const expected: Record<string, number> = Object.fromEntries(PLAYERS.map(id => [id, 0]));
+    for (const id of first.result.winningPlayers) {
+      expected[id]++;
+    }
+    expect(afterFirst.state.scores).toEqual(expected);
+
+    const manager = server.getRoomManager();
+    expect(manager.startNextRound(roomCode, 'alice')).toBe('notHost');
+    expect(manager.startNextRound(roomCode, 'host')).toBe('started');
+    expect(manager.startNextRound(roomCode, 'host')).toBe('notEnded');
+
+    const second = await host.waitFor('gameEnd', () => true, 2);
+    const afterSecond = await host.waitFor('roomUpdate', message =>
+      message.state.status === 'ended' && message.state.round === 2
+    );
+
+    for (const id of second.result.winningPlayers) {
+      expected[id]++;
+    }
+    expect(afterSecond.state.scores).toEqual(expected);
+    expect(afterSecond.state.players.map(p => p.id).sort()).toEqual([...PLAYERS].sort());
+
+    for (const client of clients) {
+      expect(client.received.filter(m => m.type === 'gameStarted')).toHaveLength(2);
+      expect(client.received.filter(m => m.type === 'error')).toEqual([]);
+    }
+  }, 30000);
+
+  it('refuses the next round before any game has ended', async () => {
+    const clients: ScriptedClient[] = [];
+    for (const id of PLAYERS) {
+      clients.push(await connect(id));
+    }
+    const roomCode = await seatPlayers(clients);
+
+    expect(server.getRoomManager().startNextRound(roomCode, 'host')).toBe('notEnded');
+    expect(server.getRoomManager().startNextRound('NOPE', 'host')).toBe('notFound');
+  });
+});
@@ -0,0 +1,100 @@
+/**
+ * @fileoverview Prometheus metrics tests.
+ * The room manager reports lifecycle events to its metrics sink, and the
+ * collectors render them in the text format a Prometheus scraper reads.
+ */
+
+import { IncomingMessage, ServerResponse } from 'http';
+import { RoomConfig } from '../../network/protocol';
+import { handleMetricsRequest, PrometheusMetrics } from '../../server/Metrics';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('PrometheusMetrics', () => {
+  it('renders counters and a per-role histogram', () => {
+    const metrics = new PrometheusMetrics();
+    metrics.gameCreated();
+    metrics.gameCreated();
+    metrics.playerJoined();
+    metrics.nightActionCompleted('SEER', 300);
+    metrics.nightActionCompleted('SEER', 4000);
+
+    const text = metrics.render();
+
+    expect(text).toContain('# TYPE onuw_games_created_total counter\nonuw_games_created_total 2\n');
+    expect(text).toContain('onuw_players_joined_total 1\n');
+    expect(text).toContain('onuw_games_completed_total 0\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_bucket{role="SEER",le="0.1"} 0\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_bucket{role="SEER",le="0.5"} 1\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_bucket{role="SEER",le="5"} 2\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_bucket{role="SEER",le="+Inf"} 2\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_sum{role="SEER"} 4.3\n');
+    expect(text).toContain('onuw_night_action_duration_seconds_count{role="SEER"} 2\n');
+  });
+
+  it('answers only GET /metrics', () => {
+    const metrics = new PrometheusMetrics();
+    const res = { writeHead: jest.fn(), end: jest.fn() };
+
+    const other = handleMetricsRequest(
+      { method: 'GET', url: '/api/games' } as IncomingMessage,
+      res as unknown as ServerResponse,
+      metrics
+    );
+    const scrape = handleMetricsRequest(
+      { method: 'GET', url: '/metrics' } as IncomingMessage,
+      res as unknown as ServerResponse,
+      metrics
+    );
+
+    expect(other).toBe(false);
+    expect(scrape).toBe(true);
+    expect(res.writeHead).toHaveBeenCalledWith(200, expect.objectContaining({
+      'Content-Type': 'text/plain; version=0.0.4; charset=utf-8'
+    }));
+    expect(res.end).toHaveBeenCalledWith(metrics.render());
+  });
+});
+
+describe('RoomManager metrics', () => {
+  let manager: RoomManager;
+  let metrics: PrometheusMetrics;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    metrics = new PrometheusMetrics();
+    manager = new RoomManager({ metrics });
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  it('counts rooms created, players joined and failed sends', () => {
+    const room = manager.createRoom('host', CONFIG);
+    room.addPlayer('host', 'host', new MockConnection('conn-host'));
+    const broken = new MockConnection('conn-alice');
+    room.addPlayer('alice', 'alice', broken);
+
+    broken.send = () => {
+      throw new Error('socket closed');
+    };
+    room.setPlayerReady('alice', true);
+
+    const text = metrics.render();
+    expect(text).toContain('onuw_games_created_total 1\n');
+    expect(text).toContain('onuw_players_joined_total 2\n');
+    expect(text).toMatch(/onuw_broadcast_errors_total [1-9]\d*\n/);
+  });
+});
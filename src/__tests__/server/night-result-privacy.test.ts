@@ -0,0 +1,100 @@
+/**
+ * @fileoverview Night results reach only the player who acted.
+ * A Seer's look or a Robber's new card is private: it is sent as a
+ * nightResult to that player's connection and to no one else's.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game, IGameAgent } from '../../core/Game';
+import { ActionRequest, ServerMessage } from '../../network/protocol';
+import { NetworkAgent } from '../../server/NetworkAgent';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+/** Connection whose client answers every request with the first valid choice */
+class AnsweringConnection extends MockConnection {
+  send(message: ServerMessage): void {
+    super.send(message);
+    if (message.type !== 'actionRequired') {
+      return;
+    }
+
+    const { requestId } = message.request;
+    const response = AnsweringConnection.answer(message.request);
+    Promise.resolve().then(() => this.receive({
+      type: 'actionResponse',
+      requestId,
+      response,
+      timestamp: Date.now()
+    }));
+  }
+
+  private static answer(request: ActionRequest): unknown {
+    const options = 'options' in request ? (request.options as readonly unknown[]) : [];
+
+    switch (request.actionType) {
+      case 'selectPlayer':
+        return options[0];
+      case 'selectTwoPlayers':
+        return options.slice(0, 2);
+      case 'selectCenter':
+        return 0;
+      case 'seerChoice':
+        return 'player';
+      case 'vote':
+        return request.eligibleTargets[0];
+      default:
+        return null;
+    }
+  }
+}
+
+describe('Night result privacy', () => {
+  it('sends the Seer\'s view to the Seer\'s connection only', async () => {
+    const playerCount = ROLE_CONFIGS.STANDARD.length - 3;
+    const game = new Game({
+      players: Array.from({ length: playerCount }, (_, i) => `Player${i + 1}`),
+      roles: ROLE_CONFIGS.STANDARD,
+      forcedRoles: new Map([[0, RoleName.SEER]]),
+      auditLevel: 'minimal',
+      votingTimeoutMs: null
+    });
+
+    const connections = new Map<string, AnsweringConnection>();
+    const agents = new Map<string, IGameAgent>();
+    for (const id of game.getPlayerIds()) {
+      const connection = new AnsweringConnection(`conn-${id}`);
+      connections.set(id, connection);
+      agents.set(id, new NetworkAgent(id, connection, true));
+    }
+    game.registerAgents(agents);
+
+    // No one submits statements, so close the day as soon as it starts
+    game.addObserver({
+      onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+        if (event.type === 'PHASE_CHANGED' && event.data?.to === GamePhase.DAY) {
+          setTimeout(() => game.endDayPhase(), 0);
+        }
+      }
+    });
+
+    await game.run();
+
+    const seerId = 'player-1';
+    const seerResults = connections.get(seerId)!.sentOfType('nightResult')
+      .map(message => message.result)
+      .filter(result => result.roleName === RoleName.SEER);
+    expect(seerResults).toHaveLength(1);
+    expect(seerResults[0].actorId).toBe(seerId);
+    expect(seerResults[0].info.viewed).toHaveLength(1);
+
+    for (const [id, connection] of connections) {
+      if (id === seerId) {
+        continue;
+      }
+      const leaked = connection.sentOfType('nightResult')
+        .filter(message => message.result.actorId === seerId);
+      expect(leaked).toEqual([]);
+    }
+  });
+});
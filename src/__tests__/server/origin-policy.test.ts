@@ -0,0 +1,45 @@
+/**
+ * @fileoverview Origin allowlist tests.
+ * With ALLOWED_ORIGINS unset any origin is accepted; once set, only the
+ * listed origins get CORS headers and WebSocket upgrades.
+ */
+
+import { OriginPolicy, parseAllowedOrigins } from '../../server/OriginPolicy';
+
+const GAME_ORIGIN = 'https://game.example.com';
+
+describe('OriginPolicy', () => {
+  it('parses a comma-separated list, ignoring blanks and trailing slashes', () => {
+    expect(parseAllowedOrigins(undefined)).toEqual([]);
+    expect(parseAllowedOrigins('')).toEqual([]);
+    expect(parseAllowedOrigins(' https://game.example.com/ ,, http://localhost:3000')).toEqual([
+      GAME_ORIGIN,
+      'http://localhost:3000'
+    ]);
+  });
+
+  it('allows any origin when no allowlist is configured', () => {
+    const policy = new OriginPolicy();
+
+    expect(policy.isPermissive()).toBe(true);
+    expect(policy.isAllowed('https://anything.example.com')).toBe(true);
+    expect(policy.getAllowOriginHeader('https://anything.example.com')).toBe('*');
+  });
+
+  it('echoes only allowed origins in the CORS header', () => {
+    const policy = new OriginPolicy([GAME_ORIGIN]);
+
+    expect(policy.getAllowOriginHeader(GAME_ORIGIN)).toBe(GAME_ORIGIN);
+    expect(policy.getAllowOriginHeader('https://evil.example.com')).toBeNull();
+    expect(policy.getAllowOriginHeader(undefined)).toBeNull();
+  });
+
+  it('refuses other origins but not requests without an Origin header', () => {
+    const policy = new OriginPolicy([GAME_ORIGIN]);
+
+    expect(policy.isAllowed(GAME_ORIGIN)).toBe(true);
+    expect(policy.isAllowed('https://evil.example.com')).toBe(false);
+    expect(policy.isAllowed('https://game.example.com.evil.com')).toBe(false);
+    expect(policy.isAllowed(undefined)).toBe(true);
+  });
+});
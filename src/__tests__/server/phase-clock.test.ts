@@ -0,0 +1,100 @@
+/**
+ * @fileoverview Phase clock tests.
+ * A player reconnecting during discussion must see the real time left,
+ * not a reset timer, including when everyone had disconnected.
+ */
+
+import { PhaseClock } from '../../server/PhaseClock';
+
+const DISCUSSION_MS = 180000;
+
+describe('PhaseClock', () => {
+  beforeEach(() => {
+    jest.useFakeTimers();
+    jest.setSystemTime(new Date('2024-01-01T00:00:00Z'));
+  });
+
+  afterEach(() => {
+    jest.useRealTimers();
+  });
+
+  it('keeps the same deadline across a single player reconnecting', () => {
+    const clock = new PhaseClock();
+    clock.start(DISCUSSION_MS);
+    const endsAt = clock.getEndsAt();
+
+    jest.advanceTimersByTime(45000);
+
+    expect(clock.getEndsAt()).toBe(endsAt);
+    expect(clock.getTimeRemaining()).toBe(135);
+  });
+
+  it('resumes discussion with the time left when the last client dropped', () => {
+    const clock = new PhaseClock();
+    clock.start(DISCUSSION_MS);
+
+    jest.advanceTimersByTime(60000);
+    clock.pause(); // Every human disconnected
+
+    jest.advanceTimersByTime(300000); // Nobody around for five minutes
+    expect(clock.isPaused()).toBe(true);
+    expect(clock.getTimeRemaining()).toBe(120);
+
+    clock.resume(); // First player reconnects
+    expect(clock.getTimeRemaining()).toBe(120);
+    expect(clock.getEndsAt()).toBe(Date.now() + 120000);
+
+    jest.advanceTimersByTime(20000);
+    expect(clock.getTimeRemaining()).toBe(100);
+  });
+
+  it('ignores a second pause while already paused', () => {
+    const clock = new PhaseClock();
+    clock.start(DISCUSSION_MS);
+
+    jest.advanceTimersByTime(30000);
+    clock.pause();
+    jest.advanceTimersByTime(30000);
+    clock.pause();
+    clock.resume();
+
+    expect(clock.getTimeRemaining()).toBe(150);
+  });
+
+  it('extends the deadline whether running or paused', () => {
+    const clock = new PhaseClock();
+    clock.start(DISCUSSION_MS);
+
+    jest.advanceTimersByTime(60000);
+    clock.extend(60000);
+    expect(clock.getTimeRemaining()).toBe(180);
+
+    clock.pause();
+    clock.extend(30000);
+    clock.resume();
+    expect(clock.getTimeRemaining()).toBe(210);
+  });
+
+  it('never pauses an untimed phase', () => {
+    const clock = new PhaseClock();
+    clock.start(null);
+    clock.pause();
+
+    expect(clock.isPaused()).toBe(false);
+    expect(clock.getEndsAt()).toBeNull();
+    expect(clock.getTimeRemaining()).toBeNull();
+  });
+
+  it('restores a saved deadline paused at the time left when saved', () => {
+    const savedAt = Date.now();
+    const clock = new PhaseClock();
+    clock.restore(savedAt + 90000, savedAt);
+
+    jest.advanceTimersByTime(600000); // Server was down for ten minutes
+    expect(clock.getTimeRemaining()).toBe(90);
+
+    clock.resume();
+    jest.advanceTimersByTime(30000);
+    expect(clock.getTimeRemaining()).toBe(60);
+  });
+});
@@ -0,0 +1,109 @@
+/**
+ * @fileoverview Per-room player limit tests.
+ * Hosts can run anything from a three-player test game to a large house
+ * variant, so each room sets its own minimum and maximum within the
+ * range a game supports, and both are enforced on join and start.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { RoleFactory } from '../../patterns/factory';
+import { Room, RoomStatus, validatePlayerLimits } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+
+const FILL_CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 10,
+  roles: [],
+  roleFillMode: 'fill',
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('validatePlayerLimits', () => {
+  it('accepts limits at either end of the supported range', () => {
+    expect(validatePlayerLimits({ minPlayers: 3, maxPlayers: 3 })).toEqual([]);
+    expect(validatePlayerLimits({ minPlayers: 16, maxPlayers: 16 })).toEqual([]);
+  });
+
+  it.each([
+    [2, 10, 'Minimum players must be a whole number from 3 to 16'],
+    [3, 17, 'Maximum players must be a whole number from 3 to 16'],
+    [4.5, 10, 'Minimum players must be a whole number from 3 to 16'],
+    [6, 5, 'Minimum players (6) cannot exceed maximum players (5)']
+  ])('rejects min %p / max %p', (minPlayers, maxPlayers, error) => {
+    expect(validatePlayerLimits({ minPlayers, maxPlayers })).toEqual([error]);
+  });
+});
+
+describe('Room player limits', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  /** Creates a room with the host seated and the rest of the table as bots */
+  function createTable(players: number, config: Partial<RoomConfig> = {}): Room {
+    const room = manager.createRoom('host', { ...FILL_CONFIG, ...config });
+    room.addPlayer('host', 'host', new MockConnection('conn-host'));
+    expect(manager.addBots(room.getCode(), 'host', players - 1)).toBe('added');
+    return room;
+  }
+
+  it('refuses to create a room outside the supported range', () => {
+    expect(() => manager.createRoom('host', { ...FILL_CONFIG, maxPlayers: RoleFactory.MAX_PLAYERS + 1 }))
+      .toThrow('Invalid player limits: Maximum players must be a whole number from 3 to 16');
+    expect(() => manager.createRoom('host', { ...FILL_CONFIG, minPlayers: 2 }))
+      .toThrow('Invalid player limits: Minimum players must be a whole number from 3 to 16');
+  });
+
+  it('starts a tiny game at the configured minimum and maximum', () => {
+    const room = createTable(3, { minPlayers: 3, maxPlayers: 3 });
+
+    expect(() => room.addPlayer('late', 'late', new MockConnection('conn-late'))).toThrow('Room is full');
+
+    room.startGame('host');
+    expect(room.getStatus()).toBe(RoomStatus.PLAYING);
+  });
+
+  it('starts a large house game at the supported maximum', () => {
+    const room = createTable(16, { minPlayers: 12, maxPlayers: 16 });
+
+    expect(manager.addBots(room.getCode(), 'host', 1)).toBe('roomFull');
+
+    const game = room.startGame('host');
+    expect(game.getPlayerIds()).toHaveLength(16);
+  });
+
+  it('will not start below the configured minimum', () => {
+    const room = createTable(5, { minPlayers: 6, maxPlayers: 8 });
+
+    expect(room.canStart()).toBe(false);
+    expect(() => room.startGame('host')).toThrow('Need at least 6 players (have 5)');
+  });
+
+  it('refuses a maximum below the players already seated', () => {
+    const room = createTable(5);
+
+    expect(() => room.updateConfig('host', { maxPlayers: 4 }))
+      .toThrow('Maximum players cannot be below the 5 players already in the room');
+    expect(() => room.updateConfig('host', { minPlayers: 11 }))
+      .toThrow('Minimum players (11) cannot exceed maximum players (10)');
+  });
+
+  it('fills a valid default role list for every supported size', () => {
+    for (let playerCount = RoleFactory.MIN_PLAYERS; playerCount <= RoleFactory.MAX_PLAYERS; playerCount++) {
+      const roles = RoleFactory.completeRoleSet([], playerCount);
+
+      expect(RoleFactory.validateRoleList(roles, { playerCount }).errors).toEqual([]);
+    }
+  });
+});
@@ -0,0 +1,53 @@
+/**
+ * @fileoverview Player display name validation tests.
+ * Names are shown to everyone in the room and written to logs, so blank,
+ * oversized or multi-line names must be refused with a clear reason.
+ */
+
+import { MAX_PLAYER_NAME_LENGTH, normalizePlayerName } from '../../server/PlayerNames';
+
+describe('normalizePlayerName', () => {
+  it('accepts an ordinary name unchanged', () => {
+    expect(normalizePlayerName('Alice')).toEqual({ valid: true, name: 'Alice' });
+  });
+
+  it('trims and collapses whitespace', () => {
+    expect(normalizePlayerName('  Mary   Jane ')).toEqual({ valid: true, name: 'Mary Jane' });
+  });
+
+  it('accepts a name of exactly the maximum length', () => {
+    const name = 'a'.repeat(MAX_PLAYER_NAME_LENGTH);
+    expect(normalizePlayerName(name)).toEqual({ valid: true, name });
+  });
+
+  it('counts an emoji as one character', () => {
+    const name = '🐺'.repeat(MAX_PLAYER_NAME_LENGTH);
+    expect(normalizePlayerName(name).valid).toBe(true);
+  });
+
+  it.each([
+    ['an empty name', '', 'Player name cannot be empty.'],
+    ['only spaces', ' '.repeat(500), 'Player name cannot be empty.'],
+    ['a line break', 'Alice\nBob', 'Player name cannot contain control characters.'],
+    ['a tab', 'Alice\tBob', 'Player name cannot contain control characters.'],
+    ['a NUL byte', 'Alice\u0000', 'Player name cannot contain control characters.'],
+    ['a line separator', 'Alice\u2028Bob', 'Player name cannot contain control characters.'],
+    [
+      'a name over the limit',
+      'a'.repeat(MAX_PLAYER_NAME_LENGTH + 1),
+      `Player name must be at most ${MAX_PLAYER_NAME_LENGTH} characters.`
+    ],
+    ['a non-string', 42, 'Player name must be a string.'],
+    ['a missing name', undefined, 'Player name must be a string.']
+  ])('rejects %s', (_label, raw, error) => {
+    expect(normalizePlayerName(raw)).toEqual({ valid: false, error });
+  });
+
+  it('checks the length after collapsing whitespace', () => {
+    const name = `${'a'.repeat(MAX_PLAYER_NAME_LENGTH - 2)}     b`;
+    expect(normalizePlayerName(name)).toEqual({
+      valid: true,
+      name: `${'a'.repeat(MAX_PLAYER_NAME_LENGTH - 2)} b`
+    });
+  });
+});
@@ -0,0 +1,148 @@
+/**
+ * @fileoverview Polling fallback for a player's private game view.
+ * Players on networks that block WebSockets fetch their view over REST,
+ * so it must carry their own role and never anyone else's.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { GamePhase } from '../../enums';
+import { ErrorCodes, RoomConfig, SerializablePlayerGameView } from '../../network/protocol';
+import { ApiHandler } from '../../server/ApiHandler';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { AuthService, IOAuthService } from '../../services';
+import {
+  IGameRepository,
+  IReplayRepository,
+  IStatisticsRepository,
+  IUserRepository
+} from '../../database/repositories';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+const PLAYERS = ['host', 'alice', 'bob', 'carol', 'dave'];
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  body = '';
+
+  setHeader(): void {}
+
+  writeHead(status: number): this {
+    this.statusCode = status;
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+
+  json(): { success: boolean; code?: string; data?: SerializablePlayerGameView } {
+    return JSON.parse(this.body);
+  }
+}
+
+describe('GET /api/games/:code/players/:playerId/state', () => {
+  let manager: RoomManager;
+  let handler: ApiHandler;
+  let room: Room;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+
+    manager = new RoomManager();
+    handler = new ApiHandler({
+      authService: {} as AuthService,
+      oauthService: {} as IOAuthService,
+      userRepo: {} as IUserRepository,
+      statsRepo: {} as IStatisticsRepository,
+      replayRepo: {} as IReplayRepository,
+      gameRepo: {} as IGameRepository
+    });
+    handler.attachRoomManager(manager);
+
+    room = manager.createRoom('host', CONFIG);
+    for (const id of PLAYERS) {
+      room.addPlayer(id, id, new MockConnection(`conn-${id}`));
+      if (id !== 'host') {
+        room.setPlayerReady(id, true);
+      }
+    }
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  async function getState(playerId: string, requesterId?: string): Promise<FakeResponse> {
+    const res = new FakeResponse();
+    const headers: Record<string, string> = requesterId
+      ? { 'x-player-token': room.getPlayer(requesterId)!.token }
+      : {};
+    const req = {
+      method: 'GET',
+      url: `/api/games/${room.getCode()}/players/${playerId}/state`,
+      headers
+    } as unknown as IncomingMessage;
+    await handler.handleRequest(req, res as unknown as ServerResponse);
+    return res;
+  }
+
+  it('returns the player their own role and the current phase', async () => {
+    const game = room.startGame('host');
+
+    const res = await getState('alice', 'alice');
+
+    expect(res.statusCode).toBe(200);
+    const view = res.json().data!;
+    expect([GamePhase.SETUP, GamePhase.NIGHT]).toContain(view.phase);
+    expect(view.myStartingRole).toBe(game.getPlayerStartingRole(room.getGamePlayerId('alice')!));
+    expect(view.finalRoles).toBeNull();
+    expect(view.revealedCards).toBeUndefined();
+  });
+
+  it('refuses to show one player another player\'s state', async () => {
+    room.startGame('host');
+
+    const res = await getState('alice', 'bob');
+
+    expect(res.statusCode).toBe(403);
+    expect(res.json().code).toBe(ErrorCodes.AUTH_INVALID);
+  });
+
+  it('requires the player token, not just a player ID', async () => {
+    room.startGame('host');
+
+    expect((await getState('alice')).statusCode).toBe(401);
+
+    const res = new FakeResponse();
+    const req = {
+      method: 'GET',
+      url: `/api/games/${room.getCode()}/players/alice/state`,
+      headers: { 'x-player-id': 'alice' }
+    } as unknown as IncomingMessage;
+    await handler.handleRequest(req, res as unknown as ServerResponse);
+    expect(res.statusCode).toBe(401);
+    expect(res.json().code).toBe(ErrorCodes.AUTH_REQUIRED);
+  });
+
+  it('answers 409 before the game starts', async () => {
+    const res = await getState('alice', 'alice');
+
+    expect(res.statusCode).toBe(409);
+    expect(res.json().code).toBe(ErrorCodes.INVALID_STATE);
+  });
+});
@@ -0,0 +1,88 @@
+/**
+ * @fileoverview Public room list tests.
+ * The room browser shows each room's capacity and whether it can be
+ * joined, and can ask only for rooms with enough free seats for a group.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+describe('RoomManager.getPublicRoomSummaries', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  /** Creates a public lobby with the given number of seated players */
+  function createLobby(players: number, config: Partial<RoomConfig> = {}): Room {
+    const hostId = `host-${manager.getRoomCount()}`;
+    const room = manager.createRoom(hostId, { ...CONFIG, ...config });
+    room.addPlayer(hostId, hostId, new MockConnection(`conn-${hostId}`));
+    for (let i = 1; i < players; i++) {
+      room.addPlayer(`${hostId}-p${i}`, `p${i}`, new MockConnection(`conn-${hostId}-p${i}`));
+    }
+    return room;
+  }
+
+  it('includes capacity and joinability', () => {
+    const room = createLobby(2);
+
+    expect(manager.getPublicRoomSummaries()).toEqual([{
+      roomCode: room.getCode(),
+      hostName: 'host-0',
+      playerCount: 2,
+      maxPlayers: 5,
+      joinable: true,
+      roles: ROLE_CONFIGS.STANDARD
+    }]);
+  });
+
+  it('lists a full room as not joinable', () => {
+    createLobby(5);
+
+    const [summary] = manager.getPublicRoomSummaries();
+
+    expect(summary.playerCount).toBe(5);
+    expect(summary.joinable).toBe(false);
+  });
+
+  it('only lists rooms with at least minOpenSlots free seats', () => {
+    const empty = createLobby(1);
+    const half = createLobby(3);
+    const full = createLobby(5);
+
+    const codes = (minOpenSlots?: number) =>
+      manager.getPublicRoomSummaries(minOpenSlots).map(r => r.roomCode).sort();
+
+    expect(codes()).toEqual([empty.getCode(), half.getCode(), full.getCode()].sort());
+    expect(codes(1)).toEqual([empty.getCode(), half.getCode()].sort());
+    expect(codes(2)).toEqual([empty.getCode(), half.getCode()].sort());
+    expect(codes(3)).toEqual([empty.getCode()]);
+    expect(codes(5)).toEqual([]);
+  });
+
+  it('leaves out private rooms whatever their free seats', () => {
+    createLobby(1, { isPrivate: true });
+
+    expect(manager.getPublicRoomSummaries(1)).toEqual([]);
+  });
+});
@@ -0,0 +1,100 @@
+/**
+ * @fileoverview Skipping the rest of the discussion.
+ * When every connected player says they are ready to vote, voting starts
+ * at once instead of after the day timer, and a dropped player does not
+ * hold the table up.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig, ServerMessage } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Ready to vote', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient({ readyToVote: false, votes: false });
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /** Plays a five-player game into the day, returning each player's client */
+  async function playUntilDay(): Promise<Map<string, ScriptedClient>> {
+    const clients = new Map<string, ScriptedClient>();
+    const host = await connect('host');
+    clients.set('host', host);
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    for (const id of ['alice', 'bob', 'carol', 'dave']) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      clients.set(id, guest);
+    }
+
+    await host.waitFor('roomUpdate', message => message.state.readyCount === 5);
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    await host.waitFor('phaseChange', message => message.phase === GamePhase.DAY);
+    return clients;
+  }
+
+  it('starts voting early once everyone is ready', async () => {
+    const clients = await playUntilDay();
+    const host = clients.get('host')!;
+
+    for (const client of clients.values()) {
+      client.receive({ type: 'readyToVote', timestamp: Date.now() });
+    }
+    await host.waitFor('phaseChange', message => message.phase === GamePhase.VOTING);
+
+    const counts = host.received
+      .filter(m => m.type === 'playerReadyToVote')
+      .map(m => (m as Extract<ServerMessage, { type: 'playerReadyToVote' }>).readyCount);
+    expect(counts).toEqual([1, 2, 3, 4, 5]);
+  }, 20000);
+
+  it('does not wait for a player who dropped', async () => {
+    const clients = await playUntilDay();
+    const host = clients.get('host')!;
+
+    clients.get('dave')!.drop();
+    for (const id of ['host', 'alice', 'bob', 'carol']) {
+      clients.get(id)!.receive({ type: 'readyToVote', timestamp: Date.now() });
+    }
+
+    const voting = await host.waitFor('phaseChange', message => message.phase === GamePhase.VOTING);
+    expect(voting.phase).toBe(GamePhase.VOTING);
+    expect(host.received.filter(m => m.type === 'playerReadyToVote').pop()).toMatchObject({
+      readyCount: 4,
+      totalPlayers: 4
+    });
+  }, 20000);
+});
@@ -0,0 +1,88 @@
+/**
+ * @fileoverview Night results survive a dropped connection.
+ * A player whose socket drops after their night action gets their
+ * latest private result again when they reconnect, after the game state.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Night result on reconnect', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient({ readyToVote: false, votes: false });
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  /** Plays a five-player game through the night, returning each player's client */
+  async function playNight(): Promise<Map<string, ScriptedClient>> {
+    const clients = new Map<string, ScriptedClient>();
+    const host = await connect('host');
+    clients.set('host', host);
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    for (const id of ['alice', 'bob', 'carol', 'dave']) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      await guest.waitFor('roomJoined');
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      clients.set(id, guest);
+    }
+
+    await host.waitFor('roomUpdate', message => message.state.readyCount === 5);
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+    await host.waitFor('phaseChange', message => message.phase === GamePhase.DAY);
+    return clients;
+  }
+
+  it('re-sends the latest night result after the game state', async () => {
+    const clients = await playNight();
+
+    // Five players hold at least three of the six night-action cards
+    const [playerId, dropped] = [...clients].find(([, client]) =>
+      client.received.some(m => m.type === 'nightResult')
+    )!;
+    const results = dropped.received.filter(m => m.type === 'nightResult');
+    const lastResult = results[results.length - 1];
+
+    dropped.drop();
+    const rejoined = await connect(playerId);
+    await rejoined.waitFor('nightResult');
+
+    const types = rejoined.received.map(m => m.type);
+    expect(types.indexOf('gameState')).toBeLessThan(types.indexOf('nightResult'));
+    expect(rejoined.received.filter(m => m.type === 'nightResult')).toEqual([
+      { ...lastResult, timestamp: expect.any(Number) }
+    ]);
+  }, 20000);
+});
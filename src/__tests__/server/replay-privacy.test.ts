@@ -0,0 +1,96 @@
+/**
+ * @fileoverview Replay vote privacy tests.
+ * Players in a room with secret votes were told nobody would see who they
+ * voted for, so the replay of that game must leave the votes out too.
+ */
+
+import { EventEmitter } from 'events';
+import { IncomingMessage, ServerResponse } from 'http';
+import { ApiHandler } from '../../server/ApiHandler';
+import { AuthService, IOAuthService } from '../../services';
+import {
+  IGameRepository,
+  IReplayRepository,
+  IStatisticsRepository,
+  IUserRepository
+} from '../../database/repositories';
+import { DbGameConfiguration } from '../../database/types';
+
+/** Minimal response recording what the handler wrote */
+class FakeResponse extends EventEmitter {
+  statusCode = 200;
+  headers: Record<string, string> = {};
+  body = '';
+
+  setHeader(name: string, value: string): void {
+    this.headers[name] = value;
+  }
+
+  writeHead(status: number, headers: Record<string, string> = {}): this {
+    this.statusCode = status;
+    this.headers = { ...this.headers, ...headers };
+    return this;
+  }
+
+  end(chunk?: string): this {
+    this.body += chunk ?? '';
+    return this;
+  }
+
+  json(): Record<string, any> {
+    return JSON.parse(this.body);
+  }
+}
+
+const VOTES = [
+  { voterPlayerId: 'p1', targetPlayerId: 'p2' },
+  { voterPlayerId: 'p2', targetPlayerId: 'p1' }
+];
+
+/** Creates a handler whose stored game used the given vote visibility */
+function createHandler(voteVisibility: DbGameConfiguration['vote_visibility']): ApiHandler {
+  const replayRepo = {
+    getFullReplay: async () => ({ nightActions: [], statements: [], votes: [...VOTES] })
+  } as unknown as IReplayRepository;
+  const gameRepo = {
+    getConfiguration: async () => ({ vote_visibility: voteVisibility })
+  } as unknown as IGameRepository;
+
+  return new ApiHandler({
+    authService: {} as AuthService,
+    oauthService: {} as IOAuthService,
+    userRepo: {} as IUserRepository,
+    statsRepo: {} as IStatisticsRepository,
+    replayRepo,
+    gameRepo
+  });
+}
+
+async function getReplay(handler: ApiHandler): Promise<FakeResponse> {
+  const res = new FakeResponse();
+  const req = { method: 'GET', url: '/api/games/game-1/replay', headers: {} } as unknown as IncomingMessage;
+  await handler.handleRequest(req, res as unknown as ServerResponse);
+  return res;
+}
+
+describe('Replay vote privacy', () => {
+  it('includes votes for a game with public votes', async () => {
+    const res = await getReplay(createHandler('public'));
+
+    expect(res.statusCode).toBe(200);
+    expect(res.json().data.replay.votes).toEqual(VOTES);
+  });
+
+  it('includes votes once a secret-until-end game is over', async () => {
+    const res = await getReplay(createHandler('secretUntilEnd'));
+
+    expect(res.json().data.replay.votes).toEqual(VOTES);
+  });
+
+  it('leaves votes out of a game with secret votes', async () => {
+    const res = await getReplay(createHandler('secret'));
+
+    expect(res.statusCode).toBe(200);
+    expect(res.json().data.replay.votes).toEqual([]);
+  });
+});
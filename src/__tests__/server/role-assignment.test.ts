@@ -0,0 +1,64 @@
+/**
+ * @fileoverview Private role delivery at game start.
+ * Each player learns their starting role from a gameStarted message sent
+ * to their own connection, which must not reveal anyone else's role.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+const PLAYERS = ['host', 'alice', 'bob', 'carol', 'dave'];
+
+describe('Role assignment at game start', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  it('sends each player only their own starting role', () => {
+    const room = manager.createRoom('host', CONFIG);
+    const connections = new Map<string, MockConnection>();
+    for (const id of PLAYERS) {
+      const connection = new MockConnection(`conn-${id}`);
+      connections.set(id, connection);
+      room.addPlayer(id, id, connection);
+      if (id !== 'host') {
+        room.setPlayerReady(id, true);
+      }
+    }
+
+    const game = room.startGame('host');
+
+    for (const [id, connection] of connections) {
+      const started = connection.sentOfType('gameStarted');
+      expect(started).toHaveLength(1);
+
+      const { view } = started[0];
+      expect(view.myPlayerId).toBe(id);
+      expect(view.myStartingRole).toBe(game.getPlayerStartingRole(room.getGamePlayerId(id)!));
+      expect(view.finalRoles).toBeNull();
+      expect(view.debugInfo).toBeUndefined();
+      for (const player of view.players) {
+        expect(player).not.toHaveProperty('role');
+      }
+    }
+  });
+});
@@ -0,0 +1,78 @@
+/**
+ * @fileoverview Filling lobby seats with AI players.
+ * A small group can reach the player minimum with bots; only the host
+ * may add them, and a request that would overfill the room adds none.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+/** Creates a lobby holding the host and one friend */
+function createLobby(manager: RoomManager): Room {
+  const room = manager.createRoom('host', CONFIG);
+  room.addPlayer('host', 'host', new MockConnection('conn-host'));
+  room.addPlayer('alice', 'alice', new MockConnection('conn-alice'));
+  room.setPlayerReady('alice', true);
+  return room;
+}
+
+describe('RoomManager.addBots', () => {
+  let manager: RoomManager;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    manager = new RoomManager();
+  });
+
+  afterEach(() => {
+    manager.shutdown();
+    jest.useRealTimers();
+  });
+
+  it('seats ready AI players with distinct names', () => {
+    const room = createLobby(manager);
+
+    expect(manager.addBots(room.getCode(), 'host', 3)).toBe('added');
+
+    const bots = room.getPlayers().filter(p => p.isAI);
+    expect(bots).toHaveLength(3);
+    expect(bots.every(p => p.isReady)).toBe(true);
+    expect(new Set(bots.map(p => p.name)).size).toBe(3);
+    expect(room.canStart()).toBe(true);
+  });
+
+  it('only lets the host add bots', () => {
+    const room = createLobby(manager);
+
+    expect(manager.addBots(room.getCode(), 'alice', 1)).toBe('notHost');
+    expect(manager.addBots('NOPE', 'host', 1)).toBe('notFound');
+    expect(room.getPlayerCount()).toBe(2);
+  });
+
+  it('adds none when there are not enough free seats', () => {
+    const room = createLobby(manager);
+
+    expect(manager.addBots(room.getCode(), 'host', 4)).toBe('roomFull');
+    expect(room.getPlayerCount()).toBe(2);
+  });
+
+  it('refuses once the game has started', () => {
+    const room = createLobby(manager);
+    manager.addBots(room.getCode(), 'host', 3);
+    room.startGame('host');
+
+    expect(manager.addBots(room.getCode(), 'host', 1)).toBe('notWaiting');
+  });
+});
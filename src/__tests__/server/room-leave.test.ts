@@ -0,0 +1,139 @@
+/**
+ * @fileoverview Lobby leave and host transfer tests.
+ * A player leaving before the game starts must not strand the lobby:
+ * the host is passed on, and a lobby with nobody left is deleted.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { Room } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+/** Creates a lobby holding the host plus the given players */
+function createLobby(manager: RoomManager, others: string[]): {
+  room: Room;
+  connections: Map<string, MockConnection>;
+} {
+  const room = manager.createRoom('host', CONFIG);
+  const connections = new Map<string, MockConnection>();
+  for (const id of ['host', ...others]) {
+    const connection = new MockConnection(`conn-${id}`);
+    connections.set(id, connection);
+    room.addPlayer(id, id, connection);
+  }
+  return { room, connections };
+}
+
+describe('RoomManager.leaveRoom', () => {
+  it('removes the player and broadcasts the updated lobby', () => {
+    const manager = new RoomManager();
+    const { room, connections } = createLobby(manager, ['alice', 'bob']);
+    room.setPlayerReady('alice', true);
+
+    expect(manager.leaveRoom(room.getCode(), 'alice')).toBe('left');
+
+    expect(room.getPlayer('alice')).toBeUndefined();
+    const updates = connections.get('bob')!.sentOfType('roomUpdate');
+    const lastState = updates[updates.length - 1].state;
+    expect(lastState.players.map(p => p.id)).toEqual(['host', 'bob']);
+  });
+
+  it('passes the host to the earliest-joined player when the host leaves', () => {
+    const manager = new RoomManager();
+    const { room, connections } = createLobby(manager, ['alice', 'bob']);
+
+    expect(manager.leaveRoom(room.getCode(), 'host')).toBe('left');
+
+    expect(room.getHostId()).toBe('alice');
+    expect(manager.getRoom(room.getCode())).toBe(room);
+    const updates = connections.get('bob')!.sentOfType('roomUpdate');
+    expect(updates[updates.length - 1].state.hostId).toBe('alice');
+  });
+
+  it('deletes the lobby once the last player leaves', () => {
+    const manager = new RoomManager();
+    const { room } = createLobby(manager, []);
+    const code = room.getCode();
+
+    expect(manager.leaveRoom(code, 'host')).toBe('left');
+
+    expect(manager.getRoom(code)).toBeUndefined();
+  });
+
+  it('reports unknown rooms and players', () => {
+    const manager = new RoomManager();
+    const { room } = createLobby(manager, ['alice']);
+
+    expect(manager.leaveRoom('NOPE42', 'alice')).toBe('notFound');
+    expect(manager.leaveRoom(room.getCode(), 'mallory')).toBe('notInRoom');
+  });
+});
+
+describe('RoomManager.transferHost', () => {
+  it('hands the host role to another player and broadcasts it', () => {
+    const manager = new RoomManager();
+    const { room, connections } = createLobby(manager, ['alice', 'bob']);
+
+    expect(manager.transferHost(room.getCode(), 'host', 'bob')).toBe('transferred');
+
+    expect(room.getHostId()).toBe('bob');
+    const updates = connections.get('alice')!.sentOfType('roomUpdate');
+    expect(updates[updates.length - 1].state.hostId).toBe('bob');
+  });
+
+  it('rejects requests from non-hosts and to absent players', () => {
+    const manager = new RoomManager();
+    const { room } = createLobby(manager, ['alice']);
+
+    expect(manager.transferHost(room.getCode(), 'alice', 'alice')).toBe('notHost');
+    expect(manager.transferHost(room.getCode(), 'host', 'mallory')).toBe('invalidTarget');
+    expect(room.getHostId()).toBe('host');
+  });
+
+  describe('when the host disconnects', () => {
+    beforeEach(() => {
+      jest.useFakeTimers();
+    });
+
+    afterEach(() => {
+      jest.useRealTimers();
+    });
+
+    it('passes the host on once the grace period runs out', () => {
+      const manager = new RoomManager({ hostDisconnectGraceMs: 30000 });
+      const { room, connections } = createLobby(manager, ['alice', 'bob']);
+
+      connections.get('host')!.close();
+      room.handlePlayerDisconnected('host');
+
+      jest.advanceTimersByTime(29999);
+      expect(room.getHostId()).toBe('host');
+
+      jest.advanceTimersByTime(1);
+      expect(room.getHostId()).toBe('alice');
+      expect(room.getPlayer('host')).toBeUndefined();
+    });
+
+    it('keeps the host if they rejoin within the grace period', () => {
+      const manager = new RoomManager({ hostDisconnectGraceMs: 30000 });
+      const { room, connections } = createLobby(manager, ['alice']);
+
+      connections.get('host')!.close();
+      room.handlePlayerDisconnected('host');
+      room.addPlayer('host', 'host', new MockConnection('conn-host-2'));
+
+      jest.advanceTimersByTime(30000);
+      expect(room.getHostId()).toBe('host');
+    });
+  });
+});
@@ -0,0 +1,148 @@
+/**
+ * @fileoverview Per-IP limits on creating and joining rooms.
+ * Rooms stay in memory until they time out, so a script creating them
+ * in a loop is throttled by a token bucket per client IP: a burst goes
+ * through, then one more per refill.
+ */
+
+import { ErrorCodes, RoomConfig, ServerMessage } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { TokenBucketRateLimiter } from '../../server/RateLimiter';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+/** Waits for the first message of one of the types on a socket */
+async function waitForAny(socket: FakeWebSocket, types: ServerMessage['type'][]): Promise<ServerMessage> {
+  for (let waited = 0; waited < 2000; waited += 5) {
+    const message = socket.sent
+      .map(data => JSON.parse(data) as ServerMessage)
+      .find(m => types.includes(m.type));
+    if (message) {
+      return message;
+    }
+    await new Promise(resolve => setTimeout(resolve, 5));
+  }
+  throw new Error(`Timed out waiting for ${types.join(' or ')}`);
+}
+
+describe('TokenBucketRateLimiter', () => {
+  it('allows a burst, then refills at the configured rate', () => {
+    const limiter = new TokenBucketRateLimiter({ ratePerMinute: 6, burst: 3 });
+
+    expect([1, 2, 3, 4].map(() => limiter.tryConsume('10.0.0.1', 0))).toEqual([true, true, true, false]);
+    expect(limiter.retryAfterMs('10.0.0.1', 0)).toBe(10000);
+    expect(limiter.tryConsume('10.0.0.2', 0)).toBe(true);
+
+    expect(limiter.tryConsume('10.0.0.1', 9999)).toBe(false);
+    expect(limiter.tryConsume('10.0.0.1', 10000)).toBe(true);
+  });
+
+  it('drops buckets once they have refilled', () => {
+    const limiter = new TokenBucketRateLimiter({ ratePerMinute: 6, burst: 3 });
+    limiter.tryConsume('10.0.0.1', 0);
+    limiter.tryConsume('10.0.0.2', 0);
+    limiter.tryConsume('10.0.0.2', 0);
+
+    expect(limiter.sweep(10000)).toBe(1);
+    expect(limiter.size).toBe(1);
+    expect(limiter.sweep(20000)).toBe(1);
+    expect(limiter.size).toBe(0);
+  });
+
+  it('sweeps on a timer until stopped', () => {
+    jest.useFakeTimers();
+    try {
+      const limiter = new TokenBucketRateLimiter({ ratePerMinute: 60, burst: 1, cleanupIntervalMs: 5000 });
+      limiter.startCleanup();
+      limiter.tryConsume('10.0.0.1');
+
+      jest.advanceTimersByTime(5000);
+      expect(limiter.size).toBe(0);
+
+      limiter.stopCleanup();
+      expect(jest.getTimerCount()).toBe(0);
+    } finally {
+      jest.useRealTimers();
+    }
+  });
+
+  it('refuses a limit that would block everything', () => {
+    expect(() => new TokenBucketRateLimiter({ ratePerMinute: 0 })).toThrow('positive rate');
+    expect(() => new TokenBucketRateLimiter({ burst: 0 })).toThrow('burst of at least 1');
+  });
+});
+
+describe('Room create/join rate limit', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0, roomRateLimit: { ratePerMinute: 1, burst: 3 } });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects and authenticates a player from the given IP */
+  async function connect(playerId: string, ip: string): Promise<FakeWebSocket> {
+    const socket = new FakeWebSocket(ip);
+    backend.connect(socket);
+    socket.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await waitForAny(socket, ['authenticated']);
+    return socket;
+  }
+
+  /** Creates a room from a new connection and returns the answer */
+  async function createRoom(playerId: string, ip: string): Promise<ServerMessage> {
+    const socket = await connect(playerId, ip);
+    socket.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    return waitForAny(socket, ['roomCreated', 'error']);
+  }
+
+  it('throttles the create after the burst from one IP', async () => {
+    for (const playerId of ['p1', 'p2', 'p3']) {
+      expect((await createRoom(playerId, '203.0.113.7')).type).toBe('roomCreated');
+    }
+
+    const refused = await createRoom('p4', '203.0.113.7');
+    expect(refused).toMatchObject({
+      type: 'error',
+      code: ErrorCodes.RATE_LIMITED,
+      message: 'Too many rooms created or joined, try again later',
+      details: { retryAfterMs: expect.any(Number) }
+    });
+    expect(server.getRoomManager().getRoomCount()).toBe(3);
+
+    // Another address has its own bucket
+    expect((await createRoom('p5', '198.51.100.2')).type).toBe('roomCreated');
+  });
+
+  it('counts joins against the same bucket', async () => {
+    const created = await createRoom('host', '198.51.100.2');
+    const roomCode = created.type === 'roomCreated' ? created.roomCode : '';
+
+    // The first guesses a code that doesn't exist, which still counts
+    const answers: string[] = [];
+    for (const playerId of ['a', 'b', 'c', 'd']) {
+      const socket = await connect(playerId, '203.0.113.7');
+      socket.receive({ type: 'joinRoom', roomCode: playerId === 'a' ? 'NOPE' : roomCode, playerName: playerId, timestamp: Date.now() });
+      const answer = await waitForAny(socket, ['roomJoined', 'error']);
+      answers.push(answer.type === 'error' ? answer.code : answer.type);
+    }
+
+    expect(answers).toEqual([ErrorCodes.ROOM_NOT_FOUND, 'roomJoined', 'roomJoined', ErrorCodes.RATE_LIMITED]);
+  });
+});
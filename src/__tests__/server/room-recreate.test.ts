@@ -0,0 +1,110 @@
+/**
+ * @fileoverview Creating a room after leaving one by other means.
+ * A session remembers its room until the player leaves over the
+ * WebSocket; a room deleted, left over HTTP or finished must not block
+ * the player from creating or joining another.
+ */
+
+import { ErrorCodes, RoomConfig, ServerMessage } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { FakeWebSocket } from '../setup/FakeWebSocket';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+/** Messages of one type the server has sent on a socket */
+function sentOfType<T extends ServerMessage['type']>(
+  socket: FakeWebSocket,
+  type: T
+): Extract<ServerMessage, { type: T }>[] {
+  return socket.sent
+    .map(data => JSON.parse(data) as ServerMessage)
+    .filter((message): message is Extract<ServerMessage, { type: T }> => message.type === type);
+}
+
+/** Waits until the socket has received count messages of the type */
+async function waitFor<T extends ServerMessage['type']>(
+  socket: FakeWebSocket,
+  type: T,
+  count: number = 1
+): Promise<Extract<ServerMessage, { type: T }>> {
+  for (let waited = 0; waited < 2000; waited += 5) {
+    const messages = sentOfType(socket, type);
+    if (messages.length >= count) {
+      return messages[count - 1];
+    }
+    await new Promise(resolve => setTimeout(resolve, 5));
+  }
+  throw new Error(`Timed out waiting for ${type} #${count}`);
+}
+
+describe('Creating a room after a stale one', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  async function connect(playerId: string): Promise<FakeWebSocket> {
+    const socket = new FakeWebSocket();
+    backend.connect(socket);
+    socket.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await waitFor(socket, 'authenticated');
+    return socket;
+  }
+
+  async function createRoom(socket: FakeWebSocket, count: number = 1): Promise<string> {
+    socket.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    return (await waitFor(socket, 'roomCreated', count)).roomCode;
+  }
+
+  it('lets the host create a new room after deleting the old one', async () => {
+    const host = await connect('host');
+    const first = await createRoom(host);
+
+    expect(server.getRoomManager().deleteRoom(first, 'host')).toBe('deleted');
+    const second = await createRoom(host, 2);
+
+    expect(second).not.toBe(first);
+    expect(sentOfType(host, 'error')).toEqual([]);
+  });
+
+  it('lets a player create a room after leaving over HTTP', async () => {
+    const host = await connect('host');
+    const roomCode = await createRoom(host);
+    const guest = await connect('guest');
+    guest.receive({ type: 'joinRoom', roomCode, playerName: 'guest', timestamp: Date.now() });
+    await waitFor(guest, 'roomJoined');
+
+    expect(server.getRoomManager().leaveRoom(roomCode, 'guest')).toBe('left');
+    await createRoom(guest);
+
+    expect(sentOfType(guest, 'error')).toEqual([]);
+  });
+
+  it('still refuses a second room while the player is in one', async () => {
+    const host = await connect('host');
+    await createRoom(host);
+
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const error = await waitFor(host, 'error');
+
+    expect(error.code).toBe(ErrorCodes.ROOM_STARTED);
+    expect(server.getRoomManager().getRoomCount()).toBe(1);
+  });
+});
@@ -0,0 +1,66 @@
+/**
+ * @fileoverview Live room stats tests.
+ * The ops dashboard polls these counts to see whether the server is
+ * under load, so they must reflect room status and live connections.
+ */
+
+import { RoomConfig } from '../../network/protocol';
+import { RoomStatus } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { MockConnection } from '../setup/MockConnection';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 3,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: false,
+  allowSpectators: false
+};
+
+const HOUR_MS = 60 * 60 * 1000;
+
+describe('RoomManager.getStats', () => {
+  it('reports zero counts with no rooms', () => {
+    const stats = new RoomManager().getStats();
+
+    expect(stats).toEqual({
+      totalRooms: 0,
+      roomsByStatus: {
+        [RoomStatus.WAITING]: 0,
+        [RoomStatus.PLAYING]: 0,
+        [RoomStatus.ENDED]: 0,
+        [RoomStatus.CLOSED]: 0
+      },
+      connectedPlayers: 0,
+      roomsCreatedLastHour: 0
+    });
+  });
+
+  it('counts rooms by status and only connected players', () => {
+    const manager = new RoomManager();
+    const first = manager.createRoom('host-1', CONFIG);
+    const second = manager.createRoom('host-2', CONFIG);
+
+    first.addPlayer('host-1', 'host-1', new MockConnection('conn-1'));
+    const dropped = new MockConnection('conn-2');
+    first.addPlayer('alice', 'alice', dropped);
+    second.addPlayer('host-2', 'host-2', new MockConnection('conn-3'));
+    dropped.state = 'disconnected';
+
+    const stats = manager.getStats();
+
+    expect(stats.totalRooms).toBe(2);
+    expect(stats.roomsByStatus[RoomStatus.WAITING]).toBe(2);
+    expect(stats.connectedPlayers).toBe(2);
+  });
+
+  it('only counts rooms created in the last hour', () => {
+    const manager = new RoomManager();
+    const room = manager.createRoom('host', CONFIG);
+
+    expect(manager.getStats(room.getCreatedAt() + HOUR_MS - 1).roomsCreatedLastHour).toBe(1);
+    expect(manager.getStats(room.getCreatedAt() + HOUR_MS).roomsCreatedLastHour).toBe(0);
+  });
+});
@@ -0,0 +1,141 @@
+/**
+ * @fileoverview Spectator broadcast delay tests.
+ * Spectators must see room events on a lag while players see them immediately.
+ */
+
+import { GamePhase } from '../../enums';
+import { PHASE_DESCRIPTIONS, RoomState, ServerMessage } from '../../network/protocol';
+import { SpectatorBroadcaster } from '../../server/SpectatorBroadcaster';
+import { MockConnection } from '../setup/MockConnection';
+
+function roomState(round: number): RoomState {
+  return { roomCode: 'ABCD', round } as unknown as RoomState;
+}
+
+function phaseChange(phase: GamePhase): ServerMessage {
+  return {
+    type: 'phaseChange',
+    phase,
+    description: PHASE_DESCRIPTIONS[phase],
+    timeRemaining: null,
+    timestamp: Date.now()
+  };
+}
+
+describe('SpectatorBroadcaster', () => {
+  beforeEach(() => {
+    jest.useFakeTimers();
+  });
+
+  afterEach(() => {
+    jest.useRealTimers();
+  });
+
+  it('delivers to spectators only after the configured delay', () => {
+    const player = new MockConnection('player');
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(30000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    // Mirrors Room.broadcast(): players first, then the spectator channel
+    const message = phaseChange(GamePhase.DAY);
+    player.send(message);
+    spectators.send(message);
+
+    expect(player.sent).toHaveLength(1);
+    expect(spectator.sent).toHaveLength(0);
+
+    jest.advanceTimersByTime(29999);
+    expect(spectator.sent).toHaveLength(0);
+
+    jest.advanceTimersByTime(1);
+    expect(spectator.sent).toEqual([message]);
+  });
+
+  it('preserves message order', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(60000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    jest.advanceTimersByTime(1000);
+    spectators.send(phaseChange(GamePhase.VOTING));
+
+    jest.advanceTimersByTime(61000);
+    expect(spectator.sentOfType('phaseChange').map(m => m.phase))
+      .toEqual([GamePhase.DAY, GamePhase.VOTING]);
+  });
+
+  it('delivers immediately when the delay is zero', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(0);
+    spectators.addSpectator('viewer-1', spectator);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    expect(spectator.sent).toHaveLength(1);
+  });
+
+  it('drops buffered messages on dispose', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(30000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    spectators.dispose();
+    jest.advanceTimersByTime(30000);
+
+    expect(spectator.sent).toHaveLength(0);
+  });
+
+  it('does not deliver to spectators who left during the delay', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(30000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    spectators.removeSpectator('viewer-1');
+    jest.advanceTimersByTime(30000);
+
+    expect(spectator.sent).toHaveLength(0);
+  });
+
+  it('keeps order when the delay is shortened with messages queued', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(60000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    spectators.setDelayMs(0);
+    spectators.send(phaseChange(GamePhase.VOTING));
+
+    expect(spectator.sent).toHaveLength(0);
+
+    jest.advanceTimersByTime(60000);
+    expect(spectator.sentOfType('phaseChange').map(m => m.phase))
+      .toEqual([GamePhase.DAY, GamePhase.VOTING]);
+  });
+
+  it('tracks the released room state even with nobody watching', () => {
+    const spectators = new SpectatorBroadcaster(30000);
+    const opened = roomState(0);
+    spectators.seedState(opened);
+
+    spectators.send({ type: 'roomUpdate', state: roomState(1), timestamp: Date.now() });
+    expect(spectators.getReleasedState()).toBe(opened);
+
+    jest.advanceTimersByTime(30000);
+    expect(spectators.getReleasedState()?.round).toBe(1);
+  });
+
+  it('delivers messages queued before a spectator joined', () => {
+    const spectator = new MockConnection('spectator');
+    const spectators = new SpectatorBroadcaster(30000);
+
+    spectators.send(phaseChange(GamePhase.DAY));
+    jest.advanceTimersByTime(10000);
+    spectators.addSpectator('viewer-1', spectator);
+
+    jest.advanceTimersByTime(20000);
+    expect(spectator.sent).toHaveLength(1);
+  });
+});
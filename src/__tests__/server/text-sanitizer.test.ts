@@ -0,0 +1,81 @@
+/**
+ * @fileoverview Player text sanitizer tests.
+ * Names and statements are stored as typed apart from control characters
+ * and words on the operator's blocklist; escaping is left to renderers.
+ */
+
+import * as fs from 'fs';
+import * as os from 'os';
+import * as path from 'path';
+import {
+  createSanitizerFromEnv,
+  escapeHtml,
+  loadBlocklist,
+  TextSanitizer
+} from '../../server/TextSanitizer';
+
+describe('TextSanitizer', () => {
+  it('escapes HTML special characters', () => {
+    expect(escapeHtml(`<img src="x" onerror='a && b'>`))
+      .toBe('&lt;img src=&quot;x&quot; onerror=&#39;a &amp;&amp; b&#39;&gt;');
+  });
+
+  it('leaves ordinary text unchanged', () => {
+    expect(new TextSanitizer().sanitize('I am the Seer 🔮')).toBe('I am the Seer 🔮');
+  });
+
+  it('strips control characters', () => {
+    expect(new TextSanitizer().sanitize('line\none\u0000 ')).toBe('lineone');
+  });
+
+  it('masks blocked words whatever their case', () => {
+    const sanitizer = new TextSanitizer(['darn', 'heck']);
+
+    expect(sanitizer.sanitize('Darn it, what the HECK')).toBe('**** it, what the ****');
+  });
+
+  it('only masks whole words', () => {
+    const sanitizer = new TextSanitizer(['ass']);
+
+    expect(sanitizer.sanitize('pass the ass')).toBe('pass the ***');
+  });
+
+  it('does not escape apostrophes or markup', () => {
+    const sanitizer = new TextSanitizer();
+
+    expect(sanitizer.sanitize("I'm the Seer")).toBe("I'm the Seer");
+    expect(sanitizer.sanitize('O\'Brien & <b>')).toBe("O'Brien & <b>");
+  });
+});
+
+describe('Blocklist loading', () => {
+  let dir: string;
+
+  beforeEach(() => {
+    dir = fs.mkdtempSync(path.join(os.tmpdir(), 'onuw-blocklist-'));
+  });
+
+  afterEach(() => {
+    fs.rmSync(dir, { recursive: true, force: true });
+  });
+
+  it('reads one word per line, skipping comments and blank lines', () => {
+    const file = path.join(dir, 'blocklist.txt');
+    fs.writeFileSync(file, '# house rules\ndarn\n\n  heck  \r\n');
+
+    expect(loadBlocklist(file)).toEqual(['darn', 'heck']);
+  });
+
+  it('uses the file named by CHAT_BLOCKLIST_FILE', () => {
+    const file = path.join(dir, 'blocklist.txt');
+    fs.writeFileSync(file, 'darn\n');
+
+    const sanitizer = createSanitizerFromEnv({ CHAT_BLOCKLIST_FILE: file });
+
+    expect(sanitizer.sanitize('darn')).toBe('****');
+  });
+
+  it('defaults to an empty blocklist', () => {
+    expect(createSanitizerFromEnv({}).sanitize('darn')).toBe('darn');
+  });
+});
@@ -0,0 +1,120 @@
+/**
+ * @fileoverview Game round tracing tests.
+ * A traced room produces one game span with a child per phase and a
+ * child of the night phase per night action; without an OTLP endpoint
+ * in the environment tracing is switched off.
+ */
+
+import { GamePhase } from '../../enums';
+import { RoomConfig } from '../../network/protocol';
+import { Room, RoomEventType } from '../../server/Room';
+import {
+  createTracerFromEnv,
+  NOOP_TRACER,
+  OtlpHttpTracer,
+  RecordingTracer,
+  toOtlpJson,
+  traceRoom
+} from '../../server/Tracing';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+/** Room whose events the test raises by hand, without playing a game */
+class EventRoom extends Room {
+  raise(type: RoomEventType, data: Record<string, unknown> = {}): void {
+    (this as unknown as { emitEvent(type: RoomEventType, data: Record<string, unknown>): void })
+      .emitEvent(type, data);
+  }
+}
+
+describe('traceRoom', () => {
+  it('nests phases under the game and night actions under the night', () => {
+    const tracer = new RecordingTracer();
+    const room = new EventRoom('host', CONFIG, 'TRACE1');
+    traceRoom(room, tracer);
+
+    room.raise('gameStarted', { playerIds: ['a', 'b', 'c', 'd', 'e'] });
+    room.raise('phaseChanged', { phase: GamePhase.NIGHT });
+    room.raise('nightActionCompleted', { role: 'SEER', durationMs: 1500 });
+    room.raise('phaseChanged', { phase: GamePhase.DAY });
+    room.raise('gameEnded', {});
+
+    const byName = new Map(tracer.finished.map(span => [span.name, span]));
+    const game = byName.get('game')!;
+    const night = byName.get(`phase ${GamePhase.NIGHT}`)!;
+    const action = byName.get('night action SEER')!;
+
+    expect(tracer.finished.map(span => span.name).sort()).toEqual(
+      ['game', 'night action SEER', `phase ${GamePhase.DAY}`, `phase ${GamePhase.NIGHT}`].sort()
+    );
+    expect(game.attributes).toMatchObject({ 'game.id': 'TRACE1', 'game.players': 5, 'game.outcome': 'ended' });
+    expect(night.parentSpanId).toBe(game.spanId);
+    expect(byName.get(`phase ${GamePhase.DAY}`)!.parentSpanId).toBe(game.spanId);
+    expect(action.parentSpanId).toBe(night.spanId);
+    expect(action.attributes['game.role']).toBe('SEER');
+    expect(action.endTime - action.startTime).toBe(1500);
+    expect(new Set(tracer.finished.map(span => span.traceId)).size).toBe(1);
+  });
+
+  it('ends the spans when the room closes mid-game', () => {
+    const tracer = new RecordingTracer();
+    const room = new EventRoom('host', CONFIG, 'TRACE2');
+    traceRoom(room, tracer);
+
+    room.raise('gameStarted', { playerIds: [] });
+    room.raise('phaseChanged', { phase: GamePhase.NIGHT });
+    room.raise('roomClosed', { reason: 'Host deleted the game' });
+
+    expect(tracer.finished).toHaveLength(2);
+    expect(tracer.finished[1].attributes['game.outcome']).toBe('closed');
+  });
+});
+
+describe('createTracerFromEnv', () => {
+  it('is a no-op without an OTLP endpoint', () => {
+    expect(createTracerFromEnv({})).toBe(NOOP_TRACER);
+    expect(createTracerFromEnv({
+      OTEL_EXPORTER_OTLP_ENDPOINT: 'http://collector:4318',
+      OTEL_TRACES_EXPORTER: 'none'
+    })).toBe(NOOP_TRACER);
+  });
+
+  it('exports to the collector named in the environment', async () => {
+    const tracer = createTracerFromEnv({ OTEL_EXPORTER_OTLP_ENDPOINT: 'http://collector:4318/' });
+
+    expect(tracer).toBeInstanceOf(OtlpHttpTracer);
+    await tracer.shutdown();
+  });
+});
+
+describe('toOtlpJson', () => {
+  it('encodes spans in the OTLP/JSON shape', () => {
+    const body = toOtlpJson([{
+      traceId: 'a'.repeat(32),
+      spanId: 'b'.repeat(16),
+      name: 'game',
+      startTime: 1000,
+      endTime: 2500,
+      attributes: { 'game.id': 'ABC123', 'game.players': 5 }
+    }], 'onuw-server') as {
+      resourceSpans: Array<{ scopeSpans: Array<{ spans: Array<Record<string, unknown>> }> }>;
+    };
+
+    const [span] = body.resourceSpans[0].scopeSpans[0].spans;
+    expect(span.startTimeUnixNano).toBe('1000000000');
+    expect(span.endTimeUnixNano).toBe('2500000000');
+    expect(span.attributes).toEqual([
+      { key: 'game.id', value: { stringValue: 'ABC123' } },
+      { key: 'game.players', value: { intValue: 5 } }
+    ]);
+    expect(span).not.toHaveProperty('parentSpanId');
+  });
+});
@@ -0,0 +1,70 @@
+/**
+ * @fileoverview Post-game vote breakdown tests.
+ * The reveal shows how the vote went: who voted for whom by name and the
+ * votes each player got, with voters left out under a secret ballot.
+ */
+
+import { ABSTAIN_VOTE } from '../../types';
+import { buildVoteBreakdown } from '../../server/VoteBreakdown';
+
+const NAMES = new Map([
+  ['p1', 'Alice'],
+  ['p2', 'Bob'],
+  ['p3', 'Carol'],
+  ['p4', 'Dave'],
+  ['p5', 'Erin']
+]);
+
+const VOTES = {
+  p4: 'p1',
+  p1: 'p2',
+  p2: 'p1',
+  p3: 'p2',
+  p5: ABSTAIN_VOTE
+};
+
+describe('buildVoteBreakdown', () => {
+  it('names every voter and target in seating order', () => {
+    const breakdown = buildVoteBreakdown(VOTES, NAMES);
+
+    expect(breakdown.anonymous).toBe(false);
+    expect(breakdown.votes!.map(v => [v.voterName, v.targetName])).toEqual([
+      ['Alice', 'Bob'],
+      ['Bob', 'Alice'],
+      ['Carol', 'Bob'],
+      ['Dave', 'Alice'],
+      ['Erin', 'Abstained']
+    ]);
+  });
+
+  it('tallies votes per target, most first, with ties in seating order', () => {
+    const breakdown = buildVoteBreakdown({ ...VOTES, p5: 'p3' }, NAMES);
+
+    expect(breakdown.tally).toEqual([
+      { playerId: 'p1', playerName: 'Alice', votes: 2 },
+      { playerId: 'p2', playerName: 'Bob', votes: 2 },
+      { playerId: 'p3', playerName: 'Carol', votes: 1 }
+    ]);
+    expect(breakdown.abstentions).toBe(0);
+  });
+
+  it('counts abstentions toward no one', () => {
+    const breakdown = buildVoteBreakdown(VOTES, NAMES);
+
+    expect(breakdown.abstentions).toBe(1);
+    expect(breakdown.tally.reduce((sum, t) => sum + t.votes, 0)).toBe(4);
+  });
+
+  it('keeps the tally but not the voters when anonymous', () => {
+    const breakdown = buildVoteBreakdown(VOTES, NAMES, true);
+
+    expect(breakdown).toEqual({
+      anonymous: true,
+      tally: [
+        { playerId: 'p1', playerName: 'Alice', votes: 2 },
+        { playerId: 'p2', playerName: 'Bob', votes: 2 }
+      ],
+      abstentions: 1
+    });
+  });
+});
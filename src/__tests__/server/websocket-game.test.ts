@@ -0,0 +1,95 @@
+/**
+ * @fileoverview Full game played over the WebSocket protocol.
+ * Readiness, night actions, the end of the day and votes all travel as
+ * client messages, so a game must reach gameEnd with no other input.
+ * Every phase transition along the way is announced with a phaseChange.
+ */
+
+import { GamePhase } from '../../enums';
+import { PHASE_DESCRIPTIONS, RoomConfig, ServerMessage } from '../../network/protocol';
+import { GameServerFacade } from '../../server/GameServerFacade';
+import { FakeServerBackend } from '../setup/FakeServerBackend';
+import { ScriptedClient } from '../setup/ScriptedClient';
+import { ROLE_CONFIGS } from '../setup/testUtils';
+
+const CONFIG: RoomConfig = {
+  minPlayers: 5,
+  maxPlayers: 5,
+  roles: ROLE_CONFIGS.STANDARD,
+  timeoutStrategy: 'casual',
+  isPrivate: true,
+  allowSpectators: false
+};
+
+describe('Game over WebSocket', () => {
+  let server: GameServerFacade;
+  let backend: FakeServerBackend;
+
+  beforeEach(async () => {
+    backend = new FakeServerBackend();
+    server = new GameServerFacade(backend, { port: 0 });
+    await server.start();
+  });
+
+  afterEach(async () => {
+    await server.stop();
+  });
+
+  /** Connects a client and authenticates it as the given player */
+  async function connect(playerId: string): Promise<ScriptedClient> {
+    const client = new ScriptedClient();
+    backend.connect(client);
+    client.receive({ type: 'authenticate', playerId, playerName: playerId, timestamp: Date.now() });
+    await client.waitFor('authenticated');
+    return client;
+  }
+
+  it('plays from lobby to gameEnd using only client messages', async () => {
+    const host = await connect('host');
+    host.receive({ type: 'createRoom', config: CONFIG, timestamp: Date.now() });
+    const { roomCode } = await host.waitFor('roomCreated');
+
+    const guests: ScriptedClient[] = [];
+    for (const id of ['alice', 'bob', 'carol', 'dave']) {
+      const guest = await connect(id);
+      guest.receive({ type: 'joinRoom', roomCode, playerName: id, timestamp: Date.now() });
+      const joined = await guest.waitFor('roomJoined');
+      expect(joined.roomCode).toBe(roomCode);
+      expect(joined.playerId).toBe(id);
+      expect(joined.state.players.map(p => p.id)).toContain(id);
+      guest.receive({ type: 'setReady', ready: true, timestamp: Date.now() });
+      guests.push(guest);
+    }
+
+    await host.waitFor('roomUpdate', message =>
+      message.state.players.length === 5 &&
+      message.state.players.every(p => p.isReady || p.id === 'host')
+    );
+    host.receive({ type: 'startGame', timestamp: Date.now() });
+
+    const clients = [host, ...guests];
+    const endings = await Promise.all(clients.map(client => client.waitFor('gameEnd')));
+
+    for (const client of clients) {
+      expect(client.received.filter(m => m.type === 'error')).toEqual([]);
+      expect(client.received.some(m =>
+        m.type === 'actionRequired' && m.request.actionType === 'vote'
+      )).toBe(true);
+    }
+    expect(Object.keys(endings[0].result.votes).sort())
+      .toEqual(['alice', 'bob', 'carol', 'dave', 'host']);
+
+    // One phaseChange per transition, in order, before the game ends
+    for (const client of clients) {
+      const phaseChanges = client.received.filter(
+        (m): m is Extract<ServerMessage, { type: 'phaseChange' }> => m.type === 'phaseChange'
+      );
+      expect(phaseChanges.map(m => m.phase))
+        .toEqual([GamePhase.NIGHT, GamePhase.DAY, GamePhase.VOTING, GamePhase.RESOLUTION]);
+      expect(phaseChanges.map(m => m.description))
+        .toEqual(phaseChanges.map(m => PHASE_DESCRIPTIONS[m.phase]));
+      expect(client.received.findIndex(m => m.type === 'gameEnd'))
+        .toBeGreaterThan(client.received.indexOf(phaseChanges[phaseChanges.length - 1]));
+    }
+  }, 20000);
+});
@@ -0,0 +1,45 @@
+/**
+ * @fileoverview In-process WebSocket server backend for end-to-end server tests.
+ * @module __tests__/setup/FakeServerBackend
+ *
+ * @description
+ * Lets a test run a real GameServerFacade without opening a port: the
+ * test builds client sockets itself and hands them to the server with
+ * connect().
+ */
+
+import { IWebSocketServerBackend } from '../../network/WebSocketServer';
+import { IWebSocket } from '../../network/WebSocketConnection';
+
+/**
+ * Backend that hands sockets to the server when a test connects them.
+ *
+ * @example
+ * ```typescript
+ * const backend = new FakeServerBackend();
+ * const server = new GameServerFacade(backend, { port: 0 });
+ * await server.start();
+ * backend.connect(new ScriptedClient());
+ * ```
+ */
+export class FakeServerBackend implements IWebSocketServerBackend {
+  private connectionHandler: ((socket: IWebSocket) => void) | null = null;
+
+  listen(_port: number, _host: string, callback: () => void): void {
+    callback();
+  }
+
+  close(callback: () => void): void {
+    callback();
+  }
+
+  onConnection(handler: (socket: IWebSocket) => void): void {
+    this.connectionHandler = handler;
+  }
+
+  onError(): void {}
+
+  connect(socket: IWebSocket): void {
+    this.connectionHandler?.(socket);
+  }
+}
@@ -0,0 +1,98 @@
+/**
+ * @fileoverview Scriptable socket for WebSocketConnection tests.
+ * @module __tests__/setup/FakeWebSocket
+ *
+ * @description
+ * FakeWebSocket stands in for a Node.js ws socket. It counts pings,
+ * lets a test deliver client messages and pong frames, and exposes
+ * bufferedAmount so a test can simulate a client that stops reading.
+ */
+
+import { IWebSocket } from '../../network/WebSocketConnection';
+
+/**
+ * A ws-like socket that records pings and lets tests fire events.
+ *
+ * @example
+ * ```typescript
+ * const socket = new FakeWebSocket();
+ * const connection = new WebSocketConnection('conn-1', socket);
+ * socket.receive({ type: 'pong', timestamp: Date.now() });
+ * ```
+ */
+export class FakeWebSocket implements IWebSocket {
+  readonly CONNECTING = 0;
+  readonly OPEN = 1;
+  readonly CLOSING = 2;
+  readonly CLOSED = 3;
+  readyState = 1;
+  bufferedAmount = 0;
+
+  /** Ping frames sent so far */
+  pings = 0;
+
+  /** Every string passed to send(), in order */
+  readonly sent: string[] = [];
+
+  /** Close code, once the connection closed the socket */
+  closeCode: number | null = null;
+
+  private readonly listeners = new Map<string, Set<(event: unknown) => void>>();
+  private pongListener: (() => void) | null = null;
+
+  /** @param remoteAddress - Client IP, as the server backend would set it */
+  constructor(readonly remoteAddress?: string) {}
+
+  send(data: string): void {
+    this.sent.push(data);
+  }
+
+  close(code?: number): void {
+    this.readyState = this.CLOSED;
+    this.closeCode = code ?? null;
+  }
+
+  addEventListener(type: string, listener: (event: unknown) => void): void {
+    if (!this.listeners.has(type)) {
+      this.listeners.set(type, new Set());
+    }
+    this.listeners.get(type)!.add(listener);
+  }
+
+  removeEventListener(type: string, listener: (event: unknown) => void): void {
+    this.listeners.get(type)?.delete(listener);
+  }
+
+  ping(): void {
+    this.pings++;
+  }
+
+  on(_event: 'pong', listener: () => void): void {
+    this.pongListener = listener;
+  }
+
+  off(): void {
+    this.pongListener = null;
+  }
+
+  /** Delivers a client message; objects are JSON encoded first */
+  receive(message: object | string): void {
+    const data = typeof message === 'string' ? message : JSON.stringify(message);
+    for (const listener of this.listeners.get('message') ?? []) {
+      listener({ data });
+    }
+  }
+
+  /** Closes the socket from the client side, as when the network drops */
+  drop(code: number = 1006): void {
+    this.readyState = this.CLOSED;
+    for (const listener of this.listeners.get('close') ?? []) {
+      listener({ code, reason: 'Connection lost' });
+    }
+  }
+
+  /** Delivers a protocol-level pong frame */
+  pong(): void {
+    this.pongListener?.();
+  }
+}
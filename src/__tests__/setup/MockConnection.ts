@@ -0,0 +1,98 @@
+/**
+ * @fileoverview In-memory client connection for server-side tests.
+ * @module __tests__/setup/MockConnection
+ *
+ * @description
+ * MockConnection records every message the server sends and lets a test
+ * inject client messages as if they had arrived over a WebSocket.
+ */
+
+import {
+  IClientConnection,
+  ConnectionType,
+  ConnectionState,
+  MessageHandler,
+  DisconnectHandler,
+  ErrorHandler
+} from '../../network/IClientConnection';
+import { ClientMessage, CloseCode, ServerMessage } from '../../network/protocol';
+
+/**
+ * A scriptable connection that captures outbound server messages.
+ *
+ * @example
+ * ```typescript
+ * const conn = new MockConnection('conn-1');
+ * const agent = new NetworkAgent('player-1', conn);
+ * conn.receive({ type: 'actionResponse', requestId: 'player-1-1', response: 'player-2', timestamp: Date.now() });
+ * expect(conn.sentOfType('error')).toHaveLength(0);
+ * ```
+ */
+export class MockConnection implements IClientConnection {
+  readonly type: ConnectionType = 'websocket';
+  state: ConnectionState = 'connected';
+
+  /** Every message sent to this connection, in order */
+  readonly sent: ServerMessage[] = [];
+
+  /** Close code passed to close(), if any */
+  closeCode: CloseCode | null = null;
+
+  private readonly messageHandlers = new Set<MessageHandler>();
+  private readonly disconnectHandlers = new Set<DisconnectHandler>();
+  private readonly errorHandlers = new Set<ErrorHandler>();
+  private readonly connectedAt = Date.now();
+
+  constructor(readonly id: string) {}
+
+  send(message: ServerMessage): void {
+    this.sent.push(message);
+  }
+
+  onMessage(handler: MessageHandler): () => void {
+    this.messageHandlers.add(handler);
+    return () => this.messageHandlers.delete(handler);
+  }
+
+  onDisconnect(handler: DisconnectHandler): () => void {
+    this.disconnectHandlers.add(handler);
+    return () => this.disconnectHandlers.delete(handler);
+  }
+
+  onError(handler: ErrorHandler): () => void {
+    this.errorHandlers.add(handler);
+    return () => this.errorHandlers.delete(handler);
+  }
+
+  close(reason: string = 'closed', code?: CloseCode): void {
+    this.state = 'disconnected';
+    this.closeCode = code ?? null;
+    for (const handler of this.disconnectHandlers) {
+      handler(reason);
+    }
+  }
+
+  isConnected(): boolean {
+    return this.state === 'connected';
+  }
+
+  getLatency(): number {
+    return 0;
+  }
+
+  getConnectedAt(): number {
+    return this.connectedAt;
+  }
+
+  /** Delivers a client message to all registered handlers. */
+  receive(message: ClientMessage): void {
+    for (const handler of this.messageHandlers) {
+      handler(message);
+    }
+  }
+
+  /** Returns sent messages of the given type. */
+  sentOfType<T extends ServerMessage['type']>(type: T): Extract<ServerMessage, { type: T }>[] {
+    return this.sent.filter(m => m.type === type) as Extract<ServerMessage, { type: T }>[];
+  }
+}
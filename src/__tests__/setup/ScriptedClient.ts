@@ -0,0 +1,129 @@
+/**
+ * @fileoverview Client socket that plays by the protocol on its own.
+ * @module __tests__/setup/ScriptedClient
+ *
+ * @description
+ * ScriptedClient answers every action request with the first valid
+ * choice, so a server test only sends the messages it is about. Options
+ * hold a client back from signalling ready to vote or from voting, for
+ * tests that need the day or the vote to stay open.
+ */
+
+import { GamePhase } from '../../enums';
+import { ActionRequest, ClientMessage, ServerMessage } from '../../network/protocol';
+import { FakeWebSocket } from './FakeWebSocket';
+
+/**
+ * What a scripted client does without being told.
+ */
+export interface ScriptedClientOptions {
+  /** Signal ready to vote as soon as the day starts (default true) */
+  readyToVote?: boolean;
+
+  /** Answer vote requests (default true) */
+  votes?: boolean;
+}
+
+/**
+ * A FakeWebSocket that records server messages and answers requests.
+ *
+ * @example
+ * ```typescript
+ * const client = new ScriptedClient({ readyToVote: false });
+ * backend.connect(client);
+ * client.receive({ type: 'authenticate', playerId: 'alice', playerName: 'alice', timestamp: Date.now() });
+ * await client.waitFor('authenticated');
+ * ```
+ */
+export class ScriptedClient extends FakeWebSocket {
+  /** Every server message, in order */
+  readonly received: ServerMessage[] = [];
+
+  private readonly readyToVote: boolean;
+  private readonly votes: boolean;
+  private readonly waiters: Array<{
+    match: (message: ServerMessage) => boolean;
+    count: number;
+    resolve: (message: ServerMessage) => void;
+  }> = [];
+
+  constructor(options: ScriptedClientOptions = {}) {
+    super();
+    this.readyToVote = options.readyToVote ?? true;
+    this.votes = options.votes ?? true;
+  }
+
+  send(data: string): void {
+    super.send(data);
+    const message = JSON.parse(data) as ServerMessage;
+    this.received.push(message);
+
+    if (message.type === 'actionRequired' && (message.request.actionType !== 'vote' || this.votes)) {
+      const { requestId } = message.request;
+      const response = ScriptedClient.answer(message.request);
+      this.reply({ type: 'actionResponse', requestId, response, timestamp: Date.now() });
+    } else if (message.type === 'phaseChange' && message.phase === GamePhase.DAY && this.readyToVote) {
+      this.reply({ type: 'readyToVote', timestamp: Date.now() });
+    }
+
+    for (const waiter of [...this.waiters]) {
+      const matches = this.received.filter(waiter.match);
+      if (matches.length >= waiter.count) {
+        this.waiters.splice(this.waiters.indexOf(waiter), 1);
+        waiter.resolve(matches[waiter.count - 1]);
+      }
+    }
+  }
+
+  /** Sends a client message on the next tick, like a network hop */
+  reply(message: ClientMessage): void {
+    setTimeout(() => this.receive(message), 0);
+  }
+
+  /** Resolves with the count-th message of the type that passes the check */
+  waitFor<T extends ServerMessage['type']>(
+    type: T,
+    check: (message: Extract<ServerMessage, { type: T }>) => boolean = () => true,
+    count: number = 1
+  ): Promise<Extract<ServerMessage, { type: T }>> {
+    const match = (message: ServerMessage): boolean =>
+      message.type === type && check(message as Extract<ServerMessage, { type: T }>);
+
+    const matches = this.received.filter(match);
+    if (matches.length >= count) {
+      return Promise.resolve(matches[count - 1] as Extract<ServerMessage, { type: T }>);
+    }
+    return new Promise(resolve => {
+      this.waiters.push({
+        match,
+        count,
+        resolve: message => resolve(message as Extract<ServerMessage, { type: T }>)
+      });
+    });
+  }
+
+  /** Picks the first valid answer; selectTwoCenter is sent but not in ActionRequest */
+  private static answer(request: ActionRequest | { actionType: string }): unknown {
+    const { actionType } = request;
+    const options = 'options' in request ? (request.options as readonly unknown[]) : [];
+
+    switch (actionType) {
+      case 'selectPlayer':
+        return options[0];
+      case 'selectTwoPlayers':
+        return options.slice(0, 2);
+      case 'selectCenter':
+        return 0;
+      case 'selectTwoCenter':
+        return [0, 1];
+      case 'seerChoice':
+        return 'player';
+      case 'rotationChoice':
+        return 'NONE';
+      case 'vote':
+        return (request as Extract<ActionRequest, { actionType: 'vote' }>).eligibleTargets[0];
+      default:
+        return null;
+    }
+  }
+}
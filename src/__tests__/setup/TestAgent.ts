@@ -12,7 +12,8 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../../types';
 
 /**
@@ -31,6 +32,9 @@ export interface TestAgentConfig {
   /** Seer's choice: view 'player' or 'center' */
   seerChoice?: 'player' | 'center';
 
+  /** Village Idiot's choice: 'LEFT', 'RIGHT', or 'NONE' to decline */
+  rotationChoice?: RotationChoice;
+
   /** Two players for Troublemaker to swap */
   selectTwoPlayersTargets?: [string, string];
 
@@ -107,6 +111,14 @@ export class TestAgent extends AbstractAgent {
     return this.config.seerChoice ?? 'player';
   }
 
+  /**
+   * Chooses which way to move every player's card (Village Idiot).
+   * Uses configured choice or defaults to 'NONE'.
+   */
+  async chooseRotation(_context: NightActionContext): Promise<RotationChoice> {
+    return this.config.rotationChoice ?? 'NONE';
+  }
+
   /**
    * Selects two different players (Troublemaker).
    * Uses configured targets or defaults to first two options.
@@ -0,0 +1,85 @@
+/**
+ * @fileoverview Fakes for testing night actions in isolation.
+ * @module __tests__/setup/nightActionFakes
+ *
+ * @description
+ * Builds the context, agent and game state a night action needs, without
+ * a Game. Each factory returns sensible defaults (three players, three
+ * center cards, every card a Villager) that a test overrides where it cares.
+ */
+
+import { RoleName } from '../../enums';
+import { INightActionAgent, INightActionGameState } from '../../patterns/strategy';
+import { NightActionContext } from '../../types';
+
+/** Player IDs used by the default context and game state */
+export const FAKE_PLAYER_IDS = ['player-1', 'player-2', 'player-3'];
+
+/**
+ * Creates a night action context for player-1.
+ *
+ * @param role - Starting role of the acting player
+ * @param overrides - Fields to replace in the default context
+ */
+export function createNightActionContext(
+  role: RoleName,
+  overrides: Partial<NightActionContext> = {}
+): NightActionContext {
+  return {
+    myPlayerId: 'player-1',
+    myStartingRole: role,
+    rolesInGame: [role],
+    allPlayerIds: FAKE_PLAYER_IDS,
+    previousResults: [],
+    centerCardCount: 3,
+    ...overrides
+  };
+}
+
+/**
+ * Creates an agent that picks the first options offered.
+ *
+ * @param overrides - Decisions to replace
+ */
+export function createNightActionAgent(overrides: Partial<INightActionAgent> = {}): INightActionAgent {
+  return {
+    selectPlayer: async (options) => options[0],
+    selectCenterCard: async () => 0,
+    selectTwoCenterCards: async () => [0, 1],
+    chooseSeerOption: async () => 'center',
+    chooseRotation: async () => 'NONE',
+    selectTwoPlayers: async (options) => [options[0], options[1]],
+    receiveNightInfo: () => {},
+    ...overrides
+  };
+}
+
+/**
+ * Creates a game state where every card is a Villager and nobody is shielded.
+ * swapCards is a jest mock so tests can check whether cards moved.
+ *
+ * @param overrides - Methods to replace
+ */
+export function createNightActionGameState(
+  overrides: Partial<INightActionGameState> = {}
+): INightActionGameState {
+  return {
+    getPlayerRole: () => RoleName.VILLAGER,
+    viewPlayerCard: () => RoleName.VILLAGER,
+    getCenterCard: () => RoleName.VILLAGER,
+    getCenterCardCount: () => 3,
+    swapCards: jest.fn(),
+    getPlayersWithRole: () => [],
+    getPlayersWithStartingRole: () => [],
+    getAllPlayerIds: () => FAKE_PLAYER_IDS,
+    setDoppelgangerCopiedRole: () => {},
+    getDoppelgangersWhoCopied: () => [],
+    shieldPlayer: () => {},
+    isShielded: () => false,
+    revealCard: () => {},
+    placeArtifact: () => {},
+    getAlphaWolfCard: () => null,
+    swapAlphaWolfCard: () => {},
+    ...overrides
+  };
+}
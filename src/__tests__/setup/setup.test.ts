@@ -37,7 +37,8 @@ describe('Test Infrastructure', () => {
           myStartingRole: RoleName.SEER,
           allPlayerIds: ['player-1', 'player-2', 'player-3', 'player-4'],
           rolesInGame: [RoleName.SEER, RoleName.WEREWOLF],
-          previousResults: []
+          previousResults: [],
+          centerCardCount: 3
         }
       );
       expect(selected).toBe('player-3');
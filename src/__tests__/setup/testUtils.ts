@@ -9,7 +9,7 @@
 
 import { Game, IGameAgent } from '../../core/Game';
 import { RoleName, Team } from '../../enums';
-import { GameConfig, GameResult } from '../../types';
+import { GameConfig, GameResult, TieBreakMode } from '../../types';
 import { TestAgent, TestAgentConfig } from './TestAgent';
 
 /**
@@ -117,23 +117,41 @@ export interface TestGameConfig {
   /** Force all werewolves to center */
   forceWerewolvesToCenter?: boolean;
 
+  /** Number of center cards (default: 3) */
+  centerCardCount?: number;
+
+  /** Custom night wake order (default: NIGHT_WAKE_ORDER) */
+  nightOrder?: RoleName[];
+
   /** Agent configurations by player index */
   agentConfigs?: Map<number, TestAgentConfig>;
 
+  /** Agents to register instead of building a TestAgent, by player index */
+  agents?: Map<number, TestAgent>;
+
   /** Default vote target for all agents (player ID) */
   defaultVoteTarget?: string;
+
+  /** Votes a player needs to die (default: the game's default of 2) */
+  minVotesToKill?: number;
+
+  /** Voting time limit in ms, null for none (default: the game's default) */
+  votingTimeoutMs?: number | null;
+
+  /** Accept ABSTAIN_VOTE as a vote (default: false) */
+  allowAbstain?: boolean;
+
+  /** How a tie for the most votes is resolved (default: 'all-die') */
+  tieBreak?: TieBreakMode;
 }
 
 /**
- * Result of a test game with additional helper data.
+ * A test game with its agents registered, not yet run.
  */
-export interface TestGameResult {
+export interface TestGameSetup {
   /** The game instance */
   game: Game;
 
-  /** The game result */
-  result: GameResult;
-
   /** Map of player ID to TestAgent */
   agents: Map<string, TestAgent>;
 
@@ -141,6 +159,14 @@ export interface TestGameResult {
   playerIds: string[];
 }
 
+/**
+ * Result of a test game with additional helper data.
+ */
+export interface TestGameResult extends TestGameSetup {
+  /** The game result */
+  result: GameResult;
+}
+
 /**
  * Creates a test game with the specified configuration.
  *
@@ -154,6 +180,26 @@ export interface TestGameResult {
  * ```
  */
 export async function createTestGame(config: TestGameConfig): Promise<TestGameResult> {
+  const setup = setupTestGame(config);
+
+  // Run the game
+  const result = await setup.game.run();
+
+  return { ...setup, result };
+}
+
+/**
+ * Creates a test game and registers its agents without running it, for
+ * tests that observe, cancel or snapshot the game while it runs.
+ *
+ * @example
+ * ```typescript
+ * const { game } = setupTestGame({ roles: ROLE_CONFIGS.STANDARD });
+ * game.cancel('Room closed');
+ * await expect(game.run()).rejects.toBeInstanceOf(GameCancelledError);
+ * ```
+ */
+export function setupTestGame(config: TestGameConfig): TestGameSetup {
   const playerCount = config.playerCount ?? 5;
   const players = Array.from({ length: playerCount }, (_, i) => `Player${i + 1}`);
   const playerIds = Array.from({ length: playerCount }, (_, i) => `player-${i + 1}`);
@@ -164,6 +210,12 @@ export async function createTestGame(config: TestGameConfig): Promise<TestGameRe
     roles: config.roles,
     forcedRoles: config.forcedRoles,
     forceWerewolvesToCenter: config.forceWerewolvesToCenter,
+    centerCardCount: config.centerCardCount,
+    nightOrder: config.nightOrder,
+    minVotesToKill: config.minVotesToKill,
+    votingTimeoutMs: config.votingTimeoutMs,
+    allowAbstain: config.allowAbstain,
+    tieBreak: config.tieBreak,
     auditLevel: 'minimal' // Reduce noise in tests
   };
 
@@ -173,6 +225,12 @@ export async function createTestGame(config: TestGameConfig): Promise<TestGameRe
   const agents = new Map<string, TestAgent>();
   for (let i = 0; i < playerCount; i++) {
     const playerId = playerIds[i];
+    const customAgent = config.agents?.get(i);
+    if (customAgent) {
+      agents.set(playerId, customAgent);
+      continue;
+    }
+
     const agentConfig = config.agentConfigs?.get(i) ?? {};
 
     // Apply default vote target if not specified
@@ -187,10 +245,7 @@ export async function createTestGame(config: TestGameConfig): Promise<TestGameRe
   // Register agents
   game.registerAgents(agents as Map<string, IGameAgent>);
 
-  // Run the game
-  const result = await game.run();
-
-  return { game, result, agents, playerIds };
+  return { game, agents, playerIds };
 }
 
 /**
@@ -275,6 +330,19 @@ export function createUnanimousVoteConfigs(
   return configs;
 }
 
+/**
+ * Creates agent configs where player i votes for votes[i].
+ */
+export function createVoteConfigs(votes: string[]): Map<number, TestAgentConfig> {
+  const configs = new Map<number, TestAgentConfig>();
+
+  votes.forEach((voteTarget, i) => {
+    configs.set(i, { voteTarget });
+  });
+
+  return configs;
+}
+
 /**
  * Creates agent configs for a tie vote (everyone votes for themselves).
  */
@@ -0,0 +1,87 @@
+/**
+ * @fileoverview Abstain vote tests.
+ * Under the abstain house rule an ABSTAIN vote counts as cast but adds to
+ * no one's tally, and no one dies unless some player gets more than 1 vote.
+ */
+
+import { ABSTAIN_VOTE, VotingContext } from '../../types';
+import { TestAgent } from '../setup/TestAgent';
+import { createTestGame, createVoteConfigs, ROLE_CONFIGS, TestGameConfig } from '../setup/testUtils';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+/** Agent that abstains, recording whether it was offered the choice */
+class AbstainingVoter extends TestAgent {
+  canAbstain: boolean | undefined;
+
+  async vote(context: VotingContext): Promise<string> {
+    this.canAbstain = context.canAbstain;
+    return ABSTAIN_VOTE;
+  }
+}
+
+/**
+ * Game config where each player votes for the given target, or abstains
+ * when the target is ABSTAIN_VOTE.
+ */
+function voting(votes: string[]): TestGameConfig {
+  const abstainers = new Map<number, TestAgent>();
+  votes.forEach((vote, i) => {
+    if (vote === ABSTAIN_VOTE) {
+      abstainers.set(i, new AbstainingVoter(PLAYER_IDS[i]));
+    }
+  });
+
+  return {
+    roles: ROLE_CONFIGS.STANDARD,
+    agentConfigs: createVoteConfigs(votes),
+    agents: abstainers,
+    allowAbstain: true
+  };
+}
+
+describe('Abstain votes', () => {
+  it('records abstentions without counting them toward anyone', async () => {
+    const { result } = await createTestGame(voting([
+      'player-2', ABSTAIN_VOTE, 'player-2', ABSTAIN_VOTE, 'player-1'
+    ]));
+
+    expect(result.votes.get('player-2')).toBe(ABSTAIN_VOTE);
+    expect(result.votes.get('player-4')).toBe(ABSTAIN_VOTE);
+    expect(result.votes.size).toBe(5);
+    expect(result.eliminatedPlayers).toEqual(['player-2']);
+  });
+
+  it('kills no one when every player abstains', async () => {
+    const { result } = await createTestGame(voting(PLAYER_IDS.map(() => ABSTAIN_VOTE)));
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('kills no one when no player gets more than one vote', async () => {
+    const { result } = await createTestGame(voting([
+      'player-2', 'player-3', ABSTAIN_VOTE, ABSTAIN_VOTE, ABSTAIN_VOTE
+    ]));
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('kills every player tied on the most votes', async () => {
+    const { result } = await createTestGame(voting([
+      'player-3', 'player-3', 'player-1', 'player-1', ABSTAIN_VOTE
+    ]));
+
+    expect([...result.eliminatedPlayers].sort()).toEqual(['player-1', 'player-3']);
+  });
+
+  it('drops ABSTAIN votes when the house rule is off', async () => {
+    const { result, agents } = await createTestGame({
+      ...voting(['player-2', ABSTAIN_VOTE, 'player-2', 'player-2', 'player-1']),
+      allowAbstain: false
+    });
+
+    expect((agents.get('player-2') as AbstainingVoter).canAbstain).toBe(false);
+    expect(result.votes.has('player-2')).toBe(false);
+    expect(result.eliminatedPlayers).toEqual(['player-2']);
+  });
+});
@@ -0,0 +1,77 @@
+/**
+ * @fileoverview Timestamps on night actions and votes.
+ * Replays and debugging need to know when each action and vote happened,
+ * not just what it was, so the engine stamps both as it records them.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game, GameSnapshot, IGameAgent } from '../../core/Game';
+import { buildNightActionLog } from '../../server/NightActionLog';
+import { TestAgent } from '../setup/TestAgent';
+import { createTestGame, ROLE_CONFIGS } from '../setup/testUtils';
+
+describe('Event timestamps', () => {
+  it('stamps every night action when it is recorded', async () => {
+    const before = Date.now();
+    const { game } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      forcedRoles: new Map([[0, RoleName.SEER], [1, RoleName.ROBBER]]),
+      defaultVoteTarget: 'player-2'
+    });
+    const after = Date.now();
+
+    const results = game.getAllNightResults();
+    expect(results.length).toBeGreaterThan(0);
+    for (const result of results) {
+      expect(result.timestamp).toBeGreaterThanOrEqual(before);
+      expect(result.timestamp).toBeLessThanOrEqual(after);
+    }
+
+    const log = buildNightActionLog(results, new Map());
+    expect(log.every(entry => typeof entry.timestamp === 'number')).toBe(true);
+  });
+
+  it('keeps a timestamped log alongside the votes map', async () => {
+    const before = Date.now();
+    const { game, result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      defaultVoteTarget: 'player-2'
+    });
+    const after = Date.now();
+
+    expect(result.voteLog).toHaveLength(result.votes.size);
+    for (const vote of result.voteLog) {
+      expect(result.votes.get(vote.voterId)).toBe(vote.targetId);
+      expect(vote.timestamp).toBeGreaterThanOrEqual(before);
+      expect(vote.timestamp).toBeLessThanOrEqual(after);
+    }
+    expect(game.getVoteLog()).toEqual(result.voteLog);
+  });
+
+  it('carries the vote log through a snapshot', async () => {
+    const playerIds = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+    const game = new Game({
+      players: playerIds.map((_, i) => `Player${i + 1}`),
+      roles: ROLE_CONFIGS.STANDARD,
+      auditLevel: 'minimal'
+    });
+
+    let snapshot = null as GameSnapshot | null;
+    game.addObserver({
+      onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+        if (event.type === 'PHASE_CHANGED' && event.data?.to === GamePhase.RESOLUTION) {
+          snapshot = JSON.parse(JSON.stringify(game.toSnapshot()));
+        }
+      }
+    });
+    game.registerAgents(new Map(playerIds.map(id =>
+      [id, new TestAgent(id, { voteTarget: 'player-3' }) as IGameAgent]
+    )));
+    await game.run();
+
+    const restored = Game.fromSnapshot(snapshot!);
+
+    expect(restored.getVoteLog()).toEqual(game.getVoteLog());
+    expect(restored.getAllNightResults()).toEqual(game.getAllNightResults());
+  });
+});
@@ -0,0 +1,74 @@
+/**
+ * @fileoverview Game cancellation tests.
+ * A cancelled game must stop at the next checkpoint and reject run()
+ * with GameCancelledError rather than finishing or reporting a failure.
+ */
+
+import { DOPPEL_INSOMNIAC_ORDER, GamePhase } from '../../enums';
+import { GameCancelledError } from '../../core/Game';
+import { ROLE_CONFIGS, setupTestGame, TestGameConfig } from '../setup/testUtils';
+
+const CONFIG: TestGameConfig = {
+  roles: ROLE_CONFIGS.STANDARD,
+  defaultVoteTarget: 'player-1'
+};
+
+describe('Game cancellation', () => {
+  it('stops before the next phase runs', async () => {
+    const { game } = setupTestGame(CONFIG);
+    const phases: GamePhase[] = [];
+
+    game.addObserver({
+      onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+        if (event.type === 'PHASE_CHANGED') {
+          phases.push(event.data?.to as GamePhase);
+          if (event.data?.to === GamePhase.DAY) {
+            game.cancel('Server shutting down');
+          }
+        }
+      }
+    });
+
+    await expect(game.run()).rejects.toBeInstanceOf(GameCancelledError);
+    expect(phases).not.toContain(GamePhase.VOTING);
+    expect(game.getStatements()).toHaveLength(0);
+  });
+
+  it('stops the night between actions', async () => {
+    const { game } = setupTestGame(CONFIG);
+    let actionsSeen = 0;
+
+    game.addObserver({
+      onEvent: (event: { type: string }) => {
+        if (event.type === 'NIGHT_ACTION_EXECUTED') {
+          actionsSeen++;
+          game.cancel();
+        }
+      }
+    });
+
+    await expect(game.run()).rejects.toThrow('cancelled');
+    expect(actionsSeen).toBe(1);
+    expect(game.getAllNightResults()).toHaveLength(1);
+  });
+
+  it('checks for cancellation at every wake order, even empty ones', async () => {
+    const { game } = setupTestGame(CONFIG);
+    game.cancel('Room closed');
+
+    await expect(game.executeNightActionsForRole(DOPPEL_INSOMNIAC_ORDER))
+      .rejects.toBeInstanceOf(GameCancelledError);
+    expect(game.getAllNightResults()).toHaveLength(0);
+  });
+
+  it('keeps the first cancellation reason', async () => {
+    const { game } = setupTestGame(CONFIG);
+    game.cancel('Room closed');
+    game.cancel('Server shutting down');
+
+    const error = await game.run().catch(e => e);
+    expect(game.isCancelled()).toBe(true);
+    expect(error).toBeInstanceOf(GameCancelledError);
+    expect((error as GameCancelledError).reason).toBe('Room closed');
+  });
+});
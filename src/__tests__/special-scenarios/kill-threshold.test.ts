@@ -0,0 +1,55 @@
+/**
+ * @fileoverview Kill threshold tests.
+ * A player needs at least minVotesToKill votes to die. The default of 2
+ * is the standard rule that one vote each kills no one.
+ */
+
+import { createTestGame, createVoteConfigs, ROLE_CONFIGS } from '../setup/testUtils';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+/** Every player gets exactly one vote */
+const ONE_EACH = ['player-2', 'player-3', 'player-4', 'player-5', 'player-1'];
+
+/** player-3 gets three votes */
+const MAJORITY = ['player-3', 'player-3', 'player-1', 'player-3', 'player-2'];
+
+describe('Kill threshold', () => {
+  it('kills no one when everyone gets one vote', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agentConfigs: createVoteConfigs(ONE_EACH)
+    });
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('kills a clear majority under the default threshold', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agentConfigs: createVoteConfigs(MAJORITY)
+    });
+
+    expect(result.eliminatedPlayers).toEqual(['player-3']);
+  });
+
+  it('spares a leader below a higher threshold', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agentConfigs: createVoteConfigs(MAJORITY),
+      minVotesToKill: 4
+    });
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('kills everyone on one vote each with a threshold of 1', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agentConfigs: createVoteConfigs(ONE_EACH),
+      minVotesToKill: 1
+    });
+
+    expect([...result.eliminatedPlayers].sort()).toEqual(PLAYER_IDS);
+  });
+});
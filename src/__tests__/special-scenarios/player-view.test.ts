@@ -0,0 +1,179 @@
+/**
+ * @fileoverview Player view construction tests.
+ * Views are built from an explicit list of fields, so a player who
+ * learned nothing overnight gets no one else's role by construction.
+ */
+
+import { GamePhase, RoleName } from '../../enums';
+import { Game, IGameAgent } from '../../core/Game';
+import { PlayerViewFactory, toClientNightResult } from '../../players/PlayerView';
+import { SerializablePlayerGameView } from '../../network/protocol';
+import { NightActionResult } from '../../types';
+import { TestAgent, TestAgentConfig } from '../setup/TestAgent';
+import { createTestGame } from '../setup/testUtils';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+const VILLAGER_ID = 'player-5';
+
+/** Runs a night with a Seer, Robber and Werewolf and captures views at dawn */
+async function viewsAtDawn(): Promise<Map<string, SerializablePlayerGameView>> {
+  const game = new Game({
+    players: PLAYER_IDS.map((_, i) => `Player${i + 1}`),
+    roles: [
+      RoleName.SEER, RoleName.ROBBER, RoleName.WEREWOLF, RoleName.WEREWOLF,
+      RoleName.VILLAGER, RoleName.TROUBLEMAKER, RoleName.DRUNK, RoleName.TANNER
+    ],
+    forcedRoles: new Map([
+      [0, RoleName.SEER],
+      [1, RoleName.ROBBER],
+      [2, RoleName.WEREWOLF],
+      [3, RoleName.WEREWOLF],
+      [4, RoleName.VILLAGER]
+    ]),
+    auditLevel: 'minimal'
+  });
+
+  const agents = new Map<string, TestAgent>();
+  for (const id of PLAYER_IDS) {
+    agents.set(id, new TestAgent(id, { selectPlayerTarget: 'player-3', voteTarget: 'player-3' }));
+  }
+  game.registerAgents(agents as Map<string, IGameAgent>);
+
+  const views = new Map<string, SerializablePlayerGameView>();
+  game.addObserver({
+    onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
+      if (event.type === 'PHASE_CHANGED' && event.data?.to === GamePhase.DAY) {
+        for (const id of PLAYER_IDS) {
+          views.set(id, PlayerViewFactory.createView(game, id));
+        }
+      }
+    }
+  });
+
+  await game.run();
+  return views;
+}
+
+describe('Player View', () => {
+  it('gives a player who did not act no role but their own', async () => {
+    const view = (await viewsAtDawn()).get(VILLAGER_ID)!;
+    const json = JSON.stringify(view);
+
+    expect(view.myNightInfo).toEqual([]);
+    expect(view.finalRoles).toBeNull();
+    for (const role of Object.values(RoleName).filter(r => r !== RoleName.VILLAGER)) {
+      expect(json).not.toContain(role);
+    }
+  });
+
+  it('keeps what an acting player learned', async () => {
+    const seerView = (await viewsAtDawn()).get('player-1')!;
+
+    expect(seerView.myNightInfo).toHaveLength(1);
+    expect(seerView.myNightInfo[0].info.viewed).toEqual([
+      { playerId: 'player-3', role: RoleName.WEREWOLF }
+    ]);
+  });
+
+  it('copies only known fields from a night result', () => {
+    const internal = {
+      actorId: 'player-2',
+      roleName: RoleName.ROBBER,
+      actionType: 'SWAP',
+      success: true,
+      info: {
+        swapped: { from: { playerId: 'player-2' }, to: { playerId: 'player-3', debugRole: RoleName.WEREWOLF } },
+        viewed: [{ playerId: 'player-2', role: RoleName.WEREWOLF }],
+        centerCards: [RoleName.TANNER]
+      },
+      auditTrail: ['internal']
+    } as unknown as NightActionResult;
+
+    const copy = toClientNightResult(internal);
+
+    expect(copy).toEqual({
+      actorId: 'player-2',
+      roleName: RoleName.ROBBER,
+      actionType: 'SWAP',
+      success: true,
+      info: {
+        swapped: { from: { playerId: 'player-2' }, to: { playerId: 'player-3' } },
+        viewed: [{ playerId: 'player-2', role: RoleName.WEREWOLF }]
+      }
+    });
+    expect(JSON.stringify(copy)).not.toContain('TANNER');
+  });
+});
+
+describe('Client copies of role results', () => {
+  /** Runs a game with the role as player-1 and returns its client copy */
+  async function clientResultFor(
+    role: RoleName,
+    agentConfig: TestAgentConfig,
+    extraRoles: RoleName[] = []
+  ): Promise<NightActionResult> {
+    let info: NightActionResult | null = null;
+
+    await createTestGame({
+      roles: [
+        role, RoleName.WEREWOLF, RoleName.SEER,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+        ...extraRoles
+      ],
+      forcedRoles: new Map([[0, role], [1, RoleName.WEREWOLF], [2, RoleName.SEER]]),
+      agentConfigs: new Map([[0, { ...agentConfig, onNightInfo: (result: NightActionResult) => { info = result; } }]]),
+      defaultVoteTarget: 'player-2'
+    });
+
+    expect(info).not.toBeNull();
+    return toClientNightResult(info!);
+  }
+
+  it('keeps who the Beholder saw as the Seer', async () => {
+    const copy = await clientResultFor(RoleName.BEHOLDER, {});
+    expect(copy.info).toEqual({ seers: ['player-3'] });
+  });
+
+  it('keeps who the Sentinel shielded', async () => {
+    const copy = await clientResultFor(RoleName.SENTINEL, { selectPlayerTarget: 'player-4' });
+    expect(copy.info).toEqual({ shielded: 'player-4' });
+  });
+
+  it('keeps the Village Idiot rotation', async () => {
+    const copy = await clientResultFor(RoleName.VILLAGE_IDIOT, { rotationChoice: 'LEFT' });
+    expect(copy.info.rotated).toEqual({
+      direction: 'LEFT',
+      playerIds: ['player-1', 'player-2', 'player-3', 'player-4', 'player-5']
+    });
+  });
+
+  it('keeps the card the Revealer turned up', async () => {
+    const copy = await clientResultFor(RoleName.REVEALER, { selectPlayerTarget: 'player-3' });
+    expect(copy.info).toEqual({
+      viewed: [{ playerId: 'player-3', role: RoleName.SEER }],
+      revealed: 'player-3'
+    });
+  });
+
+  it('keeps who got the Curator artifact', async () => {
+    const copy = await clientResultFor(RoleName.CURATOR, { selectPlayerTarget: 'player-4' });
+    expect(copy.info).toEqual({ artifact: 'player-4' });
+  });
+
+  it('keeps who the Alpha Wolf turned', async () => {
+    const copy = await clientResultFor(RoleName.ALPHA_WOLF, { selectPlayerTarget: 'player-3' }, [RoleName.VILLAGER]);
+    expect(copy.info.turned).toBe('player-3');
+  });
+
+  it('keeps a skipped action', () => {
+    const copy = toClientNightResult({
+      actorId: 'player-1',
+      roleName: RoleName.TROUBLEMAKER,
+      actionType: 'NONE',
+      success: true,
+      info: { skipped: true }
+    });
+    expect(copy.info).toEqual({ skipped: true });
+  });
+});
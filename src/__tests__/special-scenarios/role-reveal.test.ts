@@ -0,0 +1,100 @@
+/**
+ * @fileoverview End-of-game role reveal tests.
+ * Original roles must survive every swap while final roles reflect them,
+ * for the center cards as well as the players.
+ */
+
+import { RoleName } from '../../enums';
+import { getRevealSequence } from '../../types';
+import { createTestGame, getFinalRole } from '../setup/testUtils';
+
+const SWAP_ROLES = [
+  RoleName.ROBBER, RoleName.TROUBLEMAKER, RoleName.WEREWOLF,
+  RoleName.VILLAGER, RoleName.DRUNK,
+  RoleName.VILLAGER, RoleName.SEER, RoleName.TANNER
+];
+
+describe('Role Reveal', () => {
+  it('keeps original roles unchanged after swaps while final roles reflect them', async () => {
+    const { result } = await createTestGame({
+      roles: SWAP_ROLES,
+      forcedRoles: new Map([
+        [0, RoleName.ROBBER],
+        [1, RoleName.TROUBLEMAKER],
+        [2, RoleName.WEREWOLF],
+        [3, RoleName.VILLAGER],
+        [4, RoleName.DRUNK]
+      ]),
+      agentConfigs: new Map([
+        [0, { selectPlayerTarget: 'player-3' }],                                   // Robber takes Werewolf
+        [1, { selectTwoPlayersTargets: ['player-1', 'player-4'] as [string, string] }], // Swap Robber and Villager
+        [4, { selectCenterIndex: 0 }]                                               // Drunk takes a center card
+      ]),
+      defaultVoteTarget: 'player-2'
+    });
+
+    expect(Object.fromEntries(result.originalRoles)).toEqual({
+      'player-1': RoleName.ROBBER,
+      'player-2': RoleName.TROUBLEMAKER,
+      'player-3': RoleName.WEREWOLF,
+      'player-4': RoleName.VILLAGER,
+      'player-5': RoleName.DRUNK
+    });
+
+    expect(getFinalRole(result, 'player-1')).toBe(RoleName.VILLAGER);
+    expect(getFinalRole(result, 'player-3')).toBe(RoleName.ROBBER);
+    expect(getFinalRole(result, 'player-4')).toBe(RoleName.WEREWOLF);
+    expect(getFinalRole(result, 'player-5')).not.toBe(RoleName.DRUNK);
+  });
+
+  it('keeps the dealt center cards unchanged after a Drunk swap', async () => {
+    const { game, result } = await createTestGame({
+      roles: SWAP_ROLES,
+      forcedRoles: new Map([
+        [0, RoleName.ROBBER],
+        [1, RoleName.TROUBLEMAKER],
+        [2, RoleName.WEREWOLF],
+        [3, RoleName.VILLAGER],
+        [4, RoleName.DRUNK]
+      ]),
+      agentConfigs: new Map([[4, { selectCenterIndex: 0 }]]),
+      defaultVoteTarget: 'player-2'
+    });
+
+    const dealt = result.originalCenterCards;
+    expect([...dealt].sort()).toEqual([RoleName.SEER, RoleName.TANNER, RoleName.VILLAGER].sort());
+
+    // Only the card the Drunk took moved
+    expect(result.finalCenterCards).toEqual([RoleName.DRUNK, dealt[1], dealt[2]]);
+    expect(game.getOriginalCenterCards()).toEqual(dealt);
+  });
+
+  it('does not let callers change the recorded deal', async () => {
+    const { game } = await createTestGame({
+      roles: SWAP_ROLES,
+      defaultVoteTarget: 'player-1'
+    });
+    const dealt = game.getOriginalCenterCards();
+
+    game.getOriginalCenterCards()[0] = RoleName.WEREWOLF;
+
+    expect(game.getOriginalCenterCards()).toEqual(dealt);
+  });
+
+  it('defaults to leading with final roles', async () => {
+    const { result } = await createTestGame({
+      roles: SWAP_ROLES,
+      defaultVoteTarget: 'player-1'
+    });
+
+    expect(result.revealEmphasis).toBe('final');
+    expect(result.revealSequence).toEqual(getRevealSequence('final'));
+  });
+
+  it('reveals original roles before final roles when emphasizing the original deal', () => {
+    const sequence = getRevealSequence('original');
+
+    expect(sequence).toEqual(['originalRoles', 'nightActions', 'finalRoles']);
+    expect(getRevealSequence('final')[0]).toBe('finalRoles');
+  });
+});
@@ -0,0 +1,138 @@
+/**
+ * @fileoverview Tie-break mode tests.
+ * A tie for the most votes kills everyone tied, no one, or goes to one
+ * revote among the tied players, depending on the game's tieBreak setting.
+ * Rooms refuse a mode the game doesn't know.
+ */
+
+import { ABSTAIN_VOTE, VotingContext } from '../../types';
+import { RoomConfig } from '../../network/protocol';
+import { validateTieBreak } from '../../server/Room';
+import { RoomManager } from '../../server/RoomManager';
+import { TestAgent } from '../setup/TestAgent';
+import { createTestGame, ROLE_CONFIGS } from '../setup/testUtils';
+
+const PLAYER_IDS = ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'];
+
+/** First round: player-1 and player-3 tie on 2 votes each */
+const TIED_ROUND = ['player-3', 'player-3', 'player-1', 'player-1', 'player-2'];
+
+/** Agent that votes from a script, one entry per voting round */
+class ScriptedVoter extends TestAgent {
+  readonly contexts: VotingContext[] = [];
+
+  constructor(id: string, private readonly rounds: string[]) {
+    super(id);
+  }
+
+  async vote(context: VotingContext): Promise<string> {
+    this.contexts.push(context);
+    return this.rounds[this.contexts.length - 1];
+  }
+}
+
+/** Creates one voter per player index, voting round by round as scripted */
+function scriptVoters(rounds: string[][]): Map<number, ScriptedVoter> {
+  return new Map<number, ScriptedVoter>(PLAYER_IDS.map((id, i) => [i, new ScriptedVoter(id, rounds.map(round => round[i]))]));
+}
+
+describe('Tie-break modes', () => {
+  it('kills every tied player by default', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents: scriptVoters([TIED_ROUND])
+    });
+
+    expect([...result.eliminatedPlayers].sort()).toEqual(['player-1', 'player-3']);
+  });
+
+  it('kills no one on a tie with no-kill', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents: scriptVoters([TIED_ROUND]),
+      tieBreak: 'no-kill'
+    });
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('still kills a clear leader with no-kill', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents: scriptVoters([['player-3', 'player-3', 'player-1', 'player-3', 'player-2']]),
+      tieBreak: 'no-kill'
+    });
+
+    expect(result.eliminatedPlayers).toEqual(['player-3']);
+  });
+
+  it('revotes among the tied players and kills the new leader', async () => {
+    const agents = scriptVoters([
+      TIED_ROUND,
+      ['player-3', 'player-1', 'player-1', 'player-1', 'player-3']
+    ]);
+
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents,
+      tieBreak: 'revote'
+    });
+
+    expect(agents.get(1)!.contexts).toHaveLength(2);
+    expect(agents.get(1)!.contexts[1].eligibleTargets).toEqual(['player-1', 'player-3']);
+    expect(agents.get(0)!.contexts[1].eligibleTargets).toEqual(['player-3']);
+    expect(result.votes.get('player-2')).toBe('player-1');
+    expect(result.eliminatedPlayers).toEqual(['player-1']);
+  });
+
+  it('kills every tied player if the revote ties again', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents: scriptVoters([
+        TIED_ROUND,
+        ['player-3', 'player-3', 'player-1', 'player-1', ABSTAIN_VOTE]
+      ]),
+      tieBreak: 'revote'
+    });
+
+    expect([...result.eliminatedPlayers].sort()).toEqual(['player-1', 'player-3']);
+  });
+
+  it('skips the revote when there is no tie', async () => {
+    const agents = scriptVoters([['player-3', 'player-3', 'player-1', 'player-3', 'player-2']]);
+
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      agents,
+      tieBreak: 'revote'
+    });
+
+    expect(agents.get(0)!.contexts).toHaveLength(1);
+    expect(result.eliminatedPlayers).toEqual(['player-3']);
+  });
+});
+
+describe('Tie-break configuration', () => {
+  it('accepts every known mode, or none', () => {
+    for (const tieBreak of [undefined, 'all-die', 'no-kill', 'revote'] as const) {
+      expect(validateTieBreak({ tieBreak })).toEqual([]);
+    }
+  });
+
+  it('refuses a room with an unknown mode', () => {
+    const manager = new RoomManager();
+    const config = {
+      minPlayers: 5,
+      maxPlayers: 5,
+      roles: ROLE_CONFIGS.STANDARD,
+      timeoutStrategy: 'casual',
+      isPrivate: true,
+      allowSpectators: false,
+      tieBreak: 'coin-flip'
+    } as unknown as RoomConfig;
+
+    expect(() => manager.createRoom('host', config))
+      .toThrow('Invalid tie break: Tie break must be one of all-die, no-kill, revote');
+    manager.shutdown();
+  });
+});
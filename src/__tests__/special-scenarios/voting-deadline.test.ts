@@ -0,0 +1,56 @@
+/**
+ * @fileoverview Voting deadline tests.
+ * A player who never votes must not hold the game forever: once the
+ * voting time limit runs out the game resolves with the votes cast.
+ */
+
+import { VotingContext } from '../../types';
+import { TestAgent } from '../setup/TestAgent';
+import { createTestGame, ROLE_CONFIGS } from '../setup/testUtils';
+
+/** Agent that never answers its vote request */
+class SilentVoter extends TestAgent {
+  context: VotingContext | null = null;
+
+  vote(context: VotingContext): Promise<string> {
+    this.context = context;
+    return new Promise(() => {});
+  }
+}
+
+/** Agent whose vote request fails, like a dropped connection */
+class FailingVoter extends TestAgent {
+  vote(): Promise<string> {
+    return Promise.reject(new Error('Request vote timed out'));
+  }
+}
+
+describe('Voting deadline', () => {
+  it('resolves with the votes cast once the time limit runs out', async () => {
+    const silent = new SilentVoter('player-5');
+
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      defaultVoteTarget: 'player-1',
+      agents: new Map([[4, silent]]),
+      votingTimeoutMs: 50
+    });
+
+    expect(silent.context?.timeLimitMs).toBe(50);
+    expect(result.votes.has('player-5')).toBe(false);
+    expect(result.votes.size).toBe(4);
+    expect(result.eliminatedPlayers).toContain('player-1');
+  });
+
+  it('counts a failed vote request as an abstention', async () => {
+    const { result } = await createTestGame({
+      roles: ROLE_CONFIGS.STANDARD,
+      defaultVoteTarget: 'player-1',
+      agents: new Map([[4, new FailingVoter('player-5')]]),
+      votingTimeoutMs: null
+    });
+
+    expect(result.votes.has('player-5')).toBe(false);
+    expect(result.eliminatedPlayers).toContain('player-1');
+  });
+});
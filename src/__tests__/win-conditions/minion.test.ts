@@ -4,12 +4,48 @@
  */
 
 import { RoleName, Team } from '../../enums';
+import {
+  PlayerWinInfo,
+  VillageWinCondition,
+  WerewolfWinCondition,
+  WinConditionContext
+} from '../../patterns/strategy';
 import {
   createTestGame,
   teamWon,
   playerEliminated,
 } from '../setup/testUtils';
 
+/** Builds a win context from each player's final role and who died */
+function winContext(
+  players: Array<[RoleName, boolean, RoleName?]>
+): WinConditionContext {
+  const werewolfTeam = new Set([RoleName.WEREWOLF, RoleName.MINION]);
+  const allPlayers: PlayerWinInfo[] = players.map(([currentRole, isEliminated, copiedRole], i) => ({
+    playerId: `player-${i + 1}`,
+    currentRole,
+    copiedRole,
+    isEliminated,
+    team: werewolfTeam.has(copiedRole ?? currentRole) ? Team.WEREWOLF : Team.VILLAGE
+  }));
+
+  return {
+    allPlayers,
+    eliminatedPlayers: allPlayers.filter(p => p.isEliminated),
+    werewolvesExistAmongPlayers: allPlayers.some(p => p.currentRole === RoleName.WEREWOLF),
+    minionExistsAmongPlayers: allPlayers.some(p => p.currentRole === RoleName.MINION || p.copiedRole === RoleName.MINION),
+    tannerWasEliminated: false
+  };
+}
+
+/** Which of Village and Werewolf win in a context */
+function winners(context: WinConditionContext): { village: boolean; werewolf: boolean } {
+  return {
+    village: new VillageWinCondition().evaluate(context).won,
+    werewolf: new WerewolfWinCondition().evaluate(context).won
+  };
+}
+
 describe('Minion Role Tests', () => {
   describe('Night Action Tests', () => {
     it('M1: Minion should see werewolves', async () => {
@@ -227,4 +263,62 @@ describe('Minion Role Tests', () => {
       expect(teamWon(result, Team.VILLAGE)).toBe(true);
     });
   });
+
+  describe('Win resolution', () => {
+    describe('with Werewolves among players', () => {
+      it('wins with the pack when only the Minion dies', () => {
+        const context = winContext([
+          [RoleName.WEREWOLF, false], [RoleName.MINION, true], [RoleName.VILLAGER, false]
+        ]);
+
+        expect(winners(context)).toEqual({ village: false, werewolf: true });
+      });
+
+      it('loses with the pack when a Werewolf dies', () => {
+        const context = winContext([
+          [RoleName.WEREWOLF, true], [RoleName.MINION, false], [RoleName.VILLAGER, false]
+        ]);
+
+        expect(winners(context)).toEqual({ village: true, werewolf: false });
+      });
+    });
+
+    describe('without Werewolves among players', () => {
+      it('wins when a villager dies', () => {
+        const context = winContext([[RoleName.MINION, false], [RoleName.VILLAGER, true]]);
+
+        expect(winners(context)).toEqual({ village: false, werewolf: true });
+      });
+
+      it('loses to the village when only the Minion dies', () => {
+        const context = winContext([[RoleName.MINION, true], [RoleName.VILLAGER, false]]);
+
+        expect(winners(context)).toEqual({ village: true, werewolf: false });
+      });
+
+      it('wins alone when the Minion dies alongside a villager', () => {
+        const context = winContext([[RoleName.MINION, true], [RoleName.VILLAGER, true], [RoleName.SEER, false]]);
+
+        expect(winners(context)).toEqual({ village: false, werewolf: true });
+      });
+
+      it('loses to the village when no one dies', () => {
+        const context = winContext([[RoleName.MINION, false], [RoleName.VILLAGER, false]]);
+
+        expect(winners(context)).toEqual({ village: true, werewolf: false });
+      });
+
+      it('treats a Doppel-Minion as a Minion', () => {
+        const onlyMinions = winContext([
+          [RoleName.MINION, true], [RoleName.DOPPELGANGER, true, RoleName.MINION], [RoleName.VILLAGER, false]
+        ]);
+        const doppelAndVillager = winContext([
+          [RoleName.DOPPELGANGER, true, RoleName.MINION], [RoleName.VILLAGER, true], [RoleName.SEER, false]
+        ]);
+
+        expect(winners(onlyMinions)).toEqual({ village: true, werewolf: false });
+        expect(winners(doppelAndVillager)).toEqual({ village: false, werewolf: true });
+      });
+    });
+  });
 });
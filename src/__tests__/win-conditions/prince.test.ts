@@ -0,0 +1,109 @@
+/**
+ * @fileoverview Prince role tests.
+ * The Prince can't be killed by the vote, and no one dies in their
+ * place, so every win condition is judged as if those votes killed no one.
+ */
+
+import { RoleName, Team } from '../../enums';
+import {
+  createTestGame,
+  teamWon,
+  playerEliminated,
+} from '../setup/testUtils';
+
+describe('Prince Role Tests', () => {
+  const PRINCE_ROLES = [
+    RoleName.PRINCE, RoleName.WEREWOLF, RoleName.HUNTER,
+    RoleName.VILLAGER, RoleName.VILLAGER,
+    RoleName.WEREWOLF, RoleName.VILLAGER, RoleName.VILLAGER
+  ];
+
+  const FORCED = new Map([
+    [0, RoleName.PRINCE],
+    [1, RoleName.WEREWOLF],
+    [2, RoleName.HUNTER],
+    [3, RoleName.VILLAGER],
+    [4, RoleName.VILLAGER]
+  ]);
+
+  /** Votes cast by player-1 to player-5 */
+  const votes = (...targets: string[]) => new Map(
+    targets.map((voteTarget, i) => [i, { voteTarget }])
+  );
+
+  it('PR1: Prince should survive getting the most votes', async () => {
+    const { result } = await createTestGame({
+      roles: PRINCE_ROLES,
+      forcedRoles: FORCED,
+      agentConfigs: votes('player-4', 'player-1', 'player-1', 'player-1', 'player-1')
+    });
+
+    expect(playerEliminated(result, 'player-1')).toBe(false);
+  });
+
+  it('PR2: No one should die in the Prince\'s place', async () => {
+    const { result } = await createTestGame({
+      roles: PRINCE_ROLES,
+      forcedRoles: FORCED,
+      agentConfigs: votes('player-4', 'player-1', 'player-1', 'player-1', 'player-4')
+    });
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+
+  it('PR3: Werewolves should win when the vote falls on the Prince', async () => {
+    const { result } = await createTestGame({
+      roles: PRINCE_ROLES,
+      forcedRoles: FORCED,
+      agentConfigs: votes('player-2', 'player-1', 'player-1', 'player-1', 'player-1')
+    });
+
+    expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+    expect(teamWon(result, Team.VILLAGE)).toBe(false);
+  });
+
+  it('PR4: A player tied with the Prince should still die', async () => {
+    const { result } = await createTestGame({
+      roles: PRINCE_ROLES,
+      forcedRoles: FORCED,
+      agentConfigs: votes('player-2', 'player-1', 'player-2', 'player-1', 'player-3')
+    });
+
+    expect(result.eliminatedPlayers).toEqual(['player-2']);
+    expect(teamWon(result, Team.VILLAGE)).toBe(true);
+  });
+
+  it('PR5: Hunter should not be able to shoot the Prince', async () => {
+    const { result } = await createTestGame({
+      roles: PRINCE_ROLES,
+      forcedRoles: FORCED,
+      agentConfigs: votes('player-3', 'player-3', 'player-1', 'player-3', 'player-3')
+    });
+
+    expect(playerEliminated(result, 'player-3')).toBe(true);
+    expect(playerEliminated(result, 'player-1')).toBe(false);
+  });
+
+  it('PR6: A Doppelganger who copied the Prince should survive the vote too', async () => {
+    const { result } = await createTestGame({
+      roles: [
+        RoleName.DOPPELGANGER, RoleName.PRINCE, RoleName.WEREWOLF,
+        RoleName.VILLAGER, RoleName.VILLAGER,
+        RoleName.WEREWOLF, RoleName.VILLAGER, RoleName.VILLAGER
+      ],
+      forcedRoles: new Map([
+        [0, RoleName.DOPPELGANGER],
+        [1, RoleName.PRINCE],
+        [2, RoleName.WEREWOLF],
+        [3, RoleName.VILLAGER],
+        [4, RoleName.VILLAGER]
+      ]),
+      agentConfigs: new Map([
+        [0, { selectPlayerTarget: 'player-2', voteTarget: 'player-4' }]
+      ]),
+      defaultVoteTarget: 'player-1'
+    });
+
+    expect(result.eliminatedPlayers).toEqual([]);
+  });
+});
@@ -0,0 +1,163 @@
+/**
+ * @fileoverview Squire role tests.
+ * The Squire sees the Werewolves like the Minion but has no rule of its
+ * own; these tests pin down where that makes its result differ from the
+ * Minion's.
+ */
+
+import { RoleName, Team } from '../../enums';
+import {
+  PlayerWinInfo,
+  VillageWinCondition,
+  WerewolfWinCondition,
+  WinConditionContext,
+  isMinion,
+  isWerewolf
+} from '../../patterns/strategy';
+import {
+  createTestGame,
+  teamWon,
+  playerEliminated,
+} from '../setup/testUtils';
+
+/** Builds a win context from each player's final role and who died */
+function winContext(players: Array<[RoleName, boolean]>): WinConditionContext {
+  const werewolfTeam = new Set([RoleName.WEREWOLF, RoleName.MINION, RoleName.SQUIRE]);
+  const allPlayers: PlayerWinInfo[] = players.map(([currentRole, isEliminated], i) => ({
+    playerId: `player-${i + 1}`,
+    currentRole,
+    isEliminated,
+    team: werewolfTeam.has(currentRole) ? Team.WEREWOLF : Team.VILLAGE
+  }));
+
+  return {
+    allPlayers,
+    eliminatedPlayers: allPlayers.filter(p => p.isEliminated),
+    werewolvesExistAmongPlayers: allPlayers.some(isWerewolf),
+    minionExistsAmongPlayers: allPlayers.some(isMinion),
+    tannerWasEliminated: false
+  };
+}
+
+/** Who the Werewolf condition names as winners in a context, or null if it lost */
+function werewolfWinners(context: WinConditionContext): ReadonlyArray<string> | null {
+  const result = new WerewolfWinCondition().evaluate(context);
+  return result.won ? result.winners : null;
+}
+
+const SQUIRE_ROLES = [
+  RoleName.WEREWOLF, RoleName.WEREWOLF, RoleName.SQUIRE,
+  RoleName.VILLAGER, RoleName.VILLAGER,
+  RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER
+];
+
+const NO_WOLF_ROLES = [
+  RoleName.SQUIRE, RoleName.SEER,
+  RoleName.VILLAGER, RoleName.VILLAGER, RoleName.VILLAGER,
+  RoleName.WEREWOLF, RoleName.WEREWOLF, RoleName.VILLAGER
+];
+
+describe('Squire Role Tests', () => {
+  describe('Night Action Tests', () => {
+    it('SQ1: Squire should see werewolves', async () => {
+      let squireNightInfo: any = null;
+
+      await createTestGame({
+        roles: SQUIRE_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.WEREWOLF],
+          [1, RoleName.WEREWOLF],
+          [2, RoleName.SQUIRE]
+        ]),
+        agentConfigs: new Map([
+          [2, { onNightInfo: (info: any) => { squireNightInfo = info; } }]
+        ]),
+        defaultVoteTarget: 'player-4'
+      });
+
+      expect(squireNightInfo.roleName).toBe(RoleName.SQUIRE);
+      expect([...squireNightInfo.info.werewolves].sort()).toEqual(['player-1', 'player-2']);
+    });
+  });
+
+  describe('Win Condition Tests', () => {
+    it('SQ2: Squire should win when werewolves survive', async () => {
+      const { result } = await createTestGame({
+        roles: SQUIRE_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.WEREWOLF],
+          [2, RoleName.SQUIRE],
+          [3, RoleName.VILLAGER]
+        ]),
+        defaultVoteTarget: 'player-4'
+      });
+
+      expect(playerEliminated(result, 'player-4')).toBe(true);
+      expect(teamWon(result, Team.WEREWOLF)).toBe(true);
+      expect(result.winningPlayers).toContain('player-3');
+    });
+
+    it('SQ3: Squire should lose when a werewolf is eliminated', async () => {
+      const { result } = await createTestGame({
+        roles: SQUIRE_ROLES,
+        forcedRoles: new Map([
+          [0, RoleName.WEREWOLF],
+          [2, RoleName.SQUIRE]
+        ]),
+        defaultVoteTarget: 'player-1'
+      });
+
+      expect(teamWon(result, Team.WEREWOLF)).toBe(false);
+      expect(result.winningPlayers).not.toContain('player-3');
+    });
+
+    it('SQ4: Squire wins when no werewolves exist and the Squire is eliminated', async () => {
+      const { result } = await createTestGame({
+        roles: NO_WOLF_ROLES,
+        forcedRoles: new Map([[0, RoleName.SQUIRE]]),
+        forceWerewolvesToCenter: true,
+        defaultVoteTarget: 'player-1'
+      });
+
+      // Unlike M8, where the Minion's death hands the Village the win
+      expect(playerEliminated(result, 'player-1')).toBe(true);
+      expect(teamWon(result, Team.VILLAGE)).toBe(false);
+      expect(result.winningPlayers).toContain('player-1');
+    });
+  });
+
+  describe('Win resolution compared with the Minion', () => {
+    it('both win with the pack when only they die', () => {
+      const squire = winContext([[RoleName.WEREWOLF, false], [RoleName.SQUIRE, true], [RoleName.VILLAGER, false]]);
+      const minion = winContext([[RoleName.WEREWOLF, false], [RoleName.MINION, true], [RoleName.VILLAGER, false]]);
+
+      expect(werewolfWinners(squire)).toEqual(['player-1', 'player-2']);
+      expect(werewolfWinners(minion)).toEqual(['player-1', 'player-2']);
+    });
+
+    it('only the Squire wins when there are no Werewolves and it alone dies', () => {
+      const squire = winContext([[RoleName.SQUIRE, true], [RoleName.VILLAGER, false]]);
+      const minion = winContext([[RoleName.MINION, true], [RoleName.VILLAGER, false]]);
+
+      expect(werewolfWinners(squire)).toEqual(['player-1']);
+      expect(new VillageWinCondition().evaluate(squire).won).toBe(false);
+      expect(werewolfWinners(minion)).toBeNull();
+      expect(new VillageWinCondition().evaluate(minion).won).toBe(true);
+    });
+
+    it('both win when there are no Werewolves and a villager dies', () => {
+      const squire = winContext([[RoleName.SQUIRE, false], [RoleName.VILLAGER, true]]);
+      const minion = winContext([[RoleName.MINION, false], [RoleName.VILLAGER, true]]);
+
+      expect(werewolfWinners(squire)).toEqual(['player-1']);
+      expect(werewolfWinners(minion)).toEqual(['player-1']);
+    });
+
+    it('both lose when there are no Werewolves and no one dies', () => {
+      const squire = winContext([[RoleName.SQUIRE, false], [RoleName.VILLAGER, false]]);
+
+      expect(werewolfWinners(squire)).toBeNull();
+      expect(new VillageWinCondition().evaluate(squire).won).toBe(true);
+    });
+  });
+});
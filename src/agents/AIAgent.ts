@@ -28,9 +28,10 @@ import {
   DayContext,
   VotingContext,
   NightActionResult,
-  PlayerStatement
+  PlayerStatement,
+  RotationChoice
 } from '../types';
-import { RoleName, Team } from '../enums';
+import { RoleName, Team, WEREWOLF_ROLES } from '../enums';
 import { ROLE_TEAMS } from '../core/Role';
 
 /**
@@ -167,16 +168,17 @@ export class AIAgent extends AbstractAgent {
   /**
    * @summary Selects a center card strategically.
    */
-  async selectCenterCard(_context: NightActionContext): Promise<number> {
+  async selectCenterCard(context: NightActionContext): Promise<number> {
     // Random center card (no strategic preference)
-    return Math.floor(Math.random() * 3);
+    return Math.floor(Math.random() * context.centerCardCount);
   }
 
   /**
    * @summary Selects two center cards.
    */
-  async selectTwoCenterCards(_context: NightActionContext): Promise<[number, number]> {
-    const indices = [0, 1, 2].sort(() => Math.random() - 0.5);
+  async selectTwoCenterCards(context: NightActionContext): Promise<[number, number]> {
+    const indices = Array.from({ length: context.centerCardCount }, (_, i) => i)
+      .sort(() => Math.random() - 0.5);
     return [indices[0], indices[1]];
   }
 
@@ -192,6 +194,21 @@ export class AIAgent extends AbstractAgent {
     return Math.random() < 0.7 ? 'player' : 'center';
   }
 
+  /**
+   * @summary Chooses a rotation for the Village Idiot.
+   *
+   * @description
+   * Moving every card muddles everyone's information. On the village
+   * team, leave the cards alone half the time; otherwise always stir
+   * them up.
+   */
+  async chooseRotation(_context: NightActionContext): Promise<RotationChoice> {
+    if (this.getTeam() === Team.VILLAGE && Math.random() < 0.5) {
+      return 'NONE';
+    }
+    return Math.random() < 0.5 ? 'LEFT' : 'RIGHT';
+  }
+
   /**
    * @summary Selects two players for Troublemaker swap.
    *
@@ -275,7 +292,7 @@ export class AIAgent extends AbstractAgent {
         const role = viewed[0].role;
         this.knownPlayerRoles.set(playerId, role);
 
-        if (role === RoleName.WEREWOLF) {
+        if (WEREWOLF_ROLES.has(role)) {
           return `I am the Seer! I looked at ${playerId} and they are a WEREWOLF! We must vote for them!`;
         } else if (role === RoleName.TANNER) {
           return `I am the Seer. I looked at ${playerId} and they are the Tanner. Be careful not to vote for them!`;
@@ -419,7 +436,7 @@ export class AIAgent extends AbstractAgent {
   private voteAsVillage(context: VotingContext): string {
     // Priority: known werewolf > suspicious > random
     for (const [playerId, role] of this.knownPlayerRoles) {
-      if (role === RoleName.WEREWOLF && context.eligibleTargets.includes(playerId)) {
+      if (WEREWOLF_ROLES.has(role) && context.eligibleTargets.includes(playerId)) {
         return playerId;
       }
     }
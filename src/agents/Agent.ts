@@ -34,7 +34,8 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../types';
 
 /**
@@ -96,7 +97,7 @@ export interface IAgent {
   selectPlayer(options: string[], context: NightActionContext): Promise<string>;
 
   /**
-   * @summary Selects a center card (0, 1, or 2).
+   * @summary Selects a center card, from 0 to context.centerCardCount - 1.
    *
    * @description
    * Used for:
@@ -105,12 +106,12 @@ export interface IAgent {
    *
    * @param {NightActionContext} context - Current context
    *
-   * @returns {Promise<number>} Center card index (0-2)
+   * @returns {Promise<number>} Center card index
    *
    * @example
    * ```typescript
    * const index = await agent.selectCenterCard(context);
-   * // index is 0, 1, or 2
+   * // 0 <= index < context.centerCardCount
    * ```
    */
   selectCenterCard(context: NightActionContext): Promise<number>;
@@ -152,6 +153,23 @@ export interface IAgent {
    */
   chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
 
+  /**
+   * @summary Chooses which way to move every player's card.
+   *
+   * @description
+   * Used for the Village Idiot's choice. 'NONE' leaves the cards alone.
+   *
+   * @param {NightActionContext} context - Current context
+   *
+   * @returns {Promise<RotationChoice>} 'LEFT', 'RIGHT' or 'NONE'
+   *
+   * @example
+   * ```typescript
+   * const direction = await agent.chooseRotation(context);
+   * ```
+   */
+  chooseRotation(context: NightActionContext): Promise<RotationChoice>;
+
   /**
    * @summary Selects two different players.
    *
@@ -292,6 +310,7 @@ export abstract class AbstractAgent implements IAgent {
   abstract selectCenterCard(context: NightActionContext): Promise<number>;
   abstract selectTwoCenterCards(context: NightActionContext): Promise<[number, number]>;
   abstract chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
+  abstract chooseRotation(context: NightActionContext): Promise<RotationChoice>;
   abstract selectTwoPlayers(options: string[], context: NightActionContext): Promise<[string, string]>;
   abstract makeStatement(context: DayContext): Promise<string>;
   abstract vote(context: VotingContext): Promise<string>;
@@ -24,8 +24,8 @@
  */
 
 import { AbstractAgent } from './Agent';
-import { NightActionContext, DayContext, VotingContext, NightActionResult } from '../types';
-import { RoleName, Team } from '../enums';
+import { NightActionContext, DayContext, VotingContext, NightActionResult, RotationChoice } from '../types';
+import { RoleName, Team, WEREWOLF_ROLES } from '../enums';
 import { ROLE_TEAMS } from '../core/Role';
 
 /**
@@ -92,26 +92,27 @@ export class RandomAgent extends AbstractAgent {
   /**
    * @summary Randomly selects a center card.
    *
-   * @param {NightActionContext} _context - Context (unused)
+   * @param {NightActionContext} context - Context with the center card count
    *
-   * @returns {Promise<number>} Random index 0-2
+   * @returns {Promise<number>} Random center index
    */
-  async selectCenterCard(_context: NightActionContext): Promise<number> {
-    return Math.floor(Math.random() * 3);
+  async selectCenterCard(context: NightActionContext): Promise<number> {
+    return Math.floor(Math.random() * context.centerCardCount);
   }
 
   /**
    * @summary Randomly selects two center cards.
    *
-   * @param {NightActionContext} _context - Context (unused)
+   * @param {NightActionContext} context - Context with the center card count
    *
    * @returns {Promise<[number, number]>} Two different random indices
    */
-  async selectTwoCenterCards(_context: NightActionContext): Promise<[number, number]> {
-    const first = Math.floor(Math.random() * 3);
-    let second = Math.floor(Math.random() * 3);
+  async selectTwoCenterCards(context: NightActionContext): Promise<[number, number]> {
+    const count = context.centerCardCount;
+    const first = Math.floor(Math.random() * count);
+    let second = Math.floor(Math.random() * count);
     while (second === first) {
-      second = Math.floor(Math.random() * 3);
+      second = Math.floor(Math.random() * count);
     }
     return [first, second];
   }
@@ -127,6 +128,18 @@ export class RandomAgent extends AbstractAgent {
     return Math.random() < 0.5 ? 'player' : 'center';
   }
 
+  /**
+   * @summary Randomly chooses a rotation, or none.
+   *
+   * @param {NightActionContext} _context - Context (unused)
+   *
+   * @returns {Promise<RotationChoice>} Random choice
+   */
+  async chooseRotation(_context: NightActionContext): Promise<RotationChoice> {
+    const choices: RotationChoice[] = ['LEFT', 'RIGHT', 'NONE'];
+    return choices[Math.floor(Math.random() * choices.length)];
+  }
+
   /**
    * @summary Randomly selects two different players.
    *
@@ -196,11 +209,15 @@ export class RandomAgent extends AbstractAgent {
         return 'I am the Troublemaker. I swapped two players\' cards.';
 
       case RoleName.WEREWOLF:
+      case RoleName.ALPHA_WOLF:
+      case RoleName.MYSTIC_WOLF:
+      case RoleName.DREAM_WOLF:
         // Werewolves lie
         return `I am a Villager. I have no information.`;
 
       case RoleName.MINION:
-        // Minion protects werewolves
+      case RoleName.SQUIRE:
+        // Minion and Squire protect werewolves
         return `I am a Villager. We should focus on finding the Werewolves.`;
 
       case RoleName.TANNER:
@@ -255,8 +272,9 @@ export class RandomAgent extends AbstractAgent {
       this.startingRole = info.roleName;
     }
 
-    // Werewolves and Minion should claim something else
-    if (info.roleName === RoleName.WEREWOLF || info.roleName === RoleName.MINION) {
+    // Werewolves, Minion and Squire should claim something else
+    if (WEREWOLF_ROLES.has(info.roleName) || info.roleName === RoleName.MINION ||
+        info.roleName === RoleName.SQUIRE) {
       this.claimedRole = RoleName.VILLAGER;
     }
 
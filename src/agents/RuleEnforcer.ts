@@ -29,9 +29,24 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../types';
 
+/**
+ * @summary Checks that a center card index is a whole number within the game's center.
+ *
+ * @param {number} index - Index returned by the agent
+ * @param {number} centerCardCount - Number of center cards in the game
+ *
+ * @returns {boolean} True if the index names a center card
+ *
+ * @private
+ */
+function isCenterIndex(index: number, centerCardCount: number): boolean {
+  return Number.isInteger(index) && index >= 0 && index < centerCardCount;
+}
+
 /**
  * @summary Error thrown when an agent violates game rules.
  */
@@ -137,9 +152,9 @@ export class RuleEnforcer implements IAgent {
   async selectCenterCard(context: NightActionContext): Promise<number> {
     const selected = await this.innerAgent.selectCenterCard(context);
 
-    if (selected < 0 || selected > 2 || !Number.isInteger(selected)) {
+    if (!isCenterIndex(selected, context.centerCardCount)) {
       return this.handleViolation(
-        'selectCenterCard must return 0, 1, or 2',
+        `selectCenterCard must return an index from 0 to ${context.centerCardCount - 1}`,
         selected,
         0
       );
@@ -164,9 +179,9 @@ export class RuleEnforcer implements IAgent {
 
     const [a, b] = selected;
 
-    if (a < 0 || a > 2 || b < 0 || b > 2) {
+    if (!isCenterIndex(a, context.centerCardCount) || !isCenterIndex(b, context.centerCardCount)) {
       return this.handleViolation(
-        'selectTwoCenterCards indices must be 0, 1, or 2',
+        `selectTwoCenterCards indices must be from 0 to ${context.centerCardCount - 1}`,
         selected,
         [0, 1] as [number, number]
       );
@@ -200,6 +215,23 @@ export class RuleEnforcer implements IAgent {
     return choice;
   }
 
+  /**
+   * @summary Validates and returns the rotation choice.
+   */
+  async chooseRotation(context: NightActionContext): Promise<RotationChoice> {
+    const choice = await this.innerAgent.chooseRotation(context);
+
+    if (choice !== 'LEFT' && choice !== 'RIGHT' && choice !== 'NONE') {
+      return this.handleViolation(
+        'chooseRotation must return "LEFT", "RIGHT" or "NONE"',
+        choice,
+        'NONE'
+      );
+    }
+
+    return choice;
+  }
+
   /**
    * @summary Validates and returns two player selections.
    */
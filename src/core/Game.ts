@@ -44,7 +44,7 @@
  * ```
  */
 
-import { GamePhase, RoleName, Team, NIGHT_WAKE_ORDER } from '../enums';
+import { GamePhase, RoleName, Team, NIGHT_WAKE_ORDER, DOPPEL_INSOMNIAC_ORDER } from '../enums';
 import {
   GameConfig,
   GameState,
@@ -54,7 +54,14 @@ import {
   PlayerStatement,
   VotingContext,
   DayContext,
-  AuditLevel
+  AuditLevel,
+  RevealEmphasis,
+  RotationChoice,
+  ABSTAIN_VOTE,
+  TieBreakMode,
+  VoteRecord,
+  DEFAULT_CENTER_CARD_COUNT,
+  getRevealSequence
 } from '../types';
 import { Role, ROLE_TEAMS } from './Role';
 import { Player } from './Player';
@@ -62,6 +69,10 @@ import {
   IGamePhaseState,
   IGameContext,
   SetupPhase,
+  NightPhase,
+  DayPhase,
+  VotingPhase,
+  ResolutionPhase,
   GameEventEmitter,
   RoleFactory,
   INightActionGameState,
@@ -72,10 +83,18 @@ import {
   TannerWinCondition,
   WinConditionContext,
   PlayerWinInfo,
-  WinConditionResult
+  WinConditionResult,
+  isWerewolf,
+  isMinion
 } from '../patterns';
 import { GameStateSnapshot } from '../audit/GameStateSnapshot';
 
+/** Voting time limit used when GameConfig.votingTimeoutMs is not set */
+const DEFAULT_VOTING_TIMEOUT_MS = 60000;
+
+/** Kill threshold used when GameConfig.minVotesToKill is not set */
+const DEFAULT_MIN_VOTES_TO_KILL = 2;
+
 /**
  * @summary Interface for game agents (AI or human).
  *
@@ -101,6 +120,7 @@ export interface IGameAgent {
   selectCenterCard(context: NightActionContext): Promise<number>;
   selectTwoCenterCards(context: NightActionContext): Promise<[number, number]>;
   chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
+  chooseRotation(context: NightActionContext): Promise<RotationChoice>;
   selectTwoPlayers(options: string[], context: NightActionContext): Promise<[string, string]>;
 
   // Day phase
@@ -113,6 +133,111 @@ export interface IGameAgent {
   receiveNightInfo(info: NightActionResult): void;
 }
 
+/**
+ * @summary Serializable copy of a game's state.
+ *
+ * @description
+ * Captures everything needed to resume a game after a server restart:
+ * the deal, every card's current position, the phase, and what players
+ * have learned, said, and voted. Agents and connections are not part of
+ * the snapshot; the host re-registers them after restoring.
+ *
+ * @pattern Memento Pattern - Externalizes game state without exposing internals
+ */
+export interface GameSnapshot {
+  /** Game ID */
+  gameId: string;
+
+  /** Phase to resume in */
+  phase: GamePhase;
+
+  /** Roles in the game (players + center cards) */
+  roles: RoleName[];
+
+  /** Number of center cards the game was created with */
+  centerCardCount?: number;
+
+  /** Custom night order the game was created with */
+  nightOrder?: RoleName[];
+
+  /** Audit level the game was created with */
+  auditLevel?: AuditLevel;
+
+  /** Reveal emphasis the game was created with */
+  revealEmphasis?: RevealEmphasis;
+
+  /** Voting time limit the game was created with */
+  votingTimeoutMs?: number | null;
+
+  /** Whether the game was created with abstaining allowed */
+  allowAbstain?: boolean;
+
+  /** Tie-break mode the game was created with */
+  tieBreak?: TieBreakMode;
+
+  /** Kill threshold the game was created with */
+  minVotesToKill?: number;
+
+  /** Players in seat order */
+  players: Array<{
+    id: string;
+    name: string;
+    startingRole: RoleName;
+    currentRole: RoleName;
+    isAlive: boolean;
+    isAI: boolean;
+    nightResults: NightActionResult[];
+  }>;
+
+  /** Center cards in index order */
+  centerCards: RoleName[];
+
+  /** Center cards as dealt, before any swap (missing in older snapshots) */
+  originalCenterCards?: RoleName[];
+
+  /** Card in the Alpha Wolf's center slot, if the game has one */
+  alphaWolfCard?: RoleName;
+
+  /** Statements made so far */
+  statements: PlayerStatement[];
+
+  /** Votes cast so far (voterId -> targetId) */
+  votes: Record<string, string>;
+
+  /** Every vote cast so far, with when it arrived */
+  voteLog?: VoteRecord[];
+
+  /** Roles copied by Doppelgangers (playerId -> role) */
+  doppelgangerCopiedRoles: Record<string, RoleName>;
+
+  /** Players whose cards carry a Sentinel shield (missing in older snapshots) */
+  shieldedPlayers?: string[];
+
+  /** Cards the Revealer left face up (playerId -> role, missing in older snapshots) */
+  revealedCards?: Record<string, RoleName>;
+
+  /** Players whose cards carry a Curator's artifact (missing in older snapshots) */
+  artifacts?: string[];
+}
+
+/**
+ * @summary Error thrown out of Game.run() when the game is cancelled.
+ *
+ * @description
+ * Lets callers tell a deliberate stop (server shutdown, room closed)
+ * apart from a genuine engine failure.
+ */
+export class GameCancelledError extends Error {
+  /** Why the game was cancelled */
+  public readonly reason: string;
+
+  constructor(gameId: string, reason: string) {
+    super(`Game ${gameId} cancelled: ${reason}`);
+    this.name = 'GameCancelledError';
+    this.reason = reason;
+  }
+}
+
 /**
  * @summary Main game engine for One Night Ultimate Werewolf.
  *
@@ -145,6 +270,12 @@ export class Game implements IGameContext, INightActionGameState {
   /** Center cards (3 cards) */
   private readonly centerCards: Role[] = [];
 
+  /** Center cards as dealt, kept for the reveal after swaps move them */
+  private originalCenterCards: readonly RoleName[] = [];
+
+  /** Werewolf card set aside for the Alpha Wolf, or null without one */
+  private alphaWolfCard: Role | null = null;
+
   /** Current phase state */
   private currentPhaseState: IGamePhaseState;
 
@@ -163,6 +294,12 @@ export class Game implements IGameContext, INightActionGameState {
   /** Votes cast during voting */
   private readonly votes: Map<string, string> = new Map();
 
+  /** Every vote cast, in the order recorded, with timestamps */
+  private readonly voteLog: VoteRecord[] = [];
+
+  /** Players whose vote has arrived in the current voting round */
+  private readonly submittedVoters: Set<string> = new Set();
+
   /** Resolver for ending the day phase (real-time discussion) */
   private dayPhaseResolver: (() => void) | null = null;
 
@@ -198,6 +335,40 @@ export class Game implements IGameContext, INightActionGameState {
    */
   private readonly doppelgangerCopiedRoles: Map<string, RoleName> = new Map();
 
+  /**
+   * @summary Players whose cards carry a Sentinel shield.
+   *
+   * @description
+   * A shielded card cannot be moved, swapped or viewed by any role that
+   * wakes after the Sentinel. Night actions check this before acting.
+   *
+   * @private
+   */
+  private readonly shieldedPlayers: Set<string> = new Set();
+
+  /**
+   * @summary Cards the Revealer left face up for the day.
+   *
+   * @description
+   * Maps player ID to the role showing on their card when it was flipped.
+   * Everyone sees these cards during the day, so the map is part of the
+   * public view.
+   *
+   * @private
+   */
+  private readonly revealedCards: Map<string, RoleName> = new Map();
+
+  /**
+   * @summary Players whose cards carry a Curator's artifact.
+   *
+   * @description
+   * An artifact hides the player's role from everyone, the player
+   * included, until the game ends. It never changes the card itself.
+   *
+   * @private
+   */
+  private readonly artifacts: Set<string> = new Set();
+
   /**
    * @summary Audit logging level for card state snapshots.
    *
@@ -247,11 +418,17 @@ export class Game implements IGameContext, INightActionGameState {
   private validateConfig(): void {
     const validation = RoleFactory.validateSetup(
       [...this.config.roles],
-      this.config.players.length
+      this.config.players.length,
+      this.config.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT
     );
+    const errors = [...validation.errors];
+
+    if (this.config.nightOrder) {
+      errors.push(...RoleFactory.validateNightOrder(this.config.nightOrder).errors);
+    }
 
-    if (!validation.valid) {
-      throw new Error(`Invalid game configuration: ${validation.errors.join(', ')}`);
+    if (errors.length > 0) {
+      throw new Error(`Invalid game configuration: ${errors.join(', ')}`);
     }
   }
 
@@ -261,6 +438,8 @@ export class Game implements IGameContext, INightActionGameState {
    * @description
    * Creates roles and deals them to players. Supports debug mode
    * where specific players can be forced to receive specific roles.
+   * With an Alpha Wolf, one Werewolf card is set aside first so it is
+   * never dealt.
    *
    * @private
    */
@@ -268,6 +447,12 @@ export class Game implements IGameContext, INightActionGameState {
     // Create all roles
     const roles = RoleFactory.createRoles([...this.config.roles]);
 
+    // Set the Alpha Wolf's Werewolf aside (validateConfig ensures there is one)
+    if (this.config.roles.includes(RoleName.ALPHA_WOLF)) {
+      const werewolfIndex = roles.findIndex(r => r.name === RoleName.WEREWOLF);
+      this.alphaWolfCard = roles.splice(werewolfIndex, 1)[0];
+    }
+
     // Shuffle roles
     this.shuffleArray(roles);
 
@@ -275,7 +460,7 @@ export class Game implements IGameContext, INightActionGameState {
     if (this.config.forceWerewolvesToCenter) {
       console.log('Debug: forceWerewolvesToCenter is enabled');
       const playerCount = this.config.players.length;
-      const centerStartIndex = playerCount; // Center cards are at indices playerCount, playerCount+1, playerCount+2
+      const centerStartIndex = playerCount; // Center cards follow the players' cards
       console.log(`Debug: playerCount=${playerCount}, centerStartIndex=${centerStartIndex}`);
 
       // Find all werewolf roles
@@ -288,7 +473,7 @@ export class Game implements IGameContext, INightActionGameState {
       console.log(`Debug: Found werewolves at indices: ${werewolfIndices.join(', ')}`);
 
       // Move werewolves to center positions (swap with whatever is there)
-      for (let i = 0; i < werewolfIndices.length && i < 3; i++) {
+      for (let i = 0; i < werewolfIndices.length && centerStartIndex + i < roles.length; i++) {
         const werewolfIndex = werewolfIndices[i];
         const centerIndex = centerStartIndex + i;
 
@@ -328,10 +513,11 @@ export class Game implements IGameContext, INightActionGameState {
       this.nightResults.set(playerId, []);
     }
 
-    // Put remaining 3 in center
+    // Put the remaining cards in the center
     for (let i = this.config.players.length; i < roles.length; i++) {
       this.centerCards.push(roles[i]);
     }
+    this.originalCenterCards = Object.freeze(this.getCenterCards());
   }
 
   /**
@@ -436,17 +622,18 @@ export class Game implements IGameContext, INightActionGameState {
     try {
       // Execute all phases
       while (this.currentPhaseState !== null) {
+        this.throwIfCancelled();
         await this.currentPhaseState.enter(this);
         await this.currentPhaseState.execute(this);
+        this.throwIfCancelled();
         await this.currentPhaseState.exit(this);
 
         const nextState = this.currentPhaseState.getNextState();
         if (nextState) {
-          this.eventEmitter.emitPhaseChanged(
-            this.currentPhaseState.getName(),
-            nextState.getName()
-          );
+          // Switch before notifying so observers (and snapshots) see the new phase
+          const previousPhase = this.currentPhaseState.getName();
           this.currentPhaseState = nextState;
+          this.eventEmitter.emitPhaseChanged(previousPhase, nextState.getName());
         } else {
           break;
         }
@@ -454,6 +641,15 @@ export class Game implements IGameContext, INightActionGameState {
 
       return this.getGameResult();
     } catch (error) {
+      if (this.cancelReason !== null) {
+        // Agents torn down by the cancellation reject their pending
+        // requests; report those as the cancellation, not a failure
+        this.logAuditEvent('GAME_CANCELLED', { reason: this.cancelReason });
+        throw error instanceof GameCancelledError
+          ? error
+          : new GameCancelledError(this.gameId, this.cancelReason);
+      }
+
       // Log the error and emit an error event before re-throwing
       const err = error instanceof Error ? error : new Error(String(error));
       this.logAuditEvent('GAME_ERROR', {
@@ -529,19 +725,26 @@ export class Game implements IGameContext, INightActionGameState {
   /**
    * @summary Executes night actions for roles at a specific wake order.
    *
-   * @param {number} roleOrder - The night wake order (1-9, plus 10 for Doppel-Insomniac)
+   * @description
+   * Checks for cancellation before every wake, including orders nobody
+   * holds and the Doppel-Insomniac's, so a game whose room closed
+   * mid-night doesn't go on acting for the remaining players.
+   *
+   * @param {number} roleOrder - 1-based position in the night order, or DOPPEL_INSOMNIAC_ORDER for Doppel-Insomniac
+   *
+   * @throws {GameCancelledError} If the game has been cancelled
    */
   async executeNightActionsForRole(roleOrder: number): Promise<void> {
-    // Order 10 is special: Doppelganger who copied Insomniac wakes at very end
-    if (roleOrder === 10) {
+    this.throwIfCancelled();
+
+    // The last order is special: Doppelganger who copied Insomniac wakes at very end
+    if (roleOrder === DOPPEL_INSOMNIAC_ORDER) {
       await this.executeDoppelInsomniacAction();
       return;
     }
 
-    // Find the role name for this order
-    const roleName = NIGHT_WAKE_ORDER.find(
-      rn => RoleFactory.createRole(rn).nightOrder === roleOrder
-    );
+    // Find the role that wakes at this position
+    const roleName = this.getNightOrder()[roleOrder - 1];
 
     if (!roleName) {
       return; // No role at this order
@@ -550,6 +753,7 @@ export class Game implements IGameContext, INightActionGameState {
     // Find all players with this STARTING role
     for (const playerId of this.playerOrder) {
       const player = this.players.get(playerId)!;
+      this.throwIfCancelled();
 
       if (player.startingRole.name === roleName) {
         await this.executeNightActionForPlayer(player);
@@ -586,7 +790,8 @@ export class Game implements IGameContext, INightActionGameState {
         success: true,
         info: {
           viewed: [{ playerId, role: finalRole }]
-        }
+        },
+        timestamp: Date.now()
       };
 
       // Store result
@@ -607,6 +812,12 @@ export class Game implements IGameContext, INightActionGameState {
   /**
    * @summary Executes night action for a specific player.
    *
+   * @description
+   * Each player acts once per night. A player who already has a
+   * successful result is not woken again, so a night resumed from a
+   * snapshot doesn't repeat a swap. The Doppel-Insomniac's second wake
+   * goes through executeDoppelInsomniacAction instead.
+   *
    * @param {Player} player - The player to execute action for
    *
    * @private
@@ -615,16 +826,31 @@ export class Game implements IGameContext, INightActionGameState {
     const agent = this.agents.get(player.id)!;
     const action = player.startingRole.nightAction;
 
+    if ((this.nightResults.get(player.id) || []).some(result => result.success)) {
+      this.logAuditEvent('NIGHT_ACTION_REPEATED', {
+        playerId: player.id,
+        role: player.startingRole.name
+      });
+      return;
+    }
+
     const context: NightActionContext = {
       myPlayerId: player.id,
       myStartingRole: player.startingRole.name,
       allPlayerIds: this.playerOrder.filter(id => id !== player.id),
       rolesInGame: this.config.roles,
-      previousResults: this.nightResults.get(player.id) || []
+      previousResults: this.nightResults.get(player.id) || [],
+      centerCardCount: this.getCenterCardCount(),
+      canSkip: action.isOptional()
     };
 
+    const startedAt = Date.now();
+
     try {
-      const result = await action.execute(context, agent, this);
+      const result: NightActionResult = {
+        ...await action.execute(context, agent, this),
+        timestamp: Date.now()
+      };
 
       // Store result
       const results = this.nightResults.get(player.id) || [];
@@ -650,7 +876,8 @@ export class Game implements IGameContext, INightActionGameState {
         player.id,
         player.startingRole.name,
         result.actionType,
-        result.info as Record<string, unknown>
+        result.info as Record<string, unknown>,
+        result.timestamp! - startedAt
       );
 
       this.logAuditEvent('NIGHT_ACTION_EXECUTED', {
@@ -821,8 +1048,64 @@ export class Game implements IGameContext, INightActionGameState {
 
   /**
    * @summary Collects votes from all players.
+   *
+   * @description
+   * Votes are collected at the same time against one deadline
+   * (GameConfig.votingTimeoutMs). A player who hasn't voted when it
+   * runs out, or whose vote request fails, abstains: they get no entry
+   * in the vote map and the game resolves with the votes that were cast.
+   *
+   * With GameConfig.allowAbstain set, a player can also vote
+   * ABSTAIN_VOTE. That is recorded in the vote map as their vote, but
+   * resolveGame() leaves it out of the tally. Without the house rule an
+   * ABSTAIN_VOTE is treated like not voting at all.
+   *
+   * With the 'revote' tie-break, a tie runs one more round in which
+   * only the tied players can be voted for. The second round's votes
+   * replace the first; if it ties again, resolveGame() kills everyone
+   * tied.
    */
   async collectVotes(): Promise<void> {
+    await this.collectVoteRound(this.playerOrder);
+
+    if (this.config.tieBreak !== 'revote') {
+      return;
+    }
+
+    const tiedIds = this.findVoteLeaders();
+    if (tiedIds.length < 2) {
+      return;
+    }
+
+    this.logAuditEvent('REVOTE_STARTED', {
+      tiedPlayerIds: tiedIds,
+      firstRoundVotes: Object.fromEntries(this.votes)
+    });
+    this.eventEmitter.emitRevote(tiedIds);
+    this.votes.clear();
+
+    await this.collectVoteRound(tiedIds);
+  }
+
+  /**
+   * @summary Runs one round of voting.
+   *
+   * @param {string[]} candidates - Players who can be voted for (a voter never gets themselves)
+   *
+   * @private
+   */
+  private async collectVoteRound(candidates: string[]): Promise<void> {
+    const timeLimitMs = this.config.votingTimeoutMs === undefined
+      ? DEFAULT_VOTING_TIMEOUT_MS
+      : this.config.votingTimeoutMs;
+    let deadline: ReturnType<typeof setTimeout> | undefined;
+    const expired = new Promise<null>(resolve => {
+      if (timeLimitMs !== null) {
+        deadline = setTimeout(() => resolve(null), timeLimitMs);
+      }
+    });
+    this.submittedVoters.clear();
+
     // Collect all votes simultaneously
     const votePromises = this.playerOrder.map(async playerId => {
       const player = this.players.get(playerId)!;
@@ -833,70 +1116,136 @@ export class Game implements IGameContext, INightActionGameState {
         myStartingRole: player.startingRole.name,
         myNightInfo: (this.nightResults.get(playerId) || [])[0] || null,
         allStatements: [...this.statements],
-        eligibleTargets: this.playerOrder.filter(id => id !== playerId),
-        rolesInGame: this.config.roles
+        eligibleTargets: candidates.filter(id => id !== playerId),
+        rolesInGame: this.config.roles,
+        timeLimitMs: timeLimitMs ?? undefined,
+        canAbstain: this.config.allowAbstain === true
       };
 
-      const targetId = await agent.vote(context);
-      return { voterId: playerId, targetId };
+      const vote = agent.vote(context).catch(() => null);
+      const targetId = await Promise.race([vote, expired]);
+      if (targetId !== null) {
+        this.submittedVoters.add(playerId);
+        this.eventEmitter.emitVoteSubmitted(playerId);
+      }
+      return { voterId: playerId, targetId, timestamp: Date.now() };
     });
 
-    const results = await Promise.all(votePromises);
+    let results: Array<{ voterId: string; targetId: string | null; timestamp: number }>;
+    try {
+      results = await Promise.all(votePromises);
+    } finally {
+      clearTimeout(deadline);
+    }
 
-    for (const { voterId, targetId } of results) {
+    for (const { voterId, targetId, timestamp } of results) {
+      if (targetId === null || (targetId === ABSTAIN_VOTE && !this.config.allowAbstain)) {
+        this.logAuditEvent('VOTE_ABSTAINED', { voterId });
+        continue;
+      }
       this.votes.set(voterId, targetId);
+      this.voteLog.push({ voterId, targetId, timestamp });
       this.eventEmitter.emitVote(voterId, targetId);
       this.logAuditEvent('VOTE_CAST', { voterId, targetId });
     }
   }
 
   /**
-   * @summary Resolves the game and determines winners.
+   * @summary Counts the votes each player received.
+   *
+   * @returns {Map<string, number>} Votes by target; abstentions count toward no one
+   *
+   * @private
    */
-  async resolveGame(): Promise<void> {
-    // Tally votes
+  private tallyVotes(): Map<string, number> {
     const voteCounts = new Map<string, number>();
     for (const targetId of this.votes.values()) {
+      if (targetId === ABSTAIN_VOTE) continue;
       voteCounts.set(targetId, (voteCounts.get(targetId) || 0) + 1);
     }
+    return voteCounts;
+  }
+
+  /**
+   * @summary Finds the players the current votes would kill.
+   *
+   * @description
+   * These are the players tied on the most votes, as long as that is
+   * at least GameConfig.minVotesToKill. The default threshold of 2 is
+   * the standard rule that no one dies if no one gets more than one
+   * vote, which without abstentions means everyone got exactly one.
+   *
+   * @returns {string[]} Players with the most votes, or empty if no one dies
+   *
+   * @private
+   */
+  private findVoteLeaders(): string[] {
+    const voteCounts = this.tallyVotes();
+    const maxVotes = Math.max(0, ...voteCounts.values());
+    const minVotesToKill = Math.max(1, this.config.minVotesToKill ?? DEFAULT_MIN_VOTES_TO_KILL);
+
+    if (maxVotes < minVotesToKill) {
+      return [];
+    }
 
-    // Find max votes
-    const maxVotes = Math.max(...voteCounts.values());
+    return Array.from(voteCounts.entries())
+      .filter(([_, count]) => count === maxVotes)
+      .map(([id, _]) => id);
+  }
 
-    // Check for tie where everyone has 1 vote
-    const allHaveOne = Array.from(voteCounts.values()).every(v => v === 1) &&
-      voteCounts.size === this.playerOrder.length;
+  /**
+   * @summary Resolves the game and determines winners.
+   *
+   * @description
+   * The player(s) with the most votes die, if they reached
+   * GameConfig.minVotesToKill. A tie follows
+   * GameConfig.tieBreak: 'no-kill' spares everyone tied, while
+   * 'all-die' and 'revote' (after its extra round) kill them all.
+   *
+   * A Prince who would die survives, and the votes against them kill no
+   * one else: anyone tied with the Prince still dies, but the next
+   * highest does not take their place. The same goes for a Hunter's shot.
+   */
+  async resolveGame(): Promise<void> {
+    const voteCounts = this.tallyVotes();
+    let eliminatedIds = this.findVoteLeaders();
 
-    let eliminatedIds: string[] = [];
+    if (eliminatedIds.length > 1 && this.config.tieBreak === 'no-kill') {
+      this.logAuditEvent('TIE_SPARED', { tiedPlayerIds: eliminatedIds });
+      eliminatedIds = [];
+    }
 
-    if (!allHaveOne && maxVotes > 0) {
-      // Eliminate player(s) with most votes
-      eliminatedIds = Array.from(voteCounts.entries())
-        .filter(([_, count]) => count === maxVotes)
-        .map(([id, _]) => id);
+    const princes = eliminatedIds.filter(id => this.isPrince(id));
+    if (princes.length > 0) {
+      this.logAuditEvent('PRINCE_SPARED', { princeIds: princes });
+      eliminatedIds = eliminatedIds.filter(id => !princes.includes(id));
+    }
 
-      for (const id of eliminatedIds) {
-        this.players.get(id)!.eliminate();
-      }
+    for (const id of eliminatedIds) {
+      this.players.get(id)!.eliminate();
+    }
 
-      // Handle Hunter ability (including Doppelganger who copied Hunter)
-      // Doppelganger only counts if they still have their Doppelganger card (wasn't swapped)
-      for (const id of eliminatedIds) {
-        const player = this.players.get(id)!;
-        const copiedRole = this.doppelgangerCopiedRoles.get(id);
-        const isHunter = player.currentRole.name === RoleName.HUNTER ||
-          (player.currentRole.name === RoleName.DOPPELGANGER && copiedRole === RoleName.HUNTER);
-        if (isHunter) {
-          const hunterTarget = this.votes.get(id);
-          if (hunterTarget && !eliminatedIds.includes(hunterTarget)) {
-            this.players.get(hunterTarget)!.eliminate();
-            eliminatedIds.push(hunterTarget);
-            this.logAuditEvent('HUNTER_TRIGGERED', {
-              hunterId: id,
-              targetId: hunterTarget,
-              wasDoppelganger: copiedRole === RoleName.HUNTER
-            });
+    // Handle Hunter ability (including Doppelganger who copied Hunter)
+    // Doppelganger only counts if they still have their Doppelganger card (wasn't swapped)
+    for (const id of eliminatedIds) {
+      const player = this.players.get(id)!;
+      const copiedRole = this.doppelgangerCopiedRoles.get(id);
+      const isHunter = player.currentRole.name === RoleName.HUNTER ||
+        (player.currentRole.name === RoleName.DOPPELGANGER && copiedRole === RoleName.HUNTER);
+      if (isHunter) {
+        const hunterTarget = this.votes.get(id);
+        if (hunterTarget && hunterTarget !== ABSTAIN_VOTE && !eliminatedIds.includes(hunterTarget)) {
+          if (this.isPrince(hunterTarget)) {
+            this.logAuditEvent('PRINCE_SPARED', { princeIds: [hunterTarget], hunterId: id });
+            continue;
           }
+          this.players.get(hunterTarget)!.eliminate();
+          eliminatedIds.push(hunterTarget);
+          this.logAuditEvent('HUNTER_TRIGGERED', {
+            hunterId: id,
+            targetId: hunterTarget,
+            wasDoppelganger: copiedRole === RoleName.HUNTER
+          });
         }
       }
     }
@@ -907,6 +1256,25 @@ export class Game implements IGameContext, INightActionGameState {
     });
   }
 
+  /**
+   * @summary Checks whether a player ends the game holding the Prince.
+   *
+   * @description
+   * A Doppelganger who copied the Prince counts too, as long as they
+   * still hold the Doppelganger card.
+   *
+   * @param {string} playerId - The player to check
+   *
+   * @returns {boolean} True if the vote can't kill this player
+   *
+   * @private
+   */
+  private isPrince(playerId: string): boolean {
+    const role = this.players.get(playerId)!.currentRole.name;
+    return role === RoleName.PRINCE ||
+      (role === RoleName.DOPPELGANGER && this.doppelgangerCopiedRoles.get(playerId) === RoleName.PRINCE);
+  }
+
   // =========================================================================
   // INightActionGameState IMPLEMENTATION (for Strategy Pattern)
   // =========================================================================
@@ -919,6 +1287,26 @@ export class Game implements IGameContext, INightActionGameState {
     return player.currentRole.name;
   }
 
+  /**
+   * @summary Looks at a player's card on behalf of a night action.
+   *
+   * @description
+   * Actions check the Sentinel's shield before looking; refusing here as
+   * well means a missed check can't reveal a shielded card.
+   *
+   * @param {string} playerId - Player whose card is viewed
+   *
+   * @returns {RoleName} The role on the card
+   *
+   * @throws {Error} If the player doesn't exist or their card is shielded
+   */
+  viewPlayerCard(playerId: string): RoleName {
+    if (this.isShielded(playerId)) {
+      throw new Error(`Cannot view ${playerId}'s card: it is shielded by the Sentinel`);
+    }
+    return this.getPlayerRole(playerId);
+  }
+
   getCenterCard(index: number): RoleName {
     if (index < 0 || index >= this.centerCards.length) {
       throw new Error(`Invalid center index: ${index}`);
@@ -926,16 +1314,116 @@ export class Game implements IGameContext, INightActionGameState {
     return this.centerCards[index].name;
   }
 
+  /**
+   * @summary Gets how many cards are in the center.
+   *
+   * @description
+   * Set by the game's centerCardCount (3 unless configured otherwise).
+   * Valid center indices run from 0 to one less than this.
+   *
+   * @returns {number} Number of center cards
+   */
+  getCenterCardCount(): number {
+    return this.centerCards.length;
+  }
+
+  /**
+   * @summary Gets the card in the Alpha Wolf's center slot.
+   *
+   * @description
+   * Starts as the Werewolf set aside at setup. Once the Alpha Wolf has
+   * given it out, holds the card of the player who received it.
+   *
+   * @returns {RoleName | null} The card, or null if the game has no Alpha Wolf
+   */
+  getAlphaWolfCard(): RoleName | null {
+    return this.alphaWolfCard?.name ?? null;
+  }
+
+  /**
+   * @summary Swaps the card in the Alpha Wolf's slot with a player's card.
+   *
+   * @description
+   * The player now holds the Werewolf and is on the Werewolf team, without
+   * knowing it. Like swapCards, refuses a shielded player's card.
+   *
+   * @param {string} playerId - The player who receives the card
+   *
+   * @throws {Error} If the game has no Alpha Wolf card, or the player
+   * doesn't exist or is shielded
+   *
+   * @example
+   * ```typescript
+   * // In AlphaWolfAction:
+   * gameState.swapAlphaWolfCard('player-3');
+   * ```
+   */
+  swapAlphaWolfCard(playerId: string): void {
+    const player = this.players.get(playerId);
+    if (!player) {
+      throw new Error(`Player ${playerId} not found`);
+    }
+    if (!this.alphaWolfCard) {
+      throw new Error('This game has no Alpha Wolf card');
+    }
+    if (this.isShielded(playerId)) {
+      throw new Error(`Cannot move ${playerId}'s card: it is shielded by the Sentinel`);
+    }
+
+    [player.currentRole, this.alphaWolfCard] = [this.alphaWolfCard, player.currentRole];
+    this.logAuditEvent('ALPHA_WOLF_CARD_GIVEN', { playerId, role: player.currentRole.name });
+  }
+
+  /**
+   * @summary Gets the order roles wake in this game.
+   *
+   * @returns {readonly RoleName[]} The configured night order, or NIGHT_WAKE_ORDER
+   */
+  getNightOrder(): readonly RoleName[] {
+    return this.config.nightOrder ?? NIGHT_WAKE_ORDER;
+  }
+
   /**
    * @summary Gets all center card roles.
    *
-   * @returns {RoleName[]} Array of center card roles (3 cards)
+   * @returns {RoleName[]} Array of center card roles, in index order
    */
   getCenterCards(): RoleName[] {
     return this.centerCards.map(role => role.name);
   }
 
+  /**
+   * @summary Gets the center cards as they were dealt.
+   *
+   * @description
+   * Unaffected by swaps during the night, unlike getCenterCards().
+   * Players' dealt cards are kept on each Player as startingRole.
+   *
+   * @returns {RoleName[]} Dealt center cards in index order
+   */
+  getOriginalCenterCards(): RoleName[] {
+    return [...this.originalCenterCards];
+  }
+
+  /**
+   * @summary Swaps the cards at two positions.
+   *
+   * @description
+   * Actions check the Sentinel's shield before swapping; refusing here as
+   * well means a missed check can't move a shielded card.
+   *
+   * @param {CardPosition} pos1 - First position
+   * @param {CardPosition} pos2 - Second position
+   *
+   * @throws {Error} If either position is a shielded player's card
+   */
   swapCards(pos1: CardPosition, pos2: CardPosition): void {
+    for (const pos of [pos1, pos2]) {
+      if (pos.playerId !== undefined && this.isShielded(pos.playerId)) {
+        throw new Error(`Cannot move ${pos.playerId}'s card: it is shielded by the Sentinel`);
+      }
+    }
+
     const role1 = this.getCardAtPosition(pos1);
     const role2 = this.getCardAtPosition(pos2);
 
@@ -1012,6 +1500,111 @@ export class Game implements IGameContext, INightActionGameState {
     return result;
   }
 
+  /**
+   * @summary Places a Sentinel shield on a player's card.
+   *
+   * @param {string} playerId - The player whose card is shielded
+   *
+   * @throws {Error} If the player doesn't exist
+   *
+   * @example
+   * ```typescript
+   * // In SentinelAction:
+   * gameState.shieldPlayer('player-3');
+   * ```
+   */
+  shieldPlayer(playerId: string): void {
+    if (!this.players.has(playerId)) {
+      throw new Error(`Player ${playerId} not found`);
+    }
+    this.shieldedPlayers.add(playerId);
+    this.logAuditEvent('CARD_SHIELDED', { playerId });
+  }
+
+  /**
+   * @summary Checks whether a player's card is shielded.
+   *
+   * @param {string} playerId - The player to check
+   *
+   * @returns {boolean} True if a Sentinel shielded this player's card
+   */
+  isShielded(playerId: string): boolean {
+    return this.shieldedPlayers.has(playerId);
+  }
+
+  /**
+   * @summary Leaves a player's card face up for the day.
+   *
+   * @description
+   * Records the role currently on the card. The Revealer decides whether
+   * the card stays up; this only records the cards that do.
+   *
+   * @param {string} playerId - The player whose card is revealed
+   *
+   * @throws {Error} If the player doesn't exist
+   *
+   * @example
+   * ```typescript
+   * // In RevealerAction:
+   * gameState.revealCard('player-3');
+   * ```
+   */
+  revealCard(playerId: string): void {
+    const role = this.getPlayerRole(playerId);
+    this.revealedCards.set(playerId, role);
+    this.logAuditEvent('CARD_REVEALED', { playerId, role });
+  }
+
+  /**
+   * @summary Gets the cards left face up by the Revealer.
+   *
+   * @returns {ReadonlyMap<string, RoleName>} Player ID to revealed role
+   */
+  getRevealedCards(): ReadonlyMap<string, RoleName> {
+    return this.revealedCards;
+  }
+
+  /**
+   * @summary Places a Curator's artifact on a player's card.
+   *
+   * @param {string} playerId - The player whose card gets the artifact
+   *
+   * @throws {Error} If the player doesn't exist
+   *
+   * @example
+   * ```typescript
+   * // In CuratorAction:
+   * gameState.placeArtifact('player-3');
+   * ```
+   */
+  placeArtifact(playerId: string): void {
+    if (!this.players.has(playerId)) {
+      throw new Error(`Player ${playerId} not found`);
+    }
+    this.artifacts.add(playerId);
+    this.logAuditEvent('ARTIFACT_PLACED', { playerId });
+  }
+
+  /**
+   * @summary Checks whether a player's card carries an artifact.
+   *
+   * @param {string} playerId - The player to check
+   *
+   * @returns {boolean} True if a Curator placed an artifact on this player's card
+   */
+  hasArtifact(playerId: string): boolean {
+    return this.artifacts.has(playerId);
+  }
+
+  /**
+   * @summary Gets the players whose cards carry an artifact.
+   *
+   * @returns {string[]} Player IDs with an artifact
+   */
+  getArtifactPlayerIds(): string[] {
+    return [...this.artifacts];
+  }
+
   /**
    * @summary Gets the effective team for a player, accounting for Doppelganger.
    *
@@ -1019,6 +1612,9 @@ export class Game implements IGameContext, INightActionGameState {
    * For most players, this returns their current card's team.
    * For Doppelgangers, this returns the team of the role they copied,
    * since a Doppelganger who copies Werewolf should be on Werewolf team.
+   * The copy only counts while they still hold the Doppelganger card,
+   * the same rule isWerewolf, isMinion and the Tanner check use; a
+   * Doppelganger whose card was swapped away plays for their new card.
    *
    * @param {string} playerId - The player's ID
    *
@@ -1032,9 +1628,9 @@ export class Game implements IGameContext, INightActionGameState {
       throw new Error(`Player ${playerId} not found`);
     }
 
-    // Check if this player is a Doppelganger who copied a role
+    // Check if this player is a Doppelganger who copied a role and kept the card
     const copiedRole = this.doppelgangerCopiedRoles.get(playerId);
-    if (copiedRole) {
+    if (copiedRole && player.currentRole.name === RoleName.DOPPELGANGER) {
       // Return the team of the copied role
       return ROLE_TEAMS[copiedRole];
     }
@@ -1110,12 +1706,8 @@ export class Game implements IGameContext, INightActionGameState {
     const context: WinConditionContext = {
       allPlayers,
       eliminatedPlayers,
-      werewolvesExistAmongPlayers: allPlayers.some(
-        p => p.currentRole === RoleName.WEREWOLF
-      ),
-      minionExistsAmongPlayers: allPlayers.some(
-        p => p.currentRole === RoleName.MINION
-      ),
+      werewolvesExistAmongPlayers: allPlayers.some(isWerewolf),
+      minionExistsAmongPlayers: allPlayers.some(isMinion),
       tannerWasEliminated: eliminatedPlayers.some(isTanner)
     };
 
@@ -1130,15 +1722,26 @@ export class Game implements IGameContext, INightActionGameState {
     const winningTeams = winners.map(w => w.team);
     const winningPlayers = [...new Set(winners.flatMap(w => w.winners))];
 
+    const revealEmphasis = this.config.revealEmphasis ?? 'final';
+
     const result: GameResult = {
       winningTeams,
       winningPlayers,
       eliminatedPlayers: eliminatedPlayers.map(p => p.playerId),
+      originalRoles: new Map(this.playerOrder.map(id => [
+        id,
+        this.players.get(id)!.startingRole.name
+      ])),
       finalRoles: new Map(this.playerOrder.map(id => [
         id,
         this.players.get(id)!.currentRole.name
       ])),
-      votes: new Map(this.votes)
+      originalCenterCards: this.getOriginalCenterCards(),
+      finalCenterCards: this.getCenterCards(),
+      votes: new Map(this.votes),
+      voteLog: [...this.voteLog],
+      revealEmphasis,
+      revealSequence: getRevealSequence(revealEmphasis)
     };
 
     this.eventEmitter.emitGameEnded(
@@ -1154,8 +1757,8 @@ export class Game implements IGameContext, INightActionGameState {
   // MULTIPLAYER SUPPORT METHODS
   // =========================================================================
 
-  /** Unique game identifier */
-  private readonly gameId: string = this.generateGameId();
+  /** Unique game identifier (replaced when restoring from a snapshot) */
+  private gameId: string = this.generateGameId();
 
   /** Game result (null until game ends) */
   private gameResult: GameResult | null = null;
@@ -1166,6 +1769,51 @@ export class Game implements IGameContext, INightActionGameState {
   /** Player AI status */
   private readonly playerIsAI: Map<string, boolean> = new Map();
 
+  /** Why the game was cancelled (null while it may keep running) */
+  private cancelReason: string | null = null;
+
+  /**
+   * @summary Cancels the game.
+   *
+   * @description
+   * The run loop stops at the next checkpoint (between phases and
+   * between night actions) and run() rejects with GameCancelledError.
+   * A day phase waiting on endDayPhase() is released immediately.
+   * Calling cancel() more than once keeps the first reason.
+   *
+   * @param {string} [reason='Game cancelled'] - Why the game is stopping
+   */
+  cancel(reason: string = 'Game cancelled'): void {
+    if (this.cancelReason !== null) {
+      return;
+    }
+
+    this.cancelReason = reason;
+    this.endDayPhase();
+  }
+
+  /**
+   * @summary Checks whether the game has been cancelled.
+   *
+   * @returns {boolean} True once cancel() has been called
+   */
+  isCancelled(): boolean {
+    return this.cancelReason !== null;
+  }
+
+  /**
+   * @summary Throws if the game has been cancelled.
+   *
+   * @throws {GameCancelledError} If cancel() has been called
+   *
+   * @private
+   */
+  private throwIfCancelled(): void {
+    if (this.cancelReason !== null) {
+      throw new GameCancelledError(this.gameId, this.cancelReason);
+    }
+  }
+
   /**
    * @summary Generates a unique game identifier.
    *
@@ -1222,6 +1870,28 @@ export class Game implements IGameContext, INightActionGameState {
     return new Map(this.votes);
   }
 
+  /**
+   * @summary Gets the players whose vote has arrived in the current round.
+   *
+   * @description
+   * Filled while voting is still open, unlike getVotes(), which only
+   * has entries once every vote is in. Says nothing about the targets.
+   *
+   * @returns {Set<string>} Voter IDs
+   */
+  getSubmittedVoters(): Set<string> {
+    return new Set(this.submittedVoters);
+  }
+
+  /**
+   * @summary Gets every vote cast, with when it arrived.
+   *
+   * @returns {VoteRecord[]} Votes in the order they were recorded
+   */
+  getVoteLog(): VoteRecord[] {
+    return [...this.voteLog];
+  }
+
   /**
    * @summary Gets IDs of eliminated players.
    *
@@ -1332,6 +2002,184 @@ export class Game implements IGameContext, INightActionGameState {
     this.agents.set(playerId, newAgent);
   }
 
+  // =========================================================================
+  // PERSISTENCE
+  // =========================================================================
+
+  /**
+   * @summary Captures the game state for persistence.
+   *
+   * @returns {GameSnapshot} JSON-serializable snapshot
+   *
+   * @example
+   * ```typescript
+   * fs.writeFileSync(file, JSON.stringify(game.toSnapshot()));
+   * ```
+   */
+  toSnapshot(): GameSnapshot {
+    return {
+      gameId: this.gameId,
+      phase: this.currentPhaseState.getName(),
+      roles: [...this.config.roles],
+      centerCardCount: this.config.centerCardCount,
+      nightOrder: this.config.nightOrder ? [...this.config.nightOrder] : undefined,
+      auditLevel: this.config.auditLevel,
+      revealEmphasis: this.config.revealEmphasis,
+      votingTimeoutMs: this.config.votingTimeoutMs,
+      allowAbstain: this.config.allowAbstain,
+      tieBreak: this.config.tieBreak,
+      minVotesToKill: this.config.minVotesToKill,
+      players: this.playerOrder.map(id => {
+        const player = this.players.get(id)!;
+        return {
+          id,
+          name: player.name,
+          startingRole: player.startingRole.name,
+          currentRole: player.currentRole.name,
+          isAlive: player.isAlive,
+          isAI: this.isPlayerAI(id),
+          nightResults: [...(this.nightResults.get(id) || [])]
+        };
+      }),
+      centerCards: this.getCenterCards(),
+      originalCenterCards: this.getOriginalCenterCards(),
+      alphaWolfCard: this.getAlphaWolfCard() ?? undefined,
+      statements: [...this.statements],
+      votes: Object.fromEntries(this.votes),
+      voteLog: [...this.voteLog],
+      doppelgangerCopiedRoles: Object.fromEntries(this.doppelgangerCopiedRoles),
+      shieldedPlayers: [...this.shieldedPlayers],
+      revealedCards: Object.fromEntries(this.revealedCards),
+      artifacts: [...this.artifacts]
+    };
+  }
+
+  /**
+   * @summary Recreates a game from a snapshot.
+   *
+   * @description
+   * The restored game resumes at the start of the snapshot's phase when
+   * run() is called. Agents must be registered again before running.
+   *
+   * @param {GameSnapshot} snapshot - Snapshot from toSnapshot()
+   *
+   * @returns {Game} Restored game
+   *
+   * @throws {Error} If the snapshot's role set is invalid
+   *
+   * @example
+   * ```typescript
+   * const game = Game.fromSnapshot(snapshot);
+   * game.registerAgents(agents);
+   * game.run();
+   * ```
+   */
+  static fromSnapshot(snapshot: GameSnapshot): Game {
+    const game = new Game({
+      players: snapshot.players.map(p => p.name),
+      roles: snapshot.roles,
+      centerCardCount: snapshot.centerCardCount,
+      nightOrder: snapshot.nightOrder,
+      auditLevel: snapshot.auditLevel,
+      revealEmphasis: snapshot.revealEmphasis,
+      votingTimeoutMs: snapshot.votingTimeoutMs,
+      allowAbstain: snapshot.allowAbstain,
+      tieBreak: snapshot.tieBreak,
+      minVotesToKill: snapshot.minVotesToKill
+    });
+    game.restoreState(snapshot);
+    return game;
+  }
+
+  /**
+   * @summary Replaces the freshly dealt state with a snapshot's state.
+   *
+   * @param {GameSnapshot} snapshot - Snapshot to restore
+   *
+   * @private
+   */
+  private restoreState(snapshot: GameSnapshot): void {
+    this.gameId = snapshot.gameId;
+
+    this.players.clear();
+    this.playerOrder.length = 0;
+    this.nightResults.clear();
+
+    for (const saved of snapshot.players) {
+      const player = new Player(saved.id, saved.name, RoleFactory.createRole(saved.startingRole));
+      player.currentRole = RoleFactory.createRole(saved.currentRole);
+      player.isAlive = saved.isAlive;
+
+      this.players.set(saved.id, player);
+      this.playerOrder.push(saved.id);
+      this.nightResults.set(saved.id, [...saved.nightResults]);
+      this.playerIsAI.set(saved.id, saved.isAI);
+    }
+
+    this.centerCards.length = 0;
+    for (const roleName of snapshot.centerCards) {
+      this.centerCards.push(RoleFactory.createRole(roleName));
+    }
+    // Older snapshots only have the current center; it's the best record left
+    this.originalCenterCards = Object.freeze([...(snapshot.originalCenterCards ?? snapshot.centerCards)]);
+    if (snapshot.alphaWolfCard) {
+      this.alphaWolfCard = RoleFactory.createRole(snapshot.alphaWolfCard);
+    }
+
+    this.statements.push(...snapshot.statements);
+
+    for (const [voterId, targetId] of Object.entries(snapshot.votes)) {
+      this.votes.set(voterId, targetId);
+    }
+
+    this.voteLog.push(...(snapshot.voteLog ?? []));
+
+    for (const [playerId, role] of Object.entries(snapshot.doppelgangerCopiedRoles)) {
+      this.doppelgangerCopiedRoles.set(playerId, role);
+    }
+
+    for (const playerId of snapshot.shieldedPlayers ?? []) {
+      this.shieldedPlayers.add(playerId);
+    }
+
+    for (const [playerId, role] of Object.entries(snapshot.revealedCards ?? {})) {
+      this.revealedCards.set(playerId, role);
+    }
+
+    for (const playerId of snapshot.artifacts ?? []) {
+      this.artifacts.add(playerId);
+    }
+
+    this.currentPhaseState = Game.createPhaseState(snapshot.phase);
+  }
+
+  /**
+   * @summary Creates the state object for a phase.
+   *
+   * @param {GamePhase} phase - Phase to create
+   *
+   * @returns {IGamePhaseState} Phase state
+   *
+   * @private
+   * @static
+   */
+  private static createPhaseState(phase: GamePhase): IGamePhaseState {
+    switch (phase) {
+      case GamePhase.SETUP:
+        return new SetupPhase();
+      case GamePhase.NIGHT:
+        return new NightPhase();
+      case GamePhase.DAY:
+        return new DayPhase();
+      case GamePhase.VOTING:
+        return new VotingPhase();
+      case GamePhase.RESOLUTION:
+        return new ResolutionPhase();
+      default:
+        throw new Error(`Unknown phase: ${phase}`);
+    }
+  }
+
   // =========================================================================
   // AUDIT DATA ACCESS
   // =========================================================================
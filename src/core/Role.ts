@@ -22,14 +22,14 @@
  * const seerRole = RoleFactory.createRole(RoleName.SEER);
  * console.log(seerRole.name); // RoleName.SEER
  * console.log(seerRole.team); // Team.VILLAGE
- * console.log(seerRole.nightOrder); // 5
+ * console.log(seerRole.nightOrder); // 9
  *
  * // Clone for Doppelganger
  * const clonedRole = seerRole.clone();
  * ```
  */
 
-import { RoleName, Team } from '../enums';
+import { NIGHT_WAKE_ORDER, RoleName, Team } from '../enums';
 import { IRole } from '../types';
 import { INightAction } from '../patterns/strategy';
 
@@ -45,16 +45,27 @@ import { INightAction } from '../patterns/strategy';
  */
 export const ROLE_TEAMS: Record<RoleName, Team> = {
   [RoleName.WEREWOLF]: Team.WEREWOLF,
+  [RoleName.ALPHA_WOLF]: Team.WEREWOLF,
+  [RoleName.MYSTIC_WOLF]: Team.WEREWOLF,
   [RoleName.MINION]: Team.WEREWOLF,
+  [RoleName.SQUIRE]: Team.WEREWOLF,
+  [RoleName.DREAM_WOLF]: Team.WEREWOLF,
   [RoleName.TANNER]: Team.TANNER,
   [RoleName.VILLAGER]: Team.VILLAGE,
   [RoleName.SEER]: Team.VILLAGE,
+  [RoleName.BEHOLDER]: Team.VILLAGE,
+  [RoleName.APPRENTICE_SEER]: Team.VILLAGE,
   [RoleName.ROBBER]: Team.VILLAGE,
   [RoleName.TROUBLEMAKER]: Team.VILLAGE,
+  [RoleName.VILLAGE_IDIOT]: Team.VILLAGE,
   [RoleName.DRUNK]: Team.VILLAGE,
+  [RoleName.REVEALER]: Team.VILLAGE,
+  [RoleName.CURATOR]: Team.VILLAGE,
   [RoleName.INSOMNIAC]: Team.VILLAGE,
   [RoleName.MASON]: Team.VILLAGE,
   [RoleName.HUNTER]: Team.VILLAGE,
+  [RoleName.PRINCE]: Team.VILLAGE,
+  [RoleName.SENTINEL]: Team.VILLAGE,
   [RoleName.DOPPELGANGER]: Team.VILLAGE // Doppelganger starts as Village
 };
 
@@ -62,51 +73,71 @@ export const ROLE_TEAMS: Record<RoleName, Team> = {
  * @summary Mapping of roles to their night wake order.
  *
  * @description
- * Defines when each role wakes during the night phase.
- * -1 indicates no night action.
- *
- * @remarks
- * Order is critical for correct game state:
- * 1. Doppelganger (copies before others act)
- * 2. Werewolf (sees partners)
- * 3. Minion (sees werewolves)
- * 4. Mason (sees masons)
- * 5. Seer (views cards)
- * 6. Robber (swaps and views)
- * 7. Troublemaker (swaps others)
- * 8. Drunk (swaps with center)
- * 9. Insomniac (views own card last)
+ * Each role's 1-based position in NIGHT_WAKE_ORDER, or -1 for roles with
+ * no night action. Derived so the wake order only lives in one list.
+ */
+export const NIGHT_ORDERS: Record<RoleName, number> = Object.fromEntries(
+  Object.values(RoleName).map(role => {
+    const index = NIGHT_WAKE_ORDER.indexOf(role);
+    return [role, index === -1 ? -1 : index + 1];
+  })
+) as Record<RoleName, number>;
+
+/**
+ * @summary Display names for each role.
  */
-export const NIGHT_ORDERS: Record<RoleName, number> = {
-  [RoleName.DOPPELGANGER]: 1,
-  [RoleName.WEREWOLF]: 2,
-  [RoleName.MINION]: 3,
-  [RoleName.MASON]: 4,
-  [RoleName.SEER]: 5,
-  [RoleName.ROBBER]: 6,
-  [RoleName.TROUBLEMAKER]: 7,
-  [RoleName.DRUNK]: 8,
-  [RoleName.INSOMNIAC]: 9,
-  [RoleName.VILLAGER]: -1,
-  [RoleName.HUNTER]: -1,
-  [RoleName.TANNER]: -1
+export const ROLE_DISPLAY_NAMES: Record<RoleName, string> = {
+  [RoleName.SENTINEL]: 'Sentinel',
+  [RoleName.DOPPELGANGER]: 'Doppelganger',
+  [RoleName.WEREWOLF]: 'Werewolf',
+  [RoleName.ALPHA_WOLF]: 'Alpha Wolf',
+  [RoleName.MYSTIC_WOLF]: 'Mystic Wolf',
+  [RoleName.MINION]: 'Minion',
+  [RoleName.SQUIRE]: 'Squire',
+  [RoleName.MASON]: 'Mason',
+  [RoleName.SEER]: 'Seer',
+  [RoleName.BEHOLDER]: 'Beholder',
+  [RoleName.APPRENTICE_SEER]: 'Apprentice Seer',
+  [RoleName.ROBBER]: 'Robber',
+  [RoleName.TROUBLEMAKER]: 'Troublemaker',
+  [RoleName.VILLAGE_IDIOT]: 'Village Idiot',
+  [RoleName.DRUNK]: 'Drunk',
+  [RoleName.REVEALER]: 'Revealer',
+  [RoleName.CURATOR]: 'Curator',
+  [RoleName.INSOMNIAC]: 'Insomniac',
+  [RoleName.DREAM_WOLF]: 'Dream Wolf',
+  [RoleName.VILLAGER]: 'Villager',
+  [RoleName.HUNTER]: 'Hunter',
+  [RoleName.PRINCE]: 'Prince',
+  [RoleName.TANNER]: 'Tanner'
 };
 
 /**
  * @summary Human-readable descriptions for each role.
  */
 export const ROLE_DESCRIPTIONS: Record<RoleName, string> = {
+  [RoleName.SENTINEL]: 'Place a shield on another player\'s card so no one can move or look at it',
   [RoleName.DOPPELGANGER]: 'Look at another player\'s card and become that role',
   [RoleName.WEREWOLF]: 'See other Werewolves. If alone, may look at one center card',
+  [RoleName.ALPHA_WOLF]: 'See other Werewolves, then give the center Werewolf card to another player',
+  [RoleName.MYSTIC_WOLF]: 'See other Werewolves, then look at one other player\'s card',
   [RoleName.MINION]: 'See who the Werewolves are (they don\'t see you)',
+  [RoleName.SQUIRE]: 'See who the Werewolves are. You win only if no Werewolf dies',
   [RoleName.MASON]: 'See other Masons (if alone, other Mason is in center)',
   [RoleName.SEER]: 'Look at one player\'s card OR two center cards',
+  [RoleName.BEHOLDER]: 'Learn which player is the Seer',
+  [RoleName.APPRENTICE_SEER]: 'Look at one center card',
   [RoleName.ROBBER]: 'Swap your card with another player\'s, then look at your new card',
   [RoleName.TROUBLEMAKER]: 'Swap two other players\' cards without looking',
+  [RoleName.VILLAGE_IDIOT]: 'You may move every player\'s card one seat to the left or right',
   [RoleName.DRUNK]: 'Swap your card with one center card without looking',
+  [RoleName.REVEALER]: 'Flip another player\'s card face up. A Werewolf or Tanner goes back face down',
+  [RoleName.CURATOR]: 'Place an artifact on another player\'s card. Their role stays hidden until the end',
   [RoleName.INSOMNIAC]: 'Look at your own card at the end of the night',
+  [RoleName.DREAM_WOLF]: 'You are a Werewolf, but you do not wake up. The other Werewolves see you',
   [RoleName.VILLAGER]: 'No special ability',
   [RoleName.HUNTER]: 'If you are killed, whoever you voted for also dies',
+  [RoleName.PRINCE]: 'You cannot be killed by the vote. If you get the most votes, no one dies in your place',
   [RoleName.TANNER]: 'You win if you are killed by vote'
 };
 
@@ -20,6 +20,6 @@
  * ```
  */
 
-export { Role, ROLE_TEAMS, NIGHT_ORDERS, ROLE_DESCRIPTIONS } from './Role';
+export { Role, ROLE_TEAMS, NIGHT_ORDERS, ROLE_DESCRIPTIONS, ROLE_DISPLAY_NAMES } from './Role';
 export { Player } from './Player';
-export { Game, IGameAgent } from './Game';
+export { Game, IGameAgent, GameSnapshot, GameCancelledError } from './Game';
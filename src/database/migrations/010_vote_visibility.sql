@@ -0,0 +1,16 @@
+-- =============================================================================
+-- Migration 010: Store Vote Visibility with the Game
+-- =============================================================================
+-- Records whether the room kept votes secret, so the replay can leave out
+-- who voted for whom when the players were promised that nobody would see.
+--
+-- Values mirror the server's VoteVisibility:
+-- - public: votes shown as they are cast
+-- - secretUntilEnd: votes hidden until the game ends
+-- - secret: votes never shown, the replay included
+-- =============================================================================
+
+ALTER TABLE game_configurations ADD COLUMN IF NOT EXISTS vote_visibility VARCHAR(20) NOT NULL DEFAULT 'public'
+    CHECK (vote_visibility IN ('public', 'secretUntilEnd', 'secret'));
+
+COMMENT ON COLUMN game_configurations.vote_visibility IS 'Who could see votes during the game; secret votes are left out of replays';
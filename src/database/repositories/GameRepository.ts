@@ -57,8 +57,8 @@ export class GameRepository implements IGameRepository {
       await client.query(
         `INSERT INTO game_configurations
            (game_id, player_count, day_duration_seconds, vote_duration_seconds,
-            is_private, allow_spectators, selected_roles)
-         VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+            is_private, allow_spectators, selected_roles, vote_visibility)
+         VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
         [
           gameId,
           params.playerCount,
@@ -66,7 +66,8 @@ export class GameRepository implements IGameRepository {
           params.voteDurationSeconds || 30,
           params.isPrivate ?? true,
           params.allowSpectators ?? false,
-          JSON.stringify(params.selectedRoles)
+          JSON.stringify(params.selectedRoles),
+          params.voteVisibility ?? 'public'
         ]
       );
 
@@ -28,6 +28,7 @@ import {
   LeaderboardEntryDto,
   DbGamePlayer,
   DbGame,
+  DbGameConfiguration,
   GameSummaryDto
 } from '../types';
 
@@ -87,6 +88,7 @@ export interface CreateGameParams {
   voteDurationSeconds?: number;
   isPrivate?: boolean;
   allowSpectators?: boolean;
+  voteVisibility?: DbGameConfiguration['vote_visibility'];
 }
 
 /**
@@ -416,6 +418,13 @@ export interface IGameRepository {
    */
   findByRoomCode(roomCode: string): Promise<DbGame | null>;
 
+  /**
+   * Gets a game's configuration.
+   * @param gameId - Game's unique identifier
+   * @returns Configuration or null if not found
+   */
+  getConfiguration(gameId: string): Promise<DbGameConfiguration | null>;
+
   /**
    * Adds a player to a game.
    * @param params - Player addition parameters
@@ -204,6 +204,8 @@ export interface DbGameConfiguration {
   vote_duration_seconds: number;
   allow_spectators: boolean;
   is_private: boolean;
+  /** Who could see votes during the game ('secret' votes stay out of replays) */
+  vote_visibility: 'public' | 'secretUntilEnd' | 'secret';
 }
 
 /**
@@ -113,19 +113,30 @@ export enum Team {
  * Each role has specific abilities and belongs to a team:
  *
  * **Night Wake Order:**
- * 1. DOPPELGANGER - Copies another player's role
- * 2. WEREWOLF - Sees other werewolves (or one center card if alone)
- * 3. MINION - Sees werewolves (werewolves don't see minion)
- * 4. MASON - Sees other masons
- * 5. SEER - Views one player card OR two center cards
- * 6. ROBBER - Swaps card with another player, sees new card
- * 7. TROUBLEMAKER - Swaps two other players' cards (doesn't look)
- * 8. DRUNK - Swaps card with center (doesn't look)
- * 9. INSOMNIAC - Looks at own card at end of night
+ * 1. SENTINEL - Shields one other player's card
+ * 2. DOPPELGANGER - Copies another player's role
+ * 3. WEREWOLF - Sees other werewolves (or one center card if alone)
+ * 4. ALPHA_WOLF - Sees other werewolves, then turns one player into a Werewolf
+ * 5. MYSTIC_WOLF - Sees other werewolves, then views one player card
+ * 6. MINION - Sees werewolves (werewolves don't see minion)
+ * 7. SQUIRE - Sees werewolves, but wins and loses exactly as they do
+ * 8. MASON - Sees other masons
+ * 9. SEER - Views one player card OR two center cards
+ * 10. BEHOLDER - Learns who the Seer is
+ * 11. APPRENTICE_SEER - Views one center card
+ * 12. ROBBER - Swaps card with another player, sees new card
+ * 13. TROUBLEMAKER - Swaps two other players' cards (doesn't look)
+ * 14. VILLAGE_IDIOT - May move every player's card one seat left or right
+ * 15. DRUNK - Swaps card with center (doesn't look)
+ * 16. REVEALER - Flips one player's card face up for the day
+ * 17. CURATOR - Places an artifact that hides one player's role
+ * 18. INSOMNIAC - Looks at own card at end of night
  *
  * **No Night Action:**
+ * - DREAM_WOLF - Werewolf the pack sees, but who sees no one
  * - VILLAGER - No ability
  * - HUNTER - If killed, their vote target also dies
+ * - PRINCE - Cannot be killed by the vote
  * - TANNER - Wants to die; wins only if killed
  *
  * @pattern Factory Method Pattern - RoleFactory creates roles by name
@@ -139,14 +150,23 @@ export enum Team {
  * @example
  * ```typescript
  * const nightOrder: RoleName[] = [
+ *   RoleName.SENTINEL,
  *   RoleName.DOPPELGANGER,
  *   RoleName.WEREWOLF,
+ *   RoleName.ALPHA_WOLF,
+ *   RoleName.MYSTIC_WOLF,
  *   RoleName.MINION,
+ *   RoleName.SQUIRE,
  *   RoleName.MASON,
  *   RoleName.SEER,
+ *   RoleName.BEHOLDER,
+ *   RoleName.APPRENTICE_SEER,
  *   RoleName.ROBBER,
  *   RoleName.TROUBLEMAKER,
+ *   RoleName.VILLAGE_IDIOT,
  *   RoleName.DRUNK,
+ *   RoleName.REVEALER,
+ *   RoleName.CURATOR,
  *   RoleName.INSOMNIAC
  * ];
  * ```
@@ -154,41 +174,74 @@ export enum Team {
 export enum RoleName {
   // === ROLES WITH NIGHT ACTIONS (in wake order) ===
 
+  /** Shields one other player's card from being moved or viewed */
+  SENTINEL = 'SENTINEL',
+
   /** Copies another player's role and becomes that role */
   DOPPELGANGER = 'DOPPELGANGER',
 
   /** Sees other werewolves; if alone, may view one center card */
   WEREWOLF = 'WEREWOLF',
 
+  /** Werewolf who gives the center Werewolf card to another player */
+  ALPHA_WOLF = 'ALPHA_WOLF',
+
+  /** Werewolf who also views one other player's card */
+  MYSTIC_WOLF = 'MYSTIC_WOLF',
+
   /** Sees werewolves but werewolves don't see minion */
   MINION = 'MINION',
 
+  /** Sees werewolves like the minion, but wins under the werewolves' own condition */
+  SQUIRE = 'SQUIRE',
+
   /** Sees other masons (always use 2 mason cards) */
   MASON = 'MASON',
 
   /** Views one player's card OR two center cards */
   SEER = 'SEER',
 
+  /** Learns which player is the Seer */
+  BEHOLDER = 'BEHOLDER',
+
+  /** Views one center card */
+  APPRENTICE_SEER = 'APPRENTICE_SEER',
+
   /** Swaps own card with another player, sees new card */
   ROBBER = 'ROBBER',
 
   /** Swaps two other players' cards without looking */
   TROUBLEMAKER = 'TROUBLEMAKER',
 
+  /** May move every player's card one seat left or right */
+  VILLAGE_IDIOT = 'VILLAGE_IDIOT',
+
   /** Swaps own card with center card without looking */
   DRUNK = 'DRUNK',
 
+  /** Flips one player's card face up unless it is a Werewolf or Tanner */
+  REVEALER = 'REVEALER',
+
+  /** Places an artifact on one player's card, hiding their role until the end */
+  CURATOR = 'CURATOR',
+
   /** Looks at own card at end of night to see if it changed */
   INSOMNIAC = 'INSOMNIAC',
 
   // === ROLES WITHOUT NIGHT ACTIONS ===
 
+  /** Werewolf who never wakes; the other werewolves see them */
+  DREAM_WOLF = 'DREAM_WOLF',
+
   /** No special ability - basic village team member */
   VILLAGER = 'VILLAGER',
 
   /** If voted out, their vote target also dies */
   HUNTER = 'HUNTER',
 
+  /** Survives the vote; votes against the Prince kill no one */
+  PRINCE = 'PRINCE',
+
   /** Wants to die - wins only if killed by vote */
   TANNER = 'TANNER'
 }
@@ -205,8 +258,11 @@ export enum RoleName {
  *
  * @remarks
  * This order is critical for correct game state. For example:
- * - Doppelganger must go first to copy a role before others act
+ * - Sentinel goes first so the shield is in place before any card moves
+ * - Doppelganger goes next to copy a role before others act
  * - Robber goes after Seer, so stealing a Seer doesn't give the Robber a Seer action
+ * - Village Idiot goes after the other player swaps, so it moves the cards they left
+ * - Revealer goes after every swap, so the card they flip is the one its owner ends with
  * - Insomniac goes last to see final state of their card after all swaps
  *
  * @example
@@ -220,17 +276,35 @@ export enum RoleName {
  * ```
  */
 export const NIGHT_WAKE_ORDER: RoleName[] = [
+  RoleName.SENTINEL,
   RoleName.DOPPELGANGER,
   RoleName.WEREWOLF,
+  RoleName.ALPHA_WOLF,
+  RoleName.MYSTIC_WOLF,
   RoleName.MINION,
+  RoleName.SQUIRE,
   RoleName.MASON,
   RoleName.SEER,
+  RoleName.BEHOLDER,
+  RoleName.APPRENTICE_SEER,
   RoleName.ROBBER,
   RoleName.TROUBLEMAKER,
+  RoleName.VILLAGE_IDIOT,
   RoleName.DRUNK,
+  RoleName.REVEALER,
+  RoleName.CURATOR,
   RoleName.INSOMNIAC
 ];
 
+/**
+ * @summary Night order at which a Doppelganger who copied Insomniac wakes.
+ *
+ * @description
+ * Doppel-Insomniac checks their card after every other role has acted,
+ * so they get a slot of their own after the last role in NIGHT_WAKE_ORDER.
+ */
+export const DOPPEL_INSOMNIAC_ORDER: number = NIGHT_WAKE_ORDER.length + 1;
+
 /**
  * @summary Set of roles that have no night action.
  *
@@ -249,7 +323,29 @@ export const NIGHT_WAKE_ORDER: RoleName[] = [
  * ```
  */
 export const NO_NIGHT_ACTION_ROLES: Set<RoleName> = new Set([
+  RoleName.DREAM_WOLF,
   RoleName.VILLAGER,
   RoleName.HUNTER,
+  RoleName.PRINCE,
   RoleName.TANNER
 ]);
+
+/**
+ * @summary Roles that count as Werewolves.
+ *
+ * @description
+ * Werewolves, the Minion and win conditions treat every role in this set
+ * as a Werewolf. The Minion is on the Werewolf team but is not a Werewolf.
+ * The Dream Wolf is one even though they never wake.
+ *
+ * @example
+ * ```typescript
+ * const werewolfKilled = eliminated.some(p => WEREWOLF_ROLES.has(p.currentRole));
+ * ```
+ */
+export const WEREWOLF_ROLES: Set<RoleName> = new Set([
+  RoleName.WEREWOLF,
+  RoleName.ALPHA_WOLF,
+  RoleName.MYSTIC_WOLF,
+  RoleName.DREAM_WOLF
+]);
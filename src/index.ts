@@ -38,7 +38,9 @@ export {
   Team,
   RoleName,
   NIGHT_WAKE_ORDER,
-  NO_NIGHT_ACTION_ROLES
+  DOPPEL_INSOMNIAC_ORDER,
+  NO_NIGHT_ACTION_ROLES,
+  WEREWOLF_ROLES
 } from './enums';
 
 // ============================================================================
@@ -50,7 +52,14 @@ export {
   GameState,
   GameConfig,
   GameResult,
+  RevealEmphasis,
+  RevealStage,
+  VoteRecord,
+  DEFAULT_CENTER_CARD_COUNT,
+  SKIP_NIGHT_ACTION,
   NightActionResult,
+  NightActionFailureCode,
+  NightActionFailureKind,
   NightActionInfo,
   NightActionContext,
   DayContext,
@@ -58,6 +67,8 @@ export {
   PlayerStatement,
   ViewedCard,
   SwapInfo,
+  RotationDirection,
+  RotationChoice,
   CardPosition,
   AuditEntry,
   CircularCheckResult,
@@ -68,7 +79,9 @@ export {
   TroublemakerChoice,
   SelectionOptions,
   isPlayerPosition,
-  isCenterPosition
+  isCenterPosition,
+  getNightActionFailureKind,
+  getRevealSequence
 } from './types';
 
 // ============================================================================
@@ -79,9 +92,12 @@ export {
   ROLE_TEAMS,
   NIGHT_ORDERS,
   ROLE_DESCRIPTIONS,
+  ROLE_DISPLAY_NAMES,
   Player,
   Game,
-  IGameAgent
+  IGameAgent,
+  GameSnapshot,
+  GameCancelledError
 } from './core';
 
 // ============================================================================
@@ -103,14 +119,24 @@ export {
   INightActionAgent,
   INightActionGameState,
   AbstractNightAction,
+  NightActionSkippedError,
+  SentinelAction,
   DoppelgangerAction,
   WerewolfAction,
+  AlphaWolfAction,
+  MysticWolfAction,
   MinionAction,
+  SquireAction,
   MasonAction,
   SeerAction,
+  BeholderAction,
+  ApprenticeSeerAction,
   RobberAction,
   TroublemakerAction,
+  VillageIdiotAction,
   DrunkAction,
+  RevealerAction,
+  CuratorAction,
   InsomniacAction,
   NoAction,
 
@@ -120,12 +146,17 @@ export {
   WinConditionContext,
   WinConditionResult,
   PlayerWinInfo,
+  isWerewolf,
+  isMinion,
   VillageWinCondition,
   WerewolfWinCondition,
   TannerWinCondition,
 
   // Factory Pattern
   RoleFactory,
+  RoleTable,
+  RoleListPreview,
+  RoleCatalogEntry,
 
   // Command Pattern
   IGameAction,
@@ -25,7 +25,7 @@
  * ```
  */
 
-import { ServerMessage, ClientMessage } from './protocol';
+import { ServerMessage, ClientMessage, CloseCode } from './protocol';
 
 /**
  * @summary Connection type identifier.
@@ -102,6 +102,11 @@ export interface IClientConnection {
    */
   readonly state: ConnectionState;
 
+  /**
+   * @summary IP address of the client, when the transport knows it.
+   */
+  readonly remoteAddress?: string;
+
   /**
    * @summary Sends a message to the client.
    *
@@ -161,8 +166,9 @@ export interface IClientConnection {
    * @summary Closes the connection.
    *
    * @param {string} [reason] - Optional reason for closing
+   * @param {CloseCode} [code] - Why the server ended it, if for a game reason
    */
-  close(reason?: string): void;
+  close(reason?: string, code?: CloseCode): void;
 
   /**
    * @summary Checks if connection is currently connected.
@@ -253,7 +259,7 @@ export abstract class AbstractClientConnection implements IClientConnection {
   }
 
   /** @inheritdoc */
-  abstract close(reason?: string): void;
+  abstract close(reason?: string, code?: CloseCode): void;
 
   /** @inheritdoc */
   isConnected(): boolean {
@@ -445,8 +451,9 @@ export class NullConnection implements IClientConnection {
    * @summary No-op close - AI connections don't need cleanup.
    *
    * @param {string} [_reason] - Reason (ignored)
+   * @param {CloseCode} [_code] - Close code (ignored)
    */
-  close(_reason?: string): void {
+  close(_reason?: string, _code?: CloseCode): void {
     // No-op: nothing to close
   }
 
@@ -477,3 +484,133 @@ export class NullConnection implements IClientConnection {
     return this.createdAt;
   }
 }
+
+/**
+ * @summary Placeholder connection for a player whose socket is gone.
+ *
+ * @description
+ * Used for human players in a room restored after a server restart.
+ * The player is still seated in the game, but there is no socket to
+ * talk to until they reconnect and the room swaps in their new one.
+ * Unlike NullConnection, it reports itself as disconnected so the room
+ * skips it when broadcasting and shows the player as offline.
+ *
+ * @pattern Null Object Pattern - Stands in for a missing connection
+ *
+ * @example
+ * ```typescript
+ * const placeholder = DetachedConnection.create('player-1');
+ * placeholder.isConnected(); // false
+ * ```
+ */
+export class DetachedConnection implements IClientConnection {
+  /** @inheritdoc */
+  readonly id: string;
+
+  /** @inheritdoc */
+  readonly type: ConnectionType = 'websocket';
+
+  /** @inheritdoc */
+  readonly state: ConnectionState = 'disconnected';
+
+  /**
+   * @summary Creates a new DetachedConnection.
+   *
+   * @param {string} id - Unique identifier for this connection
+   *
+   * @private Use DetachedConnection.create() instead
+   */
+  private constructor(id: string) {
+    this.id = id;
+  }
+
+  /**
+   * @summary Factory method to create a DetachedConnection.
+   *
+   * @param {string} id - Player ID the placeholder stands in for
+   *
+   * @returns {DetachedConnection} A new detached connection
+   */
+  static create(id: string): DetachedConnection {
+    return new DetachedConnection(`detached-${id}`);
+  }
+
+  /**
+   * @summary No-op send - there is no socket to write to.
+   *
+   * @param {ServerMessage} _message - Message (dropped)
+   */
+  send(_message: ServerMessage): void {
+    // No-op: the player will get a full state snapshot on reconnect
+  }
+
+  /**
+   * @summary No-op message handler registration.
+   *
+   * @param {MessageHandler} _handler - Handler (ignored)
+   *
+   * @returns {() => void} No-op unsubscribe function
+   */
+  onMessage(_handler: MessageHandler): () => void {
+    return () => {};
+  }
+
+  /**
+   * @summary No-op disconnect handler registration.
+   *
+   * @param {DisconnectHandler} _handler - Handler (ignored)
+   *
+   * @returns {() => void} No-op unsubscribe function
+   */
+  onDisconnect(_handler: DisconnectHandler): () => void {
+    return () => {};
+  }
+
+  /**
+   * @summary No-op error handler registration.
+   *
+   * @param {ErrorHandler} _handler - Handler (ignored)
+   *
+   * @returns {() => void} No-op unsubscribe function
+   */
+  onError(_handler: ErrorHandler): () => void {
+    return () => {};
+  }
+
+  /**
+   * @summary No-op close - already disconnected.
+   *
+   * @param {string} [_reason] - Reason (ignored)
+   * @param {CloseCode} [_code] - Close code (ignored)
+   */
+  close(_reason?: string, _code?: CloseCode): void {
+    // No-op: nothing to close
+  }
+
+  /**
+   * @summary Always returns false.
+   *
+   * @returns {boolean} Always false
+   */
+  isConnected(): boolean {
+    return false;
+  }
+
+  /**
+   * @summary Returns 0 - no connection to measure.
+   *
+   * @returns {number} Always 0
+   */
+  getLatency(): number {
+    return 0;
+  }
+
+  /**
+   * @summary Returns 0 - never connected.
+   *
+   * @returns {number} Always 0
+   */
+  getConnectedAt(): number {
+    return 0;
+  }
+}
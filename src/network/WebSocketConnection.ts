@@ -26,7 +26,15 @@
  */
 
 import { AbstractClientConnection, ConnectionType } from './IClientConnection';
-import { ServerMessage, ClientMessage, isClientMessage, createMessage } from './protocol';
+import {
+  ServerMessage,
+  ClientMessage,
+  CloseCode,
+  ErrorCodes,
+  validateClientMessage,
+  createMessage,
+  createErrorMessage
+} from './protocol';
 
 /**
  * @summary Configuration for WebSocket connection.
@@ -38,20 +46,46 @@ export interface WebSocketConfig {
   /** Timeout for pong response in milliseconds */
   pongTimeoutMs: number;
 
-  /** Maximum message size in bytes */
+  /** Maximum message size in bytes, in either direction */
   maxMessageSize: number;
 
+  /** How long queued outgoing data may sit without draining before the client is dropped */
+  writeTimeoutMs: number;
+
+  /** How many messages may wait in the send queue before the client is dropped */
+  sendBufferSize: number;
+
   /** Whether to enable compression */
   enableCompression: boolean;
 }
 
+/** How often the server pings each client, in milliseconds */
+export const PING_INTERVAL_MS = 30000;
+
+/**
+ * How long past a missed ping the server waits for any sign of life
+ * before it drops the connection, in milliseconds.
+ */
+export const PONG_TIMEOUT_MS = 10000;
+
+/** How long a stalled client may leave outgoing data unsent, in milliseconds */
+export const WRITE_TIMEOUT_MS = 5000;
+
+/** How many outgoing messages a connection may have waiting to be written */
+export const SEND_BUFFER_SIZE = 256;
+
+/** How soon a send queue held back by a full socket buffer is retried, in milliseconds */
+const FLUSH_RETRY_MS = 50;
+
 /**
  * @summary Default WebSocket configuration.
  */
 export const DEFAULT_WEBSOCKET_CONFIG: WebSocketConfig = {
-  pingIntervalMs: 30000,
-  pongTimeoutMs: 10000,
+  pingIntervalMs: PING_INTERVAL_MS,
+  pongTimeoutMs: PONG_TIMEOUT_MS,
   maxMessageSize: 65536,
+  writeTimeoutMs: WRITE_TIMEOUT_MS,
+  sendBufferSize: SEND_BUFFER_SIZE,
   enableCompression: false
 };
 
@@ -72,6 +106,9 @@ export interface IWebSocket {
   /** Current ready state */
   readonly readyState: number;
 
+  /** Bytes queued by send() but not yet written to the network */
+  readonly bufferedAmount: number;
+
   /** Send data through the socket */
   send(data: string): void;
 
@@ -83,6 +120,18 @@ export interface IWebSocket {
 
   /** Remove event listener */
   removeEventListener(type: string, listener: (event: unknown) => void): void;
+
+  /** Send a protocol-level ping frame (Node.js ws only) */
+  ping?(): void;
+
+  /** Listen for protocol-level events such as 'pong' (Node.js ws only) */
+  on?(event: 'pong', listener: () => void): unknown;
+
+  /** Stop listening for a protocol-level event (Node.js ws only) */
+  off?(event: 'pong', listener: () => void): unknown;
+
+  /** Client IP, set by the server from the upgrade request (Node.js only) */
+  readonly remoteAddress?: string;
 }
 
 /**
@@ -99,6 +148,13 @@ export interface IWebSocket {
  * @remarks
  * - Messages are JSON serialized/deserialized automatically
  * - Heartbeat pings maintain connection and measure latency
+ * - A read deadline drops connections that go silent, so a dead TCP
+ *   connection is reported as a disconnect instead of lingering
+ * - Messages over maxMessageSize are refused in both directions, and a
+ *   client whose outgoing data stops draining is dropped
+ * - send() only queues; the queue is written out separately, so game
+ *   code never waits on a slow client, and a client whose queue fills
+ *   up is dropped
  * - Graceful handling of disconnection and errors
  *
  * @example
@@ -131,8 +187,17 @@ export class WebSocketConnection extends AbstractClientConnection {
   /** Ping interval handle */
   private pingInterval: ReturnType<typeof setInterval> | null = null;
 
-  /** Pong timeout handle */
-  private pongTimeout: ReturnType<typeof setTimeout> | null = null;
+  /** Read deadline handle, pushed back whenever the client is heard from */
+  private readDeadline: ReturnType<typeof setTimeout> | null = null;
+
+  /** Write deadline handle, armed while outgoing data is queued */
+  private writeDeadline: ReturnType<typeof setTimeout> | null = null;
+
+  /** Serialized messages waiting to be written to the socket */
+  private readonly sendQueue: string[] = [];
+
+  /** Whether a flush of the send queue is already scheduled */
+  private flushScheduled: boolean = false;
 
   /** Time when last ping was sent */
   private lastPingTime: number = 0;
@@ -142,6 +207,7 @@ export class WebSocketConnection extends AbstractClientConnection {
     message: (event: unknown) => void;
     close: (event: unknown) => void;
     error: (event: unknown) => void;
+    pong: () => void;
   };
 
   /**
@@ -182,12 +248,18 @@ export class WebSocketConnection extends AbstractClientConnection {
     this.boundHandlers = {
       message: (event: unknown) => this.handleMessage(event),
       close: (event: unknown) => this.handleClose(event),
-      error: (event: unknown) => this.handleError(event)
+      error: (event: unknown) => this.handleError(event),
+      pong: () => this.handlePong()
     };
 
     this.setupSocket();
   }
 
+  /** @inheritdoc */
+  get remoteAddress(): string | undefined {
+    return this.socket.remoteAddress;
+  }
+
   /**
    * @summary Sets up WebSocket event handlers.
    *
@@ -209,6 +281,7 @@ export class WebSocketConnection extends AbstractClientConnection {
     this.socket.addEventListener('message', this.boundHandlers.message);
     this.socket.addEventListener('close', this.boundHandlers.close);
     this.socket.addEventListener('error', this.boundHandlers.error);
+    this.socket.on?.('pong', this.boundHandlers.pong);
   }
 
   /**
@@ -219,24 +292,42 @@ export class WebSocketConnection extends AbstractClientConnection {
    * @private
    */
   private handleMessage(event: unknown): void {
+    // Any message proves the client is still there
+    this.extendReadDeadline();
+
     try {
       // Extract data from event (browser vs Node.js compatibility)
       const eventData = (event as { data?: unknown }).data;
       const data = typeof eventData === 'string' ? eventData : String(eventData);
 
-      const parsed = JSON.parse(data);
+      // The limit is in bytes; data.length would count UTF-16 characters
+      if (Buffer.byteLength(data) > this.config.maxMessageSize) {
+        console.warn(`Connection ${this.id} sent a message over ${this.config.maxMessageSize} bytes`);
+        this.close('Message too large');
+        return;
+      }
+
+      let parsed: unknown;
+      try {
+        parsed = JSON.parse(data);
+      } catch {
+        this.rejectMessage('Message is not valid JSON');
+        return;
+      }
 
       // Handle pong response
-      if (parsed.type === 'pong') {
+      if ((parsed as { type?: unknown } | null)?.type === 'pong') {
         this.handlePong();
         return;
       }
 
       // Validate and emit client message
-      if (isClientMessage(parsed)) {
-        this.emitMessage(parsed);
+      const problem = validateClientMessage(parsed);
+      if (problem === null) {
+        this.emitMessage(parsed as ClientMessage);
       } else {
-        console.warn(`Invalid message from ${this.id}:`, parsed);
+        console.warn(`Invalid message from ${this.id}: ${problem}`);
+        this.rejectMessage(problem, (parsed as { type?: unknown }).type);
       }
     } catch (error) {
       console.error(`Error parsing message from ${this.id}:`, error);
@@ -244,6 +335,22 @@ export class WebSocketConnection extends AbstractClientConnection {
     }
   }
 
+  /**
+   * @summary Tells the client why a message it sent was dropped.
+   *
+   * @param {string} problem - What was wrong with the message
+   * @param {unknown} [type] - The message's type, if it had one
+   *
+   * @private
+   */
+  private rejectMessage(problem: string, type?: unknown): void {
+    this.send(createErrorMessage(
+      ErrorCodes.INVALID_MESSAGE,
+      problem,
+      typeof type === 'string' ? { type } : undefined
+    ));
+  }
+
   /**
    * @summary Handles WebSocket close event.
    *
@@ -253,6 +360,7 @@ export class WebSocketConnection extends AbstractClientConnection {
    */
   private handleClose(event: unknown): void {
     this.stopHeartbeat();
+    this.sendQueue.length = 0;
 
     const closeEvent = event as { code?: number; reason?: string };
     const reason = closeEvent.reason || `Code: ${closeEvent.code || 'unknown'}`;
@@ -278,6 +386,11 @@ export class WebSocketConnection extends AbstractClientConnection {
   /**
    * @summary Starts the heartbeat ping/pong cycle.
    *
+   * @description
+   * Pings every pingIntervalMs and arms the read deadline. A client that
+   * sends nothing, not even a pong, for one ping interval plus
+   * pongTimeoutMs is treated as gone.
+   *
    * @private
    */
   private startHeartbeat(): void {
@@ -286,6 +399,29 @@ export class WebSocketConnection extends AbstractClientConnection {
     this.pingInterval = setInterval(() => {
       this.sendPing();
     }, this.config.pingIntervalMs);
+    this.extendReadDeadline();
+  }
+
+  /**
+   * @summary Pushes the read deadline back after hearing from the client.
+   *
+   * @description
+   * When the deadline passes the connection is closed, which emits a
+   * disconnect so the server removes the player or starts their
+   * reconnection grace period.
+   *
+   * @private
+   */
+  private extendReadDeadline(): void {
+    if (this.readDeadline) {
+      clearTimeout(this.readDeadline);
+    }
+
+    this.readDeadline = setTimeout(() => {
+      this.readDeadline = null;
+      console.warn(`Connection ${this.id} read deadline exceeded`);
+      this.drop('Read deadline exceeded');
+    }, this.config.pingIntervalMs + this.config.pongTimeoutMs);
   }
 
   /**
@@ -299,12 +435,44 @@ export class WebSocketConnection extends AbstractClientConnection {
       this.pingInterval = null;
     }
 
-    if (this.pongTimeout) {
-      clearTimeout(this.pongTimeout);
-      this.pongTimeout = null;
+    if (this.readDeadline) {
+      clearTimeout(this.readDeadline);
+      this.readDeadline = null;
+    }
+
+    if (this.writeDeadline) {
+      clearTimeout(this.writeDeadline);
+      this.writeDeadline = null;
     }
   }
 
+  /**
+   * @summary Drops the client if queued outgoing data stops draining.
+   *
+   * @description
+   * Armed after a send that leaves data queued. If no queued bytes have
+   * been written by writeTimeoutMs, the client has stopped reading and
+   * is closed, so a slow client can't pile up broadcasts forever.
+   *
+   * @private
+   */
+  private armWriteDeadline(): void {
+    if (this.writeDeadline || this.socket.bufferedAmount === 0) {
+      return;
+    }
+
+    const queuedAtArm = this.socket.bufferedAmount;
+    this.writeDeadline = setTimeout(() => {
+      this.writeDeadline = null;
+      if (this.socket.bufferedAmount >= queuedAtArm) {
+        console.warn(`Connection ${this.id} write deadline exceeded`);
+        this.drop('Write deadline exceeded');
+        return;
+      }
+      this.armWriteDeadline();
+    }, this.config.writeTimeoutMs);
+  }
+
   /**
    * @summary Sends a ping message.
    *
@@ -318,16 +486,12 @@ export class WebSocketConnection extends AbstractClientConnection {
     this.lastPingTime = Date.now();
 
     try {
+      // Browsers answer ping frames themselves, even from a background tab
+      this.socket.ping?.();
       this.socket.send(JSON.stringify({
         type: 'ping',
         timestamp: this.lastPingTime
       }));
-
-      // Set timeout for pong response
-      this.pongTimeout = setTimeout(() => {
-        console.warn(`Connection ${this.id} pong timeout`);
-        this.close('Pong timeout');
-      }, this.config.pongTimeoutMs);
     } catch (error) {
       console.error(`Error sending ping to ${this.id}:`, error);
     }
@@ -339,11 +503,7 @@ export class WebSocketConnection extends AbstractClientConnection {
    * @private
    */
   private handlePong(): void {
-    if (this.pongTimeout) {
-      clearTimeout(this.pongTimeout);
-      this.pongTimeout = null;
-    }
-
+    this.extendReadDeadline();
     this.latency = Date.now() - this.lastPingTime;
   }
 
@@ -356,29 +516,111 @@ export class WebSocketConnection extends AbstractClientConnection {
     try {
       const serialized = JSON.stringify(message);
 
-      if (serialized.length > this.config.maxMessageSize) {
+      if (Buffer.byteLength(serialized) > this.config.maxMessageSize) {
         throw new Error(`Message exceeds maximum size of ${this.config.maxMessageSize} bytes`);
       }
 
-      this.socket.send(serialized);
+      // A client this far behind isn't keeping up; drop it rather than buffer forever
+      if (this.sendQueue.length >= this.config.sendBufferSize) {
+        console.warn(`Connection ${this.id} send buffer full`);
+        this.drop('Send buffer full');
+        return;
+      }
+
+      this.sendQueue.push(serialized);
+      this.scheduleFlush(0);
+    } catch (error) {
+      console.error(`Error sending message to ${this.id}:`, error);
+      this.emitError(error instanceof Error ? error : new Error(String(error)));
+    }
+  }
+
+  /**
+   * @summary Schedules the send queue to be written out.
+   *
+   * @param {number} delayMs - How long to wait first
+   *
+   * @private
+   */
+  private scheduleFlush(delayMs: number): void {
+    if (this.flushScheduled) {
+      return;
+    }
+
+    this.flushScheduled = true;
+    setTimeout(() => this.flushSendQueue(), delayMs);
+  }
+
+  /**
+   * @summary Writes queued messages to the socket, in order.
+   *
+   * @description
+   * Stops while the socket still holds a full-size message it hasn't
+   * written, and tries again shortly, so the queue rather than the
+   * socket absorbs a slow client. The write deadline catches a client
+   * whose socket never drains.
+   *
+   * @private
+   */
+  private flushSendQueue(): void {
+    this.flushScheduled = false;
+    if (this._state !== 'connected') {
+      this.sendQueue.length = 0;
+      return;
+    }
+
+    try {
+      while (this.sendQueue.length > 0 && this.socket.bufferedAmount < this.config.maxMessageSize) {
+        this.socket.send(this.sendQueue.shift()!);
+      }
     } catch (error) {
       console.error(`Error sending message to ${this.id}:`, error);
       this.emitError(error instanceof Error ? error : new Error(String(error)));
     }
+
+    this.armWriteDeadline();
+    if (this.sendQueue.length > 0) {
+      this.scheduleFlush(FLUSH_RETRY_MS);
+    }
+  }
+
+  /**
+   * @summary Closes a connection that has stopped keeping up.
+   *
+   * @description
+   * Unlike close(), queued messages are thrown away rather than handed
+   * to a socket that isn't draining.
+   *
+   * @param {string} reason - Why the connection was dropped
+   *
+   * @private
+   */
+  private drop(reason: string): void {
+    this.sendQueue.length = 0;
+    this.close(reason);
   }
 
   /** @inheritdoc */
-  close(reason?: string): void {
+  close(reason?: string, code?: CloseCode): void {
     this.stopHeartbeat();
 
+    // Hand anything still queued to the socket so it goes out before the close frame
+    if (this.socket.readyState === this.socket.OPEN) {
+      for (const data of this.sendQueue) {
+        this.socket.send(data);
+      }
+    }
+    this.sendQueue.length = 0;
+
     // Remove event listeners
     this.socket.removeEventListener('message', this.boundHandlers.message);
     this.socket.removeEventListener('close', this.boundHandlers.close);
     this.socket.removeEventListener('error', this.boundHandlers.error);
+    this.socket.off?.('pong', this.boundHandlers.pong);
 
     if (this.socket.readyState === this.socket.OPEN ||
         this.socket.readyState === this.socket.CONNECTING) {
-      this.socket.close(1000, reason || 'Normal closure');
+      this.socket.close(code ?? 1000, reason || 'Normal closure');
     }
 
     if (this._state !== 'disconnected') {
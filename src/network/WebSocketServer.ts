@@ -29,7 +29,7 @@
 
 import { IClientConnection } from './IClientConnection';
 import { WebSocketConnection, IWebSocket, WebSocketConfig } from './WebSocketConnection';
-import { ServerMessage, createMessage } from './protocol';
+import { ServerMessage, CloseCodes, createMessage } from './protocol';
 
 /**
  * @summary Server configuration options.
@@ -409,7 +409,7 @@ export class WebSocketServer {
     return new Promise((resolve) => {
       // Close all connections
       for (const connection of this.connections.values()) {
-        connection.close('Server shutting down');
+        connection.close('Server shutting down', CloseCodes.SERVER_SHUTDOWN);
       }
       this.connections.clear();
 
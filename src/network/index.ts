@@ -29,10 +29,12 @@ export {
   PlayerId,
   TimestampedMessage,
   TimeoutStrategyType,
+  RoleFillMode,
   RoomConfig,
   RoomPlayer,
   RoomState,
   RoomSummary,
+  PublicGameState,
   PublicPlayerInfo,
   PlayerGameView,
 
@@ -41,6 +43,7 @@ export {
   SelectPlayerRequest,
   SelectCenterRequest,
   SeerChoiceRequest,
+  RotationChoiceRequest,
   SelectTwoPlayersRequest,
   StatementRequest,
   VoteRequest,
@@ -59,6 +62,7 @@ export {
   ActionResponseMessage,
   GetStateMessage,
   PingMessage,
+  SpectateRoomMessage,
   ClientMessage,
 
   // Server messages
@@ -66,6 +70,7 @@ export {
   ErrorMessage,
   RoomCreatedMessage,
   RoomJoinedMessage,
+  SpectatingMessage,
   RoomUpdateMessage,
   RoomClosedMessage,
   GameStartedMessage,
@@ -75,6 +80,7 @@ export {
   ActionAcknowledgedMessage,
   ActionTimeoutMessage,
   NightResultMessage,
+  DawnSummaryMessage,
   StatementMadeMessage,
   VotesRevealedMessage,
   EliminationMessage,
@@ -86,13 +92,21 @@ export {
 
   // Type guards and factories
   isClientMessage,
+  validateClientMessage,
   isServerMessage,
   createMessage,
   createErrorMessage,
 
+  // Phase descriptions
+  PHASE_DESCRIPTIONS,
+
   // Error codes
   ErrorCodes,
-  ErrorCode
+  ErrorCode,
+
+  // Close codes
+  CloseCodes,
+  CloseCode
 } from './protocol';
 
 // Connection interface
@@ -112,6 +126,10 @@ export {
   WebSocketConnectionFactory,
   WebSocketConfig,
   DEFAULT_WEBSOCKET_CONFIG,
+  PING_INTERVAL_MS,
+  PONG_TIMEOUT_MS,
+  WRITE_TIMEOUT_MS,
+  SEND_BUFFER_SIZE,
   IWebSocket
 } from './WebSocketConnection';
 
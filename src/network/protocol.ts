@@ -24,7 +24,18 @@
  */
 
 import { GamePhase, RoleName, Team } from '../enums';
-import { PlayerStatement, NightActionResult, GameResult, NightActionInfo, SwapInfo, ViewedCard } from '../types';
+import {
+  PlayerStatement,
+  NightActionResult,
+  GameResult,
+  NightActionInfo,
+  SwapInfo,
+  ViewedCard,
+  RevealEmphasis,
+  RevealStage,
+  TieBreakMode,
+  VoteRecord
+} from '../types';
 
 // ============================================================================
 // ROLE-SPECIFIC NIGHT ACTION TYPES
@@ -192,6 +203,17 @@ export interface TimestampedMessage {
  */
 export type TimeoutStrategyType = 'casual' | 'competitive' | 'tournament';
 
+/**
+ * @summary How a room's role list is completed when the game starts.
+ *
+ * @description
+ * - `exact`: the configured roles must already be exactly players +
+ *   center cards
+ * - `fill`: the configured roles are a partial set; the remainder is
+ *   filled with a balanced default list sized to the actual player count
+ */
+export type RoleFillMode = 'exact' | 'fill';
+
 /**
  * @summary Configuration for creating a game room.
  *
@@ -199,15 +221,24 @@ export type TimeoutStrategyType = 'casual' | 'competitive' | 'tournament';
  * Defines the game parameters including player count, roles, and timing.
  */
 export interface RoomConfig {
-  /** Minimum number of players to start */
+  /** Minimum number of players to start (at least 3) */
   readonly minPlayers: number;
 
-  /** Maximum number of players (3-10) */
+  /** Maximum number of players (minPlayers to 16; the lobby offers 10) */
   readonly maxPlayers: number;
 
-  /** Roles to use in the game (must be maxPlayers + 3) */
+  /** Roles to use in the game (must be maxPlayers + centerCardCount) */
   readonly roles: readonly RoleName[];
 
+  /** Number of cards dealt to the center (defaults to 3) */
+  readonly centerCardCount?: number;
+
+  /** House wake order: every role with a night action (defaults to NIGHT_WAKE_ORDER) */
+  readonly nightOrder?: readonly RoleName[];
+
+  /** How to treat a partial role list (defaults to 'exact') */
+  readonly roleFillMode?: RoleFillMode;
+
   /** Timeout behavior for player actions */
   readonly timeoutStrategy: TimeoutStrategyType;
 
@@ -217,8 +248,33 @@ export interface RoomConfig {
   /** Allow non-players to watch */
   readonly allowSpectators: boolean;
 
+  /**
+   * Delay applied to everything spectators receive, in milliseconds.
+   * Prevents players gaining real-time information from a stream.
+   * 0 or omitted means spectators see events as they happen.
+   */
+  readonly spectatorDelayMs?: number;
+
   /** Room display name */
   readonly roomName?: string;
+
+  /** Whether the end-of-game reveal leads with original or final roles (defaults to 'final') */
+  readonly revealEmphasis?: RevealEmphasis;
+
+  /** House rule: players may abstain instead of voting for someone (defaults to false) */
+  readonly allowAbstain?: boolean;
+
+  /** What happens when players tie for the most votes (defaults to 'all-die') */
+  readonly tieBreak?: TieBreakMode;
+
+  /** Fewest votes needed to kill a player (defaults to 2) */
+  readonly minVotesToKill?: number;
+
+  /** Secret ballot: only the number of votes in shows while voting (defaults to false) */
+  readonly anonymousVotes?: boolean;
+
+  /** With a secret ballot, show who voted for whom once the game ends (defaults to false) */
+  readonly revealVotesAtEnd?: boolean;
 }
 
 /**
@@ -260,6 +316,12 @@ export interface RoomState {
   /** Players in the room */
   readonly players: readonly RoomPlayer[];
 
+  /** Players ready to start, counting the host */
+  readonly readyCount: number;
+
+  /** Players in the room, for "readyCount of playerCount ready" */
+  readonly playerCount: number;
+
   /** Current room status */
   readonly status: 'waiting' | 'starting' | 'inProgress' | 'playing' | 'ended';
 
@@ -268,6 +330,12 @@ export interface RoomState {
 
   /** When room was created */
   readonly createdAt: number;
+
+  /** Round of the match, 0 until the first game starts */
+  readonly round: number;
+
+  /** Rounds won by each player over the match */
+  readonly scores: Readonly<Record<PlayerId, number>>;
 }
 
 /**
@@ -283,6 +351,43 @@ export interface RoomSummary {
   readonly status?: RoomState['status'];
 }
 
+/**
+ * @summary Public snapshot of one game, safe to share with anyone.
+ *
+ * @description
+ * Served over HTTP for share link previews and polling clients. Holds
+ * no player IDs (the host's ID authorizes host actions) and no dealt
+ * roles, only the set of roles in play.
+ */
+export interface PublicGameState {
+  readonly roomCode: RoomCode;
+  readonly roomName?: string;
+  readonly status: RoomState['status'];
+
+  /** Players by name, in seating order */
+  readonly players: ReadonlyArray<{
+    readonly name: string;
+    readonly isReady: boolean;
+    readonly isHost: boolean;
+    readonly isConnected: boolean;
+  }>;
+
+  /** Roles in play, player and center cards together */
+  readonly roles: readonly RoleName[];
+
+  /** Current game phase, or null before the game starts */
+  readonly phase: GamePhase | null;
+
+  /** Seconds left in the current phase, or null if untimed */
+  readonly timeRemaining: number | null;
+
+  /** Votes received per player, once voting has closed */
+  readonly voteCounts: ReadonlyArray<{
+    readonly name: string;
+    readonly votes: number;
+  }>;
+}
+
 // ============================================================================
 // PLAYER VIEW (Information Hiding)
 // ============================================================================
@@ -340,8 +445,8 @@ export interface PlayerGameView {
   /** Current game phase */
   readonly phase: GamePhase;
 
-  /** My starting role (what I was dealt) */
-  readonly myStartingRole: RoleName;
+  /** My starting role (what I was dealt), or null while a Curator's artifact hides it */
+  readonly myStartingRole: RoleName | null;
 
   /** Information learned during night phase */
   readonly myNightInfo: readonly NightActionResult[];
@@ -361,6 +466,12 @@ export interface PlayerGameView {
   /** Final roles (only after game ends) */
   readonly finalRoles: ReadonlyMap<PlayerId, RoleName> | null;
 
+  /** Cards the Revealer left face up (from day onward) */
+  readonly revealedCards?: ReadonlyMap<PlayerId, RoleName>;
+
+  /** Players whose cards carry a Curator's artifact (from day onward) */
+  readonly artifacts?: readonly PlayerId[];
+
   /** Winning teams (only after game ends) */
   readonly winningTeams: readonly Team[] | null;
 
@@ -390,8 +501,8 @@ export interface SerializablePlayerGameView {
   /** Current game phase */
   readonly phase: GamePhase;
 
-  /** My starting role (what I was dealt) */
-  readonly myStartingRole: RoleName;
+  /** My starting role (what I was dealt), or null while a Curator's artifact hides it */
+  readonly myStartingRole: RoleName | null;
 
   /** Information learned during night phase */
   readonly myNightInfo: readonly NightActionResult[];
@@ -411,15 +522,24 @@ export interface SerializablePlayerGameView {
   /** Final roles (only after game ends) - Record for JSON */
   readonly finalRoles: Record<PlayerId, RoleName> | null;
 
+  /** Cards the Revealer left face up (from day onward) - Record for JSON */
+  readonly revealedCards?: Record<PlayerId, RoleName>;
+
+  /** Players whose cards carry a Curator's artifact (from day onward) */
+  readonly artifacts?: readonly PlayerId[];
+
   /** Winning teams (only after game ends) */
   readonly winningTeams: readonly Team[] | null;
 
   /** Winning players (only after game ends) */
   readonly winningPlayers: readonly PlayerId[] | null;
 
-  /** Current phase time remaining in ms */
+  /** Current phase time remaining in seconds */
   readonly timeRemaining: number | null;
 
+  /** Absolute deadline of the current phase (epoch ms), or null if untimed */
+  readonly phaseEndsAt?: number | null;
+
   /** Whether this player was eliminated */
   readonly isEliminated?: boolean;
 
@@ -443,6 +563,9 @@ export interface ActionRequestBase {
 
   /** When request was sent */
   readonly timestamp: number;
+
+  /** Whether 'SKIP' is accepted to pass on an optional night action */
+  readonly canSkip?: boolean;
 }
 
 /**
@@ -481,6 +604,19 @@ export interface SeerChoiceRequest extends ActionRequestBase {
   readonly options: readonly ('player' | 'center')[];
 }
 
+/**
+ * @summary Request for Village Idiot to choose a rotation.
+ */
+export interface RotationChoiceRequest extends ActionRequestBase {
+  readonly actionType: 'rotationChoice';
+
+  /** Available options ('NONE' leaves the cards alone) */
+  readonly options: readonly ('LEFT' | 'RIGHT' | 'NONE')[];
+
+  /** Why the choice is needed */
+  readonly reason: string;
+}
+
 /**
  * @summary Request to select two players (Troublemaker).
  */
@@ -515,6 +651,9 @@ export interface VoteRequest extends ActionRequestBase {
 
   /** All statements for context */
   readonly allStatements: readonly PlayerStatement[];
+
+  /** Whether 'ABSTAIN' is accepted in place of a player ID */
+  readonly canAbstain?: boolean;
 }
 
 /**
@@ -524,6 +663,7 @@ export type ActionRequest =
   | SelectPlayerRequest
   | SelectCenterRequest
   | SeerChoiceRequest
+  | RotationChoiceRequest
   | SelectTwoPlayersRequest
   | StatementRequest
   | VoteRequest;
@@ -628,6 +768,9 @@ export interface PublicRoomInfo {
   /** Maximum players allowed */
   readonly maxPlayers: number;
 
+  /** Whether the room is still in the lobby with a seat free */
+  readonly joinable: boolean;
+
   /** Selected roles (for preview) */
   readonly roles: readonly RoleName[];
 }
@@ -641,6 +784,9 @@ export interface PublicRoomInfo {
  */
 export interface ListPublicRoomsMessage extends TimestampedMessage {
   readonly type: 'listPublicRooms';
+
+  /** Only list rooms with at least this many free seats */
+  readonly minOpenSlots?: number;
 }
 
 /**
@@ -731,6 +877,16 @@ export interface ReadyToVoteMessage extends TimestampedMessage {
   readonly type: 'readyToVote';
 }
 
+/**
+ * @summary Ask for more discussion time during the day phase.
+ *
+ * @description
+ * Granted once the host or a majority of the human players have asked.
+ */
+export interface RequestExtensionMessage extends TimestampedMessage {
+  readonly type: 'requestExtension';
+}
+
 /**
  * @summary Login with email/password via WebSocket.
  */
@@ -776,6 +932,19 @@ export interface GetReplayMessage extends TimestampedMessage {
   readonly gameId: string;
 }
 
+/**
+ * @summary Watch a room without playing.
+ *
+ * @description
+ * Spectators receive the room's public broadcasts, delayed by the
+ * room's spectatorDelayMs. Only allowed when the room has
+ * allowSpectators enabled.
+ */
+export interface SpectateRoomMessage extends TimestampedMessage {
+  readonly type: 'spectateRoom';
+  readonly roomCode: RoomCode;
+}
+
 /**
  * @summary Union of all client message types.
  */
@@ -796,11 +965,13 @@ export type ClientMessage =
   | PingMessage
   | SubmitStatementMessage
   | ReadyToVoteMessage
+  | RequestExtensionMessage
   | LoginMessage
   | RegisterMessage
   | GetStatsMessage
   | GetLeaderboardMessage
-  | GetReplayMessage;
+  | GetReplayMessage
+  | SpectateRoomMessage;
 
 // ============================================================================
 // SERVER → CLIENT MESSAGES
@@ -834,15 +1005,38 @@ export interface ErrorMessage extends TimestampedMessage {
 export interface RoomCreatedMessage extends TimestampedMessage {
   readonly type: 'roomCreated';
   readonly roomCode: RoomCode;
+  /** ID the host plays under in this room */
+  readonly playerId: PlayerId;
+  /** Secret for the HTTP API, sent as X-Player-Token; never shown to others */
+  readonly playerToken: string;
   readonly state: RoomState;
 }
 
 /**
  * @summary Successfully joined room.
+ *
+ * @description
+ * Carries the full lobby state, so a client can render the room
+ * without waiting for the next roomUpdate.
  */
 export interface RoomJoinedMessage extends TimestampedMessage {
   readonly type: 'roomJoined';
+  readonly roomCode: RoomCode;
+  /** ID the joining player plays under in this room */
+  readonly playerId: PlayerId;
+  /** Secret for the HTTP API, sent as X-Player-Token; never shown to others */
+  readonly playerToken: string;
+  readonly state: RoomState;
+}
+
+/**
+ * @summary Successfully started spectating a room.
+ */
+export interface SpectatingMessage extends TimestampedMessage {
+  readonly type: 'spectating';
   readonly state: RoomState;
+  /** Delay applied to spectator broadcasts in milliseconds */
+  readonly delayMs: number;
 }
 
 /**
@@ -876,7 +1070,12 @@ export interface RoomClosedMessage extends TimestampedMessage {
 }
 
 /**
- * @summary Game has started.
+ * @summary Game has started (private to player).
+ *
+ * @description
+ * Sent to each player's own connection before the night begins. The view
+ * carries only the receiving player's starting role; a player who was
+ * offline gets it in the gameState sent when they reconnect.
  */
 export interface GameStartedMessage extends TimestampedMessage {
   readonly type: 'gameStarted';
@@ -887,13 +1086,38 @@ export interface GameStartedMessage extends TimestampedMessage {
 
 /**
  * @summary Game phase changed.
+ *
+ * @description
+ * Sent once per transition (setup to night, night to day, day to voting,
+ * voting to resolution), so clients can drive sounds and view changes
+ * from it instead of diffing game states.
  */
 export interface PhaseChangeMessage extends TimestampedMessage {
   readonly type: 'phaseChange';
   readonly phase: GamePhase;
+  /** Short description of the new phase, from PHASE_DESCRIPTIONS */
+  readonly description: string;
+  /** Seconds remaining in the new phase, or null if untimed */
   readonly timeRemaining: number | null;
+  /** Absolute phase deadline (epoch ms) for drift-free client countdowns */
+  readonly phaseEndsAt?: number | null;
+  /** Cards the Revealer left face up (sent when the day begins) */
+  readonly revealedCards?: Record<PlayerId, RoleName>;
+  /** Players whose cards carry a Curator's artifact (sent when the day begins) */
+  readonly artifacts?: readonly PlayerId[];
 }
 
+/**
+ * @summary What happens in each phase, as sent with phaseChange.
+ */
+export const PHASE_DESCRIPTIONS: Readonly<Record<GamePhase, string>> = {
+  [GamePhase.SETUP]: 'Cards are being dealt',
+  [GamePhase.NIGHT]: 'Night falls - roles wake in turn',
+  [GamePhase.DAY]: 'Day breaks - discuss who the Werewolves are',
+  [GamePhase.VOTING]: 'Vote for who to eliminate',
+  [GamePhase.RESOLUTION]: 'Votes are counted and the winners revealed'
+};
+
 /**
  * @summary Current game state.
  */
@@ -935,6 +1159,19 @@ export interface NightResultMessage extends TimestampedMessage {
   readonly result: NightActionResult;
 }
 
+/**
+ * @summary Everything the player learned overnight, sent at dawn (private to player).
+ *
+ * @description
+ * Carries only the receiving player's own night results. Information
+ * such as the lone werewolf's center peek is delivered this way and
+ * never through a broadcast.
+ */
+export interface DawnSummaryMessage extends TimestampedMessage {
+  readonly type: 'dawnSummary';
+  readonly nightInfo: readonly NightActionResult[];
+}
+
 /**
  * @summary Player made a statement.
  */
@@ -980,11 +1217,72 @@ export interface SerializableGameResult {
   /** Player IDs who were eliminated */
   readonly eliminatedPlayers: readonly PlayerId[];
 
+  /** Role each player was dealt */
+  readonly originalRoles: Record<PlayerId, RoleName>;
+
   /** Final role for each player (after swaps) */
   readonly finalRoles: Record<PlayerId, RoleName>;
 
   /** Vote cast by each player */
   readonly votes: Record<PlayerId, PlayerId>;
+
+  /** Every vote cast, by room player ID, with when it arrived */
+  readonly voteLog?: readonly VoteRecord[];
+
+  /** Who voted for whom, by name, and the votes each player received */
+  readonly voteBreakdown?: VoteBreakdown;
+
+  /** Which roles the reveal leads with */
+  readonly revealEmphasis: RevealEmphasis;
+
+  /** Recommended order of reveal stages */
+  readonly revealSequence: readonly RevealStage[];
+  /** Center cards as dealt */
+  readonly originalCenterCards?: readonly RoleName[];
+  /** Center cards at the end of the night */
+  readonly centerCards: readonly RoleName[];
+  /** Every night action, in the order it happened */
+  readonly nightActions: readonly NightActionSummary[];
+}
+
+/**
+ * @summary One vote, resolved to player names.
+ */
+export interface NamedVote {
+  readonly voterId: PlayerId;
+  readonly voterName: string;
+  /** Player voted for, or ABSTAIN */
+  readonly targetId: PlayerId;
+  readonly targetName: string;
+}
+
+/**
+ * @summary Votes one player received.
+ */
+export interface VoteTally {
+  readonly playerId: PlayerId;
+  readonly playerName: string;
+  readonly votes: number;
+}
+
+/**
+ * @summary How the vote went, for the post-game reveal.
+ *
+ * @description
+ * With anonymous voting, votes is left out and only the tally is shown.
+ */
+export interface VoteBreakdown {
+  /** Whether voter identities were withheld */
+  readonly anonymous: boolean;
+
+  /** Every vote in seating order of the voter (omitted when anonymous) */
+  readonly votes?: readonly NamedVote[];
+
+  /** Players who received votes, most first */
+  readonly tally: readonly VoteTally[];
+
+  /** Votes that went to no one */
+  readonly abstentions: number;
 }
 
 /**
@@ -1006,6 +1304,9 @@ export interface NightActionSummary {
 
   /** Human-readable description of what happened */
   readonly description: string;
+
+  /** When the action was taken (epoch ms) */
+  readonly timestamp?: number;
 }
 
 /**
@@ -1106,7 +1407,7 @@ export interface PlayerTeamAssignment {
  * - Final team assignments
  */
 export interface GameSummary {
-  /** All night actions in wake order */
+  /** All night actions, in the order they happened */
   readonly nightActions: readonly NightActionSummary[];
 
   /** All statements made during day phase (with player names) */
@@ -1176,10 +1477,70 @@ export interface PlayerReadyToVoteMessage extends TimestampedMessage {
   readonly type: 'playerReadyToVote';
   readonly playerId: PlayerId;
   readonly playerName: string;
+  /** Connected human players who are ready */
   readonly readyCount: number;
+  /** Connected human players; voting starts when all are ready */
   readonly totalPlayers: number;
 }
 
+/**
+ * @summary A player asked for more discussion time.
+ */
+export interface ExtensionRequestedMessage extends TimestampedMessage {
+  readonly type: 'extensionRequested';
+  readonly playerId: PlayerId;
+  readonly playerName: string;
+  /** Players asking so far */
+  readonly votes: number;
+  /** Players who must ask before the extension is granted */
+  readonly votesNeeded: number;
+}
+
+/**
+ * @summary The discussion deadline was pushed out.
+ */
+export interface DiscussionExtendedMessage extends TimestampedMessage {
+  readonly type: 'discussionExtended';
+  /** New deadline (epoch ms) */
+  readonly phaseEndsAt: number | null;
+  /** Seconds left until the new deadline */
+  readonly timeRemaining: number | null;
+  /** Extensions the table can still ask for this day */
+  readonly extensionsLeft: number;
+}
+
+/**
+ * @summary Another vote arrived while voting is open.
+ *
+ * @description
+ * Never carries the target. With a secret ballot the voter is left
+ * out as well, so the table only sees how many votes are in.
+ */
+export interface VoteProgressMessage extends TimestampedMessage {
+  readonly type: 'voteProgress';
+  /** Votes in so far this round */
+  readonly votedCount: number;
+  /** Players voting this round */
+  readonly totalVoters: number;
+  /** Player whose vote arrived (omitted with a secret ballot) */
+  readonly voterId?: PlayerId;
+}
+
+/**
+ * @summary Votes tied under the 'revote' tie-break; a new round starts.
+ *
+ * @description
+ * Every player then gets a new vote request whose eligible targets are
+ * limited to the tied players.
+ */
+export interface RevoteStartedMessage extends TimestampedMessage {
+  readonly type: 'revoteStarted';
+  /** Players tied on the most votes */
+  readonly tiedPlayerIds: readonly PlayerId[];
+  /** Deadline for the new round (epoch ms), or null if untimed */
+  readonly phaseEndsAt: number | null;
+}
+
 // ============================================================================
 // AUTHENTICATION RESPONSE MESSAGES
 // ============================================================================
@@ -1447,6 +1808,7 @@ export type ServerMessage =
   | ActionAcknowledgedMessage
   | ActionTimeoutMessage
   | NightResultMessage
+  | DawnSummaryMessage
   | StatementMadeMessage
   | VotesRevealedMessage
   | EliminationMessage
@@ -1455,34 +1817,117 @@ export type ServerMessage =
   | PlayerReconnectedMessage
   | PongMessage
   | PlayerReadyToVoteMessage
+  | ExtensionRequestedMessage
+  | DiscussionExtendedMessage
+  | VoteProgressMessage
+  | RevoteStartedMessage
   | LoginResponseMessage
   | RegisterResponseMessage
   | StatsResponseMessage
   | LeaderboardResponseMessage
-  | ReplayResponseMessage;
+  | ReplayResponseMessage
+  | SpectatingMessage;
 
 // ============================================================================
 // TYPE GUARDS
 // ============================================================================
 
 /**
- * @summary Checks if a message is a valid ClientMessage.
+ * @summary Every message type a client may send.
  */
-export function isClientMessage(data: unknown): data is ClientMessage {
-  if (typeof data !== 'object' || data === null) {
-    return false;
+const CLIENT_MESSAGE_TYPES: readonly ClientMessage['type'][] = [
+  'authenticate', 'disconnect', 'createRoom', 'joinRoom', 'listPublicRooms', 'leaveRoom',
+  'setReady', 'addAI', 'removePlayer', 'startGame', 'actionResponse',
+  'getState', 'ping', 'submitStatement', 'readyToVote', 'requestExtension',
+  'login', 'register', 'getStats', 'getLeaderboard', 'getReplay',
+  'updateRoomConfig', 'spectateRoom'
+];
+
+/** Shape a required message field must have */
+type FieldKind = 'string' | 'boolean' | 'object' | 'present';
+
+/**
+ * @summary Required fields of client messages, by message type.
+ *
+ * @description
+ * Only the shape is checked here; handlers still check the values.
+ * 'present' accepts any value, including null.
+ */
+const REQUIRED_CLIENT_FIELDS: Partial<Record<ClientMessage['type'], Record<string, FieldKind>>> = {
+  authenticate: { playerId: 'string' },
+  createRoom: { config: 'object' },
+  updateRoomConfig: { config: 'object' },
+  joinRoom: { roomCode: 'string' },
+  setReady: { ready: 'boolean' },
+  removePlayer: { playerId: 'string' },
+  actionResponse: { requestId: 'string', response: 'present' },
+  submitStatement: { statement: 'string' },
+  login: { email: 'string', password: 'string' },
+  register: { email: 'string', password: 'string', displayName: 'string' },
+  getReplay: { gameId: 'string' },
+  spectateRoom: { roomCode: 'string' }
+};
+
+const FIELD_KIND_NAMES: Record<FieldKind, string> = {
+  string: 'a string',
+  boolean: 'true or false',
+  object: 'an object',
+  present: 'present'
+};
+
+/**
+ * @summary Checks that data has the structure of a ClientMessage.
+ *
+ * @description
+ * Lets the server tell a client exactly what was wrong with a message
+ * it dropped: an unknown type, or a missing or mistyped field.
+ *
+ * @param {unknown} data - Parsed message
+ *
+ * @returns {string | null} What is wrong with the message, or null if it is well formed
+ *
+ * @example
+ * ```typescript
+ * validateClientMessage({ type: 'setReady', ready: 'yes' });
+ * // 'Malformed setReady message: ready must be true or false'
+ * ```
+ */
+export function validateClientMessage(data: unknown): string | null {
+  if (typeof data !== 'object' || data === null || Array.isArray(data)) {
+    return 'Message must be a JSON object';
   }
 
   const msg = data as Record<string, unknown>;
-  const validTypes: ClientMessage['type'][] = [
-    'authenticate', 'disconnect', 'createRoom', 'joinRoom', 'listPublicRooms', 'leaveRoom',
-    'setReady', 'addAI', 'removePlayer', 'startGame', 'actionResponse',
-    'getState', 'ping', 'submitStatement', 'readyToVote',
-    'login', 'register', 'getStats', 'getLeaderboard', 'getReplay',
-    'updateRoomConfig'
-  ];
+  if (typeof msg.type !== 'string') {
+    return 'Message has no type';
+  }
 
-  return typeof msg.type === 'string' && validTypes.includes(msg.type as ClientMessage['type']);
+  const type = msg.type as ClientMessage['type'];
+  if (!CLIENT_MESSAGE_TYPES.includes(type)) {
+    return `Unknown message type: ${msg.type}`;
+  }
+
+  for (const [field, kind] of Object.entries(REQUIRED_CLIENT_FIELDS[type] ?? {})) {
+    const value = msg[field];
+    const valid = kind === 'present'
+      ? field in msg
+      : kind === 'object'
+        ? typeof value === 'object' && value !== null && !Array.isArray(value)
+        : typeof value === kind;
+
+    if (!valid) {
+      return `Malformed ${type} message: ${field} must be ${FIELD_KIND_NAMES[kind]}`;
+    }
+  }
+
+  return null;
+}
+
+/**
+ * @summary Checks if a message is a valid ClientMessage.
+ */
+export function isClientMessage(data: unknown): data is ClientMessage {
+  return validateClientMessage(data) === null;
 }
 
 /**
@@ -1497,10 +1942,12 @@ export function isServerMessage(data: unknown): data is ServerMessage {
   const validTypes: ServerMessage['type'][] = [
     'authenticated', 'error', 'roomCreated', 'roomJoined', 'roomUpdate',
     'roomClosed', 'gameStarted', 'phaseChange', 'gameState', 'actionRequired',
-    'actionAcknowledged', 'actionTimeout', 'nightResult', 'statementMade',
+    'actionAcknowledged', 'actionTimeout', 'nightResult', 'dawnSummary', 'statementMade',
     'votesRevealed', 'elimination', 'gameEnd', 'playerDisconnected',
-    'playerReconnected', 'pong', 'playerReadyToVote',
-    'loginResponse', 'registerResponse', 'statsResponse', 'leaderboardResponse', 'replayResponse'
+    'playerReconnected', 'pong', 'playerReadyToVote', 'extensionRequested',
+    'discussionExtended', 'voteProgress', 'revoteStarted',
+    'loginResponse', 'registerResponse', 'statsResponse', 'leaderboardResponse', 'replayResponse',
+    'spectating'
   ];
 
   return typeof msg.type === 'string' && validTypes.includes(msg.type as ServerMessage['type']);
@@ -1547,6 +1994,7 @@ export const ErrorCodes = {
   AUTH_INVALID: 'AUTH_INVALID',
   AUTH_EXPIRED: 'AUTH_EXPIRED',
   NOT_AUTHENTICATED: 'NOT_AUTHENTICATED',
+  INVALID_NAME: 'INVALID_NAME',
 
   // Room errors
   ROOM_NOT_FOUND: 'ROOM_NOT_FOUND',
@@ -1554,6 +2002,8 @@ export const ErrorCodes = {
   ROOM_STARTED: 'ROOM_STARTED',
   ROOM_CLOSED: 'ROOM_CLOSED',
   ALREADY_IN_ROOM: 'ALREADY_IN_ROOM',
+  REMOVED_FROM_ROOM: 'REMOVED_FROM_ROOM',
+  SPECTATORS_NOT_ALLOWED: 'SPECTATORS_NOT_ALLOWED',
 
   // Permission errors
   NOT_HOST: 'NOT_HOST',
@@ -1568,13 +2018,49 @@ export const ErrorCodes = {
   NOT_YOUR_TURN: 'NOT_YOUR_TURN',
   ACTION_TIMEOUT: 'ACTION_TIMEOUT',
 
+  // REST API errors
+  GAME_NOT_FOUND: 'GAME_NOT_FOUND',
+  GAME_NOT_FINISHED: 'GAME_NOT_FINISHED',
+  PLAYER_NOT_FOUND: 'PLAYER_NOT_FOUND',
+  ENDPOINT_NOT_FOUND: 'ENDPOINT_NOT_FOUND',
+  INVALID_REQUEST: 'INVALID_REQUEST',
+  PAYLOAD_TOO_LARGE: 'PAYLOAD_TOO_LARGE',
+  SERVICE_UNAVAILABLE: 'SERVICE_UNAVAILABLE',
+
   // Special
   AI_TAKEOVER: 'AI_TAKEOVER',
 
   // General
   INVALID_MESSAGE: 'INVALID_MESSAGE',
   INTERNAL_ERROR: 'INTERNAL_ERROR',
-  RATE_LIMITED: 'RATE_LIMITED'
+  RATE_LIMITED: 'RATE_LIMITED',
+  SERVER_RESTARTING: 'SERVER_RESTARTING'
 } as const;
 
 export type ErrorCode = typeof ErrorCodes[keyof typeof ErrorCodes];
+
+// ============================================================================
+// CLOSE CODES
+// ============================================================================
+
+/**
+ * @summary WebSocket close codes the server ends a connection with.
+ *
+ * @description
+ * Codes 4000-4999 are reserved for applications, so a client can tell
+ * why it was disconnected from the close frame alone, even if the
+ * message sent before it was lost. Any other close (1000 for a normal
+ * one, 1006 for a dropped network) carries no game meaning.
+ */
+export const CloseCodes = {
+  /** The host deleted the game; there is nothing to rejoin */
+  GAME_DELETED: 4000,
+
+  /** The host removed the player from the lobby; they may join another room */
+  KICKED: 4001,
+
+  /** The server is shutting down; reconnecting after a short wait should work */
+  SERVER_SHUTDOWN: 4002
+} as const;
+
+export type CloseCode = typeof CloseCodes[keyof typeof CloseCodes];
@@ -26,6 +26,7 @@
  */
 
 import { GamePhase, RoleName } from '../../enums';
+import { DEFAULT_CENTER_CARD_COUNT } from '../../types';
 
 /**
  * @summary Types of network commands.
@@ -146,6 +147,9 @@ export interface NetworkCommandValidationContext {
 
   /** Valid options for selection (if applicable) */
   validOptions?: string[] | number[];
+
+  /** Number of center cards (defaults to DEFAULT_CENTER_CARD_COUNT) */
+  centerCardCount?: number;
 }
 
 /**
@@ -180,6 +184,153 @@ export function resetCommandIdCounter(): void {
   commandIdCounter = 0;
 }
 
+/**
+ * @summary Checks that a decoded target list is an array of player IDs.
+ *
+ * @description
+ * Client payloads arrive as untyped JSON, so a malformed message could
+ * carry `targets` as a string, object, or null. Decoders call this before
+ * constructing a command so the type assertion on the payload is safe.
+ *
+ * @param {unknown} value - Raw decoded value
+ * @param {number} [expectedLength] - Required number of entries, if fixed
+ *
+ * @returns {NetworkCommandValidationResult} Validation result
+ *
+ * @example
+ * ```typescript
+ * validateTargetList(['player-2', 'player-3'], 2); // { valid: true }
+ * validateTargetList('player-2', 2);               // { valid: false, ... }
+ * ```
+ */
+export function validateTargetList(
+  value: unknown,
+  expectedLength?: number
+): NetworkCommandValidationResult {
+  if (!Array.isArray(value)) {
+    return { valid: false, error: 'Targets must be an array of player IDs.' };
+  }
+
+  if (!value.every(target => typeof target === 'string')) {
+    return { valid: false, error: 'Every target must be a player ID string.' };
+  }
+
+  if (expectedLength !== undefined && value.length !== expectedLength) {
+    return {
+      valid: false,
+      error: `Expected ${expectedLength} targets, received ${value.length}.`
+    };
+  }
+
+  return { valid: true };
+}
+
+/**
+ * @summary Checks that a decoded center selection is an array of indices.
+ *
+ * @param {unknown} value - Raw decoded value
+ * @param {number} [expectedLength] - Required number of entries, if fixed
+ *
+ * @returns {NetworkCommandValidationResult} Validation result
+ */
+export function validateIndexList(
+  value: unknown,
+  expectedLength?: number
+): NetworkCommandValidationResult {
+  if (!Array.isArray(value)) {
+    return { valid: false, error: 'Center indices must be an array of numbers.' };
+  }
+
+  if (!value.every(index => typeof index === 'number' && Number.isInteger(index))) {
+    return { valid: false, error: 'Every center index must be an integer.' };
+  }
+
+  if (expectedLength !== undefined && value.length !== expectedLength) {
+    return {
+      valid: false,
+      error: `Expected ${expectedLength} center indices, received ${value.length}.`
+    };
+  }
+
+  return { valid: true };
+}
+
+/**
+ * @summary Checks a decoded action response has the shape its action requires.
+ *
+ * @description
+ * Multi-target actions expect a JSON array. Without this check a
+ * malformed payload (e.g. a bare string) would be destructured as if it
+ * were a tuple and produce nonsense targets. Both the request type a
+ * NetworkAgent sends ('selectTwoCenter') and the command type
+ * ('selectTwoCenters') are accepted for the two-center choice.
+ *
+ * @param {string} actionType - Type of action that was requested
+ * @param {unknown} response - Raw decoded response value
+ *
+ * @returns {NetworkCommandValidationResult} Validation result
+ *
+ * @example
+ * ```typescript
+ * validateResponseShape('selectTwoCenter', [0, 2]); // { valid: true }
+ * validateResponseShape('rotationChoice', 'UP');    // { valid: false, ... }
+ * ```
+ */
+export function validateResponseShape(
+  actionType: string,
+  response: unknown
+): NetworkCommandValidationResult {
+  switch (actionType) {
+    case 'selectTwoPlayers':
+      return validateTargetList(response, 2);
+
+    case 'selectTwoCenter':
+    case 'selectTwoCenters':
+      return validateIndexList(response, 2);
+
+    case 'rotationChoice':
+      return response === 'LEFT' || response === 'RIGHT' || response === 'NONE'
+        ? { valid: true }
+        : { valid: false, error: 'Rotation must be LEFT, RIGHT or NONE' };
+
+    default:
+      return { valid: true };
+  }
+}
+
+/**
+ * @summary Checks that a center index names one of the game's center cards.
+ *
+ * @param {number} index - Decoded index
+ * @param {number} centerCardCount - Number of center cards in the game
+ *
+ * @returns {boolean} True if the index is in range
+ *
+ * @private
+ */
+function isCenterIndex(index: number, centerCardCount: number): boolean {
+  return Number.isInteger(index) && index >= 0 && index < centerCardCount;
+}
+
+/**
+ * @summary Checks that a night action arrives while the game is in the Night phase.
+ *
+ * @param {NetworkCommandValidationContext} context - Validation context
+ *
+ * @returns {NetworkCommandValidationResult} Validation result
+ */
+export function validateNightPhase(
+  context: NetworkCommandValidationContext
+): NetworkCommandValidationResult {
+  if (context.phase !== GamePhase.NIGHT) {
+    return {
+      valid: false,
+      error: 'Night actions can only be taken during the Night phase.'
+    };
+  }
+  return { valid: true };
+}
+
 /**
  * @summary Abstract base class for network commands.
  *
@@ -270,6 +421,11 @@ export class SelectPlayerCommand extends AbstractNetworkCommand {
   }
 
   validate(context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+    const phaseResult = validateNightPhase(context);
+    if (!phaseResult.valid) {
+      return phaseResult;
+    }
+
     if (context.validOptions) {
       const options = context.validOptions as string[];
       if (!options.includes(this.targetId)) {
@@ -299,7 +455,7 @@ export class SelectCenterCommand extends AbstractNetworkCommand {
    *
    * @param {string} playerId - Player making the selection
    * @param {string} gameId - Game ID
-   * @param {number} centerIndex - Selected center card index (0-2)
+   * @param {number} centerIndex - Selected center card index
    */
   constructor(
     playerId: string,
@@ -314,10 +470,16 @@ export class SelectCenterCommand extends AbstractNetworkCommand {
   }
 
   validate(context: NetworkCommandValidationContext): NetworkCommandValidationResult {
-    if (this.centerIndex < 0 || this.centerIndex > 2) {
+    const phaseResult = validateNightPhase(context);
+    if (!phaseResult.valid) {
+      return phaseResult;
+    }
+
+    const count = context.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT;
+    if (!isCenterIndex(this.centerIndex, count)) {
       return {
         valid: false,
-        error: `Invalid center index: ${this.centerIndex}. Must be 0, 1, or 2.`
+        error: `Invalid center index: ${this.centerIndex}. Must be from 0 to ${count - 1}.`
       };
     }
     return { valid: true };
@@ -354,13 +516,19 @@ export class SelectTwoCentersCommand extends AbstractNetworkCommand {
     return { indices: this.indices };
   }
 
-  validate(_context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+  validate(context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+    const phaseResult = validateNightPhase(context);
+    if (!phaseResult.valid) {
+      return phaseResult;
+    }
+
     const [idx1, idx2] = this.indices;
 
-    if (idx1 < 0 || idx1 > 2 || idx2 < 0 || idx2 > 2) {
+    const count = context.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT;
+    if (!isCenterIndex(idx1, count) || !isCenterIndex(idx2, count)) {
       return {
         valid: false,
-        error: `Invalid center indices: [${idx1}, ${idx2}]. Must be 0, 1, or 2.`
+        error: `Invalid center indices: [${idx1}, ${idx2}]. Must be from 0 to ${count - 1}.`
       };
     }
 
@@ -406,6 +574,11 @@ export class SelectTwoPlayersCommand extends AbstractNetworkCommand {
   }
 
   validate(context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+    const phaseResult = validateNightPhase(context);
+    if (!phaseResult.valid) {
+      return phaseResult;
+    }
+
     const [target1, target2] = this.targets;
 
     if (target1 === target2) {
@@ -462,7 +635,12 @@ export class SeerChoiceCommand extends AbstractNetworkCommand {
     return { choice: this.choice };
   }
 
-  validate(_context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+  validate(context: NetworkCommandValidationContext): NetworkCommandValidationResult {
+    const phaseResult = validateNightPhase(context);
+    if (!phaseResult.valid) {
+      return phaseResult;
+    }
+
     if (this.choice !== 'player' && this.choice !== 'center') {
       return {
         valid: false,
@@ -598,7 +776,7 @@ export class NetworkCommandFactory {
    *
    * @returns {INetworkCommand} Reconstructed command object
    *
-   * @throws {Error} If command type is unknown
+   * @throws {Error} If command type is unknown or a target list is malformed
    *
    * @example
    * ```typescript
@@ -629,6 +807,7 @@ export class NetworkCommandFactory {
         );
 
       case 'selectTwoCenters':
+        NetworkCommandFactory.assertValid(validateIndexList(data.payload.indices, 2));
         return new SelectTwoCentersCommand(
           data.playerId,
           data.gameId,
@@ -636,6 +815,7 @@ export class NetworkCommandFactory {
         );
 
       case 'selectTwoPlayers':
+        NetworkCommandFactory.assertValid(validateTargetList(data.payload.targets, 2));
         return new SelectTwoPlayersCommand(
           data.playerId,
           data.gameId,
@@ -668,6 +848,21 @@ export class NetworkCommandFactory {
     }
   }
 
+  /**
+   * @summary Throws if a payload field failed decode-time validation.
+   *
+   * @param {NetworkCommandValidationResult} result - Field validation result
+   *
+   * @throws {Error} With the validation message if invalid
+   *
+   * @private
+   */
+  private static assertValid(result: NetworkCommandValidationResult): void {
+    if (!result.valid) {
+      throw new Error(result.error);
+    }
+  }
+
   /**
    * @summary Validates serialized command data structure.
    *
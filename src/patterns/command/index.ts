@@ -68,5 +68,9 @@ export {
   NetworkCommandFactory,
 
   // Utilities
-  resetCommandIdCounter
+  resetCommandIdCounter,
+  validateTargetList,
+  validateNightPhase,
+  validateIndexList,
+  validateResponseShape
 } from './NetworkCommand';
@@ -51,18 +51,28 @@
  * ```
  */
 
-import { RoleName, Team } from '../../enums';
-import { Role, ROLE_TEAMS, NIGHT_ORDERS, ROLE_DESCRIPTIONS } from '../../core/Role';
+import { RoleName, Team, NIGHT_WAKE_ORDER, WEREWOLF_ROLES } from '../../enums';
+import { Role, ROLE_TEAMS, NIGHT_ORDERS, ROLE_DESCRIPTIONS, ROLE_DISPLAY_NAMES } from '../../core/Role';
+import { DEFAULT_CENTER_CARD_COUNT } from '../../types';
 import {
   INightAction,
+  SentinelAction,
   DoppelgangerAction,
   WerewolfAction,
+  AlphaWolfAction,
+  MysticWolfAction,
   MinionAction,
+  SquireAction,
   MasonAction,
   SeerAction,
+  BeholderAction,
+  ApprenticeSeerAction,
   RobberAction,
   TroublemakerAction,
+  VillageIdiotAction,
   DrunkAction,
+  RevealerAction,
+  CuratorAction,
   InsomniacAction,
   NoAction
 } from '../strategy';
@@ -77,6 +87,69 @@ import {
  */
 export type NightActionFactory = () => INightAction;
 
+/**
+ * @summary The static role definitions checked at startup.
+ *
+ * @description
+ * Every role constant needs a team and a night order, and every role
+ * that wakes needs a place in the wake order and a registered action.
+ * Entries are loosely typed so a half-added or mistyped role can be
+ * described (and rejected) by validateRoleTable.
+ */
+export interface RoleTable {
+  /** Values of every role constant */
+  readonly roleNames: readonly string[];
+
+  /** Team of each role */
+  readonly teams: Readonly<Record<string, Team | undefined>>;
+
+  /** Night order of each role (-1 if it does not wake) */
+  readonly nightOrders: Readonly<Record<string, number | undefined>>;
+
+  /** Roles that wake, in the order they wake */
+  readonly wakeOrder: readonly string[];
+
+  /** Roles with a registered night action */
+  readonly actionRoles: readonly string[];
+}
+
+/**
+ * @summary What clients need to know to describe a role.
+ */
+export interface RoleCatalogEntry {
+  /** Stable machine name (the RoleName value) */
+  readonly role: RoleName;
+
+  /** Name to show players */
+  readonly displayName: string;
+
+  /** Team the role wins with */
+  readonly team: Team;
+
+  /** One-line summary of the role's ability */
+  readonly description: string;
+
+  /** Whether the role wakes during the night */
+  readonly hasNightAction: boolean;
+
+  /** Position in the night wake order (1 = first), or null if it does not wake */
+  readonly nightOrder: number | null;
+}
+
+/**
+ * @summary The role list a game of a given size is dealt by default.
+ */
+export interface RoleListPreview {
+  /** Number of players */
+  readonly playerCount: number;
+
+  /** Every card, players + 3 */
+  readonly roles: RoleName[];
+
+  /** How many of each role, in first-appearance order */
+  readonly counts: { role: RoleName; count: number }[];
+}
+
 /**
  * @summary Factory for creating Role instances.
  *
@@ -240,20 +313,31 @@ export class RoleFactory {
     }
 
     // Register all default night actions
+    RoleFactory.registerAction(RoleName.SENTINEL, () => new SentinelAction());
     RoleFactory.registerAction(RoleName.DOPPELGANGER, () => new DoppelgangerAction());
     RoleFactory.registerAction(RoleName.WEREWOLF, () => new WerewolfAction());
+    RoleFactory.registerAction(RoleName.ALPHA_WOLF, () => new AlphaWolfAction());
+    RoleFactory.registerAction(RoleName.MYSTIC_WOLF, () => new MysticWolfAction());
     RoleFactory.registerAction(RoleName.MINION, () => new MinionAction());
+    RoleFactory.registerAction(RoleName.SQUIRE, () => new SquireAction());
     RoleFactory.registerAction(RoleName.MASON, () => new MasonAction());
     RoleFactory.registerAction(RoleName.SEER, () => new SeerAction());
+    RoleFactory.registerAction(RoleName.BEHOLDER, () => new BeholderAction());
+    RoleFactory.registerAction(RoleName.APPRENTICE_SEER, () => new ApprenticeSeerAction());
     RoleFactory.registerAction(RoleName.ROBBER, () => new RobberAction());
     RoleFactory.registerAction(RoleName.TROUBLEMAKER, () => new TroublemakerAction());
+    RoleFactory.registerAction(RoleName.VILLAGE_IDIOT, () => new VillageIdiotAction());
     RoleFactory.registerAction(RoleName.DRUNK, () => new DrunkAction());
+    RoleFactory.registerAction(RoleName.REVEALER, () => new RevealerAction());
+    RoleFactory.registerAction(RoleName.CURATOR, () => new CuratorAction());
     RoleFactory.registerAction(RoleName.INSOMNIAC, () => new InsomniacAction());
 
     // Roles without night actions use Null Object Pattern
     // These are registered with NoAction factory
+    RoleFactory.registerAction(RoleName.DREAM_WOLF, () => new NoAction(RoleName.DREAM_WOLF));
     RoleFactory.registerAction(RoleName.VILLAGER, () => new NoAction(RoleName.VILLAGER));
     RoleFactory.registerAction(RoleName.HUNTER, () => new NoAction(RoleName.HUNTER));
+    RoleFactory.registerAction(RoleName.PRINCE, () => new NoAction(RoleName.PRINCE));
     RoleFactory.registerAction(RoleName.TANNER, () => new NoAction(RoleName.TANNER));
 
     RoleFactory.initialized = true;
@@ -285,7 +369,7 @@ export class RoleFactory {
    * ```typescript
    * const werewolf = RoleFactory.createRole(RoleName.WEREWOLF);
    * console.log(werewolf.team); // Team.WEREWOLF
-   * console.log(werewolf.nightOrder); // 2
+   * console.log(werewolf.nightOrder); // 3
    * ```
    */
   static createRole(roleName: RoleName): Role {
@@ -397,7 +481,7 @@ export class RoleFactory {
    * @example
    * ```typescript
    * const werewolfTeamRoles = RoleFactory.getRolesByTeam(Team.WEREWOLF);
-   * // [WEREWOLF, MINION]
+   * // [WEREWOLF, ALPHA_WOLF, MYSTIC_WOLF, MINION, SQUIRE, DREAM_WOLF]
    * ```
    */
   static getRolesByTeam(team: Team): RoleName[] {
@@ -414,7 +498,7 @@ export class RoleFactory {
    * @example
    * ```typescript
    * const nightRoles = RoleFactory.getNightActionRoles();
-   * // [DOPPELGANGER, WEREWOLF, MINION, MASON, SEER, ROBBER, TROUBLEMAKER, DRUNK, INSOMNIAC]
+   * // [SENTINEL, DOPPELGANGER, WEREWOLF, ALPHA_WOLF, MYSTIC_WOLF, MINION, SQUIRE, MASON, SEER, BEHOLDER, APPRENTICE_SEER, ROBBER, TROUBLEMAKER, VILLAGE_IDIOT, DRUNK, REVEALER, CURATOR, INSOMNIAC]
    * ```
    */
   static getNightActionRoles(): RoleName[] {
@@ -429,11 +513,14 @@ export class RoleFactory {
    *
    * @description
    * Checks that:
-   * - Number of roles equals players + 3 (for center)
+   * - Number of roles equals players + center cards, plus the Werewolf
+   *   set aside for an Alpha Wolf
    * - If Masons are used, both are included
+   * - An Alpha Wolf is alone and has a Werewolf card to set aside
    *
    * @param {RoleName[]} roles - Roles to validate
    * @param {number} playerCount - Number of players
+   * @param {number} [centerCardCount=3] - Number of center cards
    *
    * @returns {{ valid: boolean; errors: string[] }} Validation result
    *
@@ -449,14 +536,22 @@ export class RoleFactory {
    * }
    * ```
    */
-  static validateSetup(roles: RoleName[], playerCount: number): {
+  static validateSetup(
+    roles: RoleName[],
+    playerCount: number,
+    centerCardCount: number = DEFAULT_CENTER_CARD_COUNT
+  ): {
     valid: boolean;
     errors: string[];
   } {
     const errors: string[] = [];
 
+    if (!Number.isInteger(centerCardCount) || centerCardCount < 1) {
+      errors.push(`Center card count must be a positive whole number, got ${centerCardCount}`);
+    }
+
     // Check role count
-    const expectedRoles = playerCount + 3;
+    const expectedRoles = playerCount + centerCardCount + RoleFactory.getSetAsideCardCount(roles);
     if (roles.length !== expectedRoles) {
       errors.push(
         `Expected ${expectedRoles} roles for ${playerCount} players, got ${roles.length}`
@@ -469,9 +564,523 @@ export class RoleFactory {
       errors.push('Masons must be used in pairs (0 or 2)');
     }
 
+    // The Alpha Wolf hands out a plain Werewolf card kept in the center
+    const alphaWolfCount = roles.filter(r => r === RoleName.ALPHA_WOLF).length;
+    if (alphaWolfCount > 1) {
+      errors.push('Only one Alpha Wolf can be used');
+    }
+    if (alphaWolfCount > 0 && !roles.includes(RoleName.WEREWOLF)) {
+      errors.push('The Alpha Wolf needs a Werewolf card to set aside in the center');
+    }
+
+    return {
+      valid: errors.length === 0,
+      errors
+    };
+  }
+
+  /**
+   * @summary Validates a role list chosen by a host.
+   *
+   * @description
+   * Host lists come from clients, so on top of validateSetup this checks
+   * that every entry is a known role and that the Werewolf count (Mystic
+   * Wolves included) makes for a playable game: at least one, and no more
+   * than half the players (or two, for small games).
+   *
+   * With `partial`, only the role names are checked; a partial list is
+   * completed later and validated again then. Without a player count,
+   * the list is assumed to be complete for roles.length minus the
+   * center cards.
+   *
+   * @param {readonly string[]} roles - Role names to validate
+   * @param {object} [options] - Validation options
+   * @param {number} [options.playerCount] - Number of players the list is for
+   * @param {number} [options.centerCardCount=3] - Number of center cards
+   * @param {boolean} [options.partial] - Whether the list will be completed later
+   *
+   * @returns {{ valid: boolean; errors: string[] }} Validation result
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.validateRoleList(['WEREWOLF', 'SEER', 'WITCH'], { partial: true });
+   * // { valid: false, errors: ['Unknown role WITCH'] }
+   * ```
+   */
+  static validateRoleList(
+    roles: readonly string[],
+    options: { playerCount?: number; centerCardCount?: number; partial?: boolean } = {}
+  ): { valid: boolean; errors: string[] } {
+    const known = new Set<string>(RoleFactory.getAllRoleNames());
+    const unknown = [...new Set(roles.filter(role => !known.has(role)))];
+    const errors = unknown.map(role => `Unknown role ${role}`);
+
+    if (options.partial || unknown.length > 0) {
+      return { valid: errors.length === 0, errors };
+    }
+
+    const centerCardCount = options.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT;
+    const setAside = RoleFactory.getSetAsideCardCount(roles);
+    const playerCount = options.playerCount ?? roles.length - centerCardCount - setAside;
+    errors.push(...RoleFactory.validateSetup(roles as RoleName[], playerCount, centerCardCount).errors);
+
+    // The Alpha Wolf's set-aside Werewolf is only dealt if they give it out
+    const werewolves = roles.filter(role => WEREWOLF_ROLES.has(role as RoleName)).length - setAside;
+    const maxWerewolves = Math.max(2, Math.floor(playerCount / 2));
+    if (werewolves === 0) {
+      errors.push('The role list needs at least one Werewolf');
+    } else if (werewolves > maxWerewolves) {
+      errors.push(
+        `Too many Werewolves for ${playerCount} players: ${werewolves} chosen, at most ${maxWerewolves}`
+      );
+    }
+
+    return { valid: errors.length === 0, errors };
+  }
+
+  /**
+   * @summary Validates a custom night wake order.
+   *
+   * @description
+   * A house order may move roles around but must still wake every role
+   * that has a night action, exactly once, and nothing else. Roles not
+   * dealt in a given game are skipped when the night runs, so the order
+   * does not have to match the role list.
+   *
+   * The Doppelganger must wake before the Werewolves and the Minion, so
+   * a Doppelganger who copies a Werewolf is already in the pack when
+   * they look for each other.
+   *
+   * @param {readonly string[]} order - Role names in the order they wake
+   *
+   * @returns {{ valid: boolean; errors: string[] }} Validation result
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.validateNightOrder(['WITCH', ...RoleFactory.getNightActionRoles()]);
+   * // { valid: false, errors: ['Unknown role WITCH in night order'] }
+   * ```
+   */
+  static validateNightOrder(order: readonly string[]): { valid: boolean; errors: string[] } {
+    const known = new Set<string>(RoleFactory.getAllRoleNames());
+    const nightRoles = RoleFactory.getNightActionRoles();
+    const errors: string[] = [];
+
+    const seen = new Set<string>();
+    for (const role of order) {
+      if (!known.has(role)) {
+        errors.push(`Unknown role ${role} in night order`);
+      } else if (!nightRoles.includes(role as RoleName)) {
+        errors.push(`${role} has no night action and cannot be in the night order`);
+      } else if (seen.has(role)) {
+        errors.push(`${role} appears more than once in the night order`);
+      }
+      seen.add(role);
+    }
+
+    const missing = nightRoles.filter(role => !seen.has(role));
+    if (missing.length > 0) {
+      errors.push(`Night order is missing ${missing.join(', ')}`);
+    }
+
+    const doppelganger = order.indexOf(RoleName.DOPPELGANGER);
+    const wokenFirst = [...WEREWOLF_ROLES, RoleName.MINION, RoleName.SQUIRE]
+      .filter(role => order.includes(role) && order.indexOf(role) < doppelganger);
+    if (doppelganger !== -1 && wokenFirst.length > 0) {
+      errors.push(`DOPPELGANGER must wake before ${wokenFirst.join(', ')}`);
+    }
+
+    return { valid: errors.length === 0, errors };
+  }
+
+  /**
+   * @summary Describes every role for clients.
+   *
+   * @description
+   * Night action flags and positions come from NIGHT_WAKE_ORDER, the
+   * same list the night phase walks, so turn indicators built from the
+   * catalog always match the real wake order.
+   *
+   * @returns {RoleCatalogEntry[]} One entry per role
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.getRoleCatalog().find(r => r.role === RoleName.SEER);
+   * // { role: SEER, displayName: 'Seer', team: VILLAGE, hasNightAction: true, nightOrder: 9, ... }
+   * ```
+   */
+  static getRoleCatalog(): RoleCatalogEntry[] {
+    return RoleFactory.getAllRoleNames().map(role => {
+      const wakeIndex = NIGHT_WAKE_ORDER.indexOf(role);
+      return {
+        role,
+        displayName: ROLE_DISPLAY_NAMES[role],
+        team: ROLE_TEAMS[role],
+        description: ROLE_DESCRIPTIONS[role],
+        hasNightAction: wakeIndex !== -1,
+        nightOrder: wakeIndex !== -1 ? wakeIndex + 1 : null
+      };
+    });
+  }
+
+  // =========================================================================
+  // ROLE TABLE VALIDATION
+  // =========================================================================
+
+  /**
+   * @summary Gets the role table the factory builds roles from.
+   *
+   * @returns {RoleTable} Role constants, teams, night orders, wake order and actions
+   */
+  static getRoleTable(): RoleTable {
+    return {
+      roleNames: RoleFactory.getAllRoleNames(),
+      teams: ROLE_TEAMS,
+      nightOrders: NIGHT_ORDERS,
+      wakeOrder: NIGHT_WAKE_ORDER,
+      actionRoles: RoleFactory.getRegisteredRoles()
+    };
+  }
+
+  /**
+   * @summary Checks that the role table is internally consistent.
+   *
+   * @description
+   * Checks that:
+   * - Every role constant is non-empty and unique
+   * - Every role has a valid team and a night order
+   * - No team or night order is defined for an unknown role
+   * - Roles that wake appear exactly once in the wake order, and roles
+   *   that don't wake do not appear in it
+   * - The wake order follows the night orders, with no two roles sharing one
+   * - Every role that wakes has a registered night action
+   *
+   * @param {RoleTable} [table] - Table to check (defaults to the live table)
+   *
+   * @returns {{ valid: boolean; errors: string[] }} Validation result
+   *
+   * @example
+   * ```typescript
+   * const result = RoleFactory.validateRoleTable();
+   * if (!result.valid) {
+   *   console.error(result.errors);
+   * }
+   * ```
+   */
+  static validateRoleTable(table: RoleTable = RoleFactory.getRoleTable()): {
+    valid: boolean;
+    errors: string[];
+  } {
+    const errors: string[] = [];
+    const teams = new Set<string>(Object.values(Team));
+    const seen = new Set<string>();
+
+    for (const name of table.roleNames) {
+      if (name.trim() === '') {
+        errors.push('Role constant has an empty value');
+        continue;
+      }
+
+      if (seen.has(name)) {
+        errors.push(`Role ${name} is defined more than once`);
+        continue;
+      }
+      seen.add(name);
+
+      const team = table.teams[name];
+      if (team === undefined || !teams.has(team)) {
+        errors.push(`Role ${name} has no valid team`);
+      }
+
+      const nightOrder = table.nightOrders[name];
+      if (nightOrder === undefined) {
+        errors.push(`Role ${name} has no night order`);
+        continue;
+      }
+
+      const wakeCount = table.wakeOrder.filter(r => r === name).length;
+      if (nightOrder > 0 && wakeCount !== 1) {
+        errors.push(`Role ${name} wakes at ${nightOrder} but appears ${wakeCount} times in the wake order`);
+      }
+      if (nightOrder <= 0 && wakeCount > 0) {
+        errors.push(`Role ${name} does not wake but is in the wake order`);
+      }
+      if (nightOrder > 0 && !table.actionRoles.includes(name)) {
+        errors.push(`Role ${name} wakes but has no registered night action`);
+      }
+    }
+
+    for (const name of [...Object.keys(table.teams), ...Object.keys(table.nightOrders), ...table.wakeOrder]) {
+      if (!seen.has(name)) {
+        errors.push(`Unknown role ${name} in role table`);
+        seen.add(name);
+      }
+    }
+
+    for (let i = 1; i < table.wakeOrder.length; i++) {
+      const previous = table.wakeOrder[i - 1];
+      const current = table.wakeOrder[i];
+      const previousOrder = table.nightOrders[previous];
+      const currentOrder = table.nightOrders[current];
+
+      if (previousOrder !== undefined && currentOrder !== undefined && previousOrder >= currentOrder) {
+        errors.push(`Wake order puts ${previous} (${previousOrder}) before ${current} (${currentOrder})`);
+      }
+    }
+
     return {
       valid: errors.length === 0,
       errors
     };
   }
+
+  /**
+   * @summary Fails fast if the role table is inconsistent.
+   *
+   * @description
+   * Called at server startup so a half-added role is caught before any
+   * game is dealt, rather than surfacing as a silently skipped night
+   * action mid-game.
+   *
+   * @param {RoleTable} [table] - Table to check (defaults to the live table)
+   *
+   * @throws {Error} Listing every problem found
+   */
+  static assertValidRoleTable(table: RoleTable = RoleFactory.getRoleTable()): void {
+    const { valid, errors } = RoleFactory.validateRoleTable(table);
+    if (!valid) {
+      throw new Error(`Inconsistent role table:\n  ${errors.join('\n  ')}`);
+    }
+  }
+
+  // =========================================================================
+  // ROLE LIST GENERATION
+  // =========================================================================
+
+  /**
+   * @summary Generates a balanced default role list for a player count.
+   *
+   * @description
+   * Starts from a core of two Werewolves plus Seer, Robber and
+   * Troublemaker, then adds roles from a fixed priority list until
+   * there are players + center cards. Matches the lobby's default selection.
+   *
+   * @param {number} playerCount - Number of players
+   * @param {number} [centerCardCount=3] - Number of center cards
+   *
+   * @returns {RoleName[]} Role list of length playerCount + centerCardCount
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.generateRoleList(5);
+   * // [WEREWOLF, WEREWOLF, SEER, ROBBER, TROUBLEMAKER, VILLAGER, DRUNK, INSOMNIAC]
+   * ```
+   */
+  static generateRoleList(
+    playerCount: number,
+    centerCardCount: number = DEFAULT_CENTER_CARD_COUNT
+  ): RoleName[] {
+    const totalRoles = playerCount + centerCardCount;
+    const roles = [...RoleFactory.BASE_ROLES];
+
+    for (const role of RoleFactory.FILL_PRIORITY) {
+      if (roles.length >= totalRoles) {
+        break;
+      }
+      roles.push(role);
+    }
+
+    // Pad any remaining slots (very large games) with Villagers
+    while (roles.length < totalRoles) {
+      roles.push(RoleName.VILLAGER);
+    }
+
+    return roles.slice(0, totalRoles);
+  }
+
+  /**
+   * @summary Previews the roles a game of a given size would be dealt.
+   *
+   * @description
+   * Uses the same path as a room in 'fill' mode whose host picked no
+   * roles: completeRoleSet([], playerCount). That keeps Masons paired,
+   * so the preview always matches what the game actually deals.
+   *
+   * @param {number} playerCount - Number of players (MIN_PLAYERS to MAX_PLAYERS)
+   *
+   * @returns {RoleListPreview} Roles and per-role counts
+   *
+   * @throws {Error} If the player count is out of range
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.previewRoleList(5).counts;
+   * // [{ role: WEREWOLF, count: 2 }, { role: SEER, count: 1 }, ...]
+   * ```
+   */
+  static previewRoleList(playerCount: number): RoleListPreview {
+    if (
+      !Number.isInteger(playerCount) ||
+      playerCount < RoleFactory.MIN_PLAYERS ||
+      playerCount > RoleFactory.MAX_PLAYERS
+    ) {
+      throw new Error(
+        `Player count must be a whole number from ${RoleFactory.MIN_PLAYERS} to ${RoleFactory.MAX_PLAYERS}`
+      );
+    }
+
+    const roles = RoleFactory.completeRoleSet([], playerCount);
+    const counts: { role: RoleName; count: number }[] = [];
+    for (const role of roles) {
+      const entry = counts.find(c => c.role === role);
+      if (entry) {
+        entry.count++;
+      } else {
+        counts.push({ role, count: 1 });
+      }
+    }
+
+    return { playerCount, roles, counts };
+  }
+
+  /**
+   * @summary Completes a partial role set to players + center cards.
+   *
+   * @description
+   * Keeps every role the host chose, then walks the default list from
+   * generateRoleList and adds each role the partial set has fewer copies
+   * of, so a set with no wolves still gets the standard two. Masons are
+   * only added as a pair. Remaining slots are Villagers.
+   *
+   * @param {readonly RoleName[]} partial - Roles explicitly chosen by the host
+   * @param {number} playerCount - Number of players
+   * @param {number} [centerCardCount=3] - Number of center cards
+   *
+   * @returns {RoleName[]} Completed role list
+   *
+   * @throws {Error} If the partial set already exceeds players + center cards
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.completeRoleSet([RoleName.SEER, RoleName.TANNER], 5);
+   * // [SEER, TANNER, WEREWOLF, WEREWOLF, ROBBER, TROUBLEMAKER, VILLAGER, DRUNK]
+   * ```
+   */
+  static completeRoleSet(
+    partial: readonly RoleName[],
+    playerCount: number,
+    centerCardCount: number = DEFAULT_CENTER_CARD_COUNT
+  ): RoleName[] {
+    const totalRoles = playerCount + centerCardCount + RoleFactory.getSetAsideCardCount(partial);
+
+    if (partial.length > totalRoles) {
+      throw new Error(
+        `Too many roles for ${playerCount} players: ${partial.length} chosen, ${totalRoles} allowed`
+      );
+    }
+
+    const roles = [...partial];
+    const countOf = (list: readonly RoleName[], role: RoleName): number =>
+      list.filter(r => r === role).length;
+
+    // A lone Mason needs its partner before anything else
+    if (countOf(roles, RoleName.MASON) === 1 && roles.length < totalRoles) {
+      roles.push(RoleName.MASON);
+    }
+
+    const template = RoleFactory.generateRoleList(playerCount, centerCardCount);
+    for (const role of template) {
+      if (roles.length >= totalRoles) {
+        break;
+      }
+
+      if (role === RoleName.MASON) {
+        if (countOf(roles, RoleName.MASON) === 0 && totalRoles - roles.length >= 2) {
+          roles.push(RoleName.MASON, RoleName.MASON);
+        }
+        continue;
+      }
+
+      if (countOf(roles, role) < countOf(template, role)) {
+        roles.push(role);
+      }
+    }
+
+    while (roles.length < totalRoles) {
+      roles.push(RoleName.VILLAGER);
+    }
+
+    return roles;
+  }
+
+  /**
+   * @summary Counts the cards a role list sets aside outside the center.
+   *
+   * @description
+   * An Alpha Wolf needs a plain Werewolf card kept apart from the normal
+   * center cards, so a role list with one is a card longer than
+   * players + center cards.
+   *
+   * @param {readonly string[]} roles - Role list
+   *
+   * @returns {number} 1 if the list has an Alpha Wolf, otherwise 0
+   *
+   * @example
+   * ```typescript
+   * RoleFactory.getSetAsideCardCount([RoleName.ALPHA_WOLF, RoleName.WEREWOLF, ...]); // 1
+   * ```
+   */
+  static getSetAsideCardCount(roles: readonly string[]): number {
+    return roles.includes(RoleName.ALPHA_WOLF) ? 1 : 0;
+  }
+
+  /**
+   * @summary Fewest players a game supports.
+   * @static
+   */
+  static readonly MIN_PLAYERS = 3;
+
+  /**
+   * @summary Most players a game supports.
+   *
+   * @description
+   * Rooms can raise their player cap as far as this for house variants;
+   * larger default role lists are padded with Villagers.
+   *
+   * @static
+   */
+  static readonly MAX_PLAYERS = 16;
+
+  /**
+   * @summary Roles every generated list starts with.
+   * @private
+   * @static
+   */
+  private static readonly BASE_ROLES: readonly RoleName[] = [
+    RoleName.WEREWOLF,
+    RoleName.WEREWOLF,
+    RoleName.SEER,
+    RoleName.ROBBER,
+    RoleName.TROUBLEMAKER
+  ];
+
+  /**
+   * @summary Order in which additional roles are added as the game grows.
+   * @private
+   * @static
+   */
+  private static readonly FILL_PRIORITY: readonly RoleName[] = [
+    RoleName.VILLAGER,
+    RoleName.DRUNK,
+    RoleName.INSOMNIAC,
+    RoleName.MASON,
+    RoleName.MASON,
+    RoleName.MINION,
+    RoleName.HUNTER,
+    RoleName.APPRENTICE_SEER,
+    RoleName.TANNER,
+    RoleName.VILLAGER,
+    RoleName.DOPPELGANGER,
+    RoleName.VILLAGER
+  ];
 }
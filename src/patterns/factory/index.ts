@@ -34,4 +34,4 @@
  * ```
  */
 
-export { RoleFactory } from './RoleFactory';
+export { RoleFactory, RoleTable, RoleListPreview, RoleCatalogEntry } from './RoleFactory';
@@ -141,6 +141,9 @@ export class ConsoleObserver implements IGameObserver {
       case 'VOTE_CAST':
         return this.formatVote(event.data);
 
+      case 'REVOTE_STARTED':
+        return this.formatRevote(event.data);
+
       case 'GAME_ENDED':
         return this.formatGameEnded(event.data);
 
@@ -194,6 +197,15 @@ export class ConsoleObserver implements IGameObserver {
     return `${data.voterId} votes for ${data.targetId}`;
   }
 
+  /**
+   * @summary Formats REVOTE_STARTED event.
+   * @private
+   */
+  private formatRevote(data: Record<string, unknown>): string {
+    const tied = data.tiedPlayerIds as string[];
+    return `Tie between ${tied.join(', ')}. Voting again`;
+  }
+
   /**
    * @summary Formats GAME_ENDED event.
    * @private
@@ -229,6 +241,7 @@ export class ConsoleObserver implements IGameObserver {
       'NIGHT_ACTION_EXECUTED': '\x1b[35m', // Magenta
       'STATEMENT_MADE': '\x1b[33m',   // Yellow
       'VOTE_CAST': '\x1b[34m',        // Blue
+      'REVOTE_STARTED': '\x1b[34m',   // Blue
       'GAME_ENDED': '\x1b[32m',       // Green
       'ERROR': '\x1b[31m'             // Red
     };
@@ -24,6 +24,7 @@
  * - NIGHT_ACTION_EXECUTED
  * - STATEMENT_MADE
  * - VOTE_CAST
+ * - VOTE_SUBMITTED
  * - GAME_ENDED
  * - ERROR
  *
@@ -246,6 +247,7 @@ export class GameEventEmitter {
    * @param {string} roleName - Role that performed the action
    * @param {string} actionType - Type of action (VIEW, SWAP, NONE)
    * @param {Record<string, unknown>} details - Action-specific details
+   * @param {number} [durationMs] - Time from waking the player to the action finishing
    *
    * @example
    * ```typescript
@@ -256,12 +258,13 @@ export class GameEventEmitter {
     actorId: string,
     roleName: string,
     actionType: string,
-    details: Record<string, unknown>
+    details: Record<string, unknown>,
+    durationMs?: number
   ): void {
     this.emit({
       type: 'NIGHT_ACTION_EXECUTED',
       timestamp: Date.now(),
-      data: { actorId, roleName, actionType, details }
+      data: { actorId, roleName, actionType, details, durationMs }
     });
   }
 
@@ -303,6 +306,46 @@ export class GameEventEmitter {
     });
   }
 
+  /**
+   * @summary Creates and emits a vote submitted event.
+   *
+   * @description
+   * Sent as each vote arrives, before voting closes. Carries only who
+   * voted; targets are announced with VOTE_CAST once every vote is in.
+   *
+   * @param {string} voterId - Player whose vote arrived
+   *
+   * @example
+   * ```typescript
+   * emitter.emitVoteSubmitted('player1');
+   * ```
+   */
+  emitVoteSubmitted(voterId: string): void {
+    this.emit({
+      type: 'VOTE_SUBMITTED',
+      timestamp: Date.now(),
+      data: { voterId }
+    });
+  }
+
+  /**
+   * @summary Creates and emits a revote event.
+   *
+   * @param {string[]} tiedPlayerIds - Players tied on the most votes
+   *
+   * @example
+   * ```typescript
+   * emitter.emitRevote(['player2', 'player4']);
+   * ```
+   */
+  emitRevote(tiedPlayerIds: string[]): void {
+    this.emit({
+      type: 'REVOTE_STARTED',
+      timestamp: Date.now(),
+      data: { tiedPlayerIds }
+    });
+  }
+
   /**
    * @summary Creates and emits a game ended event.
    *
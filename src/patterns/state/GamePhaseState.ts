@@ -35,7 +35,7 @@
  * ```
  */
 
-import { GamePhase } from '../../enums';
+import { GamePhase, RoleName } from '../../enums';
 
 /**
  * Forward declaration of Game class to avoid circular dependencies.
@@ -54,6 +54,12 @@ export interface IGameContext {
   /** Get roles in the game */
   getRolesInGame(): string[];
 
+  /** Get the number of cards dealt to the center */
+  getCenterCardCount(): number;
+
+  /** Get the roles with night actions in the order they wake */
+  getNightOrder(): readonly RoleName[];
+
   /** Execute night actions for a specific role order position */
   executeNightActionsForRole(roleOrder: number): Promise<void>;
 
@@ -6,7 +6,7 @@
  *
  * @description
  * The Night phase is where the core gameplay mechanics occur:
- * - Roles wake in a specific order (1-9)
+ * - Roles wake in a specific order (NIGHT_WAKE_ORDER, or the host's own)
  * - Each role performs their unique ability
  * - Cards may be viewed or swapped
  * - Players learn information based on their role
@@ -35,7 +35,7 @@
  * ```
  */
 
-import { GamePhase, NIGHT_WAKE_ORDER } from '../../enums';
+import { GamePhase, DOPPEL_INSOMNIAC_ORDER } from '../../enums';
 import {
   AbstractGamePhaseState,
   IGamePhaseState,
@@ -131,7 +131,7 @@ export class NightPhase extends AbstractGamePhaseState {
 
     context.logAuditEvent('NIGHT_STARTED', {
       phase: this.getName(),
-      wakeOrder: NIGHT_WAKE_ORDER,
+      wakeOrder: context.getNightOrder(),
       timestamp: Date.now()
     });
   }
@@ -140,7 +140,7 @@ export class NightPhase extends AbstractGamePhaseState {
    * @summary Executes the night phase.
    *
    * @description
-   * Iterates through all role wake orders (1-9) and executes
+   * Iterates through all role wake orders in turn and executes
    * night actions for any players with roles at that order.
    *
    * The order is critical for game correctness:
@@ -154,14 +154,17 @@ export class NightPhase extends AbstractGamePhaseState {
    * @remarks
    * Each role order is processed once, even if multiple players
    * have the same role (e.g., two Werewolves both see each other
-   * at order 2).
+   * at order 3).
    *
    * @example
    * ```typescript
    * await nightPhase.execute(gameContext);
-   * // Order 1: Doppelganger acts
-   * // Order 2: All Werewolves see each other
-   * // Order 3: Minion sees Werewolves
+   * // Order 1: Sentinel shields a card
+   * // Order 2: Doppelganger acts
+   * // Order 3: All Werewolves see each other
+   * // Order 4: Alpha Wolf gives the center Werewolf card to a player
+   * // Order 5: Mystic Wolf sees Werewolves and views a player
+   * // Order 6: Minion sees Werewolves
    * // ... etc.
    * ```
    */
@@ -170,8 +173,8 @@ export class NightPhase extends AbstractGamePhaseState {
       timestamp: Date.now()
     });
 
-    // Execute night actions in order (1 through 9, plus 10 for Doppel-Insomniac)
-    for (let order = 1; order <= 10; order++) {
+    // Execute night actions in wake order, plus a final slot for Doppel-Insomniac
+    for (let order = 1; order <= DOPPEL_INSOMNIAC_ORDER; order++) {
       if (this.processedOrders.has(order)) {
         continue; // Already processed (shouldn't happen normally)
       }
@@ -20,8 +20,10 @@
  * - **Werewolf team wins** if no Werewolves die
  * - **Minion** wins with Werewolves (and can die without losing)
  * - **Tanner wins** if Tanner dies (Werewolves CANNOT win if Tanner dies)
- * - **Special**: If no Werewolves in game, Village wins if Minion dies OR no one dies
- * - **Special**: If everyone gets exactly 1 vote, no one dies
+ * - **Special**: If no Werewolves in game, Village wins if only the Minion dies OR no one dies;
+ *   anyone else dying is a win for the Minion
+ * - **Special**: If no one gets more than 1 vote (or everyone abstains), no one dies
+ * - **Special**: The Prince can't be killed by the vote, and no one dies in their place
  *
  * @example
  * ```typescript
@@ -142,8 +144,8 @@ export class ResolutionPhase extends AbstractGamePhaseState {
    *
    * @remarks
    * The resolution logic handles several edge cases:
-   * - If all players get exactly 1 vote, no one dies
-   * - If there's a tie for most votes, all tied players die
+   * - If no player reaches the kill threshold (2 votes by default), no one dies
+   * - If there's a tie for most votes, the tie-break mode decides (all die by default)
    * - If Hunter dies, their vote target also dies
    * - Win conditions check CURRENT roles, not starting roles
    *
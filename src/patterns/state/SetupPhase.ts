@@ -22,7 +22,7 @@
  * ```
  */
 
-import { GamePhase } from '../../enums';
+import { GamePhase, RoleName } from '../../enums';
 import {
   AbstractGamePhaseState,
   IGamePhaseState,
@@ -146,18 +146,23 @@ export class SetupPhase extends AbstractGamePhaseState {
   async execute(context: IGameContext): Promise<void> {
     const playerIds = context.getPlayerIds();
     const roles = context.getRolesInGame();
+    const centerCards = context.getCenterCardCount();
+
+    // An Alpha Wolf's Werewolf card is set aside on top of the center
+    const setAside = roles.includes(RoleName.ALPHA_WOLF) ? 1 : 0;
+    const expectedRoles = playerIds.length + centerCards + setAside;
 
     // Validate setup
-    if (roles.length !== playerIds.length + 3) {
+    if (roles.length !== expectedRoles) {
       throw new Error(
-        `Invalid setup: Expected ${playerIds.length + 3} roles for ${playerIds.length} players, got ${roles.length}`
+        `Invalid setup: Expected ${expectedRoles} roles for ${playerIds.length} players, got ${roles.length}`
       );
     }
 
     context.logAuditEvent('SETUP_COMPLETE', {
       playerCount: playerIds.length,
       roleCount: roles.length,
-      centerCards: 3,
+      centerCards,
       timestamp: Date.now()
     });
   }
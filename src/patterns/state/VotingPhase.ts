@@ -9,14 +9,17 @@
  * - All players simultaneously point at who they want to eliminate
  * - The player(s) with the most votes are eliminated
  * - In case of ties, all tied players are eliminated
- * - Special rule: If all players receive exactly 1 vote, no one dies
+ * - Special rule: If no player receives more than 1 vote, no one dies
+ *   (the default kill threshold of 2 votes; see GameConfig.minVotesToKill)
  *
  * @pattern State Pattern - Concrete State for Voting phase
  *
  * @remarks
  * Voting is simultaneous to prevent last-vote manipulation.
- * Each player must vote for exactly one other player (or themselves
- * in rare strategic situations). Players cannot abstain.
+ * Each player votes for exactly one other player (or themselves
+ * in rare strategic situations). There is no way to choose to
+ * abstain, but a player who hasn't voted when the voting time
+ * limit runs out is left out of the tally.
  *
  * @example
  * ```typescript
@@ -33,8 +33,30 @@
  * ```
  */
 
-import { RoleName } from '../../enums';
-import { NightActionResult, NightActionContext } from '../../types';
+import { NIGHT_WAKE_ORDER, RoleName, WEREWOLF_ROLES } from '../../enums';
+import { TimeoutError } from '../../players/IPlayer';
+import {
+  NightActionResult,
+  NightActionContext,
+  NightActionFailureCode,
+  RotationChoice,
+  RotationDirection,
+  getNightActionFailureKind
+} from '../../types';
+
+/**
+ * @summary Error an agent throws to pass on its night action.
+ *
+ * @description
+ * Thrown from any agent choice method. Optional actions turn it into a
+ * no-op result; mandatory ones let it propagate like any other failure.
+ */
+export class NightActionSkippedError extends Error {
+  constructor(playerId: string) {
+    super(`${playerId} skipped their night action`);
+    this.name = 'NightActionSkippedError';
+  }
+}
 
 /**
  * Forward declaration for game state access during night actions.
@@ -44,9 +66,15 @@ export interface INightActionGameState {
   /** Get a player's current role */
   getPlayerRole(playerId: string): RoleName;
 
+  /** Look at a player's card for a night action; throws if the card is shielded */
+  viewPlayerCard(playerId: string): RoleName;
+
   /** Get a center card role */
   getCenterCard(index: number): RoleName;
 
+  /** Number of cards in the center */
+  getCenterCardCount(): number;
+
   /** Swap two card positions */
   swapCards(pos1: CardPosition, pos2: CardPosition): void;
 
@@ -64,6 +92,24 @@ export interface INightActionGameState {
 
   /** Get all Doppelgangers who copied a specific role (e.g., WEREWOLF) */
   getDoppelgangersWhoCopied(role: RoleName): string[];
+
+  /** Place a Sentinel shield on a player's card (called by SentinelAction) */
+  shieldPlayer(playerId: string): void;
+
+  /** Whether a player's card is shielded (shielded cards can't be moved or viewed) */
+  isShielded(playerId: string): boolean;
+
+  /** Leave a player's card face up for the day (called by RevealerAction) */
+  revealCard(playerId: string): void;
+
+  /** Place an artifact on a player's card (called by CuratorAction) */
+  placeArtifact(playerId: string): void;
+
+  /** Card in the Alpha Wolf's center slot, or null if the game has none */
+  getAlphaWolfCard(): RoleName | null;
+
+  /** Swap the Alpha Wolf's center card with a player's card (called by AlphaWolfAction) */
+  swapAlphaWolfCard(playerId: string): void;
 }
 
 /**
@@ -93,9 +139,9 @@ export interface INightActionAgent {
   selectPlayer(options: string[], context: NightActionContext): Promise<string>;
 
   /**
-   * Select a center card (0, 1, or 2).
+   * Select a center card.
    * @param context Context about why selection is needed
-   * @returns Center card index (0-2)
+   * @returns Center card index, below context.centerCardCount
    */
   selectCenterCard(context: NightActionContext): Promise<number>;
 
@@ -113,6 +159,13 @@ export interface INightActionAgent {
    */
   chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
 
+  /**
+   * Choose which way to move every player's card (Village Idiot choice).
+   * @param context Context about why selection is needed
+   * @returns 'LEFT', 'RIGHT', or 'NONE' to leave the cards alone
+   */
+  chooseRotation(context: NightActionContext): Promise<RotationChoice>;
+
   /**
    * Select two different players (for Troublemaker).
    * @param options Available player IDs to choose from
@@ -150,13 +203,12 @@ export interface INightActionAgent {
  * ```typescript
  * class SeerAction implements INightAction {
  *   getRoleName(): RoleName { return RoleName.SEER; }
- *   getNightOrder(): number { return 5; }
  *
  *   async execute(context, agent, gameState): Promise<NightActionResult> {
  *     const choice = await agent.chooseSeerOption(context);
  *     if (choice === 'player') {
  *       const targetId = await agent.selectPlayer(options, context);
- *       const role = gameState.getPlayerRole(targetId);
+ *       const role = gameState.viewPlayerCard(targetId);
  *       return { viewed: [{ playerId: targetId, role }], ... };
  *     }
  *     // ... handle center viewing
@@ -181,31 +233,31 @@ export interface INightAction {
    * @summary Gets the night wake order for this action.
    *
    * @description
-   * Returns the position in the night wake sequence (1-9).
-   * Returns -1 for roles with no night action.
-   *
-   * @returns {number} Night order (1-9) or -1 if no night action
+   * Returns the role's position in NIGHT_WAKE_ORDER (1-based), or -1
+   * for roles with no night action.
    *
-   * @remarks
-   * Night order determines when the role acts:
-   * 1. Doppelganger
-   * 2. Werewolf
-   * 3. Minion
-   * 4. Mason
-   * 5. Seer
-   * 6. Robber
-   * 7. Troublemaker
-   * 8. Drunk
-   * 9. Insomniac
+   * @returns {number} Night order (1-based) or -1 if no night action
    *
    * @example
    * ```typescript
-   * seerAction.getNightOrder(); // 5
+   * seerAction.getNightOrder(); // 9
    * villagerAction.getNightOrder(); // -1
    * ```
    */
   getNightOrder(): number;
 
+  /**
+   * @summary Whether the player may pass on this action.
+   *
+   * @description
+   * Optional actions ("you may...") accept SKIP_NIGHT_ACTION in place of
+   * a choice and record a no-op result. Mandatory ones, like the Robber
+   * and Drunk, must be carried out.
+   *
+   * @returns {boolean} True if the action can be skipped
+   */
+  isOptional(): boolean;
+
   /**
    * @summary Executes the night action.
    *
@@ -272,7 +324,6 @@ export interface INightAction {
  * ```typescript
  * class SeerAction extends AbstractNightAction {
  *   getRoleName(): RoleName { return RoleName.SEER; }
- *   getNightOrder(): number { return 5; }
  *   getDescription(): string { return "Look at one player's card OR two center cards"; }
  *
  *   protected async doExecute(context, agent, gameState): Promise<NightActionResult> {
@@ -289,10 +340,14 @@ export abstract class AbstractNightAction implements INightAction {
   abstract getRoleName(): RoleName;
 
   /**
-   * @summary Gets the night order.
-   * @abstract Must be implemented by concrete classes.
+   * @summary Gets the night order from the role's place in NIGHT_WAKE_ORDER.
+   *
+   * @returns {number} Night order (1-based) or -1 if the role doesn't wake
    */
-  abstract getNightOrder(): number;
+  getNightOrder(): number {
+    const index = NIGHT_WAKE_ORDER.indexOf(this.getRoleName());
+    return index === -1 ? -1 : index + 1;
+  }
 
   /**
    * @summary Gets the action description.
@@ -305,7 +360,8 @@ export abstract class AbstractNightAction implements INightAction {
    *
    * @description
    * Template method that validates context before delegating
-   * to the concrete implementation.
+   * to the concrete implementation. If the agent passes on an
+   * optional action the result is a successful no-op.
    *
    * @param {NightActionContext} context - What the player knows
    * @param {INightActionAgent} agent - Decision-maker for choices
@@ -324,7 +380,32 @@ export abstract class AbstractNightAction implements INightAction {
     this.validateContext(context);
 
     // Delegate to concrete implementation
-    return this.doExecute(context, agent, gameState);
+    try {
+      return await this.doExecute(context, agent, gameState);
+    } catch (error) {
+      if (error instanceof NightActionSkippedError && this.isOptional()) {
+        return {
+          actorId: context.myPlayerId,
+          roleName: this.getRoleName(),
+          actionType: 'NONE',
+          success: true,
+          info: { skipped: true }
+        };
+      }
+      throw error;
+    }
+  }
+
+  /**
+   * @summary Whether the player may pass on this action.
+   *
+   * @description
+   * Actions are mandatory unless a subclass says otherwise.
+   *
+   * @returns {boolean} False by default
+   */
+  isOptional(): boolean {
+    return false;
   }
 
   /**
@@ -400,26 +481,234 @@ export abstract class AbstractNightAction implements INightAction {
    * @summary Creates a failure result.
    *
    * @description
-   * Helper method for creating failed action results.
+   * Helper method for creating failed action results. The failure kind
+   * (rejected input vs rule-based failure) is derived from the code.
    *
    * @param {string} actorId - The actor's player ID
    * @param {string} error - Error message
+   * @param {NightActionFailureCode} code - Why the action did not succeed
    *
    * @returns {NightActionResult} Complete failure result
    *
    * @protected
    */
-  protected createFailureResult(actorId: string, error: string): NightActionResult {
+  protected createFailureResult(
+    actorId: string,
+    error: string,
+    code: NightActionFailureCode
+  ): NightActionResult {
     return {
       actorId,
       roleName: this.getRoleName(),
       actionType: 'NONE',
       success: false,
       info: {},
-      error
+      error,
+      failureCode: code,
+      failureKind: getNightActionFailureKind(code)
     };
   }
 
+  /**
+   * @summary Checks a chosen center card index.
+   *
+   * @description
+   * Valid indices run from 0 to one less than the number of center
+   * cards in this game, so no action assumes there are exactly three.
+   *
+   * @param {number} index - Index chosen by the agent
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {string | null} Why the index is invalid, or null if it is valid
+   *
+   * @protected
+   */
+  protected validateCenterIndex(index: number, gameState: INightActionGameState): string | null {
+    const count = gameState.getCenterCardCount();
+    if (Number.isInteger(index) && index >= 0 && index < count) {
+      return null;
+    }
+    return `Invalid center card index: ${index}. Must be from 0 to ${count - 1}.`;
+  }
+
+  /**
+   * @summary Asks for a center card, picking one at random if none is given.
+   *
+   * @description
+   * For mandatory swaps like the Drunk's. If the agent chooses an
+   * invalid index, or never answers (its request rejects with a
+   * TimeoutError), a random center card is used so the swap still
+   * happens. Any other failure, such as the game being cancelled,
+   * propagates.
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<number>} A valid center card index
+   *
+   * @protected
+   */
+  protected async selectCenterCardOrRandom(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<number> {
+    let centerIndex: number | null = null;
+    try {
+      centerIndex = await agent.selectCenterCard(context);
+    } catch (error) {
+      if (!(error instanceof TimeoutError)) {
+        throw error;
+      }
+      // No answer by the end of the turn - choose for them below
+    }
+
+    if (centerIndex === null || this.validateCenterIndex(centerIndex, gameState) !== null) {
+      return Math.floor(Math.random() * gameState.getCenterCardCount());
+    }
+    return centerIndex;
+  }
+
+  /**
+   * @summary Finds every player who wakes as a Werewolf.
+   *
+   * @description
+   * Uses STARTING roles, so swaps earlier in the night don't change who
+   * the pack is. Covers every role in WEREWOLF_ROLES plus Doppelgangers
+   * who copied one of them (Doppelganger wakes before them, so they are known).
+   *
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {string[]} Player IDs of the Werewolf pack
+   *
+   * @protected
+   */
+  protected findWerewolves(gameState: INightActionGameState): string[] {
+    const werewolfRoles = [...WEREWOLF_ROLES];
+    return [
+      ...werewolfRoles.flatMap(role => gameState.getPlayersWithStartingRole(role)),
+      ...werewolfRoles.flatMap(role => gameState.getDoppelgangersWhoCopied(role))
+    ];
+  }
+
+  /**
+   * @summary Finds every player who is the Seer.
+   *
+   * @description
+   * Uses STARTING roles, like findWerewolves, plus any Doppelganger who
+   * copied the Seer.
+   *
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {string[]} Player IDs of the Seers
+   *
+   * @protected
+   */
+  protected findSeers(gameState: INightActionGameState): string[] {
+    return [
+      ...gameState.getPlayersWithStartingRole(RoleName.SEER),
+      ...gameState.getDoppelgangersWhoCopied(RoleName.SEER)
+    ];
+  }
+
+  /**
+   * @summary Creates the failure result for a target whose card is shielded.
+   *
+   * @description
+   * A Sentinel's shield stops any later role from moving or viewing the
+   * card. The action fails as a rule outcome rather than a bad input, so
+   * clients tell the player instead of asking again.
+   *
+   * @param {string} actorId - The actor's player ID
+   * @param {string} playerId - The shielded player
+   *
+   * @returns {NightActionResult} TARGET_SHIELDED failure result
+   *
+   * @protected
+   */
+  protected createShieldedResult(actorId: string, playerId: string): NightActionResult {
+    return this.createFailureResult(
+      actorId,
+      `${playerId}'s card is shielded by the Sentinel`,
+      'TARGET_SHIELDED'
+    );
+  }
+
+  /**
+   * @summary Finds the first of the given players whose card is shielded.
+   *
+   * @param {INightActionGameState} gameState - Game state to check
+   * @param {...string} playerIds - Players whose cards the action would read or move
+   *
+   * @returns {string | undefined} The shielded player, if any
+   *
+   * @protected
+   */
+  protected findShieldedPlayer(
+    gameState: INightActionGameState,
+    ...playerIds: string[]
+  ): string | undefined {
+    return playerIds.find(id => gameState.isShielded(id));
+  }
+
+  /**
+   * @summary The shield check every action makes before it reads or moves a card.
+   *
+   * @description
+   * Call with every player whose card the action is about to look at,
+   * copy or swap (including the actor's own card for a swap with self).
+   *
+   * @param {string} actorId - The actor's player ID
+   * @param {INightActionGameState} gameState - Game state to check
+   * @param {...string} playerIds - Players whose cards the action would read or move
+   *
+   * @returns {NightActionResult | null} TARGET_SHIELDED failure, or null if none is shielded
+   *
+   * @protected
+   */
+  protected checkShielded(
+    actorId: string,
+    gameState: INightActionGameState,
+    ...playerIds: string[]
+  ): NightActionResult | null {
+    const shieldedId = this.findShieldedPlayer(gameState, ...playerIds);
+    return shieldedId === undefined ? null : this.createShieldedResult(actorId, shieldedId);
+  }
+
+  /**
+   * @summary Moves every player's card one seat in the given direction.
+   *
+   * @description
+   * Players are taken in the given (seat) order. Shielded cards stay
+   * where they are and the rotation closes over the gap. Done as a chain
+   * of adjacent swaps, so it goes through the normal swap path.
+   *
+   * @param {INightActionGameState} gameState - Game state access
+   * @param {readonly string[]} playerIds - All players, in seat order
+   * @param {RotationDirection} direction - LEFT passes each card to the previous player
+   *
+   * @returns {string[]} Players whose cards were rotated, in seat order
+   *
+   * @protected
+   */
+  protected rotatePlayerCards(
+    gameState: INightActionGameState,
+    playerIds: readonly string[],
+    direction: RotationDirection
+  ): string[] {
+    const ring = playerIds.filter(id => !gameState.isShielded(id));
+
+    // LEFT bubbles the first card to the end, RIGHT bubbles the last to the front
+    const indices = ring.slice(1).map((_, i) => i);
+    const steps = direction === 'LEFT' ? indices : indices.reverse();
+    for (const i of steps) {
+      gameState.swapCards({ playerId: ring[i] }, { playerId: ring[i + 1] });
+    }
+
+    return ring;
+  }
+
   /**
    * @summary Gets the action type for this night action.
    *
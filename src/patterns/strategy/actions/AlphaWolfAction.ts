@@ -0,0 +1,173 @@
+/**
+ * @fileoverview Alpha Wolf night action implementation.
+ * @module patterns/strategy/actions/AlphaWolfAction
+ *
+ * @summary Handles the Alpha Wolf's night action - seeing the Werewolves,
+ * then turning another player into a Werewolf.
+ *
+ * @description
+ * The Alpha Wolf is a Werewolf: the other Werewolves and the Minion see
+ * them, and they count as a Werewolf for win conditions. A plain Werewolf
+ * card is set aside in the center at setup. After the Werewolves have
+ * woken, the Alpha Wolf wakes again on their own and swaps that card with
+ * a non-Werewolf player's card.
+ *
+ * The new Werewolf is not told. They are on the Werewolf team from then
+ * on, because teams follow the card a player ends the night with.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Alpha Wolf
+ *
+ * @remarks
+ * Wakes after the Werewolves have seen each other, so the player the
+ * Alpha Wolf turns is never among them.
+ *
+ * @example
+ * ```typescript
+ * const alphaWolfAction = new AlphaWolfAction();
+ * const result = await alphaWolfAction.execute(context, agent, gameState);
+ *
+ * // result.info.werewolves = ['player-2']
+ * // result.info.turned = 'player-4'
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Alpha Wolf night action - see other Werewolves, then turn a player.
+ *
+ * @description
+ * The Alpha Wolf:
+ * 1. Learns who the other Werewolves are
+ * 2. Chooses one player outside the pack
+ * 3. Swaps the center Werewolf card with that player's card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const alphaWolf = new AlphaWolfAction();
+ * const result = await alphaWolf.execute(context, agent, gameState);
+ * // result.info.turned is the player now holding the Werewolf card
+ * ```
+ */
+export class AlphaWolfAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new AlphaWolfAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.ALPHA_WOLF
+   */
+  getRoleName(): RoleName {
+    return RoleName.ALPHA_WOLF;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Alpha Wolf night ability
+   */
+  getDescription(): string {
+    return 'See other Werewolves, then give the center Werewolf card to another player';
+  }
+
+  /**
+   * @summary Returns 'SWAP' as the action type.
+   *
+   * @returns {'SWAP'} Always returns 'SWAP'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'SWAP';
+  }
+
+  /**
+   * @summary Executes the Alpha Wolf night action.
+   *
+   * @description
+   * 1. Tell the player who the other Werewolves are
+   * 2. Ask the agent which non-Werewolf player to turn
+   * 3. Swap the center Werewolf card with that player's card
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the pack and the turned player
+   *
+   * @example
+   * ```typescript
+   * const result = await alphaWolfAction.doExecute(context, agent, gameState);
+   * // result.info = { werewolves: [...], turned: 'player-4' }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const pack = this.findWerewolves(gameState);
+    const otherWerewolves = pack.filter(id => id !== context.myPlayerId);
+
+    // Show the pack BEFORE asking for a target, so the player can pick
+    // someone outside it
+    agent.receiveNightInfo(this.createSuccessResult(context.myPlayerId, {
+      werewolves: otherWerewolves
+    }));
+
+    if (gameState.getAlphaWolfCard() === null) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No Werewolf card was set aside for the Alpha Wolf',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const validTargets = context.allPlayerIds.filter(
+      id => id !== context.myPlayerId && !pack.includes(id)
+    );
+
+    if (validTargets.length === 0) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const targetId = await agent.selectPlayer(validTargets, context);
+
+    if (!validTargets.includes(targetId)) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
+      );
+    }
+
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
+    gameState.swapAlphaWolfCard(targetId);
+
+    return this.createSuccessResult(context.myPlayerId, {
+      werewolves: otherWerewolves,
+      turned: targetId
+    });
+  }
+}
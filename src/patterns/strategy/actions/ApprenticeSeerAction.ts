@@ -0,0 +1,135 @@
+/**
+ * @fileoverview Apprentice Seer night action implementation.
+ * @module patterns/strategy/actions/ApprenticeSeerAction
+ *
+ * @summary Handles the Apprentice Seer's night action - viewing one center card.
+ *
+ * @description
+ * The Apprentice Seer is a weaker Seer: they may look at exactly ONE
+ * center card, never a player's card and never two center cards.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Apprentice Seer
+ *
+ * @remarks
+ * Like the Seer, the Apprentice Seer acts before any swaps, so the card
+ * they see is the one that was dealt to the center.
+ *
+ * @example
+ * ```typescript
+ * const apprenticeSeerAction = new ApprenticeSeerAction();
+ * const result = await apprenticeSeerAction.execute(context, agent, gameState);
+ *
+ * // result.info.viewed = [{ centerIndex: 1, role: RoleName.WEREWOLF }]
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Apprentice Seer night action - view one center card.
+ *
+ * @description
+ * The Apprentice Seer:
+ * 1. Chooses one of the three center cards
+ * 2. Sees that card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const apprenticeSeer = new ApprenticeSeerAction();
+ * const result = await apprenticeSeer.execute(context, agent, gameState);
+ * // result.info.viewed[0] is the center card the Apprentice Seer looked at
+ * ```
+ */
+export class ApprenticeSeerAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new ApprenticeSeerAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.APPRENTICE_SEER
+   */
+  getRoleName(): RoleName {
+    return RoleName.APPRENTICE_SEER;
+  }
+
+  /**
+   * @summary The Apprentice Seer may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Apprentice Seer night ability
+   */
+  getDescription(): string {
+    return 'Look at one center card';
+  }
+
+  /**
+   * @summary Returns 'VIEW' as the action type.
+   *
+   * @returns {'VIEW'} Always returns 'VIEW'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'VIEW';
+  }
+
+  /**
+   * @summary Executes the Apprentice Seer night action.
+   *
+   * @description
+   * 1. Ask the agent to select a center card (0, 1, or 2)
+   * 2. Reveal that card
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the viewed center card
+   *
+   * @example
+   * ```typescript
+   * const result = await apprenticeSeerAction.doExecute(context, agent, gameState);
+   * // result.info = { viewed: [{ centerIndex, role }] }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const centerIndex = await agent.selectCenterCard(context);
+
+    const indexError = this.validateCenterIndex(centerIndex, gameState);
+    if (indexError) {
+      return this.createFailureResult(context.myPlayerId, indexError, 'INVALID_TARGET');
+    }
+
+    return this.createSuccessResult(context.myPlayerId, {
+      viewed: [{
+        centerIndex,
+        role: gameState.getCenterCard(centerIndex)
+      }]
+    });
+  }
+}
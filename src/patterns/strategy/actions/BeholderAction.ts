@@ -0,0 +1,123 @@
+/**
+ * @fileoverview Beholder night action implementation.
+ * @module patterns/strategy/actions/BeholderAction
+ *
+ * @summary Handles the Beholder's night action - seeing who the Seer is.
+ *
+ * @description
+ * The Beholder wakes and sees which player is the Seer (the Seer sticks
+ * out their thumb). Like the Minion, the Beholder makes no choice and the
+ * Seer doesn't learn who the Beholder is.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Beholder
+ *
+ * @remarks
+ * Strategic implications:
+ * - The Beholder can back up a true Seer claim, or expose a false one
+ * - If no Seer is in play, anyone claiming Seer is lying
+ *
+ * @example
+ * ```typescript
+ * const beholderAction = new BeholderAction();
+ * const result = await beholderAction.execute(context, agent, gameState);
+ *
+ * // result.info.seers = ['player-3'] or [] if the Seer is in the center
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Beholder night action - see who the Seer is.
+ *
+ * @description
+ * The Beholder:
+ * 1. Wakes up
+ * 2. Sees which player started as the Seer (or copied it as Doppelganger)
+ * 3. Learns nothing else
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const beholder = new BeholderAction();
+ * const result = await beholder.execute(context, agent, gameState);
+ * // result.info.seers contains the Seer's player ID
+ * ```
+ */
+export class BeholderAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new BeholderAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.BEHOLDER
+   */
+  getRoleName(): RoleName {
+    return RoleName.BEHOLDER;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Beholder night ability
+   */
+  getDescription(): string {
+    return 'Learn which player is the Seer';
+  }
+
+  /**
+   * @summary Returns 'VIEW' as the action type.
+   *
+   * @returns {'VIEW'} Always returns 'VIEW'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'VIEW';
+  }
+
+  /**
+   * @summary Executes the Beholder night action.
+   *
+   * @description
+   * Finds every player who started as the Seer, plus any Doppelganger
+   * who copied the Seer. No agent decision needed.
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} _agent - Decision-maker (unused - no choice)
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result containing Seer IDs
+   *
+   * @remarks
+   * If the Seer card is in the center, the result has an empty seers
+   * array, which tells the Beholder that no one is the Seer.
+   *
+   * @example
+   * ```typescript
+   * const result = await beholderAction.doExecute(context, agent, gameState);
+   * // result.info.seers = ['player-3'] or [] if none
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    _agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    return this.createSuccessResult(context.myPlayerId, {
+      seers: this.findSeers(gameState)
+    });
+  }
+}
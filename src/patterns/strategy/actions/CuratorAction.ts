@@ -0,0 +1,154 @@
+/**
+ * @fileoverview Curator night action implementation.
+ * @module patterns/strategy/actions/CuratorAction
+ *
+ * @summary Handles the Curator's night action - placing an artifact.
+ *
+ * @description
+ * The Curator places an artifact on one other player's card. The artifact
+ * doesn't move or change the card; it only hides that player's role from
+ * everyone, the player included, until the game ends.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Curator
+ *
+ * @remarks
+ * The Curator doesn't look at the card. A shielded card can't take an
+ * artifact.
+ *
+ * @example
+ * ```typescript
+ * const curatorAction = new CuratorAction();
+ * const result = await curatorAction.execute(context, agent, gameState);
+ *
+ * // result.info.artifact = 'player-3'
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Curator night action - place an artifact on one other player's card.
+ *
+ * @description
+ * The Curator:
+ * 1. Chooses one other player
+ * 2. Places an artifact on that player's card
+ * 3. Does NOT look at the card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const curator = new CuratorAction();
+ * const result = await curator.execute(context, agent, gameState);
+ * // The player in result.info.artifact no longer sees their own role
+ * ```
+ */
+export class CuratorAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new CuratorAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.CURATOR
+   */
+  getRoleName(): RoleName {
+    return RoleName.CURATOR;
+  }
+
+  /**
+   * @summary The Curator may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Curator night ability
+   */
+  getDescription(): string {
+    return 'Place an artifact on another player\'s card. Their role stays hidden until the end';
+  }
+
+  /**
+   * @summary Returns 'NONE' as the action type.
+   *
+   * @returns {'NONE'} Always returns 'NONE' (the artifact neither views nor moves a card)
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'NONE';
+  }
+
+  /**
+   * @summary Executes the Curator night action.
+   *
+   * @description
+   * 1. Ask the agent which other player gets the artifact
+   * 2. Place the artifact on that player's card
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the artifacted player
+   *
+   * @example
+   * ```typescript
+   * const result = await curatorAction.doExecute(context, agent, gameState);
+   * // result.info = { artifact: 'player-3' }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+
+    if (validTargets.length === 0) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const targetId = await agent.selectPlayer(validTargets, context);
+
+    if (!validTargets.includes(targetId)) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
+      );
+    }
+
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
+    gameState.placeArtifact(targetId);
+
+    return this.createSuccessResult(context.myPlayerId, {
+      artifact: targetId
+    });
+  }
+}
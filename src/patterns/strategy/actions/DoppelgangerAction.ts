@@ -16,12 +16,13 @@
  * @pattern Prototype Pattern - Doppelganger clones the target's role
  *
  * @remarks
- * Wake order: 1 (FIRST, before all other roles)
+ * Wakes right after the Sentinel, before every other role.
  *
  * Special timing rules:
- * - If copies Werewolf: Joins Werewolf wake (order 2)
- * - If copies Minion: Joins Minion wake (order 3)
- * - If copies Seer/Robber/etc: Acts immediately after viewing
+ * - If copies Werewolf or Alpha Wolf: Joins the Werewolf wake
+ * - If copies Minion: Joins the Minion wake
+ * - If copies Squire: Joins the Squire wake
+ * - If copies Mystic Wolf/Seer/Robber/etc: Acts immediately after viewing
  * - If copies Insomniac: Wakes AGAIN at the very end of night
  *
  * @example
@@ -34,20 +35,34 @@
  * ```
  */
 
-import { RoleName } from '../../../enums';
-import { NightActionResult, NightActionContext, NightActionInfo } from '../../../types';
+import { RoleName, WEREWOLF_ROLES } from '../../../enums';
+import {
+  NightActionResult,
+  NightActionContext,
+  NightActionInfo,
+  NightActionFailureCode
+} from '../../../types';
 import {
   AbstractNightAction,
   INightActionAgent,
   INightActionGameState
 } from '../NightAction';
 
+/**
+ * What a copied role's immediate action produced: its info, the shielded
+ * player whose card stopped it, or why the agent's choice was rejected.
+ */
+type ImmediateActionOutcome =
+  | NightActionInfo
+  | { shieldedPlayerId: string }
+  | { failure: { error: string; code: NightActionFailureCode } };
+
 /**
  * @summary Doppelganger night action - copy another player's role.
  *
  * @description
  * The Doppelganger:
- * 1. Wakes up first
+ * 1. Wakes up right after the Sentinel
  * 2. Looks at another player's card
  * 3. Becomes that role
  * 4. If the role has an immediate action, performs it
@@ -85,19 +100,6 @@ export class DoppelgangerAction extends AbstractNightAction {
     return RoleName.DOPPELGANGER;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Doppelganger wakes FIRST at order 1. This ensures they can copy
-   * a role before that role's normal wake time.
-   *
-   * @returns {number} 1
-   */
-  getNightOrder(): number {
-    return 1;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
@@ -161,7 +163,8 @@ export class DoppelgangerAction extends AbstractNightAction {
     if (validTargets.length === 0) {
       return this.createFailureResult(
         context.myPlayerId,
-        'No valid targets to copy'
+        'No valid targets to copy',
+        'NO_VALID_TARGETS'
       );
     }
 
@@ -172,12 +175,19 @@ export class DoppelgangerAction extends AbstractNightAction {
     if (!validTargets.includes(targetId)) {
       return this.createFailureResult(
         context.myPlayerId,
-        `Invalid target: ${targetId}`
+        `Invalid target: ${targetId}`,
+        'INVALID_TARGET'
       );
     }
 
+    // A shielded card can't be looked at, so there is nothing to copy
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
     // Look at the target's card
-    const copiedRole = gameState.getPlayerRole(targetId);
+    const copiedRole = gameState.viewPlayerCard(targetId);
 
     // Record that this Doppelganger copied this role (for Werewolf/Mason visibility)
     gameState.setDoppelgangerCopiedRole(context.myPlayerId, copiedRole);
@@ -194,7 +204,10 @@ export class DoppelgangerAction extends AbstractNightAction {
 
     // For roles that require player input, notify them what role they copied BEFORE asking
     // This ensures they know they're a "Doppel-Troublemaker" before selecting two players
-    const rolesRequiringInput = [RoleName.SEER, RoleName.ROBBER, RoleName.TROUBLEMAKER, RoleName.DRUNK, RoleName.WEREWOLF];
+    const rolesRequiringInput = [
+      RoleName.SENTINEL, RoleName.SEER, RoleName.APPRENTICE_SEER, RoleName.ROBBER, RoleName.TROUBLEMAKER, RoleName.VILLAGE_IDIOT, RoleName.DRUNK,
+      RoleName.REVEALER, RoleName.CURATOR, RoleName.WEREWOLF, RoleName.ALPHA_WOLF, RoleName.MYSTIC_WOLF
+    ];
     if (rolesRequiringInput.includes(copiedRole)) {
       const copyInfo = this.createSuccessResult(context.myPlayerId, {
         copied: {
@@ -213,6 +226,20 @@ export class DoppelgangerAction extends AbstractNightAction {
       gameState
     );
 
+    // The copied action ran into a shielded card and did nothing
+    if (additionalInfo && 'shieldedPlayerId' in additionalInfo) {
+      return this.createShieldedResult(context.myPlayerId, additionalInfo.shieldedPlayerId);
+    }
+
+    // The agent's choice broke the copied role's targeting rules
+    if (additionalInfo && 'failure' in additionalInfo) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        additionalInfo.failure.error,
+        additionalInfo.failure.code
+      );
+    }
+
     // Merge additional info
     if (additionalInfo) {
       if (additionalInfo.viewed) {
@@ -227,6 +254,21 @@ export class DoppelgangerAction extends AbstractNightAction {
       if (additionalInfo.masons) {
         resultInfo.masons = additionalInfo.masons;
       }
+      if (additionalInfo.seers) {
+        resultInfo.seers = additionalInfo.seers;
+      }
+      if (additionalInfo.shielded) {
+        resultInfo.shielded = additionalInfo.shielded;
+      }
+      if (additionalInfo.rotated) {
+        resultInfo.rotated = additionalInfo.rotated;
+      }
+      if (additionalInfo.revealed) {
+        resultInfo.revealed = additionalInfo.revealed;
+      }
+      if (additionalInfo.artifact) {
+        resultInfo.artifact = additionalInfo.artifact;
+      }
     }
 
     return this.createSuccessResult(context.myPlayerId, resultInfo);
@@ -244,21 +286,30 @@ export class DoppelgangerAction extends AbstractNightAction {
    * @param {INightActionAgent} agent - Decision-maker for choices
    * @param {INightActionGameState} gameState - Game state access
    *
-   * @returns {Promise<NightActionInfo | null>} Additional info from the action
+   * @returns {Promise<ImmediateActionOutcome | null>} Additional info from the action,
+   * or the shielded player that blocked it
    *
    * @private
    *
    * @remarks
    * Immediate actions:
+   * - Sentinel: Shield a card now
    * - Seer: View a card now
+   * - Beholder: See who the Seer is now
+   * - Apprentice Seer: View a center card now
+   * - Mystic Wolf: See the Werewolves and view a player now
    * - Robber: Swap now
    * - Troublemaker: Swap two others now
+   * - Village Idiot: Move every player's card now
    * - Drunk: Swap with center now
+   * - Revealer: Flip a card now
+   * - Curator: Place an artifact now
    *
    * Delayed actions (handled by game):
-   * - Werewolf: Joins Werewolf wake at order 2
-   * - Minion: Joins Minion wake at order 3
-   * - Mason: Joins Mason wake at order 4
+   * - Werewolf, Alpha Wolf: Joins the Werewolf wake
+   * - Minion: Joins the Minion wake
+   * - Squire: Joins the Squire wake
+   * - Mason: Joins the Mason wake
    * - Insomniac: Wakes again at very end of night
    */
   private async executeImmediateAction(
@@ -266,45 +317,115 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo | null> {
+  ): Promise<ImmediateActionOutcome | null> {
     switch (copiedRole) {
+      case RoleName.SENTINEL:
+        return this.executeSentinelAction(context, agent, gameState);
+
       case RoleName.SEER:
         return this.executeSeerAction(context, agent, gameState);
 
+      case RoleName.APPRENTICE_SEER:
+        return this.executeApprenticeSeerAction(context, agent, gameState);
+
       case RoleName.ROBBER:
         return this.executeRobberAction(context, agent, gameState);
 
       case RoleName.TROUBLEMAKER:
         return this.executeTroublemakerAction(context, agent, gameState);
 
+      case RoleName.VILLAGE_IDIOT:
+        return this.executeVillageIdiotAction(context, agent, gameState);
+
       case RoleName.DRUNK:
         return this.executeDrunkAction(context, agent, gameState);
 
-      // Doppel-Werewolf: See other werewolves or peek at center if lone wolf
+      case RoleName.REVEALER:
+        return this.executeRevealerAction(context, agent, gameState);
+
+      case RoleName.CURATOR:
+        return this.executeCuratorAction(context, agent, gameState);
+
+      // Doppel-Werewolf and Doppel-Alpha Wolf: See other werewolves or peek
+      // at center if lone wolf. The Alpha Wolf's card is the original's to give.
       case RoleName.WEREWOLF:
+      case RoleName.ALPHA_WOLF:
         return this.executeWerewolfAction(context, agent, gameState);
 
-      // Doppel-Minion: See who the werewolves are
+      // Doppel-Mystic Wolf: See other werewolves, then view a player's card
+      case RoleName.MYSTIC_WOLF:
+        return this.executeMysticWolfAction(context, agent, gameState);
+
+      // Doppel-Minion and Doppel-Squire: See who the werewolves are
       case RoleName.MINION:
+      case RoleName.SQUIRE:
         return this.executeMinionAction(context, gameState);
 
       // Doppel-Mason: See other masons
       case RoleName.MASON:
         return this.executeMasonAction(context, gameState);
 
+      // Doppel-Beholder: See who the Seer is
+      case RoleName.BEHOLDER:
+        return { seers: this.findSeers(gameState) };
+
       // Doppel-Insomniac wakes again at the very end (not implemented yet)
       case RoleName.INSOMNIAC:
         return null;
 
-      // These roles have no night action
+      // These roles have no night action. A Doppel-Dream Wolf is still
+      // seen by the pack, via getDoppelgangersWhoCopied
+      case RoleName.DREAM_WOLF:
       case RoleName.VILLAGER:
       case RoleName.HUNTER:
+      case RoleName.PRINCE:
       case RoleName.TANNER:
       default:
         return null;
     }
   }
 
+  /**
+   * @summary Rejects a player target outside the copied role's options.
+   * @private
+   */
+  private invalidPlayerTarget(targetId: string, validTargets: string[]): ImmediateActionOutcome {
+    return {
+      failure: {
+        error: `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        code: 'INVALID_TARGET'
+      }
+    };
+  }
+
+  /**
+   * @summary Rejects a center card index outside the center.
+   * @private
+   */
+  private invalidCenterIndex(error: string): ImmediateActionOutcome {
+    return {
+      failure: { error, code: 'INVALID_TARGET' }
+    };
+  }
+
+  /**
+   * @summary Executes Sentinel action for Doppelganger.
+   * @private
+   */
+  private async executeSentinelAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+    const targetId = await agent.selectPlayer(validTargets, context);
+    if (!validTargets.includes(targetId)) {
+      return this.invalidPlayerTarget(targetId, validTargets);
+    }
+    gameState.shieldPlayer(targetId);
+    return { shielded: targetId };
+  }
+
   /**
    * @summary Executes Seer action for Doppelganger.
    * @private
@@ -313,16 +434,38 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo> {
+  ): Promise<ImmediateActionOutcome> {
     const choice = await agent.chooseSeerOption(context);
 
     if (choice === 'player') {
       const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
       const targetId = await agent.selectPlayer(validTargets, context);
-      const role = gameState.getPlayerRole(targetId);
+      if (!validTargets.includes(targetId)) {
+        return this.invalidPlayerTarget(targetId, validTargets);
+      }
+      if (this.findShieldedPlayer(gameState, targetId)) {
+        return { shieldedPlayerId: targetId };
+      }
+      const role = gameState.viewPlayerCard(targetId);
       return { viewed: [{ playerId: targetId, role }] };
     } else {
-      const [idx1, idx2] = await agent.selectTwoCenterCards(context);
+      const selection = await agent.selectTwoCenterCards(context);
+      if (!Array.isArray(selection) || selection.length !== 2) {
+        return {
+          failure: { error: 'Must select exactly two center cards', code: 'WRONG_TARGET_COUNT' }
+        };
+      }
+      const [idx1, idx2] = selection;
+      const indexError = this.validateCenterIndex(idx1, gameState)
+        ?? this.validateCenterIndex(idx2, gameState);
+      if (indexError) {
+        return this.invalidCenterIndex(indexError);
+      }
+      if (idx1 === idx2) {
+        return {
+          failure: { error: 'Must select two different center cards', code: 'DUPLICATE_TARGET' }
+        };
+      }
       const role1 = gameState.getCenterCard(idx1);
       const role2 = gameState.getCenterCard(idx2);
       return {
@@ -334,6 +477,24 @@ export class DoppelgangerAction extends AbstractNightAction {
     }
   }
 
+  /**
+   * @summary Executes Apprentice Seer action for Doppelganger.
+   * @private
+   */
+  private async executeApprenticeSeerAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const centerIndex = await agent.selectCenterCard(context);
+    const indexError = this.validateCenterIndex(centerIndex, gameState);
+    if (indexError) {
+      return this.invalidCenterIndex(indexError);
+    }
+    const role = gameState.getCenterCard(centerIndex);
+    return { viewed: [{ centerIndex, role }] };
+  }
+
   /**
    * @summary Executes Robber action for Doppelganger.
    * @private
@@ -342,9 +503,17 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo> {
+  ): Promise<ImmediateActionOutcome> {
     const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
     const targetId = await agent.selectPlayer(validTargets, context);
+    if (!validTargets.includes(targetId)) {
+      return this.invalidPlayerTarget(targetId, validTargets);
+    }
+
+    const shieldedPlayerId = this.findShieldedPlayer(gameState, targetId, context.myPlayerId);
+    if (shieldedPlayerId !== undefined) {
+      return { shieldedPlayerId };
+    }
 
     // Perform swap
     gameState.swapCards(
@@ -353,7 +522,7 @@ export class DoppelgangerAction extends AbstractNightAction {
     );
 
     // Get new card
-    const newRole = gameState.getPlayerRole(context.myPlayerId);
+    const newRole = gameState.viewPlayerCard(context.myPlayerId);
 
     return {
       swapped: {
@@ -372,9 +541,24 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo> {
+  ): Promise<ImmediateActionOutcome> {
     const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
     const [player1Id, player2Id] = await agent.selectTwoPlayers(validTargets, context);
+    if (!validTargets.includes(player1Id) || !validTargets.includes(player2Id)) {
+      return {
+        failure: { error: `Invalid targets: ${player1Id}, ${player2Id}`, code: 'INVALID_TARGET' }
+      };
+    }
+    if (player1Id === player2Id) {
+      return {
+        failure: { error: 'Must select two different players', code: 'DUPLICATE_TARGET' }
+      };
+    }
+
+    const shieldedPlayerId = this.findShieldedPlayer(gameState, player1Id, player2Id);
+    if (shieldedPlayerId !== undefined) {
+      return { shieldedPlayerId };
+    }
 
     gameState.swapCards(
       { playerId: player1Id },
@@ -389,6 +573,32 @@ export class DoppelgangerAction extends AbstractNightAction {
     };
   }
 
+  /**
+   * @summary Executes Village Idiot action for Doppelganger.
+   * @private
+   */
+  private async executeVillageIdiotAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const choice = await agent.chooseRotation(context);
+    if (choice === 'NONE') {
+      return {};
+    }
+    if (choice !== 'LEFT' && choice !== 'RIGHT') {
+      return {
+        failure: {
+          error: `Invalid direction: ${choice}. Must be LEFT, RIGHT, or NONE.`,
+          code: 'INVALID_TARGET'
+        }
+      };
+    }
+
+    const playerIds = this.rotatePlayerCards(gameState, gameState.getAllPlayerIds(), choice);
+    return { rotated: { direction: choice, playerIds } };
+  }
+
   /**
    * @summary Executes Drunk action for Doppelganger.
    * @private
@@ -397,8 +607,13 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo> {
-    const centerIndex = await agent.selectCenterCard(context);
+  ): Promise<ImmediateActionOutcome> {
+    if (this.findShieldedPlayer(gameState, context.myPlayerId)) {
+      return { shieldedPlayerId: context.myPlayerId };
+    }
+
+    // Mandatory, like the Drunk's own swap
+    const centerIndex = await this.selectCenterCardOrRandom(context, agent, gameState);
 
     gameState.swapCards(
       { playerId: context.myPlayerId },
@@ -413,6 +628,56 @@ export class DoppelgangerAction extends AbstractNightAction {
     };
   }
 
+  /**
+   * @summary Executes Revealer action for Doppelganger.
+   * @private
+   */
+  private async executeRevealerAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+    const targetId = await agent.selectPlayer(validTargets, context);
+    if (!validTargets.includes(targetId)) {
+      return this.invalidPlayerTarget(targetId, validTargets);
+    }
+    if (this.findShieldedPlayer(gameState, targetId)) {
+      return { shieldedPlayerId: targetId };
+    }
+
+    const role = gameState.viewPlayerCard(targetId);
+    const viewed = [{ playerId: targetId, role }];
+    if (WEREWOLF_ROLES.has(role) || role === RoleName.TANNER) {
+      return { viewed };
+    }
+
+    gameState.revealCard(targetId);
+    return { viewed, revealed: targetId };
+  }
+
+  /**
+   * @summary Executes Curator action for Doppelganger.
+   * @private
+   */
+  private async executeCuratorAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+    const targetId = await agent.selectPlayer(validTargets, context);
+    if (!validTargets.includes(targetId)) {
+      return this.invalidPlayerTarget(targetId, validTargets);
+    }
+    if (this.findShieldedPlayer(gameState, targetId)) {
+      return { shieldedPlayerId: targetId };
+    }
+
+    gameState.placeArtifact(targetId);
+    return { artifact: targetId };
+  }
+
   /**
    * @summary Executes Werewolf action for Doppelganger.
    * @description Doppel-Werewolf sees other starting werewolves and other Doppel-Werewolves,
@@ -423,18 +688,12 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     agent: INightActionAgent,
     gameState: INightActionGameState
-  ): Promise<NightActionInfo> {
-    // Find all players who STARTED as Werewolf
-    const startingWerewolves = gameState.getPlayersWithStartingRole(RoleName.WEREWOLF);
-
-    // Also find other Doppelgangers who copied Werewolf before this one
-    // (Note: typically only one Doppelganger exists, but included for completeness)
-    const otherDoppelWerewolves = gameState.getDoppelgangersWhoCopied(RoleName.WEREWOLF)
+  ): Promise<ImmediateActionOutcome> {
+    // Find all players who STARTED as a Werewolf, plus other Doppelgangers who
+    // copied one (this Doppelganger has already been recorded, so exclude it)
+    const allWerewolves = this.findWerewolves(gameState)
       .filter(id => id !== context.myPlayerId);
 
-    // Combine both groups
-    const allWerewolves = [...startingWerewolves, ...otherDoppelWerewolves];
-
     if (allWerewolves.length > 0) {
       // Doppel-Werewolf sees the starting werewolves and other Doppel-Werewolves
       return { werewolves: allWerewolves };
@@ -442,6 +701,10 @@ export class DoppelgangerAction extends AbstractNightAction {
 
     // Lone wolf (no starting werewolves or other Doppel-Werewolves) - peek at a center card
     const centerIndex = await agent.selectCenterCard(context);
+    const indexError = this.validateCenterIndex(centerIndex, gameState);
+    if (indexError) {
+      return this.invalidCenterIndex(indexError);
+    }
     const centerRole = gameState.getCenterCard(centerIndex);
 
     return {
@@ -453,6 +716,34 @@ export class DoppelgangerAction extends AbstractNightAction {
     };
   }
 
+  /**
+   * @summary Executes Mystic Wolf action for Doppelganger.
+   * @description Doppel-Mystic Wolf sees the other werewolves, then views one other player's card.
+   * @private
+   */
+  private async executeMysticWolfAction(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<ImmediateActionOutcome> {
+    const werewolves = this.findWerewolves(gameState).filter(id => id !== context.myPlayerId);
+
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+    const targetId = await agent.selectPlayer(validTargets, context);
+    if (!validTargets.includes(targetId)) {
+      return this.invalidPlayerTarget(targetId, validTargets);
+    }
+    if (this.findShieldedPlayer(gameState, targetId)) {
+      return { shieldedPlayerId: targetId };
+    }
+    const role = gameState.viewPlayerCard(targetId);
+
+    return {
+      werewolves,
+      viewed: [{ playerId: targetId, role }]
+    };
+  }
+
   /**
    * @summary Executes Minion action for Doppelganger.
    * @description Doppel-Minion sees all werewolves (starting + other Doppel-Werewolves).
@@ -462,15 +753,8 @@ export class DoppelgangerAction extends AbstractNightAction {
     context: NightActionContext,
     gameState: INightActionGameState
   ): NightActionInfo {
-    // Find all players who STARTED as Werewolf
-    const startingWerewolves = gameState.getPlayersWithStartingRole(RoleName.WEREWOLF);
-
-    // Also find other Doppelgangers who copied Werewolf
-    // (Note: this Doppelganger is copying Minion, so they wouldn't be in this list)
-    const doppelWerewolves = gameState.getDoppelgangersWhoCopied(RoleName.WEREWOLF);
-
-    // Combine for complete werewolf team
-    const werewolves = [...startingWerewolves, ...doppelWerewolves];
+    // Find all Werewolves (this Doppelganger is copying Minion, so isn't among them)
+    const werewolves = this.findWerewolves(gameState);
     return { werewolves };
   }
 
@@ -14,8 +14,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Drunk
  *
  * @remarks
- * Wake order: 8 (after Troublemaker, before Insomniac)
- *
  * Strategic implications:
  * - Drunk can claim to be Drunk (usually safe, as they don't know more)
  * - If Drunk becomes Werewolf, Village might kill an innocent
@@ -84,18 +82,6 @@ export class DrunkAction extends AbstractNightAction {
     return RoleName.DRUNK;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Drunk wakes at order 8, after Troublemaker but before Insomniac.
-   *
-   * @returns {number} 8
-   */
-  getNightOrder(): number {
-    return 8;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
@@ -124,6 +110,9 @@ export class DrunkAction extends AbstractNightAction {
    * 2. Swap their card with that center card
    * 3. Return swap info WITHOUT revealing what was swapped
    *
+   * The swap is mandatory: if the agent gives no valid index by the end
+   * of the turn, a random center card is used.
+   *
    * @param {NightActionContext} context - What the player knows
    * @param {INightActionAgent} agent - Decision-maker for choices
    * @param {INightActionGameState} gameState - Game state access
@@ -141,17 +130,15 @@ export class DrunkAction extends AbstractNightAction {
     agent: INightActionAgent,
     gameState: INightActionGameState
   ): Promise<NightActionResult> {
-    // Ask agent to select a center card
-    const centerIndex = await agent.selectCenterCard(context);
-
-    // Validate center index
-    if (centerIndex < 0 || centerIndex > 2) {
-      return this.createFailureResult(
-        context.myPlayerId,
-        `Invalid center card index: ${centerIndex}. Must be 0, 1, or 2.`
-      );
+    // A shielded Drunk can't move their card, so there is nothing to choose
+    const shielded = this.checkShielded(context.myPlayerId, gameState, context.myPlayerId);
+    if (shielded) {
+      return shielded;
     }
 
+    // Ask agent to select a center card, or pick one if they don't
+    const centerIndex = await this.selectCenterCardOrRandom(context, agent, gameState);
+
     // Perform the swap
     gameState.swapCards(
       { playerId: context.myPlayerId },
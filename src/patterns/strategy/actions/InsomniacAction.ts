@@ -13,7 +13,7 @@
  * @pattern Strategy Pattern - Concrete Strategy for Insomniac
  *
  * @remarks
- * Wake order: 9 (LAST, after all swaps have occurred)
+ * Wakes last, after all swaps have occurred.
  *
  * Strategic implications:
  * - Insomniac knows their final role with certainty
@@ -82,20 +82,6 @@ export class InsomniacAction extends AbstractNightAction {
     return RoleName.INSOMNIAC;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Insomniac wakes LAST at order 9. This is crucial because
-   * all swaps (Robber, Troublemaker, Drunk) happen before this,
-   * so the Insomniac sees their FINAL card.
-   *
-   * @returns {number} 9
-   */
-  getNightOrder(): number {
-    return 9;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
@@ -121,7 +107,8 @@ export class InsomniacAction extends AbstractNightAction {
    *
    * @description
    * Simply looks at the card in the Insomniac's position.
-   * No agent decision needed - always views own card.
+   * No agent decision needed - always views own card, unless the
+   * Sentinel shielded it.
    *
    * @param {NightActionContext} context - What the player knows
    * @param {INightActionAgent} _agent - Decision-maker (unused - no choice)
@@ -140,8 +127,14 @@ export class InsomniacAction extends AbstractNightAction {
     _agent: INightActionAgent,
     gameState: INightActionGameState
   ): Promise<NightActionResult> {
+    // A shielded card can't be viewed, even by its owner
+    const shielded = this.checkShielded(context.myPlayerId, gameState, context.myPlayerId);
+    if (shielded) {
+      return shielded;
+    }
+
     // Look at own card (no choice to make)
-    const currentRole = gameState.getPlayerRole(context.myPlayerId);
+    const currentRole = gameState.viewPlayerCard(context.myPlayerId);
 
     return this.createSuccessResult(context.myPlayerId, {
       viewed: [{
@@ -12,8 +12,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Mason
  *
  * @remarks
- * Wake order: 4 (after Minion, before Seer)
- *
  * Important rules:
  * - Always use BOTH Mason cards in a game (or neither)
  * - Two confirmed village members is powerful information
@@ -86,18 +84,6 @@ export class MasonAction extends AbstractNightAction {
     return RoleName.MASON;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Masons wake at order 4, after Minion (3) but before Seer (5).
-   *
-   * @returns {number} 4
-   */
-  getNightOrder(): number {
-    return 4;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
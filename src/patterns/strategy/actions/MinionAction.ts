@@ -16,8 +16,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Minion
  *
  * @remarks
- * Wake order: 3 (after Werewolves, before Masons)
- *
  * Strategic implications:
  * - Minion can throw suspicion away from Werewolves
  * - Minion might claim to be a Werewolf to take the kill
@@ -82,19 +80,6 @@ export class MinionAction extends AbstractNightAction {
     return RoleName.MINION;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Minion wakes at order 3, after Werewolves (2) but before Masons (4).
-   * Werewolves keep their thumbs out so Minion can see them.
-   *
-   * @returns {number} 3
-   */
-  getNightOrder(): number {
-    return 3;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
@@ -144,14 +129,9 @@ export class MinionAction extends AbstractNightAction {
     _agent: INightActionAgent,
     gameState: INightActionGameState
   ): Promise<NightActionResult> {
-    // Find all Werewolves by STARTING role (not affected by swaps)
-    const startingWerewolves = gameState.getPlayersWithStartingRole(RoleName.WEREWOLF);
-
-    // Also find Doppelgangers who copied Werewolf (Doppelganger acts at order 1, before Minion at order 3)
-    const doppelWerewolves = gameState.getDoppelgangersWhoCopied(RoleName.WEREWOLF);
-
-    // Combine both groups for the complete werewolf team
-    const werewolves = [...startingWerewolves, ...doppelWerewolves];
+    // Find all Werewolves by STARTING role (not affected by swaps), including
+    // Mystic Wolves and Doppelgangers who copied a Werewolf
+    const werewolves = this.findWerewolves(gameState);
 
     return this.createSuccessResult(context.myPlayerId, {
       werewolves
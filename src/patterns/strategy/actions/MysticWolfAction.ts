@@ -0,0 +1,168 @@
+/**
+ * @fileoverview Mystic Wolf night action implementation.
+ * @module patterns/strategy/actions/MysticWolfAction
+ *
+ * @summary Handles the Mystic Wolf's night action - seeing the Werewolves,
+ * then viewing one other player's card.
+ *
+ * @description
+ * The Mystic Wolf is a Werewolf: the other Werewolves and the Minion see
+ * them, and they count as a Werewolf for win conditions. After the
+ * Werewolves have woken, the Mystic Wolf wakes again on their own and
+ * looks at one other player's card.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Mystic Wolf
+ *
+ * @remarks
+ * Unlike a plain Werewolf, the Mystic Wolf never gets the Lone Wolf
+ * center peek - their player view replaces it.
+ *
+ * @example
+ * ```typescript
+ * const mysticWolfAction = new MysticWolfAction();
+ * const result = await mysticWolfAction.execute(context, agent, gameState);
+ *
+ * // result.info.werewolves = ['player-2']
+ * // result.info.viewed = [{ playerId: 'player-4', role: RoleName.SEER }]
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Mystic Wolf night action - see other Werewolves, then view a player.
+ *
+ * @description
+ * The Mystic Wolf:
+ * 1. Learns who the other Werewolves are
+ * 2. Chooses one other player
+ * 3. Sees that player's current card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const mysticWolf = new MysticWolfAction();
+ * const result = await mysticWolf.execute(context, agent, gameState);
+ * // result.info.viewed[0] is the card the Mystic Wolf looked at
+ * ```
+ */
+export class MysticWolfAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new MysticWolfAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.MYSTIC_WOLF
+   */
+  getRoleName(): RoleName {
+    return RoleName.MYSTIC_WOLF;
+  }
+
+  /**
+   * @summary The Mystic Wolf may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Mystic Wolf night ability
+   */
+  getDescription(): string {
+    return 'See other Werewolves, then look at one other player\'s card';
+  }
+
+  /**
+   * @summary Returns 'VIEW' as the action type.
+   *
+   * @returns {'VIEW'} Always returns 'VIEW'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'VIEW';
+  }
+
+  /**
+   * @summary Executes the Mystic Wolf night action.
+   *
+   * @description
+   * 1. Tell the player who the other Werewolves are
+   * 2. Ask the agent which other player to view
+   * 3. Reveal that player's current card
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the pack and the viewed card
+   *
+   * @example
+   * ```typescript
+   * const result = await mysticWolfAction.doExecute(context, agent, gameState);
+   * // result.info = { werewolves: [...], viewed: [{ playerId, role }] }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const otherWerewolves = this.findWerewolves(gameState).filter(id => id !== context.myPlayerId);
+
+    // Show the pack BEFORE asking for a target, so the player can pick
+    // someone outside it
+    agent.receiveNightInfo(this.createSuccessResult(context.myPlayerId, {
+      werewolves: otherWerewolves
+    }));
+
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+
+    if (validTargets.length === 0) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const targetId = await agent.selectPlayer(validTargets, context);
+
+    if (!validTargets.includes(targetId)) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
+      );
+    }
+
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
+    return this.createSuccessResult(context.myPlayerId, {
+      werewolves: otherWerewolves,
+      viewed: [{
+        playerId: targetId,
+        role: gameState.viewPlayerCard(targetId)
+      }]
+    });
+  }
+}
@@ -0,0 +1,165 @@
+/**
+ * @fileoverview Revealer night action implementation.
+ * @module patterns/strategy/actions/RevealerAction
+ *
+ * @summary Handles the Revealer's night action - flipping one player's card.
+ *
+ * @description
+ * The Revealer flips one other player's card face up. If it is a
+ * Werewolf or the Tanner the card goes back face down, and only the
+ * Revealer knows what it was. Any other card stays face up for the whole
+ * day, so every player sees it.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Revealer
+ *
+ * @remarks
+ * Waking after every swap means the card left face up is the one its
+ * owner holds at dawn. A shielded card can't be flipped.
+ *
+ * @example
+ * ```typescript
+ * const revealerAction = new RevealerAction();
+ * const result = await revealerAction.execute(context, agent, gameState);
+ *
+ * // result.info.revealed = 'player-3' (absent if the card went back down)
+ * ```
+ */
+
+import { RoleName, WEREWOLF_ROLES } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Revealer night action - flip one other player's card.
+ *
+ * @description
+ * The Revealer:
+ * 1. Chooses one other player
+ * 2. Sees that player's card
+ * 3. Leaves it face up unless it is a Werewolf or the Tanner
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const revealer = new RevealerAction();
+ * const result = await revealer.execute(context, agent, gameState);
+ * // result.info.viewed[0] is the card the Revealer flipped
+ * ```
+ */
+export class RevealerAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new RevealerAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.REVEALER
+   */
+  getRoleName(): RoleName {
+    return RoleName.REVEALER;
+  }
+
+  /**
+   * @summary The Revealer may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Revealer night ability
+   */
+  getDescription(): string {
+    return 'Flip another player\'s card face up. A Werewolf or Tanner goes back face down';
+  }
+
+  /**
+   * @summary Returns 'VIEW' as the action type.
+   *
+   * @returns {'VIEW'} Always returns 'VIEW'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'VIEW';
+  }
+
+  /**
+   * @summary Executes the Revealer night action.
+   *
+   * @description
+   * 1. Ask the agent which other player's card to flip
+   * 2. Look at the card
+   * 3. Leave it face up unless it is a Werewolf or the Tanner
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the flipped card
+   *
+   * @example
+   * ```typescript
+   * const result = await revealerAction.doExecute(context, agent, gameState);
+   * // result.info = { viewed: [{ playerId, role }], revealed: playerId }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+
+    if (validTargets.length === 0) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const targetId = await agent.selectPlayer(validTargets, context);
+
+    if (!validTargets.includes(targetId)) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
+      );
+    }
+
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
+    const role = gameState.viewPlayerCard(targetId);
+    const viewed = [{ playerId: targetId, role }];
+
+    // Werewolves and the Tanner go back face down; only the Revealer saw them
+    if (WEREWOLF_ROLES.has(role) || role === RoleName.TANNER) {
+      return this.createSuccessResult(context.myPlayerId, { viewed });
+    }
+
+    gameState.revealCard(targetId);
+
+    return this.createSuccessResult(context.myPlayerId, {
+      viewed,
+      revealed: targetId
+    });
+  }
+}
@@ -14,8 +14,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Robber
  *
  * @remarks
- * Wake order: 6 (after Seer, before Troublemaker)
- *
  * Strategic implications:
  * - If Robber steals a Werewolf, the Robber is now on the Werewolf team!
  * - The original Werewolf now has Robber card (Village team)
@@ -53,7 +51,7 @@ import {
  * @remarks
  * The Robber does NOT wake again even if the new role would normally
  * have a night action. For example, stealing Seer doesn't give the
- * Robber a Seer peek (Seer already acted at order 5, Robber acts at 6).
+ * Robber a Seer peek (the Seer has already woken by then).
  *
  * @example
  * ```typescript
@@ -82,20 +80,6 @@ export class RobberAction extends AbstractNightAction {
     return RoleName.ROBBER;
   }
 
-  /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Robber wakes at order 6, after Seer but before Troublemaker.
-   * This is important because the Robber might steal a Troublemaker
-   * card, but the Troublemaker already acted.
-   *
-   * @returns {number} 6
-   */
-  getNightOrder(): number {
-    return 6;
-  }
-
   /**
    * @summary Returns a description of the action.
    *
@@ -155,7 +139,8 @@ export class RobberAction extends AbstractNightAction {
     if (validTargets.length === 0) {
       return this.createFailureResult(
         context.myPlayerId,
-        'No valid targets to rob'
+        'No valid targets to rob',
+        'NO_VALID_TARGETS'
       );
     }
 
@@ -166,10 +151,17 @@ export class RobberAction extends AbstractNightAction {
     if (!validTargets.includes(targetId)) {
       return this.createFailureResult(
         context.myPlayerId,
-        `Invalid target: ${targetId}`
+        `Invalid target: ${targetId}`,
+        'INVALID_TARGET'
       );
     }
 
+    // A shielded card can't be moved, whether it's the target's or the Robber's own
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId, context.myPlayerId);
+    if (shielded) {
+      return shielded;
+    }
+
     // Perform the swap
     gameState.swapCards(
       { playerId: context.myPlayerId },
@@ -178,7 +170,7 @@ export class RobberAction extends AbstractNightAction {
 
     // Look at the new card (what the Robber now has)
     // After the swap, the card at myPlayerId position is what was stolen
-    const newRole = gameState.getPlayerRole(context.myPlayerId);
+    const newRole = gameState.viewPlayerCard(context.myPlayerId);
 
     return this.createSuccessResult(context.myPlayerId, {
       swapped: {
@@ -15,8 +15,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Seer
  *
  * @remarks
- * Wake order: 5 (middle of night)
- *
  * Strategic considerations:
  * - Looking at a player gives direct information about one person
  * - Looking at center cards reveals what's NOT in play
@@ -86,16 +84,12 @@ export class SeerAction extends AbstractNightAction {
   }
 
   /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Seer wakes at order 5, in the middle of night actions.
-   * This is after Werewolves/Minion/Mason but before Robber/Troublemaker.
+   * @summary The Seer may choose not to act.
    *
-   * @returns {number} 5
+   * @returns {boolean} true
    */
-  getNightOrder(): number {
-    return 5;
+  isOptional(): boolean {
+    return true;
   }
 
   /**
@@ -177,7 +171,8 @@ export class SeerAction extends AbstractNightAction {
     if (validTargets.length === 0) {
       return this.createFailureResult(
         context.myPlayerId,
-        'No valid player targets available'
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
       );
     }
 
@@ -188,12 +183,19 @@ export class SeerAction extends AbstractNightAction {
     if (!validTargets.includes(targetId)) {
       return this.createFailureResult(
         context.myPlayerId,
-        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
       );
     }
 
+    // A shielded card can't be viewed
+    const shielded = this.checkShielded(context.myPlayerId, gameState, targetId);
+    if (shielded) {
+      return shielded;
+    }
+
     // Get the player's current role
-    const role = gameState.getPlayerRole(targetId);
+    const role = gameState.viewPlayerCard(targetId);
 
     return this.createSuccessResult(context.myPlayerId, {
       viewed: [{
@@ -220,20 +222,30 @@ export class SeerAction extends AbstractNightAction {
     gameState: INightActionGameState
   ): Promise<NightActionResult> {
     // Ask agent to select two center cards
-    const [index1, index2] = await agent.selectTwoCenterCards(context);
+    const selection = await agent.selectTwoCenterCards(context);
 
-    // Validate indices
-    if (index1 < 0 || index1 > 2 || index2 < 0 || index2 > 2) {
+    if (!Array.isArray(selection) || selection.length !== 2) {
       return this.createFailureResult(
         context.myPlayerId,
-        `Invalid center indices: ${index1}, ${index2}. Must be 0, 1, or 2.`
+        'Must select exactly two center cards',
+        'WRONG_TARGET_COUNT'
       );
     }
 
+    const [index1, index2] = selection;
+
+    // Validate indices
+    const indexError = this.validateCenterIndex(index1, gameState)
+      ?? this.validateCenterIndex(index2, gameState);
+    if (indexError) {
+      return this.createFailureResult(context.myPlayerId, indexError, 'INVALID_TARGET');
+    }
+
     if (index1 === index2) {
       return this.createFailureResult(
         context.myPlayerId,
-        'Must select two different center cards'
+        'Must select two different center cards',
+        'DUPLICATE_TARGET'
       );
     }
 
@@ -0,0 +1,152 @@
+/**
+ * @fileoverview Sentinel night action implementation.
+ * @module patterns/strategy/actions/SentinelAction
+ *
+ * @summary Handles the Sentinel's night action - shielding another player's card.
+ *
+ * @description
+ * The Sentinel wakes first and places a shield on one other player's card.
+ * For the rest of the night no one can move or look at a shielded card:
+ * a Robber, Troublemaker or Drunk whose swap would touch it does nothing,
+ * and a Seer or Mystic Wolf can't view it.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Sentinel
+ *
+ * @remarks
+ * Wakes first, before the Doppelganger, so the shield is down before
+ * anything else happens.
+ *
+ * The shield is placed on the player, not the card, so it protects whatever
+ * card that player holds.
+ *
+ * @example
+ * ```typescript
+ * const sentinelAction = new SentinelAction();
+ * const result = await sentinelAction.execute(context, agent, gameState);
+ *
+ * // result.info.shielded = 'player-3'
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Sentinel night action - shield one other player's card.
+ *
+ * @description
+ * The Sentinel:
+ * 1. Chooses one other player
+ * 2. Places a shield on that player's card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const sentinel = new SentinelAction();
+ * const result = await sentinel.execute(context, agent, gameState);
+ * // gameState.isShielded(result.info.shielded) is now true
+ * ```
+ */
+export class SentinelAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new SentinelAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.SENTINEL
+   */
+  getRoleName(): RoleName {
+    return RoleName.SENTINEL;
+  }
+
+  /**
+   * @summary The Sentinel may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Sentinel night ability
+   */
+  getDescription(): string {
+    return 'Place a shield on another player\'s card';
+  }
+
+  /**
+   * @summary Returns 'NONE' as the action type.
+   *
+   * @returns {'NONE'} The Sentinel neither views nor swaps
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'NONE';
+  }
+
+  /**
+   * @summary Executes the Sentinel night action.
+   *
+   * @description
+   * 1. Ask the agent which other player to shield
+   * 2. Place the shield on that player's card
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the shielded player
+   *
+   * @example
+   * ```typescript
+   * const result = await sentinelAction.doExecute(context, agent, gameState);
+   * // result.info = { shielded: 'player-3' }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const validTargets = context.allPlayerIds.filter(id => id !== context.myPlayerId);
+
+    if (validTargets.length === 0) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'No valid player targets available',
+        'NO_VALID_TARGETS'
+      );
+    }
+
+    const targetId = await agent.selectPlayer(validTargets, context);
+
+    if (!validTargets.includes(targetId)) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid target: ${targetId}. Must be one of: ${validTargets.join(', ')}`,
+        'INVALID_TARGET'
+      );
+    }
+
+    gameState.shieldPlayer(targetId);
+
+    return this.createSuccessResult(context.myPlayerId, {
+      shielded: targetId
+    });
+  }
+}
@@ -0,0 +1,112 @@
+/**
+ * @fileoverview Squire night action implementation.
+ * @module patterns/strategy/actions/SquireAction
+ *
+ * @summary Handles the Squire's night action - seeing who the Werewolves are.
+ *
+ * @description
+ * The Squire wakes and sees the Werewolves, exactly like the Minion.
+ * The Werewolves do NOT see who the Squire is.
+ *
+ * The difference is in how the Squire wins: the Minion has its own
+ * condition when no Werewolves are among the players, while the Squire
+ * simply wins and loses with the Werewolf team under the Werewolves'
+ * condition.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Squire
+ *
+ * @example
+ * ```typescript
+ * const squireAction = new SquireAction();
+ * const result = await squireAction.execute(context, agent, gameState);
+ *
+ * // result.info.werewolves = ['player-2', 'player-4']
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Squire night action - see who the Werewolves are.
+ *
+ * @description
+ * The Squire:
+ * 1. Wakes up after the Minion
+ * 2. Sees which players are Werewolves
+ * 3. Werewolves do NOT learn the Squire's identity
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const squire = new SquireAction();
+ * const result = await squire.execute(context, agent, gameState);
+ * // result.info.werewolves lists the werewolf players
+ * ```
+ */
+export class SquireAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new SquireAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.SQUIRE
+   */
+  getRoleName(): RoleName {
+    return RoleName.SQUIRE;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Squire night ability
+   */
+  getDescription(): string {
+    return 'See who the Werewolves are. You win only if no Werewolf dies';
+  }
+
+  /**
+   * @summary Returns 'VIEW' as the action type.
+   *
+   * @returns {'VIEW'} Always returns 'VIEW'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'VIEW';
+  }
+
+  /**
+   * @summary Executes the Squire night action.
+   *
+   * @description
+   * Lists the Werewolves the same way the Minion does. No agent
+   * decision needed.
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} _agent - Decision-maker (unused - no choice)
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result containing werewolf IDs
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    _agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    return this.createSuccessResult(context.myPlayerId, {
+      werewolves: this.findWerewolves(gameState)
+    });
+  }
+}
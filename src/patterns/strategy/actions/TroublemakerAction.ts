@@ -16,8 +16,6 @@
  * @pattern Strategy Pattern - Concrete Strategy for Troublemaker
  *
  * @remarks
- * Wake order: 7 (after Robber, before Drunk)
- *
  * Strategic implications:
  * - Can "save" a player by swapping their Werewolf card away
  * - Can "condemn" a player by swapping a Werewolf card to them
@@ -83,15 +81,12 @@ export class TroublemakerAction extends AbstractNightAction {
   }
 
   /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Troublemaker wakes at order 7, after Robber but before Drunk.
+   * @summary The Troublemaker may choose not to act.
    *
-   * @returns {number} 7
+   * @returns {boolean} true
    */
-  getNightOrder(): number {
-    return 7;
+  isOptional(): boolean {
+    return true;
   }
 
   /**
@@ -147,35 +142,55 @@ export class TroublemakerAction extends AbstractNightAction {
     if (validTargets.length < 2) {
       return this.createFailureResult(
         context.myPlayerId,
-        'Not enough players to swap (need at least 2 other players)'
+        'Not enough players to swap (need at least 2 other players)',
+        'NO_VALID_TARGETS'
       );
     }
 
     // Ask agent to select two players to swap
-    const [player1Id, player2Id] = await agent.selectTwoPlayers(validTargets, context);
+    const selection = await agent.selectTwoPlayers(validTargets, context);
 
-    // Validate selections
-    if (!validTargets.includes(player1Id) || !validTargets.includes(player2Id)) {
+    if (!Array.isArray(selection) || selection.length !== 2) {
       return this.createFailureResult(
         context.myPlayerId,
-        `Invalid targets: ${player1Id}, ${player2Id}`
+        'Must select exactly two players',
+        'WRONG_TARGET_COUNT'
       );
     }
 
-    if (player1Id === player2Id) {
+    const [player1Id, player2Id] = selection;
+
+    // Validate selections (self first, since self is never a valid target)
+    if (player1Id === context.myPlayerId || player2Id === context.myPlayerId) {
       return this.createFailureResult(
         context.myPlayerId,
-        'Must select two different players'
+        'Cannot swap your own card',
+        'SELF_TARGET'
       );
     }
 
-    if (player1Id === context.myPlayerId || player2Id === context.myPlayerId) {
+    if (!validTargets.includes(player1Id) || !validTargets.includes(player2Id)) {
       return this.createFailureResult(
         context.myPlayerId,
-        'Cannot swap your own card'
+        `Invalid targets: ${player1Id}, ${player2Id}`,
+        'INVALID_TARGET'
       );
     }
 
+    if (player1Id === player2Id) {
+      return this.createFailureResult(
+        context.myPlayerId,
+        'Must select two different players',
+        'DUPLICATE_TARGET'
+      );
+    }
+
+    // A shielded card can't be moved
+    const shielded = this.checkShielded(context.myPlayerId, gameState, player1Id, player2Id);
+    if (shielded) {
+      return shielded;
+    }
+
     // Perform the swap
     gameState.swapCards(
       { playerId: player1Id },
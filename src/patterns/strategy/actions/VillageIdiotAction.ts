@@ -0,0 +1,147 @@
+/**
+ * @fileoverview Village Idiot night action implementation.
+ * @module patterns/strategy/actions/VillageIdiotAction
+ *
+ * @summary Handles the Village Idiot's night action - moving every player's card.
+ *
+ * @description
+ * The Village Idiot may move every player's card one seat to the left or
+ * to the right, without looking at any of them. Center cards never move.
+ * They may also choose to leave the cards alone.
+ *
+ * @pattern Strategy Pattern - Concrete Strategy for Village Idiot
+ *
+ * @remarks
+ * Waking after the Robber and Troublemaker means the rotation moves the
+ * cards those swaps left behind. The Drunk and Insomniac act on the
+ * rotated cards.
+ *
+ * Seats follow the game's player order. Shielded cards stay put and the
+ * rotation closes over them.
+ *
+ * @example
+ * ```typescript
+ * const villageIdiotAction = new VillageIdiotAction();
+ * const result = await villageIdiotAction.execute(context, agent, gameState);
+ *
+ * // result.info.rotated = { direction: 'LEFT', playerIds: ['player-1', ...] }
+ * ```
+ */
+
+import { RoleName } from '../../../enums';
+import { NightActionResult, NightActionContext } from '../../../types';
+import {
+  AbstractNightAction,
+  INightActionAgent,
+  INightActionGameState
+} from '../NightAction';
+
+/**
+ * @summary Village Idiot night action - rotate every player's card.
+ *
+ * @description
+ * The Village Idiot:
+ * 1. Chooses LEFT, RIGHT, or NONE
+ * 2. On LEFT or RIGHT, every player's card moves one seat that way
+ * 3. Does NOT look at any card
+ *
+ * @pattern Strategy Pattern - Concrete Strategy
+ *
+ * @example
+ * ```typescript
+ * const villageIdiot = new VillageIdiotAction();
+ * const result = await villageIdiot.execute(context, agent, gameState);
+ * // result.info.rotated is undefined if they declined
+ * ```
+ */
+export class VillageIdiotAction extends AbstractNightAction {
+  /**
+   * @summary Creates a new VillageIdiotAction instance.
+   */
+  constructor() {
+    super();
+  }
+
+  /**
+   * @summary Returns the role name.
+   *
+   * @returns {RoleName} RoleName.VILLAGE_IDIOT
+   */
+  getRoleName(): RoleName {
+    return RoleName.VILLAGE_IDIOT;
+  }
+
+  /**
+   * @summary The Village Idiot may choose not to act.
+   *
+   * @returns {boolean} true
+   */
+  isOptional(): boolean {
+    return true;
+  }
+
+  /**
+   * @summary Returns a description of the action.
+   *
+   * @returns {string} Description of Village Idiot night ability
+   */
+  getDescription(): string {
+    return 'You may move every player\'s card one seat to the left or right';
+  }
+
+  /**
+   * @summary Returns 'SWAP' as the action type.
+   *
+   * @returns {'SWAP'} Always returns 'SWAP'
+   *
+   * @protected
+   */
+  protected getActionType(): 'VIEW' | 'SWAP' | 'NONE' {
+    return 'SWAP';
+  }
+
+  /**
+   * @summary Executes the Village Idiot night action.
+   *
+   * @description
+   * 1. Ask the agent for a direction, or NONE
+   * 2. Rotate every player's card one seat that way
+   *
+   * @param {NightActionContext} context - What the player knows
+   * @param {INightActionAgent} agent - Decision-maker for choices
+   * @param {INightActionGameState} gameState - Game state access
+   *
+   * @returns {Promise<NightActionResult>} Result with the rotation, if any
+   *
+   * @example
+   * ```typescript
+   * const result = await villageIdiotAction.doExecute(context, agent, gameState);
+   * // result.info = { rotated: { direction: 'RIGHT', playerIds: [...] } }
+   * ```
+   */
+  protected async doExecute(
+    context: NightActionContext,
+    agent: INightActionAgent,
+    gameState: INightActionGameState
+  ): Promise<NightActionResult> {
+    const choice = await agent.chooseRotation(context);
+
+    if (choice === 'NONE') {
+      return this.createSuccessResult(context.myPlayerId, {});
+    }
+
+    if (choice !== 'LEFT' && choice !== 'RIGHT') {
+      return this.createFailureResult(
+        context.myPlayerId,
+        `Invalid direction: ${choice}. Must be LEFT, RIGHT, or NONE.`,
+        'INVALID_TARGET'
+      );
+    }
+
+    const playerIds = this.rotatePlayerCards(gameState, gameState.getAllPlayerIds(), choice);
+
+    return this.createSuccessResult(context.myPlayerId, {
+      rotated: { direction: choice, playerIds }
+    });
+  }
+}
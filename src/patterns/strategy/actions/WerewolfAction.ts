@@ -15,11 +15,10 @@
  * @pattern Strategy Pattern - Concrete Strategy for Werewolf
  *
  * @remarks
- * Wake order: 2 (after Doppelganger, before Minion)
- *
  * Important notes:
  * - Werewolves see each other simultaneously
  * - Werewolves do NOT see the Minion
+ * - Werewolves DO see the Dream Wolf, who doesn't wake to see them back
  * - A Doppelganger who copied Werewolf will also participate
  * - The Lone Wolf choice is optional (player decides if they want to look)
  *
@@ -99,17 +98,12 @@ export class WerewolfAction extends AbstractNightAction {
   }
 
   /**
-   * @summary Returns the night wake order.
-   *
-   * @description
-   * Werewolves wake at order 2, after Doppelganger (1) but before
-   * Minion (3). This ensures Doppelganger has already copied their
-   * role before Werewolf identification happens.
+   * @summary The lone Werewolf may choose not to act.
    *
-   * @returns {number} 2
+   * @returns {boolean} true
    */
-  getNightOrder(): number {
-    return 2;
+  isOptional(): boolean {
+    return true;
   }
 
   /**
@@ -167,15 +161,9 @@ export class WerewolfAction extends AbstractNightAction {
     agent: INightActionAgent,
     gameState: INightActionGameState
   ): Promise<NightActionResult> {
-    // Find all other Werewolves by STARTING role (not affected by swaps)
-    const startingWerewolves = gameState.getPlayersWithStartingRole(RoleName.WEREWOLF);
-
-    // Also find Doppelgangers who copied Werewolf (they wake at order 1, before us at order 2)
-    const doppelWerewolves = gameState.getDoppelgangersWhoCopied(RoleName.WEREWOLF);
-
-    // Combine both groups (no duplicates since starting werewolves can't be doppelgangers)
-    const allWerewolves = [...startingWerewolves, ...doppelWerewolves];
-    const otherWerewolves = allWerewolves.filter(id => id !== context.myPlayerId);
+    // Find all other Werewolves (including Mystic Wolves, Dream Wolves and
+    // Doppel-Werewolves) by STARTING role, so swaps don't affect who wakes together
+    const otherWerewolves = this.findWerewolves(gameState).filter(id => id !== context.myPlayerId);
 
     if (otherWerewolves.length > 0) {
       // Not alone - see other Werewolves
@@ -196,11 +184,9 @@ export class WerewolfAction extends AbstractNightAction {
     const centerIndex = await agent.selectCenterCard(context);
 
     // Validate center index
-    if (centerIndex < 0 || centerIndex > 2) {
-      return this.createFailureResult(
-        context.myPlayerId,
-        `Invalid center card index: ${centerIndex}. Must be 0, 1, or 2.`
-      );
+    const indexError = this.validateCenterIndex(centerIndex, gameState);
+    if (indexError) {
+      return this.createFailureResult(context.myPlayerId, indexError, 'INVALID_TARGET');
     }
 
     const centerRole = gameState.getCenterCard(centerIndex);
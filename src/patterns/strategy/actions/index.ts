@@ -28,14 +28,23 @@
  */
 
 // Roles with night actions
+export { SentinelAction } from './SentinelAction';
 export { DoppelgangerAction } from './DoppelgangerAction';
 export { WerewolfAction } from './WerewolfAction';
+export { AlphaWolfAction } from './AlphaWolfAction';
+export { MysticWolfAction } from './MysticWolfAction';
 export { MinionAction } from './MinionAction';
+export { SquireAction } from './SquireAction';
 export { MasonAction } from './MasonAction';
 export { SeerAction } from './SeerAction';
+export { BeholderAction } from './BeholderAction';
+export { ApprenticeSeerAction } from './ApprenticeSeerAction';
 export { RobberAction } from './RobberAction';
 export { TroublemakerAction } from './TroublemakerAction';
+export { VillageIdiotAction } from './VillageIdiotAction';
 export { DrunkAction } from './DrunkAction';
+export { RevealerAction } from './RevealerAction';
+export { CuratorAction } from './CuratorAction';
 export { InsomniacAction } from './InsomniacAction';
 
 // Null Object Pattern for roles without night actions
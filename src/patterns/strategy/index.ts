@@ -19,6 +19,8 @@
  * import {
  *   INightAction,
  *   SeerAction,
+  BeholderAction,
+  ApprenticeSeerAction,
  *   IWinCondition,
  *   VillageWinCondition
  * } from './patterns/strategy';
@@ -39,19 +41,27 @@ export {
   INightActionAgent,
   INightActionGameState,
   AbstractNightAction,
+  NightActionSkippedError,
   CardPosition
 } from './NightAction';
 
 // All night action implementations
 export {
+  SentinelAction,
   DoppelgangerAction,
   WerewolfAction,
+  AlphaWolfAction,
+  MysticWolfAction,
   MinionAction,
+  SquireAction,
   MasonAction,
   SeerAction,
   RobberAction,
   TroublemakerAction,
+  VillageIdiotAction,
   DrunkAction,
+  RevealerAction,
+  CuratorAction,
   InsomniacAction,
   NoAction
 } from './actions';
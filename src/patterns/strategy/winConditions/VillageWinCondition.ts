@@ -9,7 +9,7 @@
  *
  * Special cases:
  * - If NO Werewolves exist among players (all in center):
- *   - Village wins if Minion is killed, OR
+ *   - Village wins if only the Minion is killed, OR
  *   - Village wins if no one dies
  * - If NO Werewolves AND NO Minion exist:
  *   - Village wins if no one dies
@@ -43,11 +43,13 @@
  * ```
  */
 
-import { Team, RoleName } from '../../../enums';
+import { Team } from '../../../enums';
 import {
   AbstractWinCondition,
   WinConditionContext,
-  WinConditionResult
+  WinConditionResult,
+  isWerewolf,
+  isMinion
 } from './WinCondition';
 
 /**
@@ -56,7 +58,7 @@ import {
  * @description
  * Evaluates whether the Village team won based on:
  * 1. Normal case: At least one Werewolf was killed
- * 2. No werewolves: Minion was killed OR no one died
+ * 2. No werewolves: only the Minion was killed OR no one died
  *
  * @pattern Strategy Pattern - Concrete Strategy
  *
@@ -98,7 +100,7 @@ export class VillageWinCondition extends AbstractWinCondition {
    * @returns {string} Description of Village win condition
    */
   getDescription(): string {
-    return 'At least one Werewolf must die. If no Werewolves exist, Minion must die OR no one dies.';
+    return 'At least one Werewolf must die. If no Werewolves exist, only the Minion may die, or no one.';
   }
 
   /**
@@ -111,8 +113,9 @@ export class VillageWinCondition extends AbstractWinCondition {
    * 2. If Werewolves exist among players:
    *    - Village wins if at least one Werewolf was killed
    * 3. If NO Werewolves exist among players:
-   *    - Village wins if Minion was killed, OR
+   *    - Village wins if the Minion was the only one killed, OR
    *    - Village wins if no one was killed
+   *    - Anyone else dying hands the win to the Minion
    *
    * @param {WinConditionContext} context - Game end state
    *
@@ -130,18 +133,14 @@ export class VillageWinCondition extends AbstractWinCondition {
     const villageMembers = this.getTeamMembers(context);
 
     // Check if any werewolves were killed
-    const werewolfKilled = context.eliminatedPlayers.some(
-      p => p.currentRole === RoleName.WEREWOLF
-    );
-
-    // Check if Minion was killed
-    const minionKilled = context.eliminatedPlayers.some(
-      p => p.currentRole === RoleName.MINION
-    );
+    const werewolfKilled = context.eliminatedPlayers.some(isWerewolf);
 
     // No one was killed
     const noOneKilled = context.eliminatedPlayers.length === 0;
 
+    // Only the Minion (or Minions) was killed
+    const onlyMinionKilled = !noOneKilled && context.eliminatedPlayers.every(isMinion);
+
     // CASE 1: Werewolves exist among players
     if (context.werewolvesExistAmongPlayers) {
       if (werewolfKilled) {
@@ -161,8 +160,8 @@ export class VillageWinCondition extends AbstractWinCondition {
 
     // Check if Minion exists among players
     if (context.minionExistsAmongPlayers) {
-      // Village wins if Minion is killed
-      if (minionKilled) {
+      // Village wins if the Minion is the only one killed
+      if (onlyMinionKilled) {
         return this.createWinResult(
           villageMembers,
           'No Werewolves exist among players; Minion was eliminated'
@@ -175,9 +174,9 @@ export class VillageWinCondition extends AbstractWinCondition {
           'No Werewolves exist among players; no one was eliminated'
         );
       }
-      // Village loses if someone other than Minion dies
+      // Village loses if anyone other than the Minion dies
       return this.createLossResult(
-        'No Werewolves exist, but Minion survived and someone else died'
+        'No Werewolves exist, but someone other than the Minion was eliminated'
       );
     }
 
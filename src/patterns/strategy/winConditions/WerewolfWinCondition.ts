@@ -18,6 +18,7 @@
  * Werewolf team members:
  * - Werewolf
  * - Minion
+ * - Squire
  *
  * The Minion has a unique position:
  * - They're on Werewolf team
@@ -25,6 +26,9 @@
  * - They know who Werewolves are
  * - Werewolves don't know who Minion is
  *
+ * The Squire knows the Werewolves like the Minion but has no rule of its
+ * own: it wins exactly when this condition says the Werewolves win.
+ *
  * @example
  * ```typescript
  * const condition = new WerewolfWinCondition();
@@ -38,11 +42,13 @@
  * ```
  */
 
-import { Team, RoleName } from '../../../enums';
+import { Team } from '../../../enums';
 import {
   AbstractWinCondition,
   WinConditionContext,
-  WinConditionResult
+  WinConditionResult,
+  isWerewolf,
+  isMinion
 } from './WinCondition';
 
 /**
@@ -56,9 +62,11 @@ import {
  * @pattern Strategy Pattern - Concrete Strategy
  *
  * @remarks
- * Special case: If no Werewolves exist among players and Minion exists:
- * - Minion wins if SOMEONE (other than Minion) is killed
- * - This is handled by the Minion still being on Werewolf team
+ * Special case: If no Werewolves exist among players:
+ * - With a Minion, the team (Minion and any Squire) wins if SOMEONE other
+ *   than the Minion is killed; a Squire's death counts
+ * - Without a Minion, a Squire still wins if anyone is killed, even the
+ *   Squire themselves
  *
  * @example
  * ```typescript
@@ -113,8 +121,10 @@ export class WerewolfWinCondition extends AbstractWinCondition {
    * Note: Minion dying does NOT affect Werewolf win condition
    *
    * Special case - no Werewolves among players:
-   * - If Minion exists and someone dies, Werewolf team (Minion) wins
+   * - If Minion exists and someone other than the Minion dies, the
+   *   Werewolf team (Minion and any Squire) wins
    * - If Minion exists and no one dies, Werewolf team loses
+   * - With only Squires, they win if anyone dies
    *
    * @param {WinConditionContext} context - Game end state
    *
@@ -129,7 +139,7 @@ export class WerewolfWinCondition extends AbstractWinCondition {
    * ```
    */
   evaluate(context: WinConditionContext): WinConditionResult {
-    // Get all Werewolf team members (Werewolves AND Minion)
+    // Get all Werewolf team members (Werewolves, Minion and Squires)
     const werewolfTeamMembers = this.getTeamMembers(context);
 
     // BLOCKING CONDITION: Tanner death blocks Werewolf win
@@ -140,9 +150,7 @@ export class WerewolfWinCondition extends AbstractWinCondition {
     }
 
     // Check if any Werewolves were killed
-    const werewolfKilled = context.eliminatedPlayers.some(
-      p => p.currentRole === RoleName.WEREWOLF
-    );
+    const werewolfKilled = context.eliminatedPlayers.some(isWerewolf);
 
     // CASE 1: Werewolves exist among players
     if (context.werewolvesExistAmongPlayers) {
@@ -158,8 +166,8 @@ export class WerewolfWinCondition extends AbstractWinCondition {
       }
     }
 
-    // CASE 2: No Werewolves exist among players
-    // Only Minion can be on Werewolf team
+    // CASE 2: No Werewolves among players, but a Minion
+    // Any Squires win or lose with the Minion
 
     if (context.minionExistsAmongPlayers) {
       // If no one dies, Minion loses (Village wins)
@@ -169,36 +177,32 @@ export class WerewolfWinCondition extends AbstractWinCondition {
         );
       }
 
-      // Check if Minion was the only one killed
-      const minionKilled = context.eliminatedPlayers.some(
-        p => p.currentRole === RoleName.MINION
-      );
-
-      if (minionKilled && context.eliminatedPlayers.length === 1) {
-        // Only Minion died - Village wins
+      // The Minion needs someone else to die; their own death doesn't count
+      if (context.eliminatedPlayers.every(isMinion)) {
         return this.createLossResult(
           'No Werewolves exist; only Minion was eliminated'
         );
       }
 
-      // Someone other than (or in addition to) Minion died
-      // Werewolf team (Minion) wins!
+      // Someone other than (or in addition to) Minion died, a Squire included
+      // Werewolf team (Minion and Squires) wins!
       return this.createWinResult(
         werewolfTeamMembers,
         'No Werewolves exist among players; someone was eliminated'
       );
     }
 
-    // CASE 3: No Werewolves AND no Minion among players
+    // CASE 3: No Werewolves AND no Minion among players (Squires at most)
     // Check if someone was killed - if so, Werewolves win (strict ONUW rules)
     // Village failed by killing an innocent when there was no threat
     if (context.eliminatedPlayers.length > 0) {
-      // Werewolf cards in center "win" - but no players to receive the victory
-      // Return empty winners array but still mark as won
+      // Werewolf cards in center "win"; the only players who can share the
+      // victory are Squires, who win whenever the Werewolves do - even when
+      // the innocent the Village killed was the Squire
       return {
         team: this.getTeam(),
         won: true,
-        winners: [], // No werewolf players to claim victory
+        winners: werewolfTeamMembers,
         reason: 'No Werewolves exist among players, but Village killed an innocent'
       };
     }
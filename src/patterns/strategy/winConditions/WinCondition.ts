@@ -36,7 +36,7 @@
  * ```
  */
 
-import { Team, RoleName } from '../../../enums';
+import { Team, RoleName, WEREWOLF_ROLES } from '../../../enums';
 
 /**
  * @summary Information about a player for win condition evaluation.
@@ -178,6 +178,39 @@ export interface IWinCondition {
   getDescription(): string;
 }
 
+/**
+ * @summary Checks whether a player ended the game as a Werewolf.
+ *
+ * @description
+ * A Doppelganger who copied a Werewolf or Mystic Wolf counts too, as
+ * long as they still hold the Doppelganger card.
+ *
+ * @param {PlayerWinInfo} player - Player to check
+ *
+ * @returns {boolean} True if the player is a Werewolf
+ */
+export function isWerewolf(player: PlayerWinInfo): boolean {
+  return WEREWOLF_ROLES.has(player.currentRole) ||
+    (player.currentRole === RoleName.DOPPELGANGER &&
+      player.copiedRole !== undefined && WEREWOLF_ROLES.has(player.copiedRole));
+}
+
+/**
+ * @summary Checks whether a player ended the game as a Minion.
+ *
+ * @description
+ * A Doppelganger who copied the Minion counts too, as long as they
+ * still hold the Doppelganger card.
+ *
+ * @param {PlayerWinInfo} player - Player to check
+ *
+ * @returns {boolean} True if the player is a Minion
+ */
+export function isMinion(player: PlayerWinInfo): boolean {
+  return player.currentRole === RoleName.MINION ||
+    (player.currentRole === RoleName.DOPPELGANGER && player.copiedRole === RoleName.MINION);
+}
+
 /**
  * @summary Abstract base class for win conditions.
  *
@@ -43,7 +43,9 @@ export {
   AbstractWinCondition,
   WinConditionContext,
   WinConditionResult,
-  PlayerWinInfo
+  PlayerWinInfo,
+  isWerewolf,
+  isMinion
 } from './WinCondition';
 
 // Concrete win condition strategies
@@ -31,7 +31,8 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../types';
 
 /**
@@ -182,6 +183,20 @@ export class AIPlayer extends AbstractPlayer {
     return this.agent.chooseSeerOption(context);
   }
 
+  /**
+   * @summary Chooses which way to move every player's card.
+   *
+   * @description
+   * Delegates to the wrapped agent's chooseRotation method.
+   *
+   * @param {NightActionContext} context - Current game context
+   *
+   * @returns {Promise<RotationChoice>} The chosen direction, or 'NONE'
+   */
+  async chooseRotation(context: NightActionContext): Promise<RotationChoice> {
+    return this.agent.chooseRotation(context);
+  }
+
   /**
    * @summary Selects two players for Troublemaker swap.
    *
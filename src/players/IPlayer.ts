@@ -30,7 +30,8 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../types';
 
 /**
@@ -185,6 +186,20 @@ export interface IPlayer {
    */
   chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
 
+  /**
+   * @summary Chooses which way to move every player's card.
+   *
+   * @description
+   * Village Idiot-specific choice. 'NONE' leaves the cards alone.
+   *
+   * @param {NightActionContext} context - Current game context
+   *
+   * @returns {Promise<RotationChoice>} 'LEFT', 'RIGHT' or 'NONE'
+   *
+   * @throws {TimeoutError} If player doesn't respond in time
+   */
+  chooseRotation(context: NightActionContext): Promise<RotationChoice>;
+
   /**
    * @summary Selects two different players.
    *
@@ -352,6 +367,9 @@ export abstract class AbstractPlayer implements IPlayer {
   /** @inheritdoc */
   abstract chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'>;
 
+  /** @inheritdoc */
+  abstract chooseRotation(context: NightActionContext): Promise<RotationChoice>;
+
   /** @inheritdoc */
   abstract selectTwoPlayers(options: string[], context: NightActionContext): Promise<[string, string]>;
 
@@ -10,6 +10,11 @@
  * - Roles, night actions, and votes are hidden until appropriate
  * - Server NEVER sends raw game state to clients
  *
+ * Views are built field by field: every value placed in a view is
+ * copied from an explicit list of fields, never passed through as the
+ * game's own object. A field added to an internal type later stays on
+ * the server until someone chooses to add it here.
+ *
  * @pattern Information Hiding - Core security for multiplayer games
  *
  * @example
@@ -24,9 +29,18 @@
 
 import { Game } from '../core/Game';
 import { GamePhase, RoleName, Team } from '../enums';
-import { PlayerStatement, NightActionResult } from '../types';
+import { PlayerStatement, NightActionResult, NightActionInfo, CardPosition } from '../types';
 import { SerializablePlayerGameView, PublicPlayerInfo, PlayerId } from '../network/protocol';
 
+/**
+ * @summary Who may see how others voted.
+ *
+ * - 'public': who has voted shows as votes arrive; the full mapping at the end
+ * - 'secretUntilEnd': only your own vote shows until the end, then the full mapping
+ * - 'secret': only your own vote shows, even after the game
+ */
+export type VoteVisibility = 'public' | 'secretUntilEnd' | 'secret';
+
 /**
  * @summary Factory for creating player-specific game views.
  *
@@ -64,6 +78,8 @@ export class PlayerViewFactory {
    * @param {Game} game - The game instance
    * @param {string} playerId - ID of the player requesting the view
    * @param {number} [timeRemaining] - Optional time remaining in current phase
+   * @param {number | null} [phaseEndsAt] - Optional absolute phase deadline (epoch ms)
+   * @param {VoteVisibility} [voteVisibility='public'] - Whether other players' votes are secret
    *
    * @returns {SerializablePlayerGameView} Sanitized view for the player (JSON-safe)
    *
@@ -79,7 +95,9 @@ export class PlayerViewFactory {
   static createView(
     game: Game,
     playerId: string,
-    timeRemaining: number | null = null
+    timeRemaining: number | null = null,
+    phaseEndsAt: number | null = null,
+    voteVisibility: VoteVisibility = 'public'
   ): SerializablePlayerGameView {
     const state = game.getState();
     const playerIndex = state.players.findIndex(p => p.id === playerId);
@@ -92,29 +110,36 @@ export class PlayerViewFactory {
     const phase = state.phase;
 
     // Build public player info (no roles exposed)
-    const publicPlayers = this.buildPublicPlayerInfo(game, state.players);
+    const publicPlayers = this.buildPublicPlayerInfo(game, state.players, playerId, voteVisibility);
 
     // Get player's private night info
     const myNightInfo = this.getPlayerNightInfo(game, playerId);
 
     // Determine what's visible based on phase
+    const isNightOver = phase !== GamePhase.SETUP && phase !== GamePhase.NIGHT;
     const isResolution = phase === GamePhase.RESOLUTION;
     const isEnded = phase === GamePhase.RESOLUTION && game.isGameEnded();
 
+    // A Curator's artifact hides the player's own role until the game ends
+    const isRoleHidden = isNightOver && !game.isGameEnded() && game.hasArtifact(playerId);
+
     return {
       myPlayerId: playerId,
       gameId: game.getId(),
       phase: phase,
-      myStartingRole: player.startingRole.name,
+      myStartingRole: isRoleHidden ? null : player.startingRole.name,
       myNightInfo: myNightInfo,
       players: publicPlayers,
       statements: this.getPublicStatements(game),
-      votes: isResolution ? this.getVotesAsRecord(game) : null,
+      votes: isResolution && voteVisibility !== 'secret' ? this.getVotesAsRecord(game) : null,
       eliminatedPlayers: isResolution ? this.getEliminatedPlayers(game) : null,
       finalRoles: isEnded ? this.getFinalRolesAsRecord(game) : null,
+      revealedCards: isNightOver ? Object.fromEntries(game.getRevealedCards()) : undefined,
+      artifacts: isNightOver ? game.getArtifactPlayerIds() : undefined,
       winningTeams: isEnded ? this.getWinningTeams(game) : null,
       winningPlayers: isEnded ? this.getWinningPlayers(game) : null,
-      timeRemaining: timeRemaining
+      timeRemaining: timeRemaining,
+      phaseEndsAt: phaseEndsAt
     };
   }
 
@@ -128,24 +153,32 @@ export class PlayerViewFactory {
    * @param {Game} game - The game instance
    * @param {string} playerId - ID of the reconnecting player
    * @param {NightActionResult[]} missedNightInfo - Night info received while disconnected
+   * @param {number | null} [timeRemaining] - Seconds left in the current phase
+   * @param {number | null} [phaseEndsAt] - Absolute phase deadline (epoch ms)
+   * @param {VoteVisibility} [voteVisibility='public'] - Whether other players' votes are secret
    *
    * @returns {SerializablePlayerGameView} View with full catch-up information (JSON-safe)
    */
   static createReconnectionView(
     game: Game,
     playerId: string,
-    missedNightInfo: NightActionResult[]
+    missedNightInfo: NightActionResult[],
+    timeRemaining: number | null = null,
+    phaseEndsAt: number | null = null,
+    voteVisibility: VoteVisibility = 'public'
   ): SerializablePlayerGameView {
-    const baseView = this.createView(game, playerId);
+    const baseView = this.createView(game, playerId, timeRemaining, phaseEndsAt, voteVisibility);
 
     // Include any night info that was received during AI takeover
     const combinedNightInfo = [
       ...baseView.myNightInfo,
-      ...missedNightInfo.filter(info =>
-        !baseView.myNightInfo.some(existing =>
-          existing.roleName === info.roleName
+      ...missedNightInfo
+        .filter(info =>
+          !baseView.myNightInfo.some(existing =>
+            existing.roleName === info.roleName
+          )
         )
-      )
+        .map(toClientNightResult)
     ];
 
     return {
@@ -154,6 +187,23 @@ export class PlayerViewFactory {
     };
   }
 
+  /**
+   * @summary Creates a player's dawn summary.
+   *
+   * @description
+   * The night results sent to a player when the day begins. Only the
+   * player's own results are included, so private information such as
+   * the lone werewolf's center peek reaches that player alone.
+   *
+   * @param {Game} game - The game instance
+   * @param {string} playerId - Player to summarize the night for
+   *
+   * @returns {NightActionResult[]} The player's own night results
+   */
+  static createDawnSummary(game: Game, playerId: string): NightActionResult[] {
+    return this.getPlayerNightInfo(game, playerId);
+  }
+
   /**
    * @summary Builds public player information.
    *
@@ -163,6 +213,8 @@ export class PlayerViewFactory {
    *
    * @param {Game} game - The game instance
    * @param {IPlayer[]} players - All players in the game
+   * @param {string} viewerId - Player the view is for
+   * @param {VoteVisibility} voteVisibility - Whether other players' votes are secret
    *
    * @returns {PublicPlayerInfo[]} Array of public player info
    *
@@ -170,10 +222,21 @@ export class PlayerViewFactory {
    */
   private static buildPublicPlayerInfo(
     game: Game,
-    players: readonly { id: string; name: string }[]
+    players: readonly { id: string; name: string }[],
+    viewerId: string,
+    voteVisibility: VoteVisibility
   ): PublicPlayerInfo[] {
     const statements = game.getStatements();
     const votes = game.getVotes();
+    const submitted = game.getPhase() === GamePhase.VOTING ? game.getSubmittedVoters() : new Set<string>();
+
+    // With a secret ballot, only the viewer's own vote shows while voting is open
+    const hasVoted = (playerId: string): boolean => {
+      if (voteVisibility !== 'public' && playerId !== viewerId && game.getPhase() !== GamePhase.RESOLUTION) {
+        return false;
+      }
+      return votes.has(playerId) || submitted.has(playerId);
+    };
 
     return players.map(player => ({
       id: player.id,
@@ -181,7 +244,7 @@ export class PlayerViewFactory {
       isConnected: game.isPlayerConnected(player.id),
       isAI: game.isPlayerAI(player.id),
       hasSpoken: statements.some(s => s.playerId === player.id),
-      hasVoted: votes.has(player.id)
+      hasVoted: hasVoted(player.id)
     }));
   }
 
@@ -199,7 +262,7 @@ export class PlayerViewFactory {
     game: Game,
     playerId: string
   ): NightActionResult[] {
-    return game.getPlayerNightInfo(playerId);
+    return game.getPlayerNightInfo(playerId).map(toClientNightResult);
   }
 
   /**
@@ -212,7 +275,11 @@ export class PlayerViewFactory {
    * @private
    */
   private static getPublicStatements(game: Game): PlayerStatement[] {
-    return game.getStatements();
+    return game.getStatements().map(s => ({
+      playerId: s.playerId,
+      statement: s.statement,
+      timestamp: s.timestamp
+    }));
   }
 
   /**
@@ -299,6 +366,82 @@ export class PlayerViewFactory {
   }
 }
 
+// ============================================================================
+// CLIENT COPIES
+// ============================================================================
+
+/**
+ * @summary Copies a card position for a client.
+ *
+ * @param {CardPosition} position - Internal card position
+ *
+ * @returns {CardPosition} Copy with only player ID or center index
+ */
+function toClientPosition(position: CardPosition): CardPosition {
+  return position.playerId !== undefined
+    ? { playerId: position.playerId }
+    : { centerIndex: position.centerIndex };
+}
+
+/**
+ * @summary How each night info field is copied for a client.
+ *
+ * @description
+ * Every NightActionInfo field needs an entry, so a role that adds a
+ * field fails to compile until it says how that field reaches the
+ * player. Each copier rebuilds its value from known properties only.
+ */
+const CLIENT_INFO_COPIERS: {
+  [K in keyof NightActionInfo]-?: (value: NonNullable<NightActionInfo[K]>) => NightActionInfo[K];
+} = {
+  viewed: viewed => viewed.map(v => (v.playerId !== undefined
+    ? { playerId: v.playerId, role: v.role }
+    : { centerIndex: v.centerIndex, role: v.role })),
+  swapped: swapped => ({ from: toClientPosition(swapped.from), to: toClientPosition(swapped.to) }),
+  copied: copied => ({ fromPlayerId: copied.fromPlayerId, role: copied.role }),
+  werewolves: werewolves => [...werewolves],
+  masons: masons => [...masons],
+  seers: seers => [...seers],
+  shielded: shielded => shielded,
+  rotated: rotated => ({ direction: rotated.direction, playerIds: [...rotated.playerIds] }),
+  revealed: revealed => revealed,
+  artifact: artifact => artifact,
+  turned: turned => turned,
+  skipped: skipped => skipped
+};
+
+/**
+ * @summary Copies a night action result for the player who performed it.
+ *
+ * @description
+ * Each info field is rebuilt by CLIENT_INFO_COPIERS, so anything else
+ * carried on the internal result never reaches the client.
+ *
+ * @param {NightActionResult} result - Internal night action result
+ *
+ * @returns {NightActionResult} Client-safe copy
+ */
+export function toClientNightResult(result: NightActionResult): NightActionResult {
+  const info: Record<string, unknown> = {};
+  for (const key of Object.keys(CLIENT_INFO_COPIERS) as (keyof NightActionInfo)[]) {
+    const value = result.info[key];
+    if (value !== undefined) {
+      info[key] = (CLIENT_INFO_COPIERS[key] as (v: unknown) => unknown)(value);
+    }
+  }
+
+  return {
+    actorId: result.actorId,
+    roleName: result.roleName,
+    actionType: result.actionType,
+    success: result.success,
+    info: info as NightActionInfo,
+    ...(result.error !== undefined && { error: result.error }),
+    ...(result.failureCode !== undefined && { failureCode: result.failureCode }),
+    ...(result.failureKind !== undefined && { failureKind: result.failureKind })
+  };
+}
+
 /**
  * @summary Validates that a view doesn't contain forbidden information.
  *
@@ -36,7 +36,8 @@ import {
   NightActionContext,
   DayContext,
   VotingContext,
-  NightActionResult
+  NightActionResult,
+  RotationChoice
 } from '../types';
 
 /**
@@ -441,6 +442,26 @@ export class RemoteHumanPlayer extends AbstractPlayer {
     );
   }
 
+  /**
+   * @summary Chooses which way to move every player's card.
+   *
+   * @description
+   * Sends a 'rotationChoice' request to the client.
+   *
+   * @param {NightActionContext} context - Current game context
+   *
+   * @returns {Promise<RotationChoice>} The chosen direction, or 'NONE'
+   *
+   * @throws {TimeoutError} If client doesn't respond in time
+   */
+  async chooseRotation(_context: NightActionContext): Promise<RotationChoice> {
+    return this.sendActionRequest<RotationChoice>(
+      'rotationChoice',
+      { options: ['LEFT', 'RIGHT', 'NONE'] },
+      this.timeoutConfig.nightActionMs
+    );
+  }
+
   /**
    * @summary Selects two players for Troublemaker swap.
    *
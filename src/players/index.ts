@@ -35,6 +35,7 @@ export {
 // Player view factory for information hiding
 export {
   PlayerViewFactory,
+  VoteVisibility,
   validatePlayerView
 } from './PlayerView';
 
@@ -8,6 +8,7 @@
  * This file creates and starts the combined HTTP/WebSocket game server:
  * - HTTP server handles REST API requests via ApiHandler
  * - WebSocket server handles real-time game communication
+ * Both run over TLS (HTTPS and wss) when a certificate and key are given.
  * It also initializes the PostgreSQL database connection.
  *
  * @pattern Facade Pattern - ApiHandler simplifies REST API access
@@ -17,13 +18,25 @@
 // Load environment variables from .env file (for local development)
 import 'dotenv/config';
 
-import { createServer, IncomingMessage, ServerResponse } from 'http';
+import { readFileSync } from 'fs';
+import { createServer, IncomingMessage, Server, ServerResponse } from 'http';
+import { createServer as createTlsServer, Server as TlsServer } from 'https';
+import * as path from 'path';
 import { WebSocketServer as WsServer, WebSocket } from 'ws';
 import { IWebSocketServerBackend } from './network/WebSocketServer';
-import { IWebSocket } from './network/WebSocketConnection';
+import { IWebSocket, DEFAULT_WEBSOCKET_CONFIG } from './network/WebSocketConnection';
 import { GameServerFacade } from './server/GameServerFacade';
 import { ApiHandler } from './server/ApiHandler';
+import { getClientIp, withRecovery, withRequestLogging } from './server/HttpMiddleware';
+import { handleHealthCheck, HealthSource } from './server/HealthCheck';
+import { handleMetricsRequest, PrometheusMetrics } from './server/Metrics';
+import { createTracerFromEnv } from './server/Tracing';
+import { createSanitizerFromEnv } from './server/TextSanitizer';
+import { OriginPolicy, parseAllowedOrigins } from './server/OriginPolicy';
+import { ListenConfig, getHeadersTimeoutMs, parseListenConfig } from './server/ListenConfig';
+import { JsonFileGameSnapshotStore } from './server/GameSnapshotStore';
 import { getDatabase } from './database';
+import { RoleFactory } from './patterns/factory';
 
 /**
  * @summary WebSocket server backend using the 'ws' library with HTTP server.
@@ -35,19 +48,63 @@ import { getDatabase } from './database';
  * @pattern Adapter Pattern - Adapts ws library to IWebSocketServerBackend
  */
 class WsServerBackend implements IWebSocketServerBackend {
-  private httpServer: ReturnType<typeof createServer> | null = null;
+  private httpServer: Server | TlsServer | null = null;
   private wss: WsServer | null = null;
   private connectionHandler: ((socket: IWebSocket) => void) | null = null;
   private errorHandler: ((error: Error) => void) | null = null;
   private apiHandler: ApiHandler;
+  private originPolicy: OriginPolicy;
 
-  constructor() {
-    this.apiHandler = new ApiHandler();
+  private healthSource: HealthSource | null = null;
+  private metrics: PrometheusMetrics;
+  private listenConfig: ListenConfig;
+  private trustProxy: boolean;
+
+  constructor(
+    apiHandler: ApiHandler,
+    originPolicy: OriginPolicy,
+    metrics: PrometheusMetrics,
+    listenConfig: ListenConfig,
+    trustProxy: boolean
+  ) {
+    this.apiHandler = apiHandler;
+    this.originPolicy = originPolicy;
+    this.metrics = metrics;
+    this.listenConfig = listenConfig;
+    this.trustProxy = trustProxy;
+  }
+
+  /**
+   * @summary Sets the game server that health probes report on.
+   *
+   * @param {HealthSource} source - Game server
+   */
+  attachHealthSource(source: HealthSource): void {
+    this.healthSource = source;
   }
 
   listen(port: number, host: string, callback: () => void): void {
-    // Create HTTP server that handles REST API requests
-    this.httpServer = createServer(async (req: IncomingMessage, res: ServerResponse) => {
+    // Create HTTP server that handles REST API requests. API requests are
+    // logged, and a handler that throws gets a 500 instead of taking the
+    // process down. The read timeout covers the whole request; headers
+    // keep Node's shorter default limit.
+    const { readTimeoutMs, writeTimeoutMs, tlsCertFile, tlsKeyFile } = this.listenConfig;
+    const wsScheme = tlsCertFile ? 'wss' : 'ws';
+    const serverOptions = {
+      requestTimeout: readTimeoutMs,
+      headersTimeout: getHeadersTimeoutMs(this.listenConfig)
+    };
+    const handler = withRequestLogging(withRecovery(async (req: IncomingMessage, res: ServerResponse) => {
+      // Load balancer probes skip CORS and the REST API entirely
+      if (this.healthSource && handleHealthCheck(req, res, this.healthSource)) {
+        return;
+      }
+
+      // So is the Prometheus scraper
+      if (handleMetricsRequest(req, res, this.metrics)) {
+        return;
+      }
+
       const handled = await this.apiHandler.handleRequest(req, res);
 
       if (!handled) {
@@ -56,16 +113,32 @@ class WsServerBackend implements IWebSocketServerBackend {
           'Content-Type': 'text/plain',
           'Upgrade': 'websocket'
         });
-        res.end('WebSocket connection required. Connect via ws:// protocol for game communication.');
+        res.end(`WebSocket connection required. Connect via ${wsScheme}:// protocol for game communication.`);
       }
-    });
+    }));
+
+    // Same handler and timeouts either way; only the transport differs
+    this.httpServer = tlsCertFile && tlsKeyFile
+      ? createTlsServer({ ...serverOptions, cert: readFileSync(tlsCertFile), key: readFileSync(tlsKeyFile) }, handler)
+      : createServer(serverOptions, handler);
 
-    // Attach WebSocket server to HTTP server
-    this.wss = new WsServer({ server: this.httpServer });
+    // Drop connections that sit idle while a response is written; ws
+    // clears this once a socket is upgraded
+    this.httpServer.setTimeout(writeTimeoutMs);
+
+    // Attach WebSocket server to HTTP server, refusing upgrades from
+    // origins outside the allowlist. Frames over the message size cap
+    // close the socket before they are buffered.
+    this.wss = new WsServer({
+      server: this.httpServer,
+      maxPayload: DEFAULT_WEBSOCKET_CONFIG.maxMessageSize,
+      verifyClient: (info: { origin?: string }) => this.originPolicy.isAllowed(info.origin || undefined)
+    });
 
-    this.wss.on('connection', (ws: WebSocket) => {
-      // The ws WebSocket matches our IWebSocket interface
-      const socket = ws as unknown as IWebSocket;
+    this.wss.on('connection', (ws: WebSocket, req: IncomingMessage) => {
+      // The ws WebSocket matches our IWebSocket interface once it knows
+      // which client it came from, for per-IP rate limits
+      const socket = Object.assign(ws, { remoteAddress: getClientIp(req, this.trustProxy) }) as unknown as IWebSocket;
 
       if (this.connectionHandler) {
         this.connectionHandler(socket);
@@ -124,20 +197,56 @@ class WsServerBackend implements IWebSocketServerBackend {
 }
 
 // Configuration
-const PORT = parseInt(process.env.PORT ?? '8080', 10);
-const HOST = process.env.HOST ?? '0.0.0.0';
+// Address from --addr, ADDR, or HOST and PORT; timeouts from flags or env
+const LISTEN = parseListenConfig(process.argv.slice(2), process.env);
+const DATA_DIR = process.env.DATA_DIR || path.join(process.cwd(), 'data');
+const SHUTDOWN_TIMEOUT_MS = parseInt(process.env.SHUTDOWN_TIMEOUT_MS ?? '10000', 10);
+const ROOM_TTL_MS = parseInt(process.env.ROOM_TTL_MS ?? '3600000', 10);
+const ROOM_SWEEP_INTERVAL_MS = parseInt(process.env.ROOM_SWEEP_INTERVAL_MS ?? '60000', 10);
+// Rooms one IP may create or join: a burst, then this many per minute
+const ROOM_RATE_LIMIT_PER_MINUTE = parseInt(process.env.ROOM_RATE_LIMIT_PER_MINUTE ?? '10', 10);
+const ROOM_RATE_LIMIT_BURST = parseInt(process.env.ROOM_RATE_LIMIT_BURST ?? '5', 10);
+const ABANDONED_GAME_TIMEOUT_MS = parseInt(process.env.ABANDONED_GAME_TIMEOUT_MS ?? '300000', 10);
+const ALLOWED_ORIGINS = parseAllowedOrigins(process.env.ALLOWED_ORIGINS);
+// Enables GET /api/admin/games when set
+const ADMIN_TOKEN = process.env.ADMIN_TOKEN;
+// Only behind a reverse proxy: take client IPs from X-Forwarded-For
+const TRUST_PROXY = process.env.TRUST_PROXY === 'true';
 
 // Create backend and server
-const backend = new WsServerBackend();
+const originPolicy = new OriginPolicy(ALLOWED_ORIGINS);
+const apiHandler = new ApiHandler({ originPolicy, adminToken: ADMIN_TOKEN, trustProxy: TRUST_PROXY });
+const metrics = new PrometheusMetrics();
+// Exports game traces when OTEL_EXPORTER_OTLP_ENDPOINT is set
+const tracer = createTracerFromEnv();
+const backend = new WsServerBackend(apiHandler, originPolicy, metrics, LISTEN, TRUST_PROXY);
 const server = new GameServerFacade(backend, {
-  port: PORT,
-  host: HOST,
+  port: LISTEN.port,
+  host: LISTEN.host,
   maxRooms: 100,
-  reconnectionGracePeriodMs: 30000
+  reconnectionGracePeriodMs: 30000,
+  roomTimeoutMs: ROOM_TTL_MS,
+  roomCleanupIntervalMs: ROOM_SWEEP_INTERVAL_MS,
+  abandonedGameTimeoutMs: ABANDONED_GAME_TIMEOUT_MS,
+  roomRateLimit: { ratePerMinute: ROOM_RATE_LIMIT_PER_MINUTE, burst: ROOM_RATE_LIMIT_BURST },
+  gameStore: new JsonFileGameSnapshotStore(path.join(DATA_DIR, 'games')),
+  metrics,
+  tracer,
+  // Masks the words listed in CHAT_BLOCKLIST_FILE, if set
+  sanitizer: createSanitizerFromEnv()
 });
 
+// Let REST endpoints manage live games
+apiHandler.attachRoomManager(server.getRoomManager());
+
+// Let /healthz and /readyz report on the game server
+backend.attachHealthSource(server);
+
 // Initialize database and start server
 async function startServer(): Promise<void> {
+  // Refuse to deal games from a half-added or mistyped role
+  RoleFactory.assertValidRoleTable();
+
   const db = getDatabase();
 
   // Connect to database
@@ -157,12 +266,20 @@ async function startServer(): Promise<void> {
   // Start WebSocket server
   await server.start();
 
+  const timeout = (ms: number): string => (ms > 0 ? `${ms}ms` : 'off');
+  const secure = LISTEN.tlsCertFile !== null;
+  console.log(
+    `Listening on ${LISTEN.host}:${LISTEN.port} over ${secure ? 'TLS' : 'plain HTTP'} ` +
+    `(read timeout ${timeout(LISTEN.readTimeoutMs)}, write timeout ${timeout(LISTEN.writeTimeoutMs)})`
+  );
+  const address = `${LISTEN.host}:${LISTEN.port}`;
+
   console.log(`
 ╔═══════════════════════════════════════════════════════════╗
 ║     One Night Ultimate Werewolf - Multiplayer Server      ║
 ╠═══════════════════════════════════════════════════════════╣
-║  WebSocket: ws://${HOST}:${PORT.toString().padEnd(32)}║
-║  REST API:  http://${HOST}:${PORT.toString().padEnd(30)}║
+║  WebSocket: ${`${secure ? 'wss' : 'ws'}://${address}`.padEnd(46)}║
+║  REST API:  ${`${secure ? 'https' : 'http'}://${address}`.padEnd(46)}║
 ${process.env.DATABASE_URL ? '║  Database:  PostgreSQL connected                          ║\n' : '║  Database:  Not configured (in-memory only)              ║\n'}║  Press Ctrl+C to stop                                     ║
 ╚═══════════════════════════════════════════════════════════╝
 `);
@@ -174,15 +291,37 @@ startServer().catch((error: Error) => {
 });
 
 // Handle graceful shutdown
-async function shutdown(): Promise<void> {
-  console.log('\nShutting down server...');
+let shuttingDown = false;
 
-  // Stop WebSocket server
-  await server.stop();
+async function shutdown(signal: NodeJS.Signals): Promise<void> {
+  if (shuttingDown) {
+    return;
+  }
+  shuttingDown = true;
 
-  // Disconnect database
-  const db = getDatabase();
-  await db.disconnect();
+  console.log(`\nReceived ${signal}, shutting down server...`);
+
+  // Don't let a stuck socket or database keep the process alive
+  const forceExit = setTimeout(() => {
+    console.error(`Shutdown did not finish within ${SHUTDOWN_TIMEOUT_MS}ms, exiting`);
+    process.exit(1);
+  }, SHUTDOWN_TIMEOUT_MS);
+  forceExit.unref();
+
+  try {
+    // Notify clients, save games and close all connections
+    await server.stop();
+
+    // Send the spans of games that just closed
+    await tracer.shutdown();
+
+    // Disconnect database
+    const db = getDatabase();
+    await db.disconnect();
+  } catch (error) {
+    console.error('Error during shutdown:', error);
+    process.exit(1);
+  }
 
   console.log('Server stopped');
   process.exit(0);
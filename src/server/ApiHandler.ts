@@ -5,7 +5,8 @@
  * @description
  * Provides HTTP REST endpoints for operations that don't fit the
  * real-time WebSocket model: authentication flows, data queries,
- * statistics, and game replay retrieval.
+ * statistics, game replay retrieval, host management of live games, and
+ * an operator view of every room.
  *
  * @pattern Facade Pattern - Simplifies access to complex subsystems
  * @pattern Repository Pattern - Uses repository interfaces for data access
@@ -13,6 +14,7 @@
  */
 
 import { IncomingMessage, ServerResponse } from 'http';
+import { timingSafeEqual } from 'crypto';
 import { AuthService, getAuthService, IOAuthService, OAuthService, getOAuthService, OAuthProvider } from '../services';
 import {
   IUserRepository,
@@ -25,6 +27,13 @@ import {
   GameRepository
 } from '../database/repositories';
 import { verifyToken } from '../utils/password';
+import { RoomManager } from './RoomManager';
+import { RoleFactory } from '../patterns/factory';
+import { OriginPolicy } from './OriginPolicy';
+import { ClientLogRateLimiter, parseClientLogs } from './ClientLogs';
+import { getClientIp } from './HttpMiddleware';
+import { getLogger } from '../utils/logger';
+import { ErrorCode, ErrorCodes } from '../network/protocol';
 
 // =============================================================================
 // TYPES
@@ -43,6 +52,8 @@ interface RequestBody {
 interface ApiResponse {
   success: boolean;
   data?: unknown;
+  /** Machine-readable error code, present whenever success is false */
+  code?: ErrorCode;
   error?: string;
 }
 
@@ -73,9 +84,27 @@ export class ApiHandler {
   private readonly replayRepo: IReplayRepository;
   private readonly gameRepo: IGameRepository;
 
+  /** Origins that receive CORS headers */
+  private readonly originPolicy: OriginPolicy;
+
+  /** Live rooms, once the game server has been attached */
+  private roomManager: RoomManager | null = null;
+
+  /** Bearer token for the admin endpoints; they are disabled without one */
+  private readonly adminToken: string | null;
+
+  /** Whether client IPs come from X-Forwarded-For rather than the socket */
+  private readonly trustProxy: boolean;
+
   /** OAuth state storage for CSRF protection (state -> { provider, expiresAt }) */
   private readonly oauthStates: Map<string, { provider: OAuthProvider; expiresAt: number }> = new Map();
 
+  /** Server logger */
+  private readonly logger = getLogger();
+
+  /** Per-IP limit on logs sent by clients */
+  private readonly clientLogLimiter = new ClientLogRateLimiter();
+
   /**
    * @summary Creates a new ApiHandler.
    *
@@ -90,6 +119,9 @@ export class ApiHandler {
    * @param {IStatisticsRepository} [deps.statsRepo] - Statistics repository
    * @param {IReplayRepository} [deps.replayRepo] - Replay repository
    * @param {IGameRepository} [deps.gameRepo] - Game repository
+   * @param {OriginPolicy} [deps.originPolicy] - Allowed CORS origins (defaults to any)
+   * @param {string} [deps.adminToken] - Token for the admin endpoints (disabled if unset)
+   * @param {boolean} [deps.trustProxy] - Take client IPs from X-Forwarded-For (behind a proxy only)
    *
    * @pattern Dependency Injection - Accepts dependencies via constructor
    */
@@ -100,6 +132,9 @@ export class ApiHandler {
     statsRepo?: IStatisticsRepository;
     replayRepo?: IReplayRepository;
     gameRepo?: IGameRepository;
+    originPolicy?: OriginPolicy;
+    adminToken?: string;
+    trustProxy?: boolean;
   }) {
     this.authService = deps?.authService ?? getAuthService();
     this.oauthService = deps?.oauthService ?? getOAuthService();
@@ -107,11 +142,29 @@ export class ApiHandler {
     this.statsRepo = deps?.statsRepo ?? new StatisticsRepository();
     this.replayRepo = deps?.replayRepo ?? new ReplayRepository();
     this.gameRepo = deps?.gameRepo ?? new GameRepository();
+    this.originPolicy = deps?.originPolicy ?? new OriginPolicy();
+    this.adminToken = deps?.adminToken || null;
+    this.trustProxy = deps?.trustProxy ?? false;
 
     // Clean up expired OAuth states periodically (every 5 minutes)
     setInterval(() => this.cleanupOAuthStates(), 5 * 60 * 1000);
   }
 
+  /**
+   * @summary Gives the handler access to live rooms.
+   *
+   * @description
+   * The game server is created after the HTTP backend that owns this
+   * handler, so its room manager is attached once both exist. Until
+   * then, live game endpoints answer 503 and game lookups only see
+   * recorded games.
+   *
+   * @param {RoomManager} roomManager - The game server's room manager
+   */
+  attachRoomManager(roomManager: RoomManager): void {
+    this.roomManager = roomManager;
+  }
+
   // ===========================================================================
   // MAIN REQUEST HANDLER
   // ===========================================================================
@@ -138,8 +191,8 @@ export class ApiHandler {
       return false;
     }
 
-    // Set CORS headers
-    this.setCorsHeaders(res);
+    // Set CORS headers (only for allowed origins)
+    this.setCorsHeaders(req, res);
 
     // Handle preflight
     if (method === 'OPTIONS') {
@@ -151,8 +204,8 @@ export class ApiHandler {
     try {
       await this.routeRequest(path, method, url, req, res);
     } catch (error) {
-      console.error('API error:', error);
-      this.sendJson(res, 500, { success: false, error: 'Internal server error' });
+      this.logger.error('API error', { method, path, error });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Internal server error');
     }
 
     return true;
@@ -256,6 +309,61 @@ export class ApiHandler {
       return;
     }
 
+    // Delete a live game (host only)
+    if (gameDetailsMatch && method === 'DELETE') {
+      await this.handleDeleteGame(gameDetailsMatch[1], req, res);
+      return;
+    }
+
+    // Leave a live game's lobby
+    const leaveGameMatch = path.match(/^\/api\/games\/([^/]+)\/players\/([^/]+)\/leave$/);
+    if (leaveGameMatch && method === 'POST') {
+      this.handleLeaveGame(leaveGameMatch[1], leaveGameMatch[2], req, res);
+      return;
+    }
+
+    // Kick a player from a live game's lobby (host only)
+    const kickPlayerMatch = path.match(/^\/api\/games\/([^/]+)\/players\/([^/]+)\/kick$/);
+    if (kickPlayerMatch && method === 'POST') {
+      this.handleKickPlayer(kickPlayerMatch[1], kickPlayerMatch[2], req, res);
+      return;
+    }
+
+    // A player's private view of a live game, for clients without WebSockets
+    const playerStateMatch = path.match(/^\/api\/games\/([^/]+)\/players\/([^/]+)\/state$/);
+    if (playerStateMatch && method === 'GET') {
+      this.handleGetPlayerState(playerStateMatch[1], playerStateMatch[2], req, res);
+      return;
+    }
+
+    // Hand a live game's host role to another player
+    const gameHostMatch = path.match(/^\/api\/games\/([^/]+)\/host$/);
+    if (gameHostMatch && method === 'POST') {
+      await this.handleTransferHost(gameHostMatch[1], req, res);
+      return;
+    }
+
+    // Fill a live game's lobby with AI players (host only)
+    const gameBotsMatch = path.match(/^\/api\/games\/([^/]+)\/bots$/);
+    if (gameBotsMatch && method === 'POST') {
+      await this.handleAddBots(gameBotsMatch[1], req, res);
+      return;
+    }
+
+    // Start the next round of a finished game's match (host only)
+    const nextRoundMatch = path.match(/^\/api\/games\/([^/]+)\/next-round$/);
+    if (nextRoundMatch && method === 'POST') {
+      await this.handleNextRound(nextRoundMatch[1], req, res);
+      return;
+    }
+
+    // Live game result route
+    const gameResultMatch = path.match(/^\/api\/games\/([^/]+)\/result$/);
+    if (gameResultMatch && method === 'GET') {
+      await this.handleGetGameResult(gameResultMatch[1], res);
+      return;
+    }
+
     // Game replay route
     const gameReplayMatch = path.match(/^\/api\/games\/([^/]+)\/replay$/);
     if (gameReplayMatch && method === 'GET') {
@@ -277,8 +385,38 @@ export class ApiHandler {
       return;
     }
 
+    // Live server load route
+    if (path === '/api/stats/live' && method === 'GET') {
+      this.handleGetLiveStats(res);
+      return;
+    }
+
+    // Every room, for operators
+    if (path === '/api/admin/games' && method === 'GET') {
+      this.handleGetAdminGames(req, res);
+      return;
+    }
+
+    // Role catalog route
+    if (path === '/api/roles' && method === 'GET') {
+      this.sendJson(res, 200, { success: true, data: RoleFactory.getRoleCatalog() });
+      return;
+    }
+
+    // Default role list preview route
+    if (path === '/api/roles/preview' && method === 'GET') {
+      this.handleGetRolePreview(url.searchParams.get('players'), res);
+      return;
+    }
+
+    // Client log upload route
+    if (path === '/api/logs' && method === 'POST') {
+      await this.handleClientLogs(req, res);
+      return;
+    }
+
     // Not found
-    this.sendJson(res, 404, { success: false, error: 'Endpoint not found' });
+    this.sendError(res, 404, ErrorCodes.ENDPOINT_NOT_FOUND, 'Endpoint not found');
   }
 
   // ===========================================================================
@@ -305,10 +443,12 @@ export class ApiHandler {
     const displayName = body.displayName as string;
 
     if (!email || !password || !displayName) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: 'Missing required fields: email, password, displayName'
-      });
+      this.sendError(
+        res,
+        400,
+        ErrorCodes.INVALID_REQUEST,
+        'Missing required fields: email, password, displayName'
+      );
       return;
     }
 
@@ -327,7 +467,7 @@ export class ApiHandler {
       });
     } catch (error) {
       const message = error instanceof Error ? error.message : 'Registration failed';
-      this.sendJson(res, 400, { success: false, error: message });
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, message);
     }
   }
 
@@ -349,10 +489,7 @@ export class ApiHandler {
     const password = body.password as string;
 
     if (!email || !password) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: 'Missing required fields: email, password'
-      });
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, 'Missing required fields: email, password');
       return;
     }
 
@@ -371,7 +508,7 @@ export class ApiHandler {
       });
     } catch (error) {
       const message = error instanceof Error ? error.message : 'Login failed';
-      this.sendJson(res, 401, { success: false, error: message });
+      this.sendError(res, 401, ErrorCodes.AUTH_INVALID, message);
     }
   }
 
@@ -390,7 +527,7 @@ export class ApiHandler {
     const token = this.extractToken(req);
 
     if (!token) {
-      this.sendJson(res, 401, { success: false, error: 'No token provided' });
+      this.sendError(res, 401, ErrorCodes.AUTH_REQUIRED, 'No token provided');
       return;
     }
 
@@ -421,14 +558,14 @@ export class ApiHandler {
     const token = this.extractToken(req);
 
     if (!token) {
-      this.sendJson(res, 401, { success: false, error: 'No token provided' });
+      this.sendError(res, 401, ErrorCodes.AUTH_REQUIRED, 'No token provided');
       return;
     }
 
     try {
       const user = await this.authService.validateToken(token);
       if (!user) {
-        this.sendJson(res, 401, { success: false, error: 'Invalid or expired token' });
+        this.sendError(res, 401, ErrorCodes.AUTH_INVALID, 'Invalid or expired token');
         return;
       }
 
@@ -442,7 +579,7 @@ export class ApiHandler {
         }
       });
     } catch (error) {
-      this.sendJson(res, 401, { success: false, error: 'Invalid token' });
+      this.sendError(res, 401, ErrorCodes.AUTH_INVALID, 'Invalid token');
     }
   }
 
@@ -479,20 +616,24 @@ export class ApiHandler {
 
     // Validate required fields
     if (!providerCode || !externalId || !email || !displayName) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: 'Missing required fields: providerCode, externalId, email, displayName'
-      });
+      this.sendError(
+        res,
+        400,
+        ErrorCodes.INVALID_REQUEST,
+        'Missing required fields: providerCode, externalId, email, displayName'
+      );
       return;
     }
 
     // Validate provider code
     const validProviders = ['google', 'discord', 'github', 'twitch'];
     if (!validProviders.includes(providerCode)) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: `Invalid provider code. Must be one of: ${validProviders.join(', ')}`
-      });
+      this.sendError(
+        res,
+        400,
+        ErrorCodes.INVALID_REQUEST,
+        `Invalid provider code. Must be one of: ${validProviders.join(', ')}`
+      );
       return;
     }
 
@@ -519,7 +660,7 @@ export class ApiHandler {
       });
     } catch (error) {
       const message = error instanceof Error ? error.message : 'OAuth exchange failed';
-      this.sendJson(res, 400, { success: false, error: message });
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, message);
     }
   }
 
@@ -568,10 +709,7 @@ export class ApiHandler {
   ): Promise<void> {
     // Check if provider is configured
     if (!this.oauthService.isProviderConfigured(provider)) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: `OAuth provider '${provider}' is not configured`
-      });
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, `OAuth provider '${provider}' is not configured`);
       return;
     }
 
@@ -713,26 +851,20 @@ export class ApiHandler {
   ): Promise<void> {
     // Check if provider is configured
     if (!this.oauthService.isProviderConfigured(provider)) {
-      this.sendJson(res, 400, {
-        success: false,
-        error: `OAuth provider '${provider}' is not configured`
-      });
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, `OAuth provider '${provider}' is not configured`);
       return;
     }
 
     // Verify user is authenticated
     const token = this.extractToken(req);
     if (!token) {
-      this.sendJson(res, 401, {
-        success: false,
-        error: 'Authentication required to link OAuth account'
-      });
+      this.sendError(res, 401, ErrorCodes.AUTH_REQUIRED, 'Authentication required to link OAuth account');
       return;
     }
 
     const user = await this.authService.validateToken(token);
     if (!user) {
-      this.sendJson(res, 401, { success: false, error: 'Invalid or expired token' });
+      this.sendError(res, 401, ErrorCodes.AUTH_INVALID, 'Invalid or expired token');
       return;
     }
 
@@ -898,14 +1030,14 @@ export class ApiHandler {
       const stats = await this.statsRepo.getPlayerStats(userId);
 
       if (!stats) {
-        this.sendJson(res, 404, { success: false, error: 'Player not found' });
+        this.sendError(res, 404, ErrorCodes.PLAYER_NOT_FOUND, 'Player not found');
         return;
       }
 
       this.sendJson(res, 200, { success: true, data: stats });
     } catch (error) {
       console.error('Error getting user stats:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get statistics' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get statistics');
     }
   }
 
@@ -937,7 +1069,7 @@ export class ApiHandler {
       });
     } catch (error) {
       console.error('Error getting user games:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get games' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get games');
     }
   }
 
@@ -949,19 +1081,28 @@ export class ApiHandler {
    * @summary Gets game details.
    *
    * @description
-   * Returns metadata about a game.
+   * A live game, looked up by room code, returns its public state:
+   * players, ready flags, phase and vote counts, with no hidden roles.
+   * Otherwise the ID is looked up among recorded games and their
+   * metadata is returned.
    *
-   * @param {string} gameId - Game ID
+   * @param {string} gameId - Room code of a live game, or recorded game ID
    * @param {ServerResponse} res - HTTP response
    *
    * @private
    */
   private async handleGetGame(gameId: string, res: ServerResponse): Promise<void> {
+    const liveGame = this.roomManager?.getPublicGame(gameId);
+    if (liveGame) {
+      this.sendJson(res, 200, { success: true, data: liveGame });
+      return;
+    }
+
     try {
       const game = await this.gameRepo.findById(gameId);
 
       if (!game) {
-        this.sendJson(res, 404, { success: false, error: 'Game not found' });
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
         return;
       }
 
@@ -978,10 +1119,428 @@ export class ApiHandler {
       });
     } catch (error) {
       console.error('Error getting game:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get game' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get game');
     }
   }
 
+  /**
+   * @summary Deletes a live game at its host's request.
+   *
+   * @description
+   * The game is identified by its room code, and the requester by their
+   * X-Player-Token. Connected players are told the game was deleted and
+   * disconnected, and any running game is cancelled.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleDeleteGame(
+    roomCode: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): Promise<void> {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const playerId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!playerId) {
+      return;
+    }
+
+    const result = this.roomManager.deleteRoom(roomCode, playerId);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notHost':
+        this.sendError(res, 403, ErrorCodes.NOT_HOST, 'Only the host can delete this game');
+        return;
+
+      case 'deleted':
+        this.sendJson(res, 200, { success: true });
+        return;
+    }
+  }
+
+  /**
+   * @summary Removes a player from a live game's lobby.
+   *
+   * @description
+   * Only allowed before the game starts. If the host leaves, another
+   * player becomes host; a lobby left with no human players is deleted.
+   * Players can only leave for themselves, proven by their X-Player-Token.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {string} playerId - ID of the leaving player
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleLeaveGame(
+    roomCode: string,
+    playerId: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): void {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const requesterId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!requesterId) {
+      return;
+    }
+    if (requesterId !== playerId) {
+      this.sendError(res, 403, ErrorCodes.AUTH_INVALID, 'Players can only leave for themselves');
+      return;
+    }
+
+    const result = this.roomManager.leaveRoom(roomCode, playerId);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notInRoom':
+        this.sendError(res, 404, ErrorCodes.NOT_IN_ROOM, 'Player is not in this game');
+        return;
+
+      case 'notWaiting':
+        this.sendError(res, 409, ErrorCodes.ROOM_STARTED, 'Game has already started');
+        return;
+
+      case 'left':
+        this.sendJson(res, 200, { success: true });
+        return;
+    }
+  }
+
+  /**
+   * @summary Removes a player from a live game's lobby.
+   *
+   * @description
+   * For the host to get rid of an unwanted player before the game
+   * starts. The host is identified by their X-Player-Token. The kicked
+   * player is told why and disconnected.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {string} playerId - ID of the player to remove
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleKickPlayer(
+    roomCode: string,
+    playerId: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): void {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const hostId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!hostId) {
+      return;
+    }
+
+    const result = this.roomManager.kickPlayer(roomCode, hostId, playerId);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notHost':
+        this.sendError(res, 403, ErrorCodes.NOT_HOST, 'Only the host can kick players');
+        return;
+
+      case 'notWaiting':
+        this.sendError(res, 409, ErrorCodes.ROOM_STARTED, 'Game has already started');
+        return;
+
+      case 'notInRoom':
+        this.sendError(res, 404, ErrorCodes.NOT_IN_ROOM, 'Player is not in this game');
+        return;
+
+      case 'invalidTarget':
+        this.sendError(res, 400, ErrorCodes.INVALID_TARGET, 'The host cannot kick themselves');
+        return;
+
+      case 'kicked':
+        this.sendJson(res, 200, { success: true });
+        return;
+    }
+  }
+
+  /**
+   * @summary Gets a player's private view of a live game.
+   *
+   * @description
+   * A polling fallback for networks that block WebSockets. Returns the
+   * same view as a gameState message: the player's own role and night
+   * results, public player info, and the current phase with its deadline.
+   * The requester's X-Player-Token must belong to the player asked
+   * about; player IDs alone are visible to the whole room.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {string} playerId - Player whose view is requested
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleGetPlayerState(
+    roomCode: string,
+    playerId: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): void {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const requesterId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!requesterId) {
+      return;
+    }
+    if (requesterId !== playerId) {
+      this.sendError(res, 403, ErrorCodes.AUTH_INVALID, 'Players can only view their own state');
+      return;
+    }
+
+    const view = this.roomManager.getRoom(roomCode)!.getPlayerView(playerId);
+    if (!view) {
+      this.sendError(res, 409, ErrorCodes.INVALID_STATE, 'Game has not started');
+      return;
+    }
+
+    this.sendJson(res, 200, { success: true, data: view });
+  }
+
+  /**
+   * @summary Hands a live game's host role to another player.
+   *
+   * @description
+   * The requester is identified by their X-Player-Token and must be the
+   * current host. The new host is given as targetPlayerId in the body
+   * and must be a human player in the game.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleTransferHost(
+    roomCode: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): Promise<void> {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const playerId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!playerId) {
+      return;
+    }
+
+    const body = await this.parseBody(req);
+    const targetPlayerId = typeof body.targetPlayerId === 'string' ? body.targetPlayerId : undefined;
+    if (!targetPlayerId) {
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, 'Missing targetPlayerId');
+      return;
+    }
+
+    const result = this.roomManager.transferHost(roomCode, playerId, targetPlayerId);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notHost':
+        this.sendError(res, 403, ErrorCodes.NOT_HOST, 'Only the host can transfer the host role');
+        return;
+
+      case 'invalidTarget':
+        this.sendError(res, 400, ErrorCodes.INVALID_TARGET, 'Target player is not in this game');
+        return;
+
+      case 'transferred':
+        this.sendJson(res, 200, { success: true });
+        return;
+    }
+  }
+
+  /**
+   * @summary Adds AI players to a live game's lobby.
+   *
+   * @description
+   * The number of bots is given as count in the JSON body (default 1).
+   * The requester is identified by their X-Player-Token and must be the
+   * host. Bots are always ready and play valid random moves.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleAddBots(
+    roomCode: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): Promise<void> {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const playerId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!playerId) {
+      return;
+    }
+
+    const body = await this.parseBody(req);
+    const count = body.count ?? 1;
+    if (typeof count !== 'number' || !Number.isInteger(count) || count < 1) {
+      this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, 'count must be a positive integer');
+      return;
+    }
+
+    const result = this.roomManager.addBots(roomCode, playerId, count);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notHost':
+        this.sendError(res, 403, ErrorCodes.NOT_HOST, 'Only the host can add bots');
+        return;
+
+      case 'notWaiting':
+        this.sendError(res, 409, ErrorCodes.ROOM_STARTED, 'Game has already started');
+        return;
+
+      case 'roomFull':
+        this.sendError(res, 409, ErrorCodes.ROOM_FULL, 'Not enough free seats for that many bots');
+        return;
+
+      case 'added':
+        this.sendJson(res, 200, {
+          success: true,
+          data: { playerCount: this.roomManager.getRoom(roomCode)?.getPlayerCount() }
+        });
+        return;
+    }
+  }
+
+  /**
+   * @summary Starts the next round of a finished live game.
+   *
+   * @description
+   * Deals a new game to the players still in the room. The round number
+   * goes up and each player's match score carries over. The requester
+   * is identified by their X-Player-Token and must be the host.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleNextRound(
+    roomCode: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): Promise<void> {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    const playerId = this.authenticateRoomPlayer(roomCode, req, res);
+    if (!playerId) {
+      return;
+    }
+
+    const result = this.roomManager.startNextRound(roomCode, playerId);
+
+    switch (result) {
+      case 'notFound':
+        this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+        return;
+
+      case 'notHost':
+        this.sendError(res, 403, ErrorCodes.NOT_HOST, 'Only the host can start the next round');
+        return;
+
+      case 'notEnded':
+        this.sendError(res, 409, ErrorCodes.GAME_NOT_FINISHED, 'Game has not finished');
+        return;
+
+      case 'cannotStart':
+        this.sendError(res, 409, ErrorCodes.INVALID_STATE, 'The next round cannot start with the players left');
+        return;
+
+      case 'started': {
+        const state = this.roomManager.getRoom(roomCode)?.getState();
+        this.sendJson(res, 200, {
+          success: true,
+          data: { round: state?.round, scores: state?.scores }
+        });
+        return;
+      }
+    }
+  }
+
+  /**
+   * @summary Gets the structured result of a finished live game.
+   *
+   * @description
+   * Returns eliminated and winning players, each player's original and
+   * final role, the center cards and the winning teams, the same result
+   * sent in the gameEnd message. Finished rooms are only kept briefly;
+   * use the replay endpoint for older games.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleGetGameResult(roomCode: string, res: ServerResponse): Promise<void> {
+    const room = this.roomManager?.getRoom(roomCode);
+    if (!room) {
+      this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+      return;
+    }
+
+    const result = room.getFinalResult();
+    if (!result) {
+      this.sendError(res, 409, ErrorCodes.GAME_NOT_FINISHED, 'Game has not finished');
+      return;
+    }
+
+    this.sendJson(res, 200, { success: true, data: result });
+  }
+
   /**
    * @summary Gets full game replay.
    *
@@ -989,6 +1548,7 @@ export class ApiHandler {
    * Returns complete game history including all night actions,
    * statements, and votes for replay functionality.
    * Data is stored in 6NF-compliant tables and reconstructed here.
+   * Votes are left out of games played with secret votes.
    *
    * @param {string} gameId - Game ID
    * @param {ServerResponse} res - HTTP response
@@ -999,7 +1559,13 @@ export class ApiHandler {
    */
   private async handleGetGameReplay(gameId: string, res: ServerResponse): Promise<void> {
     try {
-      const replay = await this.replayRepo.getFullReplay(gameId);
+      const [replay, configuration] = await Promise.all([
+        this.replayRepo.getFullReplay(gameId),
+        this.gameRepo.getConfiguration(gameId)
+      ]);
+      if (configuration?.vote_visibility === 'secret') {
+        replay.votes = [];
+      }
 
       this.sendJson(res, 200, {
         success: true,
@@ -1010,7 +1576,7 @@ export class ApiHandler {
       });
     } catch (error) {
       console.error('Error getting game replay:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get replay' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get replay');
     }
   }
 
@@ -1054,7 +1620,7 @@ export class ApiHandler {
       });
     } catch (error) {
       console.error('Error getting leaderboard:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get leaderboard' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get leaderboard');
     }
   }
 
@@ -1075,8 +1641,172 @@ export class ApiHandler {
       this.sendJson(res, 200, { success: true, data: stats });
     } catch (error) {
       console.error('Error getting global stats:', error);
-      this.sendJson(res, 500, { success: false, error: 'Failed to get statistics' });
+      this.sendError(res, 500, ErrorCodes.INTERNAL_ERROR, 'Failed to get statistics');
+    }
+  }
+
+  /**
+   * @summary Gets counts of live rooms and connected players.
+   *
+   * @description
+   * Answers from memory, not the database, so an ops dashboard can poll
+   * it to see whether the server is under load.
+   *
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleGetLiveStats(res: ServerResponse): void {
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    this.sendJson(res, 200, { success: true, data: this.roomManager.getStats() });
+  }
+
+  // ===========================================================================
+  // ADMIN HANDLERS
+  // ===========================================================================
+
+  /**
+   * @summary Lists every room in any status, for operators.
+   *
+   * @description
+   * The public list only offers rooms waiting for players. This one also
+   * shows running, ended and private rooms with their phase and age, so
+   * stuck or long-running games can be spotted. Requires the admin token
+   * as a Bearer token; without ADMIN_TOKEN set the endpoint does not exist.
+   *
+   * @param {IncomingMessage} req - HTTP request with Authorization header
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleGetAdminGames(req: IncomingMessage, res: ServerResponse): void {
+    if (!this.adminToken) {
+      this.sendError(res, 404, ErrorCodes.ENDPOINT_NOT_FOUND, 'Endpoint not found');
+      return;
+    }
+
+    const token = this.extractToken(req);
+    if (!token) {
+      this.sendError(res, 401, ErrorCodes.AUTH_REQUIRED, 'No token provided');
+      return;
+    }
+    if (!this.isAdminToken(token)) {
+      this.sendError(res, 403, ErrorCodes.AUTH_INVALID, 'Invalid admin token');
+      return;
+    }
+
+    if (!this.roomManager) {
+      this.sendError(res, 503, ErrorCodes.SERVICE_UNAVAILABLE, 'Game server not available');
+      return;
+    }
+
+    this.sendJson(res, 200, { success: true, data: this.roomManager.getAllRoomSummaries() });
+  }
+
+  /**
+   * @summary Checks a token against the admin token in constant time.
+   *
+   * @param {string} token - Token from the request
+   *
+   * @returns {boolean} True if it is the admin token
+   *
+   * @private
+   */
+  private isAdminToken(token: string): boolean {
+    const given = Buffer.from(token);
+    const expected = Buffer.from(this.adminToken ?? '');
+    return given.length === expected.length && timingSafeEqual(given, expected);
+  }
+
+  // ===========================================================================
+  // ROLE HANDLERS
+  // ===========================================================================
+
+  /**
+   * @summary Previews the default role list for a player count.
+   *
+   * @description
+   * Returns the roles a game with that many players is dealt when the
+   * host leaves the choice to the server, with a count per role.
+   *
+   * @param {string | null} players - Player count from the query string
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private handleGetRolePreview(players: string | null, res: ServerResponse): void {
+    const playerCount = players !== null && /^\d+$/.test(players) ? parseInt(players, 10) : NaN;
+
+    if (isNaN(playerCount) || playerCount < RoleFactory.MIN_PLAYERS || playerCount > RoleFactory.MAX_PLAYERS) {
+      this.sendError(
+        res,
+        400,
+        ErrorCodes.INVALID_REQUEST,
+        `players must be a whole number from ${RoleFactory.MIN_PLAYERS} to ${RoleFactory.MAX_PLAYERS}`
+      );
+      return;
+    }
+
+    this.sendJson(res, 200, { success: true, data: RoleFactory.previewRoleList(playerCount) });
+  }
+
+  // ===========================================================================
+  // CLIENT LOG HANDLERS
+  // ===========================================================================
+
+  /**
+   * @summary Writes logs sent by a client into the server log.
+   *
+   * @description
+   * The body is a single entry ({ level, message, source? }) or an array
+   * of entries. Messages are sanitized and truncated before logging, and
+   * each IP may only send so many entries per minute.
+   *
+   * @param {IncomingMessage} req - HTTP request with log entries
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @private
+   */
+  private async handleClientLogs(req: IncomingMessage, res: ServerResponse): Promise<void> {
+    let body: unknown;
+    try {
+      body = await this.parseBody(req);
+    } catch (error) {
+      this.sendError(
+        res,
+        400,
+        ErrorCodes.INVALID_REQUEST,
+        error instanceof Error ? error.message : 'Invalid request body'
+      );
+      return;
+    }
+
+    const parsed = parseClientLogs(body);
+    if (!parsed.valid) {
+      if (parsed.tooLarge) {
+        this.sendError(res, 413, ErrorCodes.PAYLOAD_TOO_LARGE, parsed.error);
+      } else {
+        this.sendError(res, 400, ErrorCodes.INVALID_REQUEST, parsed.error);
+      }
+      return;
+    }
+
+    const ip = getClientIp(req, this.trustProxy);
+    if (!this.clientLogLimiter.tryConsume(ip, parsed.entries.length)) {
+      this.sendError(res, 429, ErrorCodes.RATE_LIMITED, 'Too many log entries, try again later');
+      return;
+    }
+
+    const clientLogger = this.logger.with({ origin: 'client', clientIp: ip });
+    for (const entry of parsed.entries) {
+      clientLogger[entry.level](entry.message, entry.source ? { source: entry.source } : undefined);
     }
+
+    this.sendJson(res, 200, { success: true, data: { accepted: parsed.entries.length } });
   }
 
   // ===========================================================================
@@ -1086,14 +1816,27 @@ export class ApiHandler {
   /**
    * @summary Sets CORS headers.
    *
+   * @description
+   * Requests from an origin outside the allowlist get no CORS headers,
+   * so browsers refuse to hand the response to the calling page.
+   *
+   * @param {IncomingMessage} req - HTTP request
    * @param {ServerResponse} res - HTTP response
    *
    * @private
    */
-  private setCorsHeaders(res: ServerResponse): void {
-    res.setHeader('Access-Control-Allow-Origin', '*');
+  private setCorsHeaders(req: IncomingMessage, res: ServerResponse): void {
+    const allowOrigin = this.originPolicy.getAllowOriginHeader(req.headers.origin);
+    if (allowOrigin === null) {
+      return;
+    }
+
+    if (allowOrigin !== '*') {
+      res.setHeader('Vary', 'Origin');
+    }
+    res.setHeader('Access-Control-Allow-Origin', allowOrigin);
     res.setHeader('Access-Control-Allow-Methods', 'GET, POST, PUT, DELETE, OPTIONS');
-    res.setHeader('Access-Control-Allow-Headers', 'Content-Type, Authorization');
+    res.setHeader('Access-Control-Allow-Headers', 'Content-Type, Authorization, X-Player-Token');
     res.setHeader('Access-Control-Max-Age', '86400');
   }
 
@@ -1111,6 +1854,68 @@ export class ApiHandler {
     res.end(JSON.stringify(data));
   }
 
+  /**
+   * @summary Identifies the player making a request about a live game.
+   *
+   * @description
+   * Player IDs are shown to everyone in a room, so they prove nothing.
+   * The client sends the playerToken from its roomCreated or roomJoined
+   * message as X-Player-Token instead. When the game doesn't exist or
+   * the token is missing or wrong, the error response is sent here.
+   *
+   * @param {string} roomCode - Room code of the game
+   * @param {IncomingMessage} req - HTTP request
+   * @param {ServerResponse} res - HTTP response
+   *
+   * @returns {string | null} The requester's player ID, or null if a response was sent
+   *
+   * @private
+   */
+  private authenticateRoomPlayer(
+    roomCode: string,
+    req: IncomingMessage,
+    res: ServerResponse
+  ): string | null {
+    const room = this.roomManager?.getRoom(roomCode);
+    if (!room) {
+      this.sendError(res, 404, ErrorCodes.GAME_NOT_FOUND, 'Game not found');
+      return null;
+    }
+
+    const header = req.headers['x-player-token'];
+    const token = Array.isArray(header) ? header[0] : header;
+    if (!token) {
+      this.sendError(res, 401, ErrorCodes.AUTH_REQUIRED, 'Missing player token');
+      return null;
+    }
+
+    const playerId = room.authenticatePlayer(token);
+    if (!playerId) {
+      this.sendError(res, 401, ErrorCodes.AUTH_INVALID, 'Invalid player token');
+      return null;
+    }
+    return playerId;
+  }
+
+  /**
+   * @summary Sends a JSON error response.
+   *
+   * @description
+   * Every failed request answers with the same shape,
+   * { success: false, code, error }, so clients can branch on the code
+   * instead of matching the human-readable message.
+   *
+   * @param {ServerResponse} res - HTTP response
+   * @param {number} status - HTTP status code
+   * @param {ErrorCode} code - Machine-readable error code
+   * @param {string} message - Human-readable error message
+   *
+   * @private
+   */
+  private sendError(res: ServerResponse, status: number, code: ErrorCode, message: string): void {
+    this.sendJson(res, status, { success: false, code, error: message });
+  }
+
   /**
    * @summary Parses request body as JSON.
    *
@@ -1158,6 +1963,7 @@ export class ApiHandler {
     }
     return auth.slice(7);
   }
+
 }
 
 // =============================================================================
@@ -0,0 +1,217 @@
+/**
+ * @fileoverview Validation and rate limiting for logs sent by clients.
+ * @module server/ClientLogs
+ *
+ * @summary Turns POST /api/logs bodies into safe server log entries.
+ *
+ * @description
+ * Browsers report their own errors to the server so they end up next to
+ * the server's logs. Anything a client sends is untrusted:
+ * - A body may hold one entry or an array of them, up to a batch limit
+ * - Control characters are removed from messages, so a client cannot
+ *   forge extra lines in a text log
+ * - Overly long messages are truncated
+ * - Each source IP may only send so many entries per time window
+ *
+ * @example
+ * ```typescript
+ * const parsed = parseClientLogs(body);
+ * if (parsed.valid && limiter.tryConsume(ip, parsed.entries.length)) {
+ *   for (const entry of parsed.entries) {
+ *     logger[entry.level](entry.message);
+ *   }
+ * }
+ * ```
+ */
+
+import { LogLevel } from '../utils/logger';
+
+// =============================================================================
+// TYPES
+// =============================================================================
+
+/**
+ * @summary One log entry sent by a client, after sanitizing.
+ */
+export interface ClientLog {
+  /** Severity (unknown levels become INFO) */
+  readonly level: LogLevel;
+
+  /** Sanitized message */
+  readonly message: string;
+
+  /** Sanitized name of the client component that logged, if given */
+  readonly source?: string;
+}
+
+/**
+ * @summary Limits applied to client logs.
+ */
+export interface ClientLogConfig {
+  /** Most entries accepted in one request */
+  maxBatchSize: number;
+
+  /** Longest message kept; longer messages are truncated */
+  maxMessageLength: number;
+
+  /** Most entries one IP may send per window */
+  maxEntriesPerWindow: number;
+
+  /** Rate limit window in milliseconds */
+  windowMs: number;
+}
+
+/**
+ * @summary Default client log limits.
+ */
+export const DEFAULT_CLIENT_LOG_CONFIG: ClientLogConfig = {
+  maxBatchSize: 50,
+  maxMessageLength: 2000,
+  maxEntriesPerWindow: 200,
+  windowMs: 60000
+};
+
+/**
+ * @summary Result of parsing a client log body.
+ */
+export type ClientLogParseResult =
+  | { valid: true; entries: ClientLog[] }
+  | { valid: false; error: string; tooLarge: boolean };
+
+// =============================================================================
+// PARSING
+// =============================================================================
+
+/** Longest component name kept */
+const MAX_SOURCE_LENGTH = 100;
+
+/**
+ * @summary Removes control characters and truncates a message.
+ *
+ * @description
+ * Line breaks and tabs become spaces so words stay apart; every other
+ * control character is dropped.
+ *
+ * @param {string} message - Raw message
+ * @param {number} maxLength - Longest message kept
+ *
+ * @returns {string} Single-line message of at most maxLength characters
+ */
+export function sanitizeLogMessage(message: string, maxLength: number): string {
+  const clean = message.replace(/[\t\n\r]/g, ' ').replace(/[\u0000-\u001f\u007f-\u009f\u2028\u2029]/g, '');
+
+  if (clean.length <= maxLength) {
+    return clean;
+  }
+  return `${clean.slice(0, Math.max(0, maxLength - 3))}...`;
+}
+
+/**
+ * @summary Parses a POST /api/logs body.
+ *
+ * @description
+ * Accepts a single entry object or an array of entries. Every entry
+ * needs a string message; the whole request is rejected if any entry is
+ * malformed or the batch is too large.
+ *
+ * @param {unknown} body - Parsed JSON body
+ * @param {Partial<ClientLogConfig>} [config] - Limits
+ *
+ * @returns {ClientLogParseResult} Sanitized entries, or why the body was rejected
+ */
+export function parseClientLogs(
+  body: unknown,
+  config: Partial<ClientLogConfig> = {}
+): ClientLogParseResult {
+  const { maxBatchSize, maxMessageLength } = { ...DEFAULT_CLIENT_LOG_CONFIG, ...config };
+  const raw = Array.isArray(body) ? body : [body];
+
+  if (raw.length === 0) {
+    return { valid: false, error: 'No log entries', tooLarge: false };
+  }
+
+  if (raw.length > maxBatchSize) {
+    return { valid: false, error: `Too many log entries (max ${maxBatchSize})`, tooLarge: true };
+  }
+
+  const entries: ClientLog[] = [];
+  for (const [index, item] of raw.entries()) {
+    if (typeof item !== 'object' || item === null || typeof (item as { message?: unknown }).message !== 'string') {
+      return { valid: false, error: `Log entry ${index} needs a message`, tooLarge: false };
+    }
+
+    const { level, message, source } = item as { level?: unknown; message: string; source?: unknown };
+    entries.push({
+      level: Object.values(LogLevel).find(l => l === level) ?? LogLevel.INFO,
+      message: sanitizeLogMessage(message, maxMessageLength),
+      ...(typeof source === 'string' ? { source: sanitizeLogMessage(source, MAX_SOURCE_LENGTH) } : {})
+    });
+  }
+
+  return { valid: true, entries };
+}
+
+// =============================================================================
+// RATE LIMITING
+// =============================================================================
+
+/**
+ * @summary Counts client log entries per source IP in fixed windows.
+ */
+export class ClientLogRateLimiter {
+  /** Entries counted in the current window, by IP */
+  private readonly windows: Map<string, { startedAt: number; count: number }> = new Map();
+
+  /** Limits */
+  private readonly config: ClientLogConfig;
+
+  /**
+   * @summary Creates a rate limiter.
+   *
+   * @param {Partial<ClientLogConfig>} [config] - Limits
+   */
+  constructor(config: Partial<ClientLogConfig> = {}) {
+    this.config = { ...DEFAULT_CLIENT_LOG_CONFIG, ...config };
+  }
+
+  /**
+   * @summary Records entries from an IP if it is still under its limit.
+   *
+   * @description
+   * A request that would take the IP over its limit is refused as a
+   * whole and not counted.
+   *
+   * @param {string} ip - Source IP
+   * @param {number} count - Entries in the request
+   * @param {number} [now] - Current time (epoch ms)
+   *
+   * @returns {boolean} True if the entries may be logged
+   */
+  tryConsume(ip: string, count: number, now: number = Date.now()): boolean {
+    this.prune(now);
+
+    const window = this.windows.get(ip) ?? { startedAt: now, count: 0 };
+    if (window.count + count > this.config.maxEntriesPerWindow) {
+      return false;
+    }
+
+    window.count += count;
+    this.windows.set(ip, window);
+    return true;
+  }
+
+  /**
+   * @summary Forgets windows that have ended.
+   *
+   * @param {number} now - Current time (epoch ms)
+   *
+   * @private
+   */
+  private prune(now: number): void {
+    for (const [ip, window] of this.windows) {
+      if (now - window.startedAt >= this.config.windowMs) {
+        this.windows.delete(ip);
+      }
+    }
+  }
+}
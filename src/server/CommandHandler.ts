@@ -47,7 +47,8 @@ import {
   SelectTwoPlayersCommand,
   SeerChoiceCommand,
   StatementCommand,
-  VoteCommand
+  VoteCommand,
+  validateResponseShape
 } from '../patterns/command';
 import { Game } from '../core/Game';
 import { GamePhase } from '../enums';
@@ -146,6 +147,15 @@ export class CommandHandler {
     additionalContext?: Partial<NetworkCommandValidationContext>
   ): CommandResult {
     try {
+      // Reject structurally malformed responses before any type assertions
+      const decodeCheck = validateResponseShape(actionType, response);
+      if (!decodeCheck.valid) {
+        return {
+          success: false,
+          error: decodeCheck.error
+        };
+      }
+
       // Create command from action type and response
       const command = this.createCommandFromResponse(
         playerId,
@@ -165,6 +175,7 @@ export class CommandHandler {
       const context: NetworkCommandValidationContext = {
         phase: game.getPhase(),
         playerIds: game.getPlayerIds?.() || [],
+        centerCardCount: game.getCenterCardCount(),
         ...additionalContext
       };
 
@@ -320,7 +331,8 @@ export class CommandHandler {
       // Validate
       const context: NetworkCommandValidationContext = {
         phase: game.getPhase(),
-        playerIds: game.getPlayerIds?.() || []
+        playerIds: game.getPlayerIds?.() || [],
+        centerCardCount: game.getCenterCardCount()
       };
 
       const validation = command.validate(context);
@@ -28,7 +28,7 @@
  */
 
 import { WebSocketServer, IWebSocketServerBackend, WebSocketServerConfig } from '../network/WebSocketServer';
-import { IClientConnection, NullConnection } from '../network/IClientConnection';
+import { IClientConnection } from '../network/IClientConnection';
 import {
   ClientMessage,
   ServerMessage,
@@ -49,6 +49,10 @@ import {
 } from '../network/protocol';
 import { Room, RoomStatus } from './Room';
 import { RoomManager, RoomManagerConfig } from './RoomManager';
+import { IGameSnapshotStore } from './GameSnapshotStore';
+import { IRoomStore } from './RoomStore';
+import { GameMetrics, NULL_METRICS } from './Metrics';
+import { GameTracer, NOOP_TRACER } from './Tracing';
 import {
   ReconnectionManager,
   ReconnectionConfig,
@@ -60,14 +64,20 @@ import {
 } from './TimeoutStrategies';
 import { AdminAuthorizationService } from './AdminAuthorizationService';
 import { PlayerViewFactory } from '../players/PlayerView';
+import { normalizePlayerName } from './PlayerNames';
+import { TextSanitizer } from './TextSanitizer';
+import { RateLimitConfig, TokenBucketRateLimiter } from './RateLimiter';
 import { Game } from '../core/Game';
 import { AuthService, getAuthService } from '../services';
 import {
+  IGameRepository,
   IStatisticsRepository,
   IReplayRepository,
+  GameRepository,
   StatisticsRepository,
   ReplayRepository
 } from '../database/repositories';
+import { getLogger, Logger } from '../utils/logger';
 
 /**
  * @summary Game server configuration.
@@ -82,14 +92,38 @@ export interface GameServerConfig {
   /** Maximum rooms */
   maxRooms?: number;
 
-  /** Room timeout in milliseconds */
+  /** How long an unstarted room with no connected players is kept (milliseconds) */
   roomTimeoutMs?: number;
 
+  /** How often stale rooms are swept (milliseconds) */
+  roomCleanupIntervalMs?: number;
+
   /** Reconnection grace period in milliseconds */
   reconnectionGracePeriodMs?: number;
 
+  /** How long a game may run with no player connected before it is cancelled (milliseconds) */
+  abandonedGameTimeoutMs?: number;
+
   /** Default timeout strategy */
   defaultTimeoutStrategy?: TimeoutStrategyType;
+
+  /** Store for persisting in-progress games across restarts */
+  gameStore?: IGameSnapshotStore;
+
+  /** Backend for live rooms (defaults to in-memory) */
+  roomStore?: IRoomStore;
+
+  /** Where game lifecycle metrics are reported (defaults to none) */
+  metrics?: GameMetrics;
+
+  /** Where game round traces are sent (defaults to none) */
+  tracer?: GameTracer;
+
+  /** Cleans player names and statements (defaults to an empty blocklist) */
+  sanitizer?: TextSanitizer;
+
+  /** How often one client IP may create or join rooms */
+  roomRateLimit?: Partial<RateLimitConfig>;
 }
 
 /**
@@ -100,6 +134,8 @@ interface PlayerSession {
   playerName: string;
   connection: IClientConnection;
   roomCode: RoomCode | null;
+  /** Room being watched as a spectator, if any */
+  spectatingRoomCode?: RoomCode | null;
   authenticatedAt: number;
   /** Database user ID (UUID) for authenticated users */
   userId?: string;
@@ -161,6 +197,18 @@ export class GameServerFacade {
   /** Replay repository for game replay data */
   private readonly replayRepo: IReplayRepository;
 
+  /** Game repository, for the settings a replay must respect */
+  private readonly gameRepo: IGameRepository;
+
+  /** Cleans player-written text before it is shown to others */
+  private readonly sanitizer: TextSanitizer;
+
+  /** Throttles room creation and joining per client IP */
+  private readonly roomRateLimiter: TokenBucketRateLimiter;
+
+  /** Server logger */
+  private readonly logger: Logger = getLogger();
+
   /** Whether server is running */
   private _isRunning: boolean = false;
 
@@ -180,6 +228,8 @@ export class GameServerFacade {
    */
   constructor(backend: IWebSocketServerBackend, config: GameServerConfig) {
     this.config = config;
+    this.sanitizer = config.sanitizer ?? new TextSanitizer();
+    this.roomRateLimiter = new TokenBucketRateLimiter(config.roomRateLimit);
 
     // Initialize WebSocket server
     this.wsServer = new WebSocketServer(backend, {
@@ -191,8 +241,12 @@ export class GameServerFacade {
     // Initialize room manager
     this.roomManager = new RoomManager({
       maxRooms: config.maxRooms ?? 100,
-      roomTimeoutMs: config.roomTimeoutMs ?? 3600000
-    });
+      roomTimeoutMs: config.roomTimeoutMs ?? 3600000,
+      cleanupIntervalMs: config.roomCleanupIntervalMs ?? 60000,
+      abandonedGameTimeoutMs: config.abandonedGameTimeoutMs ?? 300000,
+      metrics: config.metrics ?? NULL_METRICS,
+      tracer: config.tracer ?? NOOP_TRACER
+    }, config.gameStore, config.roomStore);
 
     // Initialize reconnection manager
     this.reconnectionManager = new ReconnectionManager({
@@ -203,6 +257,7 @@ export class GameServerFacade {
     this.authService = getAuthService();
     this.statsRepo = new StatisticsRepository();
     this.replayRepo = new ReplayRepository();
+    this.gameRepo = new GameRepository();
 
     // Initialize admin authorization service
     this.adminAuth = new AdminAuthorizationService();
@@ -249,12 +304,12 @@ export class GameServerFacade {
 
     // Handle room events
     this.roomManager.onEvent((event) => {
-      console.log(`Room event: ${event.type} for ${event.roomCode}`);
+      this.logger.info('Room event', { event: event.type, roomCode: event.roomCode });
     });
 
     // Handle reconnection events
     this.reconnectionManager.onEvent((event) => {
-      console.log(`Reconnection event: ${event.type} for ${event.playerId}`);
+      this.logger.info('Reconnection event', { event: event.type, playerId: event.playerId });
     });
   }
 
@@ -290,7 +345,7 @@ export class GameServerFacade {
       switch (message.type) {
         case 'authenticate':
           this.handleAuthenticate(connection, message).catch((err) => {
-            console.error('Error during WebSocket authentication:', err);
+            this.logger.error('Error during WebSocket authentication', { error: err });
           });
           break;
 
@@ -311,7 +366,7 @@ export class GameServerFacade {
           break;
 
         case 'listPublicRooms':
-          this.handleListPublicRooms(connection);
+          this.handleListPublicRooms(connection, message);
           break;
 
         case 'leaveRoom':
@@ -354,6 +409,10 @@ export class GameServerFacade {
           this.handleReadyToVote(connection);
           break;
 
+        case 'requestExtension':
+          this.handleRequestExtension(connection);
+          break;
+
         case 'login':
           this.handleLogin(connection, message);
           break;
@@ -374,16 +433,60 @@ export class GameServerFacade {
           this.handleGetReplay(connection, message);
           break;
 
+        case 'spectateRoom':
+          this.handleSpectateRoom(connection, message);
+          break;
+
         default:
-          this.sendError(connection, ErrorCodes.INVALID_MESSAGE, `Unknown message type`);
+          this.sendError(
+            connection,
+            ErrorCodes.INVALID_MESSAGE,
+            `Unknown message type: ${(message as { type: unknown }).type}`
+          );
       }
     } catch (error) {
-      console.error('Error handling message:', error);
-      this.sendError(
-        connection,
-        ErrorCodes.INTERNAL_ERROR,
-        error instanceof Error ? error.message : 'Internal error'
-      );
+      this.recoverFromMessageError(connection, message, error);
+    }
+  }
+
+  /**
+   * @summary Contains an unexpected error thrown while handling a message.
+   *
+   * @description
+   * Handlers report expected failures themselves, so anything reaching
+   * here is a bug. It is logged with its stack and the client gets a
+   * generic error. If the sender is in a game in progress, that game is
+   * closed, since its state can no longer be trusted; every other room
+   * keeps running.
+   *
+   * @param {IClientConnection} connection - Source connection
+   * @param {ClientMessage} message - Message being handled
+   * @param {unknown} error - Thrown error
+   *
+   * @private
+   */
+  private recoverFromMessageError(
+    connection: IClientConnection,
+    message: ClientMessage,
+    error: unknown
+  ): void {
+    this.connectionLogger(connection).error('Unhandled error handling message', {
+      type: message.type,
+      error: error instanceof Error ? error : String(error)
+    });
+
+    this.sendError(connection, ErrorCodes.INTERNAL_ERROR, 'Internal error');
+
+    const roomCode = this.getSession(connection)?.roomCode;
+    const room = roomCode ? this.roomManager.getRoom(roomCode) : undefined;
+    if (room && room.getStatus() === RoomStatus.PLAYING) {
+      room.broadcast({
+        type: 'error',
+        code: ErrorCodes.INTERNAL_ERROR,
+        message: 'The game was stopped by a server error',
+        timestamp: Date.now()
+      });
+      this.roomManager.closeRoom(room.getCode(), 'Internal server error');
     }
   }
 
@@ -399,7 +502,16 @@ export class GameServerFacade {
     connection: IClientConnection,
     message: Extract<ClientMessage, { type: 'authenticate' }>
   ): Promise<void> {
-    const { playerId, playerName, token } = message;
+    const { playerId, token } = message;
+    const log = this.logger.with({ playerId });
+
+    // Names are shown to other players and logged, so clean them up first
+    const nameResult = normalizePlayerName(message.playerName);
+    if (!nameResult.valid) {
+      this.sendError(connection, ErrorCodes.INVALID_NAME, nameResult.error);
+      return;
+    }
+    const playerName = this.sanitizer.sanitize(nameResult.name);
 
     // Check if player is reconnecting
     if (this.reconnectionManager.canReconnect(playerId)) {
@@ -407,20 +519,27 @@ export class GameServerFacade {
       return;
     }
 
+    // Check if player is returning to a room restored after a restart
+    const restoredRoom = this.roomManager.findPlayerRoom(playerId);
+    if (restoredRoom && restoredRoom.isPlayerDetached(playerId)) {
+      this.handleRestoredPlayer(connection, restoredRoom, playerId, playerName);
+      return;
+    }
+
     // Check if this connection already has an active session (re-authentication)
     const existingPlayerId = this.connectionToSession.get(connection.id);
     const existingSession = existingPlayerId ? this.sessions.get(existingPlayerId) : null;
     const isReauthentication = existingSession !== null && existingSession !== undefined;
 
     if (isReauthentication) {
-      console.log(`WebSocket auth: Re-authentication for ${playerName} (preserving session state)`);
+      log.info('WebSocket auth: re-authentication, preserving session state', { playerName });
     }
 
     // If a JWT token is provided, validate it and link to database user
     let userId: string | undefined;
     let isAdmin = false;
     if (token) {
-      console.log(`WebSocket auth: Token received for ${playerName}`);
+      log.debug('WebSocket auth: token received', { playerName });
       try {
         const user = await this.authService.validateToken(token);
         if (user) {
@@ -429,19 +548,19 @@ export class GameServerFacade {
           this.authenticatedUsers.set(connection.id, userId);
           if (isAdmin) {
             this.adminAuth.registerAdmin(connection.id);
-            console.log(`WebSocket auth: Admin user ${playerName} (${userId}) connected`);
+            log.info('WebSocket auth: admin user connected', { playerName, userId });
           } else {
-            console.log(`WebSocket auth: Linked ${playerName} to database user ${userId}`);
+            log.info('WebSocket auth: linked to database user', { playerName, userId });
           }
         } else {
-          console.log(`WebSocket auth: Token valid but no user returned`);
+          log.warn('WebSocket auth: token valid but no user returned');
         }
       } catch (error) {
         // Token validation failed - continue without database user link
-        console.log('Token validation failed during WebSocket auth:', error);
+        log.warn('WebSocket auth: token validation failed', { error });
       }
     } else {
-      console.log(`WebSocket auth: No token provided for ${playerName}`);
+      log.debug('WebSocket auth: no token provided', { playerName });
     }
 
     // Create or update session
@@ -503,9 +622,18 @@ export class GameServerFacade {
 
     // Get room and game state
     const room = this.roomManager.getRoom(state.roomCode);
+    room?.reattachPlayer(playerId, connection);
     if (room && room.getGame()) {
       const game = room.getGame()!;
-      const view = PlayerViewFactory.createReconnectionView(game, playerId, state.nightInfo);
+      // reattachPlayer() resumes a paused phase clock, so read timing after it
+      const view = PlayerViewFactory.createReconnectionView(
+        game,
+        room.getGamePlayerId(playerId) ?? playerId,
+        state.nightInfo,
+        room.getTimeRemaining(),
+        room.getPhaseEndsAt(),
+        room.getVoteVisibility()
+      );
 
       const authMessage: ServerMessage = {
         type: 'authenticated',
@@ -522,11 +650,80 @@ export class GameServerFacade {
         timestamp: Date.now()
       };
       connection.send(stateMessage);
+
+      // Any nightResult sent while the socket was down never arrived
+      room.resendLatestNightResult(playerId);
     }
 
     this.reconnectionManager.completeReconnection(playerId);
   }
 
+  /**
+   * @summary Re-attaches a player to a room restored from disk.
+   *
+   * @description
+   * After a restart the player's old socket is gone, so the room holds a
+   * placeholder. Moves the player onto the new connection and sends the
+   * full game state, then their latest night result, so the client can
+   * pick up where it left off.
+   *
+   * @param {IClientConnection} connection - New connection
+   * @param {Room} room - Restored room the player is seated in
+   * @param {PlayerId} playerId - Player ID
+   * @param {string} playerName - Player name
+   *
+   * @private
+   */
+  private handleRestoredPlayer(
+    connection: IClientConnection,
+    room: Room,
+    playerId: PlayerId,
+    playerName: string
+  ): void {
+    room.reattachPlayer(playerId, connection);
+
+    const session: PlayerSession = {
+      playerId,
+      playerName,
+      connection,
+      roomCode: room.getCode(),
+      authenticatedAt: Date.now()
+    };
+
+    this.sessions.set(playerId, session);
+    this.connectionToSession.set(connection.id, playerId);
+
+    const authMessage: ServerMessage = {
+      type: 'authenticated',
+      playerId,
+      playerName,
+      serverVersion: '2.0.0',
+      timestamp: Date.now()
+    };
+    connection.send(authMessage);
+
+    const game = room.getGame();
+    const gamePlayerId = room.getGamePlayerId(playerId);
+    if (game && gamePlayerId) {
+      const view = PlayerViewFactory.createReconnectionView(
+        game,
+        gamePlayerId,
+        game.getPlayerNightInfo(gamePlayerId),
+        room.getTimeRemaining(),
+        room.getPhaseEndsAt(),
+        room.getVoteVisibility()
+      );
+
+      const stateMessage: ServerMessage = {
+        type: 'gameState',
+        view,
+        timestamp: Date.now()
+      };
+      connection.send(stateMessage);
+      room.resendLatestNightResult(playerId);
+    }
+  }
+
   /**
    * @summary Handles disconnect request.
    *
@@ -560,11 +757,16 @@ export class GameServerFacade {
       return;
     }
 
+    this.releaseStaleRoom(session);
     if (session.roomCode) {
       this.sendError(connection, ErrorCodes.ROOM_STARTED, 'Already in a room');
       return;
     }
 
+    if (!this.allowRoomAction(connection)) {
+      return;
+    }
+
     try {
       // Only allow debug options for admin users (uses centralized authorization)
       const debug = this.adminAuth.authorizeDebugOptions(
@@ -585,6 +787,8 @@ export class GameServerFacade {
       const createdMessage: ServerMessage = {
         type: 'roomCreated',
         roomCode: room.getCode(),
+        playerId: session.playerId,
+        playerToken: room.getPlayer(session.playerId)!.token,
         state: room.getState(),
         timestamp: Date.now()
       };
@@ -658,11 +862,17 @@ export class GameServerFacade {
       return;
     }
 
+    this.releaseStaleRoom(session);
     if (session.roomCode) {
       this.sendError(connection, ErrorCodes.ROOM_STARTED, 'Already in a room');
       return;
     }
 
+    // Counted before the lookup so room codes can't be guessed at speed
+    if (!this.allowRoomAction(connection)) {
+      return;
+    }
+
     const room = this.roomManager.getRoom(message.roomCode);
     if (!room) {
       this.sendError(connection, ErrorCodes.ROOM_NOT_FOUND, 'Room not found');
@@ -675,9 +885,19 @@ export class GameServerFacade {
 
       room.addPlayer(session.playerId, session.playerName, connection, false, userId);
       session.roomCode = room.getCode();
+      this.connectionLogger(connection).info('Player joined room', { playerName: session.playerName });
+
+      // Joining as a player ends any spectating session
+      if (session.spectatingRoomCode) {
+        this.roomManager.getRoom(session.spectatingRoomCode)?.removeSpectator(session.playerId);
+        session.spectatingRoomCode = null;
+      }
 
       const joinedMessage: ServerMessage = {
         type: 'roomJoined',
+        roomCode: room.getCode(),
+        playerId: session.playerId,
+        playerToken: room.getPlayer(session.playerId)!.token,
         state: room.getState(),
         timestamp: Date.now()
       };
@@ -693,37 +913,97 @@ export class GameServerFacade {
     }
   }
 
+  /**
+   * @summary Handles a request to spectate a room.
+   *
+   * @description
+   * Registers the connection as a spectator. Spectators receive the
+   * room's public broadcasts after the room's spectator delay.
+   *
+   * @param {IClientConnection} connection - Connection
+   * @param {ClientMessage} message - Spectate room message
+   *
+   * @private
+   */
+  private handleSpectateRoom(
+    connection: IClientConnection,
+    message: Extract<ClientMessage, { type: 'spectateRoom' }>
+  ): void {
+    const session = this.getSession(connection);
+    if (!session) {
+      this.sendError(connection, ErrorCodes.AUTH_REQUIRED, 'Not authenticated');
+      return;
+    }
+
+    if (session.roomCode) {
+      this.sendError(connection, ErrorCodes.ALREADY_IN_ROOM, 'Already in a room');
+      return;
+    }
+
+    const room = this.roomManager.getRoom(message.roomCode);
+    if (!room) {
+      this.sendError(connection, ErrorCodes.ROOM_NOT_FOUND, 'Room not found');
+      return;
+    }
+
+    try {
+      // Leave any room previously being watched
+      if (session.spectatingRoomCode) {
+        this.roomManager.getRoom(session.spectatingRoomCode)?.removeSpectator(session.playerId);
+      }
+
+      room.addSpectator(session.playerId, connection);
+      session.spectatingRoomCode = room.getCode();
+
+      const spectatingMessage: ServerMessage = {
+        type: 'spectating',
+        state: room.getSpectatorState(),
+        delayMs: room.getSpectatorDelayMs(),
+        timestamp: Date.now()
+      };
+      connection.send(spectatingMessage);
+    } catch (error) {
+      this.sendError(
+        connection,
+        ErrorCodes.SPECTATORS_NOT_ALLOWED,
+        error instanceof Error ? error.message : 'Failed to spectate room'
+      );
+    }
+  }
+
   /**
    * @summary Handles list public rooms request.
    *
    * @description
    * Returns a list of all public rooms that are waiting for players.
-   * Filters rooms by isPrivate=false and status=WAITING.
+   * Filters rooms by isPrivate=false and status=WAITING, and by free
+   * seats when the client sends minOpenSlots.
    *
    * @param {IClientConnection} connection - Connection requesting room list
+   * @param {ClientMessage} message - List rooms message
    *
    * @pattern Observer Pattern - Provides snapshot of available rooms
    * @private
    */
-  private handleListPublicRooms(connection: IClientConnection): void {
+  private handleListPublicRooms(
+    connection: IClientConnection,
+    message: Extract<ClientMessage, { type: 'listPublicRooms' }>
+  ): void {
     const session = this.getSession(connection);
     if (!session) {
       this.sendError(connection, ErrorCodes.AUTH_REQUIRED, 'Not authenticated');
       return;
     }
 
-    // Get all public rooms that are waiting for players
-    const publicRooms = this.roomManager.getPublicRooms();
+    const minOpenSlots = message.minOpenSlots ?? 0;
+    if (!Number.isInteger(minOpenSlots) || minOpenSlots < 0) {
+      this.sendError(connection, ErrorCodes.INVALID_MESSAGE, 'minOpenSlots must be a whole number of at least 0');
+      return;
+    }
 
     const response: ServerMessage = {
       type: 'publicRoomsResponse',
-      rooms: publicRooms.map(room => ({
-        roomCode: room.getCode(),
-        hostName: room.getHostName(),
-        playerCount: room.getPlayerCount(),
-        maxPlayers: room.getConfig().maxPlayers,
-        roles: room.getConfig().roles
-      })),
+      rooms: this.roomManager.getPublicRoomSummaries(minOpenSlots),
       timestamp: Date.now()
     };
 
@@ -743,8 +1023,9 @@ export class GameServerFacade {
       return;
     }
 
+    // The player may already have left through the HTTP API
     const room = this.roomManager.getRoom(session.roomCode);
-    if (room) {
+    if (room?.getPlayer(session.playerId)) {
       room.removePlayer(session.playerId);
     }
 
@@ -795,9 +1076,6 @@ export class GameServerFacade {
    *
    * @private
    */
-  /** Counter for AI player IDs */
-  private aiPlayerCounter: number = 0;
-
   private handleAddAI(
     connection: IClientConnection,
     message: Extract<ClientMessage, { type: 'addAI' }>
@@ -822,30 +1100,7 @@ export class GameServerFacade {
     }
 
     try {
-      // Create a null connection for AI players (Null Object Pattern)
-      const aiId = `ai-${++this.aiPlayerCounter}`;
-
-      // Get names already used in this room to avoid duplicates
-      const usedNames = new Set(
-        room.getPlayers().map(p => p.name)
-      );
-
-      // Find an unused AI name for this room
-      const aiNames = ['Bot Alice', 'Bot Bob', 'Bot Charlie', 'Bot Diana', 'Bot Eve', 'Bot Frank',
-                       'Bot Grace', 'Bot Henry', 'Bot Ivy', 'Bot Jack'];
-      let aiName = message.aiName;
-      if (!aiName) {
-        // Find first unused name
-        aiName = aiNames.find(name => !usedNames.has(name));
-        // Fallback to numbered name if all names used
-        if (!aiName) {
-          aiName = `Bot Player ${this.aiPlayerCounter}`;
-        }
-      }
-
-      const nullConnection = NullConnection.create(aiId);
-
-      room.addPlayer(aiId, aiName, nullConnection, true);
+      this.roomManager.addBot(room, message.aiName);
     } catch (error) {
       this.sendError(
         connection,
@@ -858,6 +1113,10 @@ export class GameServerFacade {
   /**
    * @summary Handles remove player request.
    *
+   * @description
+   * Kicks the player from the lobby through the room manager, which
+   * tells them why and closes their connection.
+   *
    * @param {IClientConnection} connection - Connection
    * @param {ClientMessage} message - Remove player message
    *
@@ -873,43 +1132,32 @@ export class GameServerFacade {
       return;
     }
 
-    const room = this.roomManager.getRoom(session.roomCode);
-    if (!room) {
-      session.roomCode = null;
-      this.sendError(connection, ErrorCodes.ROOM_NOT_FOUND, 'Room no longer exists');
-      return;
-    }
+    const result = this.roomManager.kickPlayer(session.roomCode, session.playerId, message.playerId);
 
-    if (room.getHostId() !== session.playerId) {
-      this.sendError(connection, ErrorCodes.NOT_HOST, 'Only host can remove players');
-      return;
-    }
+    switch (result) {
+      case 'notFound':
+        session.roomCode = null;
+        this.sendError(connection, ErrorCodes.ROOM_NOT_FOUND, 'Room no longer exists');
+        return;
 
-    try {
-      // Get kicked player's connection before removing them
-      const kickedPlayer = room.getPlayer(message.playerId);
-      if (kickedPlayer && kickedPlayer.connection) {
-        // Notify the kicked player
-        kickedPlayer.connection.send({
-          type: 'roomClosed',
-          reason: 'You have been removed from the room',
-          timestamp: Date.now()
-        });
+      case 'notHost':
+        this.sendError(connection, ErrorCodes.NOT_HOST, 'Only host can remove players');
+        return;
 
-        // Clear their session's room code
-        const kickedSession = this.getSession(kickedPlayer.connection);
-        if (kickedSession) {
-          kickedSession.roomCode = null;
-        }
-      }
+      case 'notWaiting':
+        this.sendError(connection, ErrorCodes.ROOM_STARTED, 'Cannot remove players after the game has started');
+        return;
 
-      room.removePlayer(message.playerId);
-    } catch (error) {
-      this.sendError(
-        connection,
-        ErrorCodes.INVALID_ACTION,
-        error instanceof Error ? error.message : 'Failed to remove player'
-      );
+      case 'notInRoom':
+        this.sendError(connection, ErrorCodes.INVALID_TARGET, 'Player is not in the room');
+        return;
+
+      case 'invalidTarget':
+        this.sendError(connection, ErrorCodes.INVALID_TARGET, 'The host cannot remove themselves');
+        return;
+
+      case 'kicked':
+        return;
     }
   }
 
@@ -937,7 +1185,8 @@ export class GameServerFacade {
 
     try {
       // Apply debug options if provided by an admin (uses centralized authorization)
-      console.log(`WebSocket: ${session.playerId} starting game with debug options:`, message.debug);
+      const log = this.connectionLogger(connection);
+      log.info('Starting game', { debug: message.debug });
       const authorizedDebug = this.adminAuth.authorizeDebugOptions(
         connection.id,
         message.debug,
@@ -945,9 +1194,9 @@ export class GameServerFacade {
       );
       if (authorizedDebug) {
         room.setDebugOptions(authorizedDebug);
-        console.log(`WebSocket: Admin ${session.playerId} applied debug options:`, authorizedDebug);
+        log.info('Admin applied debug options', { debug: authorizedDebug });
       } else if (message.debug) {
-        console.log(`WebSocket: Debug options REJECTED for ${session.playerId} (not admin). Options were:`, message.debug);
+        log.warn('Debug options rejected: not an admin', { debug: message.debug });
       }
 
       const game = room.startGame(session.playerId);
@@ -1005,7 +1254,10 @@ export class GameServerFacade {
     }
 
     try {
-      room.submitStatement(session.playerId, message.statement);
+      const statement = typeof message.statement === 'string'
+        ? this.sanitizer.sanitize(message.statement)
+        : message.statement;
+      room.submitStatement(session.playerId, statement);
     } catch (error) {
       this.sendError(
         connection,
@@ -1049,6 +1301,36 @@ export class GameServerFacade {
     }
   }
 
+  /**
+   * @summary Handles a player asking for more discussion time.
+   *
+   * @param {IClientConnection} connection - Connection
+   *
+   * @private
+   */
+  private handleRequestExtension(connection: IClientConnection): void {
+    const session = this.getSession(connection);
+    if (!session || !session.roomCode) {
+      this.sendError(connection, ErrorCodes.NOT_IN_ROOM, 'Not in a room');
+      return;
+    }
+
+    const room = this.roomManager.getRoom(session.roomCode);
+    if (!room) {
+      return;
+    }
+
+    try {
+      room.requestDiscussionExtension(session.playerId);
+    } catch (error) {
+      this.sendError(
+        connection,
+        ErrorCodes.INVALID_ACTION,
+        error instanceof Error ? error.message : 'Failed to extend the discussion'
+      );
+    }
+  }
+
   /**
    * @summary Handles get state request.
    *
@@ -1082,9 +1364,11 @@ export class GameServerFacade {
         timestamp: Date.now()
       };
       connection.send(updateMessage);
-    } else if (room.getGame()) {
-      const game = room.getGame()!;
-      const view = PlayerViewFactory.createView(game, session.playerId);
+    } else {
+      const view = room.getPlayerView(session.playerId);
+      if (!view) {
+        return;
+      }
       const stateMessage: ServerMessage = {
         type: 'gameState',
         view,
@@ -1119,6 +1403,10 @@ export class GameServerFacade {
     this.authenticatedUsers.delete(connection.id);
     this.adminAuth.unregisterAdmin(connection.id);
 
+    if (session.spectatingRoomCode) {
+      this.roomManager.getRoom(session.spectatingRoomCode)?.removeSpectator(playerId);
+    }
+
     // Handle room disconnection
     if (session.roomCode) {
       const room = this.roomManager.getRoom(session.roomCode);
@@ -1132,7 +1420,11 @@ export class GameServerFacade {
             [], // Night info would come from the player
             playerInfo?.name ?? 'Unknown'
           );
-        } else {
+          room.handlePlayerDisconnected(playerId);
+        } else if (room.getStatus() === RoomStatus.WAITING && room.getHostId() === playerId) {
+          // Keep the host's seat; the room manager passes the role on if they don't return
+          room.handlePlayerDisconnected(playerId);
+        } else if (room.getPlayer(playerId)) {
           // Not playing - just remove from room
           room.removePlayer(playerId);
         }
@@ -1140,6 +1432,36 @@ export class GameServerFacade {
     }
   }
 
+  /**
+   * @summary Forgets a session's room once the player can no longer be in it.
+   *
+   * @description
+   * A session keeps its room code until the player leaves over the
+   * WebSocket, but meanwhile the room may have been deleted by its host,
+   * swept as idle, or left through the HTTP API. A finished game is left
+   * the same way leaveRoom would. None of these may stop the player
+   * creating or joining another room.
+   *
+   * @param {PlayerSession} session - Session to check
+   *
+   * @private
+   */
+  private releaseStaleRoom(session: PlayerSession): void {
+    if (!session.roomCode) {
+      return;
+    }
+
+    const room = this.roomManager.getRoom(session.roomCode);
+    if (room?.getPlayer(session.playerId)) {
+      if (room.getStatus() !== RoomStatus.ENDED) {
+        return;
+      }
+      room.removePlayer(session.playerId);
+    }
+
+    session.roomCode = null;
+  }
+
   /**
    * @summary Gets session for a connection.
    *
@@ -1157,6 +1479,26 @@ export class GameServerFacade {
     return this.sessions.get(playerId) ?? null;
   }
 
+  /**
+   * @summary Gets a logger tagged with a connection's player and room.
+   *
+   * @param {IClientConnection} connection - Connection
+   *
+   * @returns {Logger} Logger carrying playerId and roomCode when known
+   *
+   * @private
+   */
+  private connectionLogger(connection: IClientConnection): Logger {
+    const session = this.getSession(connection);
+    if (!session) {
+      return this.logger.with({ connectionId: connection.id });
+    }
+    return this.logger.with({
+      playerId: session.playerId,
+      ...(session.roomCode ? { roomCode: session.roomCode } : {})
+    });
+  }
+
   /**
    * @summary Sends an error message to a connection.
    *
@@ -1172,6 +1514,38 @@ export class GameServerFacade {
     }
   }
 
+  /**
+   * @summary Takes a room create/join token for the connection's IP.
+   *
+   * @description
+   * Refuses with RATE_LIMITED, saying how long to wait, once the IP has
+   * used up its burst. Connections with no known address are limited
+   * on their own.
+   *
+   * @param {IClientConnection} connection - Connection creating or joining
+   *
+   * @returns {boolean} True if the action may go ahead
+   *
+   * @private
+   */
+  private allowRoomAction(connection: IClientConnection): boolean {
+    const key = connection.remoteAddress ?? connection.id;
+    if (this.roomRateLimiter.tryConsume(key)) {
+      return true;
+    }
+
+    const retryAfterMs = this.roomRateLimiter.retryAfterMs(key);
+    this.connectionLogger(connection).warn('Room create/join rate limited', { remoteAddress: key });
+    if (connection.isConnected()) {
+      connection.send(createErrorMessage(
+        ErrorCodes.RATE_LIMITED,
+        'Too many rooms created or joined, try again later',
+        { retryAfterMs }
+      ));
+    }
+    return false;
+  }
+
   // ============================================================================
   // DATABASE AUTHENTICATION HANDLERS
   // ============================================================================
@@ -1426,7 +1800,8 @@ export class GameServerFacade {
    *
    * @description
    * Returns full game replay data including night actions, statements, and votes.
-   * Follows 6NF decomposition for multi-valued attributes.
+   * Follows 6NF decomposition for multi-valued attributes. Votes are left
+   * out of games played with secret votes.
    *
    * @pattern Repository Pattern - Uses ReplayRepository for 6NF data retrieval
    * @pattern 6NF Compliance - Targets, views, swaps stored in separate tables
@@ -1446,7 +1821,10 @@ export class GameServerFacade {
       // Get all replay data using 6NF repository
       const nightActions = await this.replayRepo.getNightActions(gameId);
       const statements = await this.replayRepo.getStatements(gameId);
-      const votes = await this.replayRepo.getVotes(gameId);
+      const configuration = await this.gameRepo.getConfiguration(gameId);
+      const votes = configuration?.vote_visibility === 'secret'
+        ? []
+        : await this.replayRepo.getVotes(gameId);
 
       if (nightActions.length === 0 && statements.length === 0 && votes.length === 0) {
         const response: ReplayResponseMessage = {
@@ -1534,23 +1912,36 @@ export class GameServerFacade {
       throw new Error('Server is already running');
     }
 
+    // Resume games that were in progress when the server last stopped
+    const restored = this.roomManager.restoreRooms();
+    if (restored > 0) {
+      this.logger.info('Restored in-progress games from disk', { count: restored });
+    }
+
     // Start room manager cleanup
     this.roomManager.startCleanupTimer();
 
     // Start reconnection manager cleanup
     this.reconnectionManager.startCleanup();
 
+    // Forget rate limit buckets once they have refilled
+    this.roomRateLimiter.startCleanup();
+
     // Start WebSocket server
     await this.wsServer.start();
 
     this._isRunning = true;
 
-    console.log(`Game server started on port ${this.config.port}`);
+    this.logger.info('Game server started', { port: this.config.port });
   }
 
   /**
    * @summary Stops the game server.
    *
+   * @description
+   * Sends every client a SERVER_RESTARTING error, saves and cancels all
+   * games in progress, then closes the remaining connections.
+   *
    * @returns {Promise<void>} Resolves when server is stopped
    *
    * @example
@@ -1564,12 +1955,21 @@ export class GameServerFacade {
       return;
     }
 
-    // Stop WebSocket server
-    await this.wsServer.stop();
+    // Tell every client why they are about to be dropped
+    this.wsServer.broadcast({
+      type: 'error',
+      code: ErrorCodes.SERVER_RESTARTING,
+      message: 'Server restarting',
+      timestamp: Date.now()
+    });
 
-    // Stop managers
+    // Save and cancel games before their sockets go away
     this.roomManager.shutdown();
     this.reconnectionManager.shutdown();
+    this.roomRateLimiter.stopCleanup();
+
+    // Stop WebSocket server (closes remaining connections)
+    await this.wsServer.stop();
 
     // Clear sessions
     this.sessions.clear();
@@ -1577,7 +1977,7 @@ export class GameServerFacade {
 
     this._isRunning = false;
 
-    console.log('Game server stopped');
+    this.logger.info('Game server stopped');
   }
 
   /**
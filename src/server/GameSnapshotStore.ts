@@ -0,0 +1,151 @@
+/**
+ * @fileoverview Persistence for in-progress games.
+ * @module server/GameSnapshotStore
+ *
+ * @summary Saves running rooms so they survive a server restart.
+ *
+ * @description
+ * RoomManager keeps every room in memory, so a restart used to drop
+ * every game in progress. A game snapshot store holds the latest
+ * RoomSnapshot for each playing room; RoomManager writes one on every
+ * phase change and vote, deletes it when the game ends, and reloads
+ * whatever is left on startup.
+ *
+ * Only game state and seated players are stored. Sockets cannot be
+ * persisted, so players re-attach when they authenticate again.
+ *
+ * @pattern Repository Pattern - Abstracts where snapshots are kept
+ * @pattern Memento Pattern - Stores externalized room state
+ *
+ * @example
+ * ```typescript
+ * const store = new JsonFileGameSnapshotStore('./data/games');
+ * const manager = new RoomManager({}, store);
+ * manager.restoreRooms();
+ * ```
+ */
+
+import * as fs from 'fs';
+import * as path from 'path';
+import { RoomCode } from '../network/protocol';
+import { RoomSnapshot } from './Room';
+
+/**
+ * @summary Storage backend for room snapshots.
+ *
+ * @description
+ * Implementations must be safe to call on every phase change. A save
+ * replaces any earlier snapshot for the same room code.
+ */
+export interface IGameSnapshotStore {
+  /**
+   * @summary Saves (or replaces) a room's snapshot.
+   *
+   * @param {RoomSnapshot} snapshot - Snapshot to save
+   */
+  saveGame(snapshot: RoomSnapshot): void;
+
+  /**
+   * @summary Deletes a room's snapshot, if any.
+   *
+   * @param {RoomCode} code - Room code
+   */
+  deleteGame(code: RoomCode): void;
+
+  /**
+   * @summary Loads every saved snapshot.
+   *
+   * @returns {RoomSnapshot[]} Saved snapshots
+   */
+  loadGames(): RoomSnapshot[];
+}
+
+/**
+ * @summary Stores each room snapshot as a JSON file.
+ *
+ * @description
+ * Writes `<directory>/<ROOMCODE>.json`. Each save goes to a temporary
+ * file that is then renamed over the old one, so a crash mid-write never
+ * leaves a truncated snapshot behind. Files that fail to parse on load
+ * are skipped and logged rather than aborting startup.
+ */
+export class JsonFileGameSnapshotStore implements IGameSnapshotStore {
+  /** Directory holding snapshot files */
+  private readonly directory: string;
+
+  /**
+   * @summary Creates a file-backed snapshot store.
+   *
+   * @param {string} directory - Directory to write snapshots to (created if missing)
+   */
+  constructor(directory: string) {
+    this.directory = directory;
+
+    if (!fs.existsSync(this.directory)) {
+      fs.mkdirSync(this.directory, { recursive: true });
+    }
+  }
+
+  /**
+   * @summary Saves (or replaces) a room's snapshot.
+   *
+   * @param {RoomSnapshot} snapshot - Snapshot to save
+   */
+  saveGame(snapshot: RoomSnapshot): void {
+    const file = this.fileFor(snapshot.code);
+    const tmp = `${file}.tmp`;
+
+    fs.writeFileSync(tmp, JSON.stringify(snapshot));
+    fs.renameSync(tmp, file);
+  }
+
+  /**
+   * @summary Deletes a room's snapshot, if any.
+   *
+   * @param {RoomCode} code - Room code
+   */
+  deleteGame(code: RoomCode): void {
+    const file = this.fileFor(code);
+    if (fs.existsSync(file)) {
+      fs.unlinkSync(file);
+    }
+  }
+
+  /**
+   * @summary Loads every saved snapshot.
+   *
+   * @returns {RoomSnapshot[]} Saved snapshots
+   */
+  loadGames(): RoomSnapshot[] {
+    const snapshots: RoomSnapshot[] = [];
+
+    for (const name of fs.readdirSync(this.directory)) {
+      if (!name.endsWith('.json')) {
+        continue;
+      }
+
+      try {
+        const data = fs.readFileSync(path.join(this.directory, name), 'utf-8');
+        snapshots.push(JSON.parse(data) as RoomSnapshot);
+      } catch (error) {
+        console.error(`GameSnapshotStore: Skipping unreadable snapshot ${name}:`, error);
+      }
+    }
+
+    return snapshots;
+  }
+
+  /**
+   * @summary Gets the snapshot file path for a room.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {string} File path
+   *
+   * @private
+   */
+  private fileFor(code: RoomCode): string {
+    // Room codes are generated from [A-Z2-9]; strip anything else defensively
+    return path.join(this.directory, `${code.replace(/[^A-Za-z0-9]/g, '')}.json`);
+  }
+}
@@ -0,0 +1,82 @@
+/**
+ * @fileoverview Liveness and readiness probes for load balancers.
+ * @module server/HealthCheck
+ *
+ * @summary Answers /healthz and /readyz without touching any game.
+ *
+ * @description
+ * A load balancer polls these paths every few seconds, so they are
+ * handled before the REST API: no CORS headers, no access log entry and
+ * no database. /healthz only shows the process is answering HTTP;
+ * /readyz also requires the game server to be accepting connections.
+ *
+ * @example
+ * ```typescript
+ * const server = createServer(async (req, res) => {
+ *   if (handleHealthCheck(req, res, gameServer)) {
+ *     return;
+ *   }
+ *   await apiHandler.handleRequest(req, res);
+ * });
+ * ```
+ */
+
+import { IncomingMessage, ServerResponse } from 'http';
+import { RoomManager } from './RoomManager';
+
+/**
+ * @summary Liveness probe path.
+ */
+export const HEALTH_PATH = '/healthz';
+
+/**
+ * @summary Readiness probe path.
+ */
+export const READY_PATH = '/readyz';
+
+/**
+ * @summary What the probes need to know about the game server.
+ */
+export interface HealthSource {
+  /** Whether the game server has started and accepts connections */
+  readonly isRunning: boolean;
+
+  /** Room manager holding live games */
+  getRoomManager(): RoomManager;
+}
+
+/**
+ * @summary Answers a health probe request.
+ *
+ * @description
+ * Returns false for any other path so the caller can route the request
+ * as usual. /healthz always answers 200; /readyz answers 503 until the
+ * game server is running and again once it stops. Both report the
+ * number of rooms held, which never requires a room to exist.
+ *
+ * @param {IncomingMessage} req - HTTP request
+ * @param {ServerResponse} res - HTTP response
+ * @param {HealthSource} source - Game server to report on
+ *
+ * @returns {boolean} True if the request was a health probe
+ */
+export function handleHealthCheck(
+  req: IncomingMessage,
+  res: ServerResponse,
+  source: HealthSource
+): boolean {
+  const path = (req.url ?? '/').split('?')[0];
+  if (req.method !== 'GET' || (path !== HEALTH_PATH && path !== READY_PATH)) {
+    return false;
+  }
+
+  const games = source.getRoomManager().getRoomCount();
+  const ready = path === HEALTH_PATH || source.isRunning;
+
+  res.writeHead(ready ? 200 : 503, {
+    'Content-Type': 'application/json',
+    'Cache-Control': 'no-store'
+  });
+  res.end(JSON.stringify({ status: ready ? 'ok' : 'unavailable', games }));
+  return true;
+}
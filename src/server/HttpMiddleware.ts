@@ -0,0 +1,152 @@
+/**
+ * @fileoverview Wrappers applied around the HTTP request handler.
+ * @module server/HttpMiddleware
+ *
+ * @summary Cross-cutting behaviour for every HTTP request.
+ *
+ * @description
+ * The HTTP server hands each request to a single handler. Behaviour that
+ * applies to every request, whatever route it ends up on, is added by
+ * wrapping that handler rather than repeating it in each route.
+ *
+ * @example
+ * ```typescript
+ * const server = createServer(withRequestLogging(withRecovery(async (req, res) => {
+ *   await apiHandler.handleRequest(req, res);
+ * })));
+ * ```
+ */
+
+import { IncomingMessage, ServerResponse } from 'http';
+import { getLogger, Logger } from '../utils/logger';
+
+/**
+ * @summary An HTTP request handler.
+ */
+export type HttpHandler = (req: IncomingMessage, res: ServerResponse) => void | Promise<void>;
+
+/**
+ * @summary Which requests the access log covers.
+ */
+export interface RequestLoggingConfig {
+  /** Only paths starting with this are logged */
+  pathPrefix: string;
+
+  /** Paths never logged, e.g. endpoints that already log what they receive */
+  skipPaths: readonly string[];
+}
+
+/**
+ * @summary Default access log settings: every API route except client log uploads.
+ */
+export const DEFAULT_REQUEST_LOGGING_CONFIG: RequestLoggingConfig = {
+  pathPrefix: '/api/',
+  skipPaths: ['/api/logs']
+};
+
+/**
+ * @summary Gets the IP address a request came from.
+ *
+ * @description
+ * By default this is the address of the socket. Behind a reverse proxy
+ * every connection comes from the proxy, which appends the real client
+ * address to X-Forwarded-For; with trustProxy set, that last entry is
+ * used instead, since earlier ones are whatever the client sent.
+ *
+ * A server reached directly must leave trustProxy off: otherwise any
+ * client can pick its own address and dodge per-IP limits.
+ *
+ * @param {IncomingMessage} req - HTTP request or WebSocket upgrade
+ * @param {boolean} [trustProxy=false] - Whether X-Forwarded-For comes from a proxy we run
+ * @returns {string} Client IP, or 'unknown'
+ */
+export function getClientIp(req: IncomingMessage, trustProxy: boolean = false): string {
+  if (trustProxy) {
+    const forwarded = req.headers['x-forwarded-for'];
+    const header = Array.isArray(forwarded) ? forwarded.join(',') : forwarded;
+    const last = header?.split(',').map(part => part.trim()).filter(part => part !== '').pop();
+    if (last) {
+      return last;
+    }
+  }
+
+  return req.socket?.remoteAddress ?? 'unknown';
+}
+
+/**
+ * @summary Turns an error thrown by a handler into a 500 response.
+ *
+ * @description
+ * Without this, an exception in a handler becomes an unhandled promise
+ * rejection, which stops the whole server. The error and its stack are
+ * logged at ERROR; the client only gets a generic JSON error so no
+ * internals leak. If the handler had already started the response, it
+ * is ended as is.
+ *
+ * @param {HttpHandler} handler - Handler to protect
+ * @param {Logger} [logger] - Logger for caught errors (defaults to the server logger)
+ *
+ * @returns {HttpHandler} Handler that never throws
+ */
+export function withRecovery(handler: HttpHandler, logger: Logger = getLogger()): HttpHandler {
+  return async (req: IncomingMessage, res: ServerResponse): Promise<void> => {
+    try {
+      await handler(req, res);
+    } catch (error) {
+      logger.error('Unhandled error in HTTP handler', {
+        method: req.method,
+        path: req.url,
+        error: error instanceof Error ? error : String(error)
+      });
+
+      if (res.headersSent) {
+        res.end();
+        return;
+      }
+
+      res.writeHead(500, { 'Content-Type': 'application/json' });
+      res.end(JSON.stringify({ success: false, error: 'Internal server error' }));
+    }
+  };
+}
+
+/**
+ * @summary Logs each request's method, path, status and duration.
+ *
+ * @description
+ * The entry is written at INFO once the response has finished, so the
+ * status is whatever was actually sent, including a 500 written by
+ * withRecovery(). The path is logged without its query string, and
+ * request bodies are never logged.
+ *
+ * @param {HttpHandler} handler - Handler to time
+ * @param {Partial<RequestLoggingConfig>} [config] - Which paths to log
+ * @param {Logger} [logger] - Logger for access entries (defaults to the server logger)
+ *
+ * @returns {HttpHandler} Handler that logs every matching request
+ */
+export function withRequestLogging(
+  handler: HttpHandler,
+  config: Partial<RequestLoggingConfig> = {},
+  logger: Logger = getLogger()
+): HttpHandler {
+  const { pathPrefix, skipPaths } = { ...DEFAULT_REQUEST_LOGGING_CONFIG, ...config };
+
+  return (req: IncomingMessage, res: ServerResponse): void | Promise<void> => {
+    const path = (req.url || '/').split('?')[0];
+
+    if (path.startsWith(pathPrefix) && !skipPaths.includes(path)) {
+      const startedAt = Date.now();
+      res.once('finish', () => {
+        logger.info('HTTP request', {
+          method: req.method,
+          path,
+          status: res.statusCode,
+          durationMs: Date.now() - startedAt
+        });
+      });
+    }
+
+    return handler(req, res);
+  };
+}
@@ -0,0 +1,245 @@
+/**
+ * @fileoverview Where the server listens, its HTTP timeouts and TLS files.
+ * @module server/ListenConfig
+ *
+ * @summary Reads the listen address and timeouts from flags and environment.
+ *
+ * @description
+ * A deployment that already has something on port 8080 needs to move
+ * the server without rebuilding it. The address comes from, in order:
+ * - the --addr flag, as host:port or :port
+ * - the ADDR environment variable, in the same form
+ * - the HOST and PORT environment variables
+ * - 0.0.0.0:8080
+ *
+ * --read-timeout and --write-timeout (or READ_TIMEOUT_MS and
+ * WRITE_TIMEOUT_MS) are in milliseconds; 0 turns a timeout off. Headers
+ * keep Node's own 60 second limit, or the read timeout if that is shorter.
+ *
+ * Browsers only open wss:// game connections from an https:// page, so
+ * a server reached directly (not behind a TLS-terminating proxy) needs
+ * a certificate. --tls-cert and --tls-key (or TLS_CERT_FILE and
+ * TLS_KEY_FILE) name PEM files; with both set the server speaks HTTPS
+ * and wss, and with neither plain HTTP and ws.
+ *
+ * Flags may be written -addr or --addr, with the value after = or a space.
+ *
+ * @example
+ * ```typescript
+ * parseListenConfig(['--addr', ':9000'], {});
+ * // { host: '0.0.0.0', port: 9000, readTimeoutMs: 300000, writeTimeoutMs: 0,
+ * //   tlsCertFile: null, tlsKeyFile: null }
+ * ```
+ */
+
+/**
+ * @summary Listen address and HTTP timeouts.
+ */
+export interface ListenConfig {
+  /** Interface to bind */
+  host: string;
+
+  /** TCP port to listen on */
+  port: number;
+
+  /** Time allowed to receive a whole request, headers and body (0 = none) */
+  readTimeoutMs: number;
+
+  /** Idle time allowed on a connection while a response is sent (0 = none) */
+  writeTimeoutMs: number;
+
+  /** PEM certificate chain to serve HTTPS with, or null for plain HTTP */
+  tlsCertFile: string | null;
+
+  /** PEM private key for tlsCertFile, or null for plain HTTP */
+  tlsKeyFile: string | null;
+}
+
+/**
+ * @summary Settings used when neither flags nor environment give one.
+ *
+ * @description
+ * The read timeout matches Node's requestTimeout default and the write
+ * timeout its (disabled) socket timeout, so an unconfigured server
+ * behaves as it did before they could be set.
+ */
+export const DEFAULT_LISTEN_CONFIG: ListenConfig = {
+  host: '0.0.0.0',
+  port: 8080,
+  readTimeoutMs: 300000,
+  writeTimeoutMs: 0,
+  tlsCertFile: null,
+  tlsKeyFile: null
+};
+
+/** Node's default headersTimeout, the limit on a slow client sending headers */
+export const DEFAULT_HEADERS_TIMEOUT_MS = 60000;
+
+/**
+ * @summary Gets the time allowed to receive a request's headers.
+ *
+ * @description
+ * Node rejects a headersTimeout longer than a non-zero requestTimeout,
+ * so a read timeout under a minute shortens it; otherwise headers keep
+ * Node's default rather than the much longer whole-request limit.
+ *
+ * @param {ListenConfig} config - Listen settings
+ *
+ * @returns {number} Headers timeout in milliseconds
+ */
+export function getHeadersTimeoutMs(config: ListenConfig): number {
+  if (config.readTimeoutMs === 0) {
+    return DEFAULT_HEADERS_TIMEOUT_MS;
+  }
+  return Math.min(DEFAULT_HEADERS_TIMEOUT_MS, config.readTimeoutMs);
+}
+
+/**
+ * @summary Parses a host:port address.
+ *
+ * @description
+ * The host may be left out (":9000") to keep the default interface.
+ * IPv6 hosts are written in brackets, as in "[::1]:9000".
+ *
+ * @param {string} addr - Address such as "127.0.0.1:9000" or ":9000"
+ * @param {string} [defaultHost] - Host to use when the address has none
+ *
+ * @returns {{ host: string; port: number }} Host and port
+ *
+ * @throws {Error} If the address has no valid port
+ */
+export function parseListenAddress(
+  addr: string,
+  defaultHost: string = DEFAULT_LISTEN_CONFIG.host
+): { host: string; port: number } {
+  const separator = addr.lastIndexOf(':');
+  if (separator === -1) {
+    throw new Error(`Listen address "${addr}" must be host:port or :port`);
+  }
+
+  const host = addr.slice(0, separator).replace(/^\[(.*)\]$/, '$1');
+  return {
+    host: host || defaultHost,
+    port: parsePort(addr.slice(separator + 1), `listen address "${addr}"`)
+  };
+}
+
+/**
+ * @summary Works out the listen address and timeouts for this process.
+ *
+ * @param {readonly string[]} argv - Command-line arguments after the script name
+ * @param {Record<string, string | undefined>} env - Environment variables
+ *
+ * @returns {ListenConfig} Effective settings
+ *
+ * @throws {Error} If a flag or variable has an invalid value, or only
+ *   one of the TLS certificate and key is given
+ */
+export function parseListenConfig(
+  argv: readonly string[],
+  env: Record<string, string | undefined>
+): ListenConfig {
+  const flags = parseFlags(argv);
+  const host = env.HOST || DEFAULT_LISTEN_CONFIG.host;
+
+  let address = { host, port: DEFAULT_LISTEN_CONFIG.port };
+  const addr = flags.get('addr') ?? env.ADDR;
+  if (addr) {
+    address = parseListenAddress(addr, host);
+  } else if (env.PORT) {
+    address.port = parsePort(env.PORT, 'PORT');
+  }
+
+  const tlsCertFile = flags.get('tls-cert') || env.TLS_CERT_FILE || null;
+  const tlsKeyFile = flags.get('tls-key') || env.TLS_KEY_FILE || null;
+  if ((tlsCertFile === null) !== (tlsKeyFile === null)) {
+    throw new Error('TLS needs both a certificate (--tls-cert) and a key (--tls-key)');
+  }
+
+  return {
+    ...address,
+    readTimeoutMs: parseTimeout(
+      flags.get('read-timeout') ?? env.READ_TIMEOUT_MS,
+      DEFAULT_LISTEN_CONFIG.readTimeoutMs,
+      'read timeout'
+    ),
+    writeTimeoutMs: parseTimeout(
+      flags.get('write-timeout') ?? env.WRITE_TIMEOUT_MS,
+      DEFAULT_LISTEN_CONFIG.writeTimeoutMs,
+      'write timeout'
+    ),
+    tlsCertFile,
+    tlsKeyFile
+  };
+}
+
+/** Flags this module reads; any others are left to whoever else reads argv */
+const LISTEN_FLAGS = ['addr', 'read-timeout', 'write-timeout', 'tls-cert', 'tls-key'];
+
+/**
+ * @summary Collects the listen flags from the command line.
+ *
+ * @param {readonly string[]} argv - Command-line arguments
+ *
+ * @returns {Map<string, string>} Flag values by name
+ *
+ * @throws {Error} If a listen flag has no value
+ */
+function parseFlags(argv: readonly string[]): Map<string, string> {
+  const flags = new Map<string, string>();
+
+  for (let i = 0; i < argv.length; i++) {
+    const match = /^--?([a-z-]+)(?:=(.*))?$/.exec(argv[i]);
+    if (!match || !LISTEN_FLAGS.includes(match[1])) {
+      continue;
+    }
+
+    const value = match[2] ?? argv[++i];
+    if (value === undefined) {
+      throw new Error(`Flag --${match[1]} needs a value`);
+    }
+    flags.set(match[1], value);
+  }
+
+  return flags;
+}
+
+/**
+ * @summary Parses a TCP port number.
+ *
+ * @param {string} value - Port as written
+ * @param {string} source - Where the value came from, for the error message
+ *
+ * @returns {number} Port
+ *
+ * @throws {Error} If the value is not a port from 1 to 65535
+ */
+function parsePort(value: string, source: string): number {
+  const port = Number(value);
+  if (!/^\d+$/.test(value) || port < 1 || port > 65535) {
+    throw new Error(`Invalid port in ${source}: must be a whole number from 1 to 65535`);
+  }
+  return port;
+}
+
+/**
+ * @summary Parses a timeout in milliseconds.
+ *
+ * @param {string | undefined} value - Timeout as written, if set
+ * @param {number} fallback - Timeout to use when unset
+ * @param {string} name - Timeout name, for the error message
+ *
+ * @returns {number} Timeout in milliseconds
+ *
+ * @throws {Error} If the value is not a whole number
+ */
+function parseTimeout(value: string | undefined, fallback: number, name: string): number {
+  if (value === undefined || value === '') {
+    return fallback;
+  }
+
+  if (!/^\d+$/.test(value)) {
+    throw new Error(`Invalid ${name} "${value}": must be a whole number of milliseconds`);
+  }
+  return Number(value);
+}
@@ -0,0 +1,218 @@
+/**
+ * @fileoverview Prometheus metrics for game lifecycle events.
+ * @module server/Metrics
+ *
+ * @summary Counts games, players and night actions for production monitoring.
+ *
+ * @description
+ * The room manager reports lifecycle events through the GameMetrics
+ * interface. PrometheusMetrics keeps the counters and histograms in
+ * memory and renders them in the Prometheus text exposition format for
+ * GET /metrics; NULL_METRICS ignores everything, so tests and servers
+ * that don't want metrics pay nothing.
+ *
+ * Recording is a few additions per event. The text is only built when
+ * the endpoint is scraped.
+ *
+ * @example
+ * ```typescript
+ * const metrics = new PrometheusMetrics();
+ * const manager = new RoomManager({ metrics });
+ *
+ * const server = createServer(async (req, res) => {
+ *   if (handleMetricsRequest(req, res, metrics)) {
+ *     return;
+ *   }
+ *   await apiHandler.handleRequest(req, res);
+ * });
+ * ```
+ */
+
+import { IncomingMessage, ServerResponse } from 'http';
+
+/**
+ * @summary Metrics scrape path.
+ */
+export const METRICS_PATH = '/metrics';
+
+/**
+ * @summary Upper bounds of the night action duration buckets, in seconds.
+ *
+ * @description
+ * AI players answer in milliseconds; humans take up to the action timeout.
+ */
+export const NIGHT_ACTION_BUCKETS: readonly number[] = [0.01, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 90];
+
+/**
+ * @summary Receives game lifecycle events worth monitoring.
+ */
+export interface GameMetrics {
+  /** A room (and its game) was created */
+  gameCreated(): void;
+
+  /** A game reached its result */
+  gameCompleted(): void;
+
+  /** A player joined a room */
+  playerJoined(): void;
+
+  /** A night action finished after the given time */
+  nightActionCompleted(role: string, durationMs: number): void;
+
+  /** Sending a broadcast to one player failed */
+  broadcastError(): void;
+}
+
+/**
+ * @summary Metrics sink that records nothing.
+ */
+export const NULL_METRICS: GameMetrics = {
+  gameCreated: () => {},
+  gameCompleted: () => {},
+  playerJoined: () => {},
+  nightActionCompleted: () => {},
+  broadcastError: () => {}
+};
+
+/**
+ * @summary Observations of one histogram label set.
+ */
+interface HistogramSeries {
+  /** Cumulative count per bucket, in NIGHT_ACTION_BUCKETS order */
+  buckets: number[];
+
+  /** Sum of all observed values, in seconds */
+  sum: number;
+
+  /** Number of observations */
+  count: number;
+}
+
+/**
+ * @summary In-memory Prometheus collectors for the game server.
+ *
+ * @description
+ * Counters only go up for the life of the process, as Prometheus
+ * expects; it works out rates from successive scrapes.
+ *
+ * @implements {GameMetrics}
+ */
+export class PrometheusMetrics implements GameMetrics {
+  private gamesCreated = 0;
+  private gamesCompleted = 0;
+  private playersJoined = 0;
+  private broadcastErrors = 0;
+
+  /** Night action durations by role */
+  private readonly nightActions: Map<string, HistogramSeries> = new Map();
+
+  gameCreated(): void {
+    this.gamesCreated++;
+  }
+
+  gameCompleted(): void {
+    this.gamesCompleted++;
+  }
+
+  playerJoined(): void {
+    this.playersJoined++;
+  }
+
+  nightActionCompleted(role: string, durationMs: number): void {
+    let series = this.nightActions.get(role);
+    if (!series) {
+      series = { buckets: NIGHT_ACTION_BUCKETS.map(() => 0), sum: 0, count: 0 };
+      this.nightActions.set(role, series);
+    }
+
+    const seconds = Math.max(0, durationMs) / 1000;
+    NIGHT_ACTION_BUCKETS.forEach((bound, i) => {
+      if (seconds <= bound) {
+        series!.buckets[i]++;
+      }
+    });
+    series.sum += seconds;
+    series.count++;
+  }
+
+  broadcastError(): void {
+    this.broadcastErrors++;
+  }
+
+  /**
+   * @summary Renders every collector in the Prometheus text format.
+   *
+   * @returns {string} Exposition text, ending in a newline
+   */
+  render(): string {
+    const lines = [
+      ...counter('onuw_games_created_total', 'Rooms created', this.gamesCreated),
+      ...counter('onuw_games_completed_total', 'Games played to a result', this.gamesCompleted),
+      ...counter('onuw_players_joined_total', 'Players who joined a room', this.playersJoined),
+      ...counter('onuw_broadcast_errors_total', 'Messages that failed to send to a player', this.broadcastErrors),
+      '# HELP onuw_night_action_duration_seconds Time from waking a player to their night action finishing',
+      '# TYPE onuw_night_action_duration_seconds histogram'
+    ];
+
+    for (const [role, series] of [...this.nightActions].sort(([a], [b]) => a.localeCompare(b))) {
+      const label = `role="${escapeLabel(role)}"`;
+      NIGHT_ACTION_BUCKETS.forEach((bound, i) => {
+        lines.push(`onuw_night_action_duration_seconds_bucket{${label},le="${bound}"} ${series.buckets[i]}`);
+      });
+      lines.push(`onuw_night_action_duration_seconds_bucket{${label},le="+Inf"} ${series.count}`);
+      lines.push(`onuw_night_action_duration_seconds_sum{${label}} ${series.sum}`);
+      lines.push(`onuw_night_action_duration_seconds_count{${label}} ${series.count}`);
+    }
+
+    return `${lines.join('\n')}\n`;
+  }
+}
+
+/**
+ * @summary Renders a counter with its HELP and TYPE lines.
+ *
+ * @private
+ */
+function counter(name: string, help: string, value: number): string[] {
+  return [`# HELP ${name} ${help}`, `# TYPE ${name} counter`, `${name} ${value}`];
+}
+
+/**
+ * @summary Escapes a label value for the text format.
+ *
+ * @private
+ */
+function escapeLabel(value: string): string {
+  return value.replace(/\\/g, '\\\\').replace(/"/g, '\\"').replace(/\n/g, '\\n');
+}
+
+/**
+ * @summary Answers a metrics scrape.
+ *
+ * @description
+ * Returns false for any other path so the caller can route the request
+ * as usual. Like the health probes, scrapes skip CORS and the access log.
+ *
+ * @param {IncomingMessage} req - HTTP request
+ * @param {ServerResponse} res - HTTP response
+ * @param {PrometheusMetrics} metrics - Collectors to render
+ *
+ * @returns {boolean} True if the request was a metrics scrape
+ */
+export function handleMetricsRequest(
+  req: IncomingMessage,
+  res: ServerResponse,
+  metrics: PrometheusMetrics
+): boolean {
+  const path = (req.url ?? '/').split('?')[0];
+  if (req.method !== 'GET' || path !== METRICS_PATH) {
+    return false;
+  }
+
+  res.writeHead(200, {
+    'Content-Type': 'text/plain; version=0.0.4; charset=utf-8',
+    'Cache-Control': 'no-store'
+  });
+  res.end(metrics.render());
+  return true;
+}
@@ -35,9 +35,37 @@
  */
 
 import { IAgent } from '../agents/Agent';
+import { GamePhase } from '../enums';
 import { IClientConnection } from '../network/IClientConnection';
-import { ServerMessage, ClientMessage, RequestId } from '../network/protocol';
-import { NightActionContext, DayContext, VotingContext } from '../types';
+import {
+  ServerMessage,
+  ClientMessage,
+  RequestId,
+  ErrorCodes,
+  ActionAcknowledgedMessage,
+  createMessage,
+  createErrorMessage
+} from '../network/protocol';
+import {
+  NightActionContext,
+  NightActionResult,
+  DayContext,
+  VotingContext,
+  RotationChoice,
+  SKIP_NIGHT_ACTION
+} from '../types';
+import { NightActionSkippedError } from '../patterns/strategy';
+import { TimeoutError } from '../players/IPlayer';
+import { validateResponseShape } from '../patterns/command';
+
+/**
+ * @summary How many expired or answered requests an agent remembers.
+ *
+ * @description
+ * A player gets a handful of requests per night, so this covers any
+ * late or repeated answer while keeping a long-lived agent's memory flat.
+ */
+export const MAX_REMEMBERED_REQUESTS = 32;
 
 /**
  * @summary Network proxy agent for human players.
@@ -96,6 +124,17 @@ export class NetworkAgent implements IAgent {
    */
   private disableTimeouts: boolean = false;
 
+  /**
+   * @summary Reports the game's current phase, if the agent is in a game.
+   *
+   * @description
+   * Used to refuse night action answers once the night is over; without
+   * it (e.g. an agent used on its own) answers are not phase-checked.
+   *
+   * @private
+   */
+  private readonly getPhase: (() => GamePhase | null) | null;
+
   /**
    * @summary WebSocket connection to the remote player.
    * @private
@@ -106,16 +145,64 @@ export class NetworkAgent implements IAgent {
    * @summary Map of pending requests awaiting responses.
    *
    * @description
-   * Each entry maps a request ID to its resolve/reject handlers.
-   * Entries are removed when responses arrive or timeouts occur.
+   * Each entry maps a request ID to its action type, the request message
+   * (kept so it can be re-sent after a reconnect), its time limit, the
+   * time its window closes, and resolve/reject handlers. Entries are removed when
+   * responses arrive or timeouts occur.
    *
    * @private
    */
   private pendingRequests: Map<RequestId, {
+    actionType: string;
+    message: ServerMessage;
+    timeoutMs: number;
+    closesAt: number | null;
+    canSkip: boolean;
     resolve: (value: unknown) => void;
     reject: (error: Error) => void;
   }> = new Map();
 
+  /**
+   * @summary Requests whose window closed before the player answered.
+   *
+   * @description
+   * Kept so a late answer gets a clear "your turn has ended" error
+   * instead of being dropped silently. Only the most recent
+   * MAX_REMEMBERED_REQUESTS are kept.
+   *
+   * @private
+   */
+  private readonly expiredRequests: Set<RequestId> = new Set();
+
+  /**
+   * @summary Requests the player has already answered, with the answer given.
+   *
+   * @description
+   * Kept so a second, different answer to the same request is refused
+   * instead of being dropped silently, while a retry of the same answer
+   * is acknowledged. Only the most recent MAX_REMEMBERED_REQUESTS are kept.
+   *
+   * @private
+   */
+  private readonly answeredRequests: Map<RequestId, string> = new Map();
+
+  /**
+   * @summary Request types asked for during night actions.
+   *
+   * @description
+   * Only these may be answered with SKIP_NIGHT_ACTION.
+   *
+   * @private
+   */
+  private static readonly NIGHT_ACTION_TYPES: ReadonlySet<string> = new Set([
+    'selectPlayer',
+    'selectCenter',
+    'selectTwoCenter',
+    'seerChoice',
+    'rotationChoice',
+    'selectTwoPlayers'
+  ]);
+
   /**
    * @summary Counter for generating unique request IDs.
    * @private
@@ -134,6 +221,7 @@ export class NetworkAgent implements IAgent {
    * @param {string} id - Unique identifier for this player (game player ID)
    * @param {IClientConnection} connection - WebSocket connection to the client
    * @param {boolean} [disableTimeouts=false] - Whether to disable action timeouts (debug mode)
+   * @param {() => GamePhase | null} [getPhase] - Current phase of the player's game
    *
    * @example
    * ```typescript
@@ -142,19 +230,64 @@ export class NetworkAgent implements IAgent {
    * const debugAgent = new NetworkAgent('player-1', connection, true);
    * ```
    */
-  constructor(id: string, connection: IClientConnection, disableTimeouts: boolean = false) {
+  constructor(
+    id: string,
+    connection: IClientConnection,
+    disableTimeouts: boolean = false,
+    getPhase?: () => GamePhase | null
+  ) {
     this.id = id;
     this.connection = connection;
     this.disableTimeouts = disableTimeouts;
+    this.getPhase = getPhase ?? null;
     this.setupMessageHandler();
   }
 
+  /**
+   * @summary Moves the agent onto a new connection.
+   *
+   * @description
+   * Called when the player reconnects on a new socket. Listening switches
+   * to the new connection and any unanswered action requests are sent
+   * again so the player can still answer them.
+   *
+   * @param {IClientConnection} connection - Player's new connection
+   */
+  setConnection(connection: IClientConnection): void {
+    if (this.unsubscribe) {
+      this.unsubscribe();
+    }
+
+    this.connection = connection;
+    this.setupMessageHandler();
+
+    for (const pending of this.pendingRequests.values()) {
+      this.connection.send(pending.message);
+    }
+  }
+
   /**
    * @summary Sets up the message handler for incoming responses.
    *
    * @description
    * Listens for `actionResponse` messages from the client and
-   * resolves the corresponding pending request.
+   * resolves the corresponding pending request. Responses whose shape
+   * does not match the requested action are rejected with an error
+   * message and the request stays pending so the client can retry.
+   * A SKIP answer to a night request passes on the action if the
+   * request allowed it, and is refused the same way if not. A second
+   * answer to a request that was already answered is refused too, unless
+   * it repeats the first: flaky networks make clients retry, so the same
+   * answer again is acknowledged without being processed twice.
+   *
+   * An answer to a night action request is refused with INVALID_PHASE
+   * once the game has left the Night phase, so a stale or replayed
+   * response during the day or the vote can never move a card.
+   *
+   * Responses are checked against the time the request's window closed,
+   * not just whether its timer has fired yet: an answer that arrives
+   * late (or is queued behind a busy event loop) is refused with an
+   * ACTION_TIMEOUT error and the request times out as usual.
    *
    * @private
    */
@@ -162,14 +295,140 @@ export class NetworkAgent implements IAgent {
     this.unsubscribe = this.connection.onMessage((msg: ClientMessage) => {
       if (msg.type === 'actionResponse') {
         const pending = this.pendingRequests.get(msg.requestId);
+        if (pending && pending.closesAt !== null && Date.now() > pending.closesAt) {
+          this.expireRequest(msg.requestId);
+        }
+
+        if (this.expiredRequests.has(msg.requestId)) {
+          this.connection.send(createErrorMessage(
+            ErrorCodes.ACTION_TIMEOUT,
+            'Your turn has ended',
+            { requestId: msg.requestId }
+          ));
+          return;
+        }
+
+        const answered = this.answeredRequests.get(msg.requestId);
+        if (answered !== undefined) {
+          if (answered === JSON.stringify(msg.response)) {
+            this.connection.send(createMessage<ActionAcknowledgedMessage>({
+              type: 'actionAcknowledged',
+              requestId: msg.requestId
+            }));
+            return;
+          }
+          this.connection.send(createErrorMessage(
+            ErrorCodes.INVALID_ACTION,
+            'You have already answered this request',
+            { requestId: msg.requestId }
+          ));
+          return;
+        }
+
+        if (pending && NetworkAgent.NIGHT_ACTION_TYPES.has(pending.actionType) && this.isOutsideNight()) {
+          this.connection.send(createErrorMessage(
+            ErrorCodes.INVALID_PHASE,
+            'Night actions can only be taken during the Night phase',
+            { requestId: msg.requestId }
+          ));
+          return;
+        }
+
+        if (pending && msg.response === SKIP_NIGHT_ACTION && NetworkAgent.NIGHT_ACTION_TYPES.has(pending.actionType)) {
+          if (!pending.canSkip) {
+            this.connection.send(createErrorMessage(
+              ErrorCodes.INVALID_ACTION,
+              'This night action cannot be skipped',
+              { requestId: msg.requestId }
+            ));
+            return;
+          }
+
+          this.pendingRequests.delete(msg.requestId);
+          this.rememberAnswer(msg.requestId, msg.response);
+          pending.reject(new NightActionSkippedError(this.id));
+          return;
+        }
+
         if (pending) {
+          const check = validateResponseShape(pending.actionType, msg.response);
+          if (!check.valid) {
+            this.connection.send(createErrorMessage(
+              ErrorCodes.INVALID_TARGET,
+              check.error ?? 'Malformed action response',
+              { requestId: msg.requestId }
+            ));
+            return;
+          }
+
           this.pendingRequests.delete(msg.requestId);
+          this.rememberAnswer(msg.requestId, msg.response);
           pending.resolve(msg.response);
         }
       }
     });
   }
 
+  /**
+   * @summary Whether the player's game is known to be past the Night phase.
+   *
+   * @returns {boolean} True if night actions must be refused
+   *
+   * @private
+   */
+  private isOutsideNight(): boolean {
+    const phase = this.getPhase?.() ?? null;
+    return phase !== null && phase !== GamePhase.NIGHT;
+  }
+
+  /**
+   * @summary Times out a pending request.
+   *
+   * @param {RequestId} requestId - Request whose window has closed
+   *
+   * @private
+   */
+  private expireRequest(requestId: RequestId): void {
+    const pending = this.pendingRequests.get(requestId);
+    if (!pending) {
+      return;
+    }
+
+    this.pendingRequests.delete(requestId);
+    this.expiredRequests.add(requestId);
+    NetworkAgent.trimOldest(this.expiredRequests);
+    pending.reject(new TimeoutError(this.id, pending.actionType, pending.timeoutMs));
+  }
+
+  /**
+   * @summary Records the answer given to a request.
+   *
+   * @param {RequestId} requestId - Answered request
+   * @param {unknown} response - Answer given
+   *
+   * @private
+   */
+  private rememberAnswer(requestId: RequestId, response: unknown): void {
+    this.answeredRequests.set(requestId, JSON.stringify(response));
+    NetworkAgent.trimOldest(this.answeredRequests);
+  }
+
+  /**
+   * @summary Drops the oldest entries past MAX_REMEMBERED_REQUESTS.
+   *
+   * @param {Set<RequestId> | Map<RequestId, string>} requests - Requests in insertion order
+   *
+   * @private
+   */
+  private static trimOldest(requests: Set<RequestId> | Map<RequestId, string>): void {
+    for (const requestId of requests.keys()) {
+      if (requests.size <= MAX_REMEMBERED_REQUESTS) {
+        return;
+      }
+      requests.delete(requestId);
+    }
+  }
+
   /**
    * @summary Generates a unique request ID.
    *
@@ -223,28 +482,17 @@ export class NetworkAgent implements IAgent {
     return new Promise((resolve, reject) => {
       // Only set timeout if timeouts are not disabled
       let timeout: ReturnType<typeof setTimeout> | null = null;
+      const closesAt = this.disableTimeouts ? null : Date.now() + timeoutMs;
       if (!this.disableTimeouts) {
         console.log(`[NetworkAgent ${this.id}] Setting ${timeoutMs}ms timeout for ${actionType}`);
         timeout = setTimeout(() => {
           console.log(`[NetworkAgent ${this.id}] TIMEOUT FIRED for ${actionType} - this should not happen if timers are disabled!`);
-          this.pendingRequests.delete(requestId);
-          reject(new Error(`Request ${actionType} timed out`));
+          this.expireRequest(requestId);
         }, timeoutMs);
       } else {
         console.log(`[NetworkAgent ${this.id}] Timeouts DISABLED - no timeout set for ${actionType}`);
       }
 
-      this.pendingRequests.set(requestId, {
-        resolve: (value) => {
-          if (timeout) clearTimeout(timeout);
-          resolve(value as T);
-        },
-        reject: (error) => {
-          if (timeout) clearTimeout(timeout);
-          reject(error);
-        }
-      });
-
       const message: ServerMessage = {
         type: 'actionRequired',
         request: {
@@ -257,6 +505,22 @@ export class NetworkAgent implements IAgent {
         timestamp: Date.now()
       } as ServerMessage;
 
+      this.pendingRequests.set(requestId, {
+        actionType,
+        message,
+        timeoutMs,
+        closesAt,
+        canSkip: additionalFields.canSkip === true,
+        resolve: (value) => {
+          if (timeout) clearTimeout(timeout);
+          resolve(value as T);
+        },
+        reject: (error) => {
+          if (timeout) clearTimeout(timeout);
+          reject(error);
+        }
+      });
+
       this.connection.send(message);
     });
   }
@@ -286,6 +550,7 @@ export class NetworkAgent implements IAgent {
   async selectPlayer(options: string[], context: NightActionContext): Promise<string> {
     return this.sendRequest('selectPlayer', {
       options,
+      canSkip: context.canSkip,
       reason: 'Select a player'
     });
   }
@@ -295,17 +560,19 @@ export class NetworkAgent implements IAgent {
    *
    * @description
    * Used by Drunk (to swap with) and Werewolf (lone wolf to peek).
-   * Client shows 3 center card positions (0, 1, 2).
+   * Client shows one position per center card, as set by the room's
+   * centerCardCount.
    *
-   * @param {NightActionContext} context - Night action context (unused)
+   * @param {NightActionContext} context - Night action context
    *
-   * @returns {Promise<number>} Selected center card index (0, 1, or 2)
+   * @returns {Promise<number>} Selected center card index
    *
    * @throws {Error} If request times out
    */
   async selectCenterCard(context: NightActionContext): Promise<number> {
     return this.sendRequest('selectCenter', {
       count: 1,
+      canSkip: context.canSkip,
       reason: 'Select a center card'
     });
   }
@@ -325,6 +592,7 @@ export class NetworkAgent implements IAgent {
   async selectTwoCenterCards(context: NightActionContext): Promise<[number, number]> {
     return this.sendRequest('selectTwoCenter', {
       count: 2,
+      canSkip: context.canSkip,
       reason: 'Select two center cards'
     });
   }
@@ -344,10 +612,31 @@ export class NetworkAgent implements IAgent {
   async chooseSeerOption(context: NightActionContext): Promise<'player' | 'center'> {
     return this.sendRequest('seerChoice', {
       options: ['player', 'center'],
+      canSkip: context.canSkip,
       reason: 'Choose to view a player or two center cards'
     });
   }
 
+  /**
+   * @summary Asks the Village Idiot which way to move every player's card.
+   *
+   * @description
+   * The player may also answer 'NONE' to leave the cards where they are.
+   *
+   * @param {NightActionContext} context - Night action context (unused)
+   *
+   * @returns {Promise<RotationChoice>} 'LEFT', 'RIGHT' or 'NONE'
+   *
+   * @throws {Error} If request times out
+   */
+  async chooseRotation(context: NightActionContext): Promise<RotationChoice> {
+    return this.sendRequest('rotationChoice', {
+      options: ['LEFT', 'RIGHT', 'NONE'],
+      canSkip: context.canSkip,
+      reason: 'Move every player\'s card left or right, or leave them'
+    });
+  }
+
   /**
    * @summary Asks the player to select two other players.
    *
@@ -365,6 +654,7 @@ export class NetworkAgent implements IAgent {
   async selectTwoPlayers(options: string[], context: NightActionContext): Promise<[string, string]> {
     return this.sendRequest('selectTwoPlayers', {
       options,
+      canSkip: context.canSkip,
       reason: 'Select two players'
     });
   }
@@ -403,7 +693,9 @@ export class NetworkAgent implements IAgent {
    *
    * @description
    * Final phase where all players simultaneously vote.
-   * Player(s) with most votes are eliminated.
+   * Player(s) with most votes are eliminated. The request times out
+   * with the game's voting deadline (context.timeLimitMs), so the
+   * countdown the client shows matches it.
    *
    * @param {VotingContext} context - Voting context with eligible targets
    *
@@ -414,8 +706,9 @@ export class NetworkAgent implements IAgent {
   async vote(context: VotingContext): Promise<string> {
     return this.sendRequest('vote', {
       eligibleTargets: context.eligibleTargets,
+      canAbstain: context.canAbstain,
       reason: 'Vote for who to eliminate'
-    });
+    }, context.timeLimitMs);
   }
 
   /**
@@ -425,29 +718,33 @@ export class NetworkAgent implements IAgent {
    * After a player's night action completes, they receive information
    * about what they learned (e.g., Seer sees a role, Robber sees new role).
    *
-   * @param {unknown} info - Night action result information
+   * @param {NightActionResult} info - Night action result information
    *
    * @remarks
    * This is a one-way notification, not a request/response.
-   * The player doesn't need to respond to this message.
+   * The player doesn't need to respond to this message. It goes to this
+   * agent's own connection only, so no other player or spectator sees
+   * what was learned.
    *
    * @example
    * ```typescript
    * // Seer learns player-2 is Werewolf
    * agent.receiveNightInfo({
-   *   action: 'view',
-   *   target: 'player-2',
-   *   role: 'WEREWOLF'
+   *   actorId: 'player-1',
+   *   roleName: RoleName.SEER,
+   *   actionType: 'VIEW',
+   *   success: true,
+   *   info: { viewed: [{ playerId: 'player-2', role: RoleName.WEREWOLF }] }
    * });
    * ```
    */
-  receiveNightInfo(info: unknown): void {
+  receiveNightInfo(info: NightActionResult): void {
     // Send night info to client
     const message: ServerMessage = {
       type: 'nightResult',
-      result: info as ServerMessage['result' extends keyof ServerMessage ? 'result' : never],
+      result: info,
       timestamp: Date.now()
-    } as ServerMessage;
+    };
     this.connection.send(message);
   }
 
@@ -483,5 +780,7 @@ export class NetworkAgent implements IAgent {
       pending.reject(new Error('Agent disposed'));
     }
     this.pendingRequests.clear();
+    this.expiredRequests.clear();
+    this.answeredRequests.clear();
   }
 }
@@ -0,0 +1,259 @@
+/**
+ * @fileoverview Post-game log of every night action.
+ * @module server/NightActionLog
+ *
+ * @summary Turns a finished game's night results into a readable log.
+ *
+ * @description
+ * Night results stay private while the game runs: each player only ever
+ * sees their own. Once the game is over nothing is secret any more, so
+ * the reveal includes every action in the order it happened, with the
+ * cards each role saw and swapped.
+ *
+ * @example
+ * ```typescript
+ * const log = buildNightActionLog(game.getAllNightResults(), players);
+ * // [{ playerName: 'Alice', roleName: SEER, description: "Viewed Bob's card: WEREWOLF" }, ...]
+ * ```
+ */
+
+import { RoleName, NIGHT_WAKE_ORDER } from '../enums';
+import { NightActionInfo, NightActionResult } from '../types';
+import { NightActionSummary, PlayerId } from '../network/protocol';
+
+/**
+ * @summary How a game player appears in the log.
+ */
+export interface NightLogPlayer {
+  /** Room player ID */
+  readonly id: PlayerId;
+
+  /** Display name */
+  readonly name: string;
+}
+
+/**
+ * @summary Builds the chronological night action log.
+ *
+ * @description
+ * Results are ordered by when their role woke; players sharing a role
+ * keep their seating order. A Doppelganger acts at the start of the
+ * night, except for a Doppel-Insomniac's second look, which comes last.
+ *
+ * @param {readonly NightActionResult[]} results - All night results, grouped by player in seating order
+ * @param {ReadonlyMap<string, NightLogPlayer>} players - Room ID and name for each game player ID
+ * @param {readonly RoleName[]} [wakeOrder=NIGHT_WAKE_ORDER] - Order the game's roles woke in
+ *
+ * @returns {NightActionSummary[]} One entry per action, in the order they happened
+ */
+export function buildNightActionLog(
+  results: readonly NightActionResult[],
+  players: ReadonlyMap<string, NightLogPlayer>,
+  wakeOrder: readonly RoleName[] = NIGHT_WAKE_ORDER
+): NightActionSummary[] {
+  const nameOf = (gamePlayerId: string): string =>
+    players.get(gamePlayerId)?.name ?? gamePlayerId;
+
+  // Count each actor's results so a Doppelganger's second wake is known
+  const seen = new Map<string, number>();
+  const ordered = results.map((result, index) => {
+    const count = seen.get(result.actorId) ?? 0;
+    seen.set(result.actorId, count + 1);
+
+    const wakesLast = result.roleName === RoleName.DOPPELGANGER && count > 0;
+    return { result, index, order: wakesLast ? Infinity : wakeOrder.indexOf(result.roleName) };
+  });
+
+  ordered.sort((a, b) => a.order - b.order || a.index - b.index);
+
+  return ordered.map(({ result }) => ({
+    playerId: players.get(result.actorId)?.id ?? result.actorId,
+    playerName: nameOf(result.actorId),
+    roleName: result.roleName,
+    description: describeNightAction(result, nameOf),
+    ...(result.timestamp !== undefined && { timestamp: result.timestamp })
+  }));
+}
+
+/**
+ * @summary Describes one night action in plain words.
+ *
+ * @param {NightActionResult} result - Night action result
+ * @param {(gamePlayerId: string) => string} nameOf - Display name for a game player ID
+ *
+ * @returns {string} Human-readable description
+ */
+export function describeNightAction(
+  result: NightActionResult,
+  nameOf: (gamePlayerId: string) => string
+): string {
+  if (!result.success) {
+    return result.error ? `Action failed: ${result.error}` : 'Action failed';
+  }
+
+  const info = result.info;
+
+  if (info.skipped) {
+    return 'Chose not to act';
+  }
+
+  if (result.roleName === RoleName.DOPPELGANGER) {
+    if (!info.copied) {
+      // Second wake at the end of the night as Doppel-Insomniac
+      const finalCard = info.viewed?.[0];
+      return finalCard
+        ? `Woke as Doppel-Insomniac and saw final card: ${finalCard.role}`
+        : 'Woke as Doppel-Insomniac';
+    }
+
+    const copy = `Looked at ${nameOf(info.copied.fromPlayerId)}'s card and became ${info.copied.role}`;
+    const copiedAction = describeRoleAction(info.copied.role, info, nameOf);
+    return copiedAction
+      ? `${copy}. Then ${copiedAction.charAt(0).toLowerCase()}${copiedAction.slice(1)}`
+      : copy;
+  }
+
+  return describeRoleAction(result.roleName, info, nameOf) ?? 'No action';
+}
+
+/**
+ * @summary Describes what a role did with the information it got.
+ *
+ * @param {RoleName} role - Role whose action to describe
+ * @param {NightActionInfo} info - Information recorded for the action
+ * @param {(gamePlayerId: string) => string} nameOf - Display name for a game player ID
+ *
+ * @returns {string | null} Description, or null for roles that do nothing at night
+ *
+ * @private
+ */
+function describeRoleAction(
+  role: RoleName,
+  info: NightActionInfo,
+  nameOf: (gamePlayerId: string) => string
+): string | null {
+  const names = (ids: readonly string[]): string => ids.map(nameOf).join(', ');
+  const centerCard = (index: number | undefined): number => (index ?? 0) + 1;
+
+  switch (role) {
+    case RoleName.SENTINEL:
+      return info.shielded !== undefined
+        ? `Shielded ${nameOf(info.shielded)}'s card`
+        : 'Shielded a card';
+
+    case RoleName.WEREWOLF: {
+      if (info.werewolves && info.werewolves.length > 0) {
+        return `Saw fellow Werewolf(s): ${names(info.werewolves)}`;
+      }
+      const peek = info.viewed?.find(v => v.centerIndex !== undefined);
+      if (peek) {
+        return `Lone wolf - peeked at center card ${centerCard(peek.centerIndex)}: ${peek.role}`;
+      }
+      return 'Woke up (no other Werewolves)';
+    }
+
+    case RoleName.ALPHA_WOLF: {
+      const pack = info.werewolves && info.werewolves.length > 0
+        ? `Saw fellow Werewolf(s): ${names(info.werewolves)}`
+        : 'No other Werewolves';
+      return info.turned !== undefined
+        ? `${pack}; gave the center Werewolf card to ${nameOf(info.turned)}`
+        : pack;
+    }
+
+    case RoleName.MYSTIC_WOLF: {
+      const pack = info.werewolves && info.werewolves.length > 0
+        ? `Saw fellow Werewolf(s): ${names(info.werewolves)}`
+        : 'No other Werewolves';
+      const viewed = info.viewed?.[0];
+      return viewed?.playerId !== undefined
+        ? `${pack}; viewed ${nameOf(viewed.playerId)}'s card: ${viewed.role}`
+        : pack;
+    }
+
+    case RoleName.MINION:
+    case RoleName.SQUIRE:
+      return info.werewolves && info.werewolves.length > 0
+        ? `Saw Werewolf(s): ${names(info.werewolves)}`
+        : 'No Werewolves among players';
+
+    case RoleName.MASON:
+      return info.masons && info.masons.length > 0
+        ? `Saw fellow Mason(s): ${names(info.masons)}`
+        : 'No other Masons';
+
+    case RoleName.BEHOLDER:
+      return info.seers && info.seers.length > 0
+        ? `Saw the Seer: ${names(info.seers)}`
+        : 'No Seer among players';
+
+    case RoleName.SEER: {
+      const viewed = info.viewed ?? [];
+      if (viewed.length === 0) {
+        return 'Viewed cards';
+      }
+      if (viewed[0].playerId !== undefined) {
+        return `Viewed ${nameOf(viewed[0].playerId)}'s card: ${viewed[0].role}`;
+      }
+      return `Viewed center cards: ${viewed.map(v => `Card ${centerCard(v.centerIndex)} = ${v.role}`).join(', ')}`;
+    }
+
+    case RoleName.APPRENTICE_SEER: {
+      const viewed = info.viewed?.[0];
+      return viewed
+        ? `Viewed center card ${centerCard(viewed.centerIndex)}: ${viewed.role}`
+        : 'Viewed a center card';
+    }
+
+    case RoleName.ROBBER: {
+      const target = info.swapped?.to.playerId;
+      const newRole = info.viewed?.[0]?.role;
+      return target !== undefined && newRole
+        ? `Robbed ${nameOf(target)} and became ${newRole}`
+        : 'Robbed a player';
+    }
+
+    case RoleName.TROUBLEMAKER: {
+      const first = info.swapped?.from.playerId;
+      const second = info.swapped?.to.playerId;
+      return first !== undefined && second !== undefined
+        ? `Swapped ${nameOf(first)} and ${nameOf(second)}'s cards`
+        : 'Swapped two players\' cards';
+    }
+
+    case RoleName.VILLAGE_IDIOT:
+      return info.rotated
+        ? `Moved every player's card one seat ${info.rotated.direction.toLowerCase()}`
+        : 'Left the cards alone';
+
+    case RoleName.DRUNK: {
+      const index = info.swapped?.to.centerIndex;
+      return index !== undefined
+        ? `Swapped with center card ${centerCard(index)}`
+        : 'Swapped with a center card';
+    }
+
+    case RoleName.REVEALER: {
+      const viewed = info.viewed?.[0];
+      if (!viewed || viewed.playerId === undefined) {
+        return 'Flipped a player\'s card';
+      }
+      return info.revealed
+        ? `Revealed ${nameOf(viewed.playerId)}'s card: ${viewed.role}`
+        : `Flipped ${nameOf(viewed.playerId)}'s card and turned it back: ${viewed.role}`;
+    }
+
+    case RoleName.CURATOR:
+      return info.artifact !== undefined
+        ? `Placed an artifact on ${nameOf(info.artifact)}'s card`
+        : 'Placed an artifact';
+
+    case RoleName.INSOMNIAC: {
+      const finalCard = info.viewed?.[0];
+      return finalCard ? `Checked their card: ${finalCard.role}` : 'Checked their final card';
+    }
+
+    default:
+      return null;
+  }
+}
@@ -0,0 +1,99 @@
+/**
+ * @fileoverview Allowlist of browser origins that may use the server.
+ * @module server/OriginPolicy
+ *
+ * @summary Decides which origins get CORS headers and WebSocket upgrades.
+ *
+ * @description
+ * In development the frontend runs on a different port from the server,
+ * so any origin is accepted. A deployment sets ALLOWED_ORIGINS to a
+ * comma-separated list; from then on only those origins receive CORS
+ * headers, and WebSocket upgrades from any other origin are refused.
+ *
+ * Requests without an Origin header do not come from a web page (curl,
+ * server-side clients, health checks), so the allowlist does not apply
+ * to them.
+ *
+ * @example
+ * ```typescript
+ * const policy = new OriginPolicy(parseAllowedOrigins('https://game.example.com'));
+ * policy.isAllowed('https://game.example.com'); // true
+ * policy.isAllowed('https://evil.example.com'); // false
+ * ```
+ */
+
+/**
+ * @summary Parses a comma-separated origin list.
+ *
+ * @description
+ * Entries are trimmed and trailing slashes removed, so
+ * "https://a.example.com/, https://b.example.com" gives two origins.
+ *
+ * @param {string | undefined} value - Setting such as ALLOWED_ORIGINS
+ *
+ * @returns {string[]} Allowed origins (empty if unset)
+ */
+export function parseAllowedOrigins(value: string | undefined): string[] {
+  if (!value) {
+    return [];
+  }
+
+  return value
+    .split(',')
+    .map(origin => origin.trim().replace(/\/+$/, ''))
+    .filter(origin => origin !== '');
+}
+
+/**
+ * @summary Origin allowlist shared by the HTTP API and the WebSocket server.
+ */
+export class OriginPolicy {
+  /** Allowed origins; empty means any origin is allowed */
+  private readonly allowed: ReadonlySet<string>;
+
+  /**
+   * @summary Creates an origin policy.
+   *
+   * @param {readonly string[]} [allowedOrigins] - Allowed origins (empty allows any)
+   */
+  constructor(allowedOrigins: readonly string[] = []) {
+    this.allowed = new Set(allowedOrigins);
+  }
+
+  /**
+   * @summary Checks whether any origin is accepted.
+   *
+   * @returns {boolean} True if no allowlist is configured
+   */
+  isPermissive(): boolean {
+    return this.allowed.size === 0;
+  }
+
+  /**
+   * @summary Checks whether a request's origin may use the server.
+   *
+   * @param {string | undefined} origin - Origin header, if any
+   *
+   * @returns {boolean} True if the origin is allowed or absent
+   */
+  isAllowed(origin: string | undefined): boolean {
+    if (origin === undefined || this.isPermissive()) {
+      return true;
+    }
+    return this.allowed.has(origin);
+  }
+
+  /**
+   * @summary Gets the Access-Control-Allow-Origin value for a request.
+   *
+   * @param {string | undefined} origin - Origin header, if any
+   *
+   * @returns {string | null} Header value, or null to send no CORS headers
+   */
+  getAllowOriginHeader(origin: string | undefined): string | null {
+    if (this.isPermissive()) {
+      return '*';
+    }
+    return origin !== undefined && this.allowed.has(origin) ? origin : null;
+  }
+}
@@ -0,0 +1,148 @@
+/**
+ * @fileoverview Phase deadline tracking for timed game phases.
+ * @module server/PhaseClock
+ *
+ * @summary Keeps a room's phase deadline, pausing it while nobody is connected.
+ *
+ * @description
+ * Day discussion and voting run against a deadline that clients count
+ * down to. The deadline lives on the room, not on any connection, so a
+ * player who drops and comes back gets the same countdown everyone else
+ * sees rather than a fresh timer.
+ *
+ * If every human player disconnects, the clock pauses: the time left is
+ * frozen until someone reconnects, and the phase then continues from
+ * where it stopped. A game whose table walked away should not find its
+ * discussion already over when the first player returns.
+ *
+ * @example
+ * ```typescript
+ * const clock = new PhaseClock();
+ * clock.start(180000);           // 3 minute discussion
+ * clock.pause();                 // Everyone disconnected
+ * clock.resume();                // First player back, same time left
+ * clock.extend(60000);           // Table asked for another minute
+ * clock.getTimeRemaining();      // Seconds left
+ * ```
+ */
+
+/**
+ * @summary Deadline for the current phase, with pause/resume.
+ *
+ * @description
+ * While paused, getEndsAt() reports a deadline that moves with the
+ * clock (now + time left), so anything computing a countdown from it
+ * still gets the frozen remaining time.
+ */
+export class PhaseClock {
+  /** Absolute deadline (epoch ms) while running, or null if untimed */
+  private endsAt: number | null = null;
+
+  /** Time left when paused, or null while running */
+  private pausedRemainingMs: number | null = null;
+
+  /**
+   * @summary Starts timing a new phase.
+   *
+   * @param {number | null} durationMs - Phase length, or null for an untimed phase
+   */
+  start(durationMs: number | null): void {
+    this.endsAt = durationMs !== null ? Date.now() + durationMs : null;
+    this.pausedRemainingMs = null;
+  }
+
+  /**
+   * @summary Restores a deadline saved earlier.
+   *
+   * @description
+   * Used when a room is restored from disk. The phase resumes paused
+   * with the time that was left when the snapshot was taken, since no
+   * one is connected yet to use it.
+   *
+   * @param {number | null} endsAt - Saved deadline (epoch ms), or null if untimed
+   * @param {number} savedAt - When the deadline was saved (epoch ms)
+   */
+  restore(endsAt: number | null, savedAt: number): void {
+    this.endsAt = endsAt;
+    this.pausedRemainingMs = endsAt !== null ? Math.max(0, endsAt - savedAt) : null;
+  }
+
+  /**
+   * @summary Freezes the time left.
+   *
+   * @description
+   * Does nothing for untimed phases or if already paused.
+   */
+  pause(): void {
+    if (this.endsAt === null || this.pausedRemainingMs !== null) {
+      return;
+    }
+
+    this.pausedRemainingMs = Math.max(0, this.endsAt - Date.now());
+  }
+
+  /**
+   * @summary Continues the phase with the time that was left at pause.
+   */
+  resume(): void {
+    if (this.pausedRemainingMs === null) {
+      return;
+    }
+
+    this.endsAt = Date.now() + this.pausedRemainingMs;
+    this.pausedRemainingMs = null;
+  }
+
+  /**
+   * @summary Pushes the deadline out.
+   *
+   * @description
+   * Works while paused too, adding to the frozen time left. Does
+   * nothing for untimed phases.
+   *
+   * @param {number} extraMs - Time to add
+   */
+  extend(extraMs: number): void {
+    if (this.pausedRemainingMs !== null) {
+      this.pausedRemainingMs += extraMs;
+    } else if (this.endsAt !== null) {
+      this.endsAt += extraMs;
+    }
+  }
+
+  /**
+   * @summary Checks whether the clock is paused.
+   *
+   * @returns {boolean} True if paused
+   */
+  isPaused(): boolean {
+    return this.pausedRemainingMs !== null;
+  }
+
+  /**
+   * @summary Gets the absolute phase deadline.
+   *
+   * @returns {number | null} Deadline as epoch milliseconds, or null if untimed
+   */
+  getEndsAt(): number | null {
+    if (this.pausedRemainingMs !== null) {
+      return Date.now() + this.pausedRemainingMs;
+    }
+    return this.endsAt;
+  }
+
+  /**
+   * @summary Gets the time left in the phase.
+   *
+   * @returns {number | null} Remaining time in seconds, or null if untimed
+   */
+  getTimeRemaining(): number | null {
+    const endsAt = this.getEndsAt();
+    if (endsAt === null) {
+      return null;
+    }
+
+    const remaining = Math.max(0, endsAt - Date.now());
+    return Math.ceil(remaining / 1000);
+  }
+}
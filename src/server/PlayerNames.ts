@@ -0,0 +1,70 @@
+/**
+ * @fileoverview Validation of player-chosen display names.
+ * @module server/PlayerNames
+ *
+ * @summary Turns the name a client sends into one safe to show and log.
+ *
+ * @description
+ * A player's name is shown to every other player and written to log
+ * lines, so it is untrusted input:
+ * - Control characters (including line breaks) are rejected, so a name
+ *   cannot forge log lines or break the lobby layout
+ * - Leading and trailing whitespace is trimmed and internal runs of
+ *   whitespace collapse to one space
+ * - Empty names and names over the length limit are rejected
+ *
+ * @example
+ * ```typescript
+ * const result = normalizePlayerName(message.playerName);
+ * if (!result.valid) {
+ *   sendError(connection, ErrorCodes.INVALID_NAME, result.error);
+ *   return;
+ * }
+ * session.playerName = result.name;
+ * ```
+ */
+
+/**
+ * @summary Longest display name accepted, in characters.
+ */
+export const MAX_PLAYER_NAME_LENGTH = 24;
+
+/**
+ * @summary Result of normalizing a player name.
+ */
+export type PlayerNameResult =
+  | { valid: true; name: string }
+  | { valid: false; error: string };
+
+/**
+ * @summary Validates and normalizes a player's display name.
+ *
+ * @param {unknown} raw - Name as sent by the client
+ * @param {number} [maxLength=MAX_PLAYER_NAME_LENGTH] - Longest name accepted
+ *
+ * @returns {PlayerNameResult} The normalized name, or why it was rejected
+ */
+export function normalizePlayerName(
+  raw: unknown,
+  maxLength: number = MAX_PLAYER_NAME_LENGTH
+): PlayerNameResult {
+  if (typeof raw !== 'string') {
+    return { valid: false, error: 'Player name must be a string.' };
+  }
+
+  if (/[\u0000-\u001f\u007f-\u009f\u2028\u2029]/.test(raw)) {
+    return { valid: false, error: 'Player name cannot contain control characters.' };
+  }
+
+  const name = raw.trim().replace(/\s+/g, ' ');
+
+  if (name.length === 0) {
+    return { valid: false, error: 'Player name cannot be empty.' };
+  }
+
+  if ([...name].length > maxLength) {
+    return { valid: false, error: `Player name must be at most ${maxLength} characters.` };
+  }
+
+  return { valid: true, name };
+}
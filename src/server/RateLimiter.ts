@@ -0,0 +1,206 @@
+/**
+ * @fileoverview Token-bucket rate limiting per client IP.
+ * @module server/RateLimiter
+ *
+ * @summary Throttles room creation and joining from a single address.
+ *
+ * @description
+ * Every room lives in memory until it times out, so a script creating
+ * or joining rooms in a loop can exhaust the server. Each IP gets a
+ * bucket of tokens:
+ * - A bucket starts full, holding `burst` tokens
+ * - Each create or join takes one token; with none left it is refused
+ * - Tokens come back at `ratePerMinute`, up to `burst`
+ *
+ * A full bucket says nothing a new one wouldn't, so buckets that have
+ * refilled are dropped by a periodic sweep.
+ *
+ * @example
+ * ```typescript
+ * const limiter = new TokenBucketRateLimiter({ ratePerMinute: 10, burst: 5 });
+ * limiter.startCleanup();
+ * if (!limiter.tryConsume(ip)) {
+ *   // Refuse with RATE_LIMITED; retry after limiter.retryAfterMs(ip)
+ * }
+ * ```
+ */
+
+// =============================================================================
+// TYPES
+// =============================================================================
+
+/**
+ * @summary Token bucket limits.
+ */
+export interface RateLimitConfig {
+  /** Tokens returned to a bucket per minute */
+  ratePerMinute: number;
+
+  /** Most tokens a bucket holds, i.e. actions allowed back to back */
+  burst: number;
+
+  /** How often full buckets are dropped (milliseconds) */
+  cleanupIntervalMs: number;
+}
+
+/**
+ * @summary Default limits for creating and joining rooms.
+ *
+ * @description
+ * Five rooms back to back covers a player bouncing between lobbies;
+ * after that, one every six seconds.
+ */
+export const DEFAULT_RATE_LIMIT_CONFIG: RateLimitConfig = {
+  ratePerMinute: 10,
+  burst: 5,
+  cleanupIntervalMs: 60000
+};
+
+/** Tokens in a bucket when it was last touched */
+interface Bucket {
+  tokens: number;
+  updatedAt: number;
+}
+
+// =============================================================================
+// RATE LIMITER
+// =============================================================================
+
+/**
+ * @summary Keeps one token bucket per key.
+ */
+export class TokenBucketRateLimiter {
+  /** Buckets by key (client IP) */
+  private readonly buckets: Map<string, Bucket> = new Map();
+
+  /** Limits */
+  private readonly config: RateLimitConfig;
+
+  /** Cleanup interval handle */
+  private cleanupInterval: ReturnType<typeof setInterval> | null = null;
+
+  /**
+   * @summary Creates a rate limiter.
+   *
+   * @param {Partial<RateLimitConfig>} [config] - Limits
+   *
+   * @throws {Error} If the rate or burst is not positive
+   */
+  constructor(config: Partial<RateLimitConfig> = {}) {
+    this.config = { ...DEFAULT_RATE_LIMIT_CONFIG, ...config };
+
+    if (!(this.config.ratePerMinute > 0) || !(this.config.burst >= 1)) {
+      throw new Error('Rate limit needs a positive rate and a burst of at least 1');
+    }
+  }
+
+  /**
+   * @summary Number of keys with a bucket that is not yet full.
+   */
+  get size(): number {
+    return this.buckets.size;
+  }
+
+  /**
+   * @summary Takes a token from a key's bucket if it has one.
+   *
+   * @param {string} key - Client IP
+   * @param {number} [now] - Current time (epoch ms)
+   *
+   * @returns {boolean} True if the action may go ahead
+   */
+  tryConsume(key: string, now: number = Date.now()): boolean {
+    const tokens = this.tokensAt(key, now);
+    if (tokens < 1) {
+      this.buckets.set(key, { tokens, updatedAt: now });
+      return false;
+    }
+
+    this.buckets.set(key, { tokens: tokens - 1, updatedAt: now });
+    return true;
+  }
+
+  /**
+   * @summary How long until a key's next action would be allowed.
+   *
+   * @param {string} key - Client IP
+   * @param {number} [now] - Current time (epoch ms)
+   *
+   * @returns {number} Milliseconds to wait, 0 if a token is available
+   */
+  retryAfterMs(key: string, now: number = Date.now()): number {
+    const missing = 1 - this.tokensAt(key, now);
+    return missing > 0 ? Math.ceil(missing * this.msPerToken()) : 0;
+  }
+
+  /**
+   * @summary Drops buckets that have refilled.
+   *
+   * @param {number} [now] - Current time (epoch ms)
+   *
+   * @returns {number} Buckets dropped
+   */
+  sweep(now: number = Date.now()): number {
+    let dropped = 0;
+    for (const key of this.buckets.keys()) {
+      if (this.tokensAt(key, now) >= this.config.burst) {
+        this.buckets.delete(key);
+        dropped++;
+      }
+    }
+    return dropped;
+  }
+
+  /**
+   * @summary Starts sweeping full buckets periodically.
+   */
+  startCleanup(): void {
+    if (this.cleanupInterval) {
+      return;
+    }
+
+    this.cleanupInterval = setInterval(() => {
+      this.sweep();
+    }, this.config.cleanupIntervalMs);
+  }
+
+  /**
+   * @summary Stops the periodic sweep and forgets every bucket.
+   */
+  stopCleanup(): void {
+    if (this.cleanupInterval) {
+      clearInterval(this.cleanupInterval);
+      this.cleanupInterval = null;
+    }
+    this.buckets.clear();
+  }
+
+  /**
+   * @summary Tokens a key's bucket holds now, after refilling.
+   *
+   * @param {string} key - Client IP
+   * @param {number} now - Current time (epoch ms)
+   *
+   * @returns {number} Tokens, possibly fractional
+   *
+   * @private
+   */
+  private tokensAt(key: string, now: number): number {
+    const bucket = this.buckets.get(key);
+    if (!bucket) {
+      return this.config.burst;
+    }
+
+    const refilled = Math.max(0, now - bucket.updatedAt) / this.msPerToken();
+    return Math.min(this.config.burst, bucket.tokens + refilled);
+  }
+
+  /**
+   * @summary Milliseconds it takes to return one token.
+   *
+   * @private
+   */
+  private msPerToken(): number {
+    return 60000 / this.config.ratePerMinute;
+  }
+}
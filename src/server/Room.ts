@@ -26,7 +26,8 @@
  * ```
  */
 
-import { IClientConnection } from '../network/IClientConnection';
+import { randomBytes, timingSafeEqual } from 'crypto';
+import { IClientConnection, DetachedConnection, NullConnection } from '../network/IClientConnection';
 import {
   RoomCode,
   RoomConfig,
@@ -41,16 +42,27 @@ import {
   DebugOptions,
   CardStateSnapshot,
   WinConditionResult,
-  PlayerTeamAssignment
+  PlayerTeamAssignment,
+  PublicGameState,
+  SerializablePlayerGameView,
+  CloseCode,
+  PHASE_DESCRIPTIONS
 } from '../network/protocol';
-import { RoleName, GamePhase, NIGHT_WAKE_ORDER, Team } from '../enums';
-import { Game, IGameAgent } from '../core/Game';
-import { GameConfig } from '../types';
+import { RoleName, GamePhase, Team } from '../enums';
+import { Game, IGameAgent, GameSnapshot, GameCancelledError } from '../core/Game';
+import { DEFAULT_CENTER_CARD_COUNT, GameConfig, TieBreakMode } from '../types';
+import { RoleFactory } from '../patterns/factory';
 import { RandomAgent } from '../agents/RandomAgent';
 import { NetworkAgent } from './NetworkAgent';
 import { ITimeoutStrategy, TimeoutStrategy, TimeoutStrategyFactory, CASUAL_STRATEGY } from './TimeoutStrategies';
 import { PlayerView } from '../views/PlayerView';
+import { SpectatorBroadcaster } from './SpectatorBroadcaster';
+import { PhaseClock } from './PhaseClock';
+import { buildNightActionLog, NightLogPlayer } from './NightActionLog';
+import { buildVoteBreakdown } from './VoteBreakdown';
+import { PlayerViewFactory, VoteVisibility } from '../players/PlayerView';
 import { getDatabase, getWriteQueue } from '../database';
+import { getLogger, Logger } from '../utils/logger';
 import {
   IGameRepository,
   IReplayRepository,
@@ -60,6 +72,16 @@ import {
   StatisticsRepository
 } from '../database/repositories';
 
+/**
+ * @summary Time added to the discussion each time the table extends it.
+ */
+export const DISCUSSION_EXTENSION_MS = 60000;
+
+/**
+ * @summary Most extensions one day phase can get.
+ */
+export const MAX_DISCUSSION_EXTENSIONS = 2;
+
 /**
  * @summary Room state enumeration.
  */
@@ -99,6 +121,12 @@ export interface RoomPlayerInfo {
   /** When player joined */
   joinedAt: number;
 
+  /**
+   * Secret issued when the player joined. Player IDs are shown to the
+   * whole room, so the HTTP API identifies a player by this instead.
+   */
+  token: string;
+
   /**
    * Database user ID (UUID) for authenticated users.
    * Used to link game records to user accounts for statistics.
@@ -113,10 +141,18 @@ export interface RoomPlayerInfo {
 export type RoomEventType =
   | 'playerJoined'
   | 'playerLeft'
+  | 'hostChanged'
+  | 'hostDisconnected'
   | 'playerReady'
   | 'configChanged'
   | 'gameStarted'
+  | 'phaseChanged'
+  | 'voteCast'
+  | 'gameAbandoned'
+  | 'gameResumed'
   | 'gameEnded'
+  | 'nightActionCompleted'
+  | 'broadcastFailed'
   | 'roomClosed';
 
 /**
@@ -134,6 +170,152 @@ export interface RoomEvent {
  */
 export type RoomEventHandler = (event: RoomEvent) => void;
 
+/**
+ * @summary Serializable copy of an in-progress room.
+ *
+ * @description
+ * Everything needed to bring a room back after a server restart. Player
+ * connections cannot be persisted, so players come back detached and are
+ * re-attached when they authenticate again.
+ *
+ * @pattern Memento Pattern - Externalizes room state for persistence
+ */
+export interface RoomSnapshot {
+  /** Room code */
+  code: RoomCode;
+
+  /** Host player ID */
+  hostId: PlayerId;
+
+  /** Room configuration */
+  config: RoomConfig;
+
+  /** When the room was created */
+  createdAt: number;
+
+  /** When the game started */
+  gameStartedAt: number | null;
+
+  /** Players in seat order, with their game player IDs */
+  players: Array<{
+    id: PlayerId;
+    name: string;
+    isAI: boolean;
+    joinedAt: number;
+    userId?: string;
+    gamePlayerId: string;
+    /** Player token; missing from snapshots saved before tokens existed */
+    token?: string;
+  }>;
+
+  /** Current phase deadline (epoch ms), or null if untimed */
+  phaseEndsAt: number | null;
+
+  /** Database game ID, if the game is being recorded */
+  dbGameId: string | null;
+
+  /** Database player IDs by room player ID */
+  dbPlayerIds: Record<PlayerId, string>;
+
+  /** Engine state */
+  game: GameSnapshot;
+
+  /** Round number within the match */
+  round?: number;
+
+  /** Rounds won by each player over the match */
+  scores?: Record<PlayerId, number>;
+
+  /** When the snapshot was taken */
+  savedAt: number;
+}
+
+/**
+ * @summary Checks the role list in a room configuration.
+ *
+ * @description
+ * Used when a room is created or its configuration changes, before the
+ * player count is known. In 'exact' mode the list must already be a
+ * playable set for the players left after dealing the center cards; in
+ * 'fill' mode it is a partial list, so only the role names are checked.
+ * A custom night order, if set, is checked too.
+ *
+ * @param {Pick<RoomConfig, 'roles' | 'roleFillMode' | 'centerCardCount' | 'nightOrder'>} config - Room configuration
+ *
+ * @returns {string[]} Problems found (empty if valid)
+ */
+export function validateRoleConfig(
+  config: Pick<RoomConfig, 'roles' | 'roleFillMode' | 'centerCardCount' | 'nightOrder'>
+): string[] {
+  if (!Array.isArray(config.roles)) {
+    return ['Roles must be a list of role names'];
+  }
+  if (config.nightOrder !== undefined && !Array.isArray(config.nightOrder)) {
+    return ['Night order must be a list of role names'];
+  }
+
+  const errors = RoleFactory.validateRoleList(config.roles, {
+    centerCardCount: config.centerCardCount,
+    partial: config.roleFillMode === 'fill'
+  }).errors;
+
+  if (config.nightOrder) {
+    errors.push(...RoleFactory.validateNightOrder(config.nightOrder).errors);
+  }
+
+  return errors;
+}
+
+/**
+ * @summary Validates a room's player limits.
+ *
+ * @description
+ * Both limits must be whole numbers within the range a game supports
+ * (RoleFactory.MIN_PLAYERS to RoleFactory.MAX_PLAYERS), and the minimum
+ * may not exceed the maximum.
+ *
+ * @param {Pick<RoomConfig, 'minPlayers' | 'maxPlayers'>} config - Room configuration
+ *
+ * @returns {string[]} Problems found (empty if valid)
+ */
+export function validatePlayerLimits(config: Pick<RoomConfig, 'minPlayers' | 'maxPlayers'>): string[] {
+  const errors: string[] = [];
+  const range = `from ${RoleFactory.MIN_PLAYERS} to ${RoleFactory.MAX_PLAYERS}`;
+
+  for (const [label, value] of [['Minimum', config.minPlayers], ['Maximum', config.maxPlayers]] as const) {
+    if (!Number.isInteger(value) || value < RoleFactory.MIN_PLAYERS || value > RoleFactory.MAX_PLAYERS) {
+      errors.push(`${label} players must be a whole number ${range}`);
+    }
+  }
+
+  if (errors.length === 0 && config.minPlayers > config.maxPlayers) {
+    errors.push(`Minimum players (${config.minPlayers}) cannot exceed maximum players (${config.maxPlayers})`);
+  }
+
+  return errors;
+}
+
+/** Tie-break modes a room may be configured with */
+const TIE_BREAK_MODES: readonly TieBreakMode[] = ['all-die', 'no-kill', 'revote'];
+
+/**
+ * @summary Validates a room's tie-break mode.
+ *
+ * @description
+ * The mode may be left unset (every tied player dies); otherwise it
+ * must be one the game knows.
+ *
+ * @param {Pick<RoomConfig, 'tieBreak'>} config - Room configuration
+ *
+ * @returns {string[]} Problems found (empty if valid)
+ */
+export function validateTieBreak(config: Pick<RoomConfig, 'tieBreak'>): string[] {
+  if (config.tieBreak === undefined || TIE_BREAK_MODES.includes(config.tieBreak)) {
+    return [];
+  }
+  return [`Tie break must be one of ${TIE_BREAK_MODES.join(', ')}`];
+}
+
 /**
  * @summary Generates a random room code.
  *
@@ -150,6 +332,15 @@ export function generateRoomCode(length: number = 6): RoomCode {
   return code;
 }
 
+/**
+ * @summary Creates a secret a player proves their identity with.
+ *
+ * @returns {string} Random URL-safe token
+ */
+function createPlayerToken(): string {
+  return randomBytes(24).toString('base64url');
+}
+
 /**
  * @summary Game room for multiplayer sessions.
  *
@@ -182,8 +373,8 @@ export class Room {
   /** Unique room code for joining */
   private readonly code: RoomCode;
 
-  /** Host player ID */
-  private readonly hostId: PlayerId;
+  /** Host player ID (moves to another player if the host leaves the lobby) */
+  private hostId: PlayerId;
 
   /** Room configuration */
   private config: RoomConfig;
@@ -200,8 +391,8 @@ export class Room {
   /** Event handlers */
   private readonly eventHandlers: Set<RoomEventHandler> = new Set();
 
-  /** When room was created */
-  private readonly createdAt: number;
+  /** When room was created (restored rooms keep the original time) */
+  private createdAt: number;
 
   /** When game started (if applicable) */
   private gameStartedAt: number | null = null;
@@ -212,6 +403,15 @@ export class Room {
   /** Players who have signaled ready to vote */
   private playersReadyToVote: Set<PlayerId> = new Set();
 
+  /** Players asking for more discussion time since the last extension */
+  private readonly extensionRequests: Set<PlayerId> = new Set();
+
+  /** Extensions granted in the current day phase */
+  private discussionExtensions: number = 0;
+
+  /** Fires when the day deadline should have passed */
+  private dayDeadlineTimer: ReturnType<typeof setTimeout> | null = null;
+
   /** Database game ID (set when game starts if database is available) */
   private dbGameId: string | null = null;
 
@@ -230,6 +430,27 @@ export class Room {
   /** Duration of the current phase in milliseconds */
   private phaseDurationMs: number | null = null;
 
+  /** Deadline of the current phase, paused while no human is connected */
+  private readonly phaseClock: PhaseClock = new PhaseClock();
+
+  /** Logger tagged with this room's code */
+  private readonly logger: Logger;
+
+  /** True while a game is running with no human player connected */
+  private abandoned: boolean = false;
+
+  /** Result of the finished game, kept for clients that ask for it later */
+  private finalResult: SerializableGameResult | null = null;
+
+  /** Number of rounds started in this room's match */
+  private round: number = 0;
+
+  /** Rounds won by each player over the match */
+  private readonly scores: Map<PlayerId, number> = new Map();
+
+  /** Delayed broadcast channel for spectators */
+  private readonly spectators: SpectatorBroadcaster;
+
   /** Timeout strategy for phase durations */
   private readonly timeoutStrategy: ITimeoutStrategy;
 
@@ -299,8 +520,10 @@ export class Room {
     this.hostId = hostId;
     this.config = { ...config };
     this.code = code ?? generateRoomCode();
+    this.logger = getLogger().with({ roomCode: this.code });
     this.createdAt = Date.now();
     this.debugOptions = debugOptions || null;
+    this.spectators = new SpectatorBroadcaster(config.spectatorDelayMs ?? 0);
 
     // Initialize timeout strategy (default: casual)
     // TODO: Allow strategy selection via config
@@ -310,6 +533,9 @@ export class Room {
     this.gameRepository = repositories?.gameRepository ?? new GameRepository();
     this.replayRepository = repositories?.replayRepository ?? new ReplayRepository();
     this.statisticsRepository = repositories?.statisticsRepository ?? new StatisticsRepository();
+
+    // Spectators joining before the first delayed update see the room as it opened
+    this.spectators.seedState(this.getState());
   }
 
   /**
@@ -362,6 +588,26 @@ export class Room {
     return this.status;
   }
 
+  /**
+   * @summary Gets when the room was created.
+   *
+   * @returns {number} Creation time (epoch ms)
+   */
+  getCreatedAt(): number {
+    return this.createdAt;
+  }
+
+  /**
+   * @summary Counts human players with a live connection.
+   *
+   * @returns {number} Connected human players
+   */
+  getConnectedHumanCount(): number {
+    return Array.from(this.players.values()).filter(
+      p => !p.isAI && p.connection.isConnected()
+    ).length;
+  }
+
   /**
    * @summary Gets the room configuration.
    *
@@ -420,6 +666,28 @@ export class Room {
     return this.players.get(playerId);
   }
 
+  /**
+   * @summary Finds the player a token was issued to.
+   *
+   * @description
+   * The comparison takes the same time whichever character differs, so
+   * a token can't be guessed one character at a time.
+   *
+   * @param {string} token - Token sent by the client
+   *
+   * @returns {PlayerId | null} Player the token belongs to, or null
+   */
+  authenticatePlayer(token: string): PlayerId | null {
+    const given = Buffer.from(token);
+    for (const player of this.players.values()) {
+      const expected = Buffer.from(player.token);
+      if (given.length === expected.length && timingSafeEqual(given, expected)) {
+        return player.id;
+      }
+    }
+    return null;
+  }
+
   /**
    * @summary Updates the room configuration.
    *
@@ -440,7 +708,27 @@ export class Room {
       throw new Error('Cannot update configuration after game has started');
     }
 
-    this.config = { ...this.config, ...updates };
+    const config = { ...this.config, ...updates };
+    const roleErrors = validateRoleConfig(config);
+    if (roleErrors.length > 0) {
+      throw new Error(`Invalid role list: ${roleErrors.join(', ')}`);
+    }
+
+    const limitErrors = validatePlayerLimits(config);
+    if (limitErrors.length > 0) {
+      throw new Error(`Invalid player limits: ${limitErrors.join(', ')}`);
+    }
+    if (config.maxPlayers < this.players.size) {
+      throw new Error(`Maximum players cannot be below the ${this.players.size} players already in the room`);
+    }
+
+    const tieBreakErrors = validateTieBreak(config);
+    if (tieBreakErrors.length > 0) {
+      throw new Error(`Invalid tie break: ${tieBreakErrors.join(', ')}`);
+    }
+
+    this.config = config;
+    this.spectators.setDelayMs(this.config.spectatorDelayMs ?? 0);
 
     this.emitEvent('configChanged', {
       config: this.config
@@ -472,12 +760,19 @@ export class Room {
       throw new Error('Room is not accepting new players');
     }
 
-    if (this.players.size >= this.config.maxPlayers) {
-      throw new Error('Room is full');
+    const existing = this.players.get(playerId);
+    if (existing) {
+      // A host who dropped from the lobby keeps their seat for a grace period
+      if (!existing.isAI && !existing.connection.isConnected()) {
+        existing.connection = connection;
+        this.broadcastRoomState();
+        return;
+      }
+      throw new Error('Player is already in the room');
     }
 
-    if (this.players.has(playerId)) {
-      throw new Error('Player is already in the room');
+    if (this.players.size >= this.config.maxPlayers) {
+      throw new Error('Room is full');
     }
 
     const playerInfo: RoomPlayerInfo = {
@@ -487,6 +782,7 @@ export class Room {
       isReady: isAI, // AI players are always ready
       isAI,
       joinedAt: Date.now(),
+      token: createPlayerToken(),
       userId
     };
 
@@ -505,6 +801,11 @@ export class Room {
   /**
    * @summary Removes a player from the room.
    *
+   * @description
+   * In the lobby, the player's ready flag goes with them. If the host
+   * leaves, the earliest-joined human player still in the room becomes
+   * host. A lobby left with no humans is closed.
+   *
    * @param {PlayerId} playerId - Player to remove
    *
    * @throws {Error} If player is not in room
@@ -520,19 +821,77 @@ export class Room {
       playerId
     });
 
-    // If host left, close the room
-    if (playerId === this.hostId && this.status === RoomStatus.WAITING) {
-      this.close('Host left the room');
-      return;
+    if (this.status === RoomStatus.WAITING) {
+      const humans = Array.from(this.players.values())
+        .filter(p => !p.isAI)
+        .sort((a, b) => a.joinedAt - b.joinedAt);
+
+      // Nobody left to start the game
+      if (humans.length === 0) {
+        this.close('Room is empty');
+        return;
+      }
+
+      if (playerId === this.hostId) {
+        this.setHost(humans[0].id);
+      }
     }
 
     // Broadcast updated state
     this.broadcastRoomState();
   }
 
+  /**
+   * @summary Hands the host role to another player.
+   *
+   * @param {PlayerId} fromId - Player giving up the host role
+   * @param {PlayerId} toId - Human player in the room to become host
+   *
+   * @throws {Error} If fromId isn't the host or toId isn't a human player in the room
+   */
+  transferHost(fromId: PlayerId, toId: PlayerId): void {
+    if (fromId !== this.hostId) {
+      throw new Error('Only the host can transfer the host role');
+    }
+
+    const target = this.players.get(toId);
+    if (!target || target.isAI) {
+      throw new Error('Target player is not in the room');
+    }
+
+    if (toId === fromId) {
+      return;
+    }
+
+    this.setHost(toId);
+    this.broadcastRoomState();
+  }
+
+  /**
+   * @summary Makes a player the host and emits 'hostChanged'.
+   *
+   * @param {PlayerId} playerId - New host
+   *
+   * @private
+   */
+  private setHost(playerId: PlayerId): void {
+    const previousHostId = this.hostId;
+    this.hostId = playerId;
+    this.logger.with({ playerId }).info('Host role passed on', { previousHostId });
+    this.emitEvent('hostChanged', {
+      previousHostId,
+      hostId: playerId
+    });
+  }
+
   /**
    * @summary Sets a player's ready status.
    *
+   * @description
+   * Setting the status the player already has is a no-op, so a client
+   * retrying its request neither rebroadcasts the room nor fails because
+   * the game started in the meantime.
+   *
    * @param {PlayerId} playerId - Player ID
    * @param {boolean} isReady - Ready status
    *
@@ -544,6 +903,10 @@ export class Room {
       throw new Error('Player is not in the room');
     }
 
+    if (player.isReady === isReady) {
+      return;
+    }
+
     if (this.status !== RoomStatus.WAITING) {
       throw new Error('Cannot change ready status after game has started');
     }
@@ -601,8 +964,10 @@ export class Room {
    *
    * @description
    * During the DAY phase, players can signal they're ready to vote.
-   * When all human players are ready, the game transitions to VOTING.
-   * AI players are automatically considered ready.
+   * When every connected human player is ready, the day ends early and
+   * the game transitions to VOTING. AI players are automatically
+   * considered ready, and a player whose connection has dropped does
+   * not hold up the rest of the table.
    *
    * @param {PlayerId} playerId - Player signaling ready
    *
@@ -626,10 +991,9 @@ export class Room {
     // Mark player as ready to vote
     this.playersReadyToVote.add(playerId);
 
-    // Count human players and ready players
-    const humanPlayers = Array.from(this.players.values()).filter(p => !p.isAI);
-    const readyCount = this.playersReadyToVote.size;
-    const totalHumans = humanPlayers.length;
+    // Count connected human players and ready players among them
+    const connectedHumans = this.getConnectedHumans();
+    const readyCount = connectedHumans.filter(p => this.playersReadyToVote.has(p.id)).length;
 
     // Broadcast ready status to all players
     const readyMessage: ServerMessage = {
@@ -637,118 +1001,327 @@ export class Room {
       playerId,
       playerName: player.name,
       readyCount,
-      totalPlayers: totalHumans,
+      totalPlayers: connectedHumans.length,
       timestamp: Date.now()
     };
     this.broadcast(readyMessage);
 
-    // Check if all human players are ready
-    const allHumansReady = humanPlayers.every(p => this.playersReadyToVote.has(p.id));
-
-    if (allHumansReady) {
-      // Signal the game to end the day phase and move to voting
-      this.game.endDayPhase();
-      // Reset ready-to-vote tracking for next game
-      this.playersReadyToVote.clear();
-    }
+    this.endDayIfAllReadyToVote();
   }
 
   /**
-   * @summary Checks if the game can be started.
+   * @summary Gets the human players whose connection is up.
    *
-   * @returns {boolean} True if game can start
+   * @private
    */
-  canStart(): boolean {
-    if (this.status !== RoomStatus.WAITING) {
-      return false;
-    }
-
-    // Check minimum players
-    if (this.players.size < this.config.minPlayers) {
-      return false;
-    }
+  private getConnectedHumans(): RoomPlayerInfo[] {
+    return Array.from(this.players.values()).filter(p => !p.isAI && p.connection.isConnected());
+  }
 
-    // Check all players are ready (host is always considered ready)
-    for (const player of this.players.values()) {
-      if (!player.isReady && player.id !== this.hostId) {
-        return false;
-      }
+  /**
+   * @summary Ends the day phase once every connected human is ready to vote.
+   *
+   * @description
+   * Checked when a player signals ready and when a player drops, since
+   * the one who dropped may have been the last holdout.
+   *
+   * @private
+   */
+  private endDayIfAllReadyToVote(): void {
+    if (!this.game || this.game.getPhase() !== GamePhase.DAY) {
+      return;
     }
 
-    // Check we have enough roles for players + center cards
-    const requiredRoles = this.players.size + 3;
-    if (this.config.roles.length < requiredRoles) {
-      return false;
+    const connectedHumans = this.getConnectedHumans();
+    if (connectedHumans.length === 0 || !connectedHumans.every(p => this.playersReadyToVote.has(p.id))) {
+      return;
     }
 
-    return true;
+    // Signal the game to end the day phase and move to voting
+    this.game.endDayPhase();
+    // Reset ready-to-vote tracking for next game
+    this.playersReadyToVote.clear();
   }
 
   /**
-   * @summary Gets the reason why the game cannot start.
+   * @summary Records a player's request for more discussion time.
    *
-   * @returns {string | null} Reason or null if can start
+   * @description
+   * Once the host or a majority of the connected human players have
+   * asked, the day deadline moves out by DISCUSSION_EXTENSION_MS and
+   * everyone gets a 'discussionExtended' message with the new deadline. Until then
+   * each request is broadcast as 'extensionRequested' so the table can
+   * see who wants more time. A day phase can be extended at most
+   * MAX_DISCUSSION_EXTENSIONS times.
+   *
+   * @param {PlayerId} playerId - Player asking for more time
+   *
+   * @returns {boolean} True if this request granted the extension
+   *
+   * @throws {Error} If not in the DAY phase, the day is untimed, the player is not in the room, or no extensions are left
    */
-  getCannotStartReason(): string | null {
-    if (this.status !== RoomStatus.WAITING) {
-      return 'Game already started or room closed';
+  requestDiscussionExtension(playerId: PlayerId): boolean {
+    if (this.status !== RoomStatus.PLAYING || !this.game) {
+      throw new Error('Game is not in progress');
     }
 
-    if (this.players.size < this.config.minPlayers) {
-      return `Need at least ${this.config.minPlayers} players (have ${this.players.size})`;
+    if (this.game.getPhase() !== GamePhase.DAY) {
+      throw new Error('Can only extend the discussion during the DAY phase');
     }
 
-    const notReady = Array.from(this.players.values())
-      .filter(p => !p.isReady && p.id !== this.hostId)  // Host is always ready
-      .map(p => p.name);
+    // An untimed day has no deadline to push back
+    if (this.phaseClock.getEndsAt() === null) {
+      throw new Error('This day has no time limit to extend');
+    }
 
-    if (notReady.length > 0) {
-      return `Waiting for players: ${notReady.join(', ')}`;
+    const player = this.players.get(playerId);
+    if (!player) {
+      throw new Error('Player is not in the room');
     }
 
-    const requiredRoles = this.players.size + 3;
-    if (this.config.roles.length < requiredRoles) {
-      return `Need ${requiredRoles} roles but only ${this.config.roles.length} configured`;
+    if (this.discussionExtensions >= MAX_DISCUSSION_EXTENSIONS) {
+      throw new Error('No discussion extensions left');
     }
 
-    return null;
+    this.extensionRequests.add(playerId);
+
+    const votesNeeded = Math.floor(this.getConnectedHumans().length / 2) + 1;
+
+    if (playerId !== this.hostId && this.extensionRequests.size < votesNeeded) {
+      this.broadcast({
+        type: 'extensionRequested',
+        playerId,
+        playerName: player.name,
+        votes: this.extensionRequests.size,
+        votesNeeded,
+        timestamp: Date.now()
+      });
+      return false;
+    }
+
+    this.extensionRequests.clear();
+    this.discussionExtensions++;
+    this.phaseClock.extend(DISCUSSION_EXTENSION_MS);
+    this.phaseDurationMs = (this.phaseDurationMs ?? 0) + DISCUSSION_EXTENSION_MS;
+    this.scheduleDayDeadline();
+
+    this.logger.info('Discussion extended', { playerId, extensions: this.discussionExtensions });
+    this.broadcast({
+      type: 'discussionExtended',
+      phaseEndsAt: this.phaseClock.getEndsAt(),
+      timeRemaining: this.getTimeRemaining(),
+      extensionsLeft: MAX_DISCUSSION_EXTENSIONS - this.discussionExtensions,
+      timestamp: Date.now()
+    });
+    return true;
   }
 
   /**
-   * @summary Starts the game.
+   * @summary Arms the timer that ends the day phase at its deadline.
    *
-   * @param {PlayerId} requesterId - Player requesting game start
-   *
-   * @returns {Game} The started game instance
+   * @description
+   * The timer is only a wake-up: checkDayDeadline() reads the phase
+   * clock again when it fires, so a deadline that was extended or paused
+   * in the meantime is honoured rather than the one the timer was set for.
    *
-   * @throws {Error} If game cannot start or requester is not host
+   * @private
    */
-  /** Maps room player IDs to game player IDs */
-  private roomToGamePlayerMap: Map<PlayerId, string> = new Map();
+  private scheduleDayDeadline(): void {
+    this.clearDayDeadline();
+
+    const endsAt = this.phaseClock.getEndsAt();
+    if (
+      endsAt === null ||
+      this.phaseClock.isPaused() ||
+      this.debugOptions?.disableTimers ||
+      this.status !== RoomStatus.PLAYING ||
+      this.game?.getPhase() !== GamePhase.DAY
+    ) {
+      return;
+    }
 
-  /** Maps game player IDs to room player IDs */
-  private gameToRoomPlayerMap: Map<string, PlayerId> = new Map();
+    this.dayDeadlineTimer = setTimeout(() => this.checkDayDeadline(), Math.max(0, endsAt - Date.now()));
+    this.dayDeadlineTimer.unref?.();
+  }
 
-  startGame(requesterId: PlayerId): Game {
-    if (requesterId !== this.hostId) {
-      throw new Error('Only the host can start the game');
+  /**
+   * @summary Ends the day phase if its deadline has really passed.
+   *
+   * @private
+   */
+  private checkDayDeadline(): void {
+    this.dayDeadlineTimer = null;
+    if (!this.game || this.status !== RoomStatus.PLAYING || this.game.getPhase() !== GamePhase.DAY) {
+      return;
     }
 
-    if (!this.canStart()) {
-      const reason = this.getCannotStartReason();
-      throw new Error(reason || 'Cannot start game');
+    // Paused: reattachPlayer() re-arms the timer when someone returns
+    if (this.phaseClock.isPaused()) {
+      return;
     }
 
-    // Create player list and mapping
-    const playerList = Array.from(this.players.values());
-    this.roomToGamePlayerMap.clear();
-    this.gameToRoomPlayerMap.clear();
-
-    for (let i = 0; i < playerList.length; i++) {
-      const roomId = playerList[i].id;
-      const gameId = `player-${i + 1}`;
-      this.roomToGamePlayerMap.set(roomId, gameId);
-      this.gameToRoomPlayerMap.set(gameId, roomId);
+    const endsAt = this.phaseClock.getEndsAt();
+    if (endsAt !== null && endsAt > Date.now()) {
+      this.scheduleDayDeadline();
+      return;
+    }
+
+    this.logger.info('Discussion time is up');
+    this.game.endDayPhase();
+    this.playersReadyToVote.clear();
+  }
+
+  /**
+   * @summary Stops the day deadline timer.
+   *
+   * @private
+   */
+  private clearDayDeadline(): void {
+    if (this.dayDeadlineTimer) {
+      clearTimeout(this.dayDeadlineTimer);
+      this.dayDeadlineTimer = null;
+    }
+  }
+
+  /**
+   * @summary Checks if the game can be started.
+   *
+   * @returns {boolean} True if game can start
+   */
+  canStart(): boolean {
+    if (this.status !== RoomStatus.WAITING) {
+      return false;
+    }
+
+    // Check minimum players
+    if (this.players.size < this.config.minPlayers) {
+      return false;
+    }
+
+    // Check all players are ready (host is always considered ready)
+    for (const player of this.players.values()) {
+      if (!player.isReady && player.id !== this.hostId) {
+        return false;
+      }
+    }
+
+    // Check the role list can be completed for players + center cards
+    return this.resolveRoles().errors.length === 0;
+  }
+
+  /**
+   * @summary Resolves the role list the game will actually use.
+   *
+   * @description
+   * In 'exact' mode the configured roles are used as-is and must number
+   * exactly players + center cards (one more with an Alpha Wolf, for the
+   * Werewolf card it sets aside). In 'fill' mode they are treated as a partial
+   * set and completed for the current player count via
+   * RoleFactory.completeRoleSet. Either way the result is checked with
+   * RoleFactory.validateRoleList.
+   *
+   * @returns {{ roles: RoleName[]; errors: string[] }} Resolved roles and any problems
+   *
+   * @private
+   */
+  private resolveRoles(): { roles: RoleName[]; errors: string[] } {
+    const playerCount = this.players.size;
+    const centerCardCount = this.config.centerCardCount ?? DEFAULT_CENTER_CARD_COUNT;
+    const requiredRoles = playerCount + centerCardCount + RoleFactory.getSetAsideCardCount(this.config.roles);
+
+    if (this.config.roleFillMode === 'fill') {
+      try {
+        const roles = RoleFactory.completeRoleSet(this.config.roles, playerCount, centerCardCount);
+        return { roles, errors: RoleFactory.validateRoleList(roles, { playerCount, centerCardCount }).errors };
+      } catch (error) {
+        return {
+          roles: [...this.config.roles],
+          errors: [error instanceof Error ? error.message : 'Invalid role set']
+        };
+      }
+    }
+
+    if (this.config.roles.length < requiredRoles) {
+      return {
+        roles: [...this.config.roles],
+        errors: [`Need ${requiredRoles} roles but only ${this.config.roles.length} configured`]
+      };
+    }
+
+    return {
+      roles: [...this.config.roles],
+      errors: RoleFactory.validateRoleList(this.config.roles, { playerCount, centerCardCount }).errors
+    };
+  }
+
+  /**
+   * @summary Gets the reason why the game cannot start.
+   *
+   * @returns {string | null} Reason or null if can start
+   */
+  getCannotStartReason(): string | null {
+    if (this.status !== RoomStatus.WAITING) {
+      return 'Game already started or room closed';
+    }
+
+    if (this.players.size < this.config.minPlayers) {
+      return `Need at least ${this.config.minPlayers} players (have ${this.players.size})`;
+    }
+
+    const notReady = Array.from(this.players.values())
+      .filter(p => !p.isReady && p.id !== this.hostId)  // Host is always ready
+      .map(p => p.name);
+
+    if (notReady.length > 0) {
+      return `Waiting for players: ${notReady.join(', ')}`;
+    }
+
+    const roleErrors = this.resolveRoles().errors;
+    if (roleErrors.length > 0) {
+      return roleErrors.join(', ');
+    }
+
+    return null;
+  }
+
+  /** Maps room player IDs to game player IDs */
+  private roomToGamePlayerMap: Map<PlayerId, string> = new Map();
+
+  /** Maps game player IDs to room player IDs */
+  private gameToRoomPlayerMap: Map<string, PlayerId> = new Map();
+
+  /** Network agents for human players, by room player ID */
+  private readonly networkAgents: Map<PlayerId, NetworkAgent> = new Map();
+
+  /**
+   * @summary Starts the game.
+   *
+   * @param {PlayerId} requesterId - Player requesting game start
+   *
+   * @returns {Game} The started game instance
+   *
+   * @throws {Error} If game cannot start or requester is not host
+   */
+  startGame(requesterId: PlayerId): Game {
+    if (requesterId !== this.hostId) {
+      throw new Error('Only the host can start the game');
+    }
+
+    if (!this.canStart()) {
+      const reason = this.getCannotStartReason();
+      throw new Error(reason || 'Cannot start game');
+    }
+
+    // Create player list and mapping
+    const playerList = Array.from(this.players.values());
+    this.roomToGamePlayerMap.clear();
+    this.gameToRoomPlayerMap.clear();
+
+    for (let i = 0; i < playerList.length; i++) {
+      const roomId = playerList[i].id;
+      const gameId = `player-${i + 1}`;
+      this.roomToGamePlayerMap.set(roomId, gameId);
+      this.gameToRoomPlayerMap.set(gameId, roomId);
     }
 
     // Build forced roles map if debug mode is enabled
@@ -759,16 +1332,24 @@ export class Room {
       if (hostIndex !== -1) {
         forcedRoles = new Map();
         forcedRoles.set(hostIndex, this.debugOptions.forceRole);
-        console.log(`Debug: Will force host (index ${hostIndex}) to have role ${this.debugOptions.forceRole}`);
+        this.logger.debug('Forcing host role', { hostIndex, role: this.debugOptions.forceRole });
       }
     }
 
     // Create game configuration
     const gameConfig: GameConfig = {
       players: playerList.map(p => p.name),
-      roles: [...this.config.roles],
+      roles: this.resolveRoles().roles,
+      centerCardCount: this.config.centerCardCount,
+      nightOrder: this.config.nightOrder,
       forcedRoles,
-      forceWerewolvesToCenter: this.debugOptions?.forceWerewolvesToCenter
+      forceWerewolvesToCenter: this.debugOptions?.forceWerewolvesToCenter,
+      revealEmphasis: this.config.revealEmphasis,
+      // Votes close with the same deadline clients count down to
+      votingTimeoutMs: this.debugOptions?.disableTimers ? null : this.votingDurationMs,
+      allowAbstain: this.config.allowAbstain,
+      tieBreak: this.config.tieBreak,
+      minVotesToKill: this.config.minVotesToKill
     };
 
     // Create and setup game
@@ -776,6 +1357,7 @@ export class Room {
 
     this.status = RoomStatus.PLAYING;
     this.gameStartedAt = Date.now();
+    this.round++;
 
     // Save game to database (queued with retry)
     this.enqueueGameSave(playerList);
@@ -853,8 +1435,74 @@ export class Room {
       }
     }
 
-    // Create agents for all players
+    // Register agents and run game asynchronously
+    this.logger.info('Registering agents and starting game', { players: playerList.length });
+    this.game.registerAgents(this.createAgents(playerList));
+
+    // Subscribe to game events to broadcast to all players and save to database
+    this.observeGame(this.game);
+
+    this.runGameAsync(playerList);
+
+    return this.game;
+  }
+
+  /**
+   * @summary Starts another round with the same players.
+   *
+   * @description
+   * Clears the finished game, then deals a fresh one to everyone still
+   * seated. The round counter and the match scores carry over. If the
+   * players left can no longer start a game, the room stays open as a
+   * lobby so others can join.
+   *
+   * @param {PlayerId} requesterId - Player requesting the next round (must be host)
+   *
+   * @returns {Game} The new game
+   *
+   * @throws {Error} If requester is not host, the game has not ended, or the room cannot start
+   */
+  startNextRound(requesterId: PlayerId): Game {
+    if (requesterId !== this.hostId) {
+      throw new Error('Only the host can start the next round');
+    }
+
+    if (this.status !== RoomStatus.ENDED) {
+      throw new Error('The current round has not ended');
+    }
+
+    this.status = RoomStatus.WAITING;
+    this.game = null;
+    this.finalResult = null;
+    this.playersReadyToVote.clear();
+    this.nightActionSequence = 0;
+    this.statementSequence = 0;
+    this.dbGameId = null;
+    this.dbPlayerIds.clear();
+    this.phaseStartedAt = null;
+    this.phaseDurationMs = null;
+    this.abandoned = false;
+
+    return this.startGame(requesterId);
+  }
+
+  /**
+   * @summary Creates the agents that play for each seat.
+   *
+   * @description
+   * AI players get a RandomAgent and humans get a NetworkAgent bound to
+   * their current connection. NetworkAgents are kept so a player who
+   * reconnects on a new socket can be moved onto it.
+   *
+   * @param {RoomPlayerInfo[]} playerList - Players in seat order
+   *
+   * @returns {Map<string, IGameAgent>} Agents by game player ID
+   *
+   * @private
+   */
+  private createAgents(playerList: RoomPlayerInfo[]): Map<string, IGameAgent> {
     const agents: Map<string, IGameAgent> = new Map();
+    this.networkAgents.clear();
 
     // Determine forced vote target for bots if debug option is enabled
     let forcedVoteTarget: string | undefined;
@@ -862,7 +1510,7 @@ export class Room {
       // Get the host's game player ID
       forcedVoteTarget = this.roomToGamePlayerMap.get(this.hostId);
       if (forcedVoteTarget) {
-        console.log(`Debug: Bots will vote for host (game ID: ${forcedVoteTarget})`);
+        this.logger.debug('Bots will vote for host', { gamePlayerId: forcedVoteTarget });
       }
     }
 
@@ -871,23 +1519,40 @@ export class Room {
       if (gamePlayerId) {
         if (roomPlayer.isAI) {
           // AI player - use RandomAgent (with optional forced vote target)
-          console.log(`Creating RandomAgent for AI player ${gamePlayerId}${forcedVoteTarget ? ' (forced vote: ' + forcedVoteTarget + ')' : ''}`);
+          this.logger.debug('Creating RandomAgent for AI player', { gamePlayerId, forcedVoteTarget });
           agents.set(gamePlayerId, new RandomAgent(gamePlayerId, forcedVoteTarget));
         } else {
           // Human player - use NetworkAgent
           const disableTimeouts = this.debugOptions?.disableTimers ?? false;
-          console.log(`Creating NetworkAgent for human player ${gamePlayerId} (room: ${roomPlayer.id})${disableTimeouts ? ' [timeouts disabled]' : ''}`);
-          agents.set(gamePlayerId, new NetworkAgent(gamePlayerId, roomPlayer.connection, disableTimeouts));
+          this.logger.debug('Creating NetworkAgent for human player', {
+            gamePlayerId,
+            playerId: roomPlayer.id,
+            disableTimeouts
+          });
+          const agent = new NetworkAgent(
+            gamePlayerId,
+            roomPlayer.connection,
+            disableTimeouts,
+            () => this.game?.getPhase() ?? null
+          );
+          this.networkAgents.set(roomPlayer.id, agent);
+          agents.set(gamePlayerId, agent);
         }
       }
     }
 
-    // Register agents and run game asynchronously
-    console.log(`Registering ${agents.size} agents and starting game...`);
-    this.game.registerAgents(agents);
+    return agents;
+  }
 
-    // Subscribe to game events to broadcast to all players and save to database
-    this.game.addObserver({
+  /**
+   * @summary Relays game engine events to players and persistence.
+   *
+   * @param {Game} game - Game to observe
+   *
+   * @private
+   */
+  private observeGame(game: Game): void {
+    game.addObserver({
       onEvent: (event: { type: string; data?: Record<string, unknown> }) => {
         if (event.type === 'STATEMENT_MADE' && event.data) {
           const gamePlayerId = event.data.playerId as string;
@@ -913,7 +1578,7 @@ export class Room {
         } else if (event.type === 'PHASE_CHANGED' && event.data) {
           // Broadcast phase change to all players
           const toPhase = event.data.to as GamePhase;
-          console.log(`DEBUG PHASE_CHANGED: toPhase="${toPhase}", GamePhase.DAY="${GamePhase.DAY}", match=${toPhase === GamePhase.DAY}, dayDurationMs=${this.dayDurationMs}`);
+          this.logger.debug('Phase changing', { toPhase, dayDurationMs: this.dayDurationMs });
 
           // Set phase timing based on phase type
           this.phaseStartedAt = Date.now();
@@ -925,6 +1590,11 @@ export class Room {
             // Night and Resolution phases have no time limit
             this.phaseDurationMs = null;
           }
+          this.phaseClock.start(this.phaseDurationMs);
+          this.checkAbandoned();
+          this.extensionRequests.clear();
+          this.discussionExtensions = 0;
+          this.scheduleDayDeadline();
 
           // Update game status in database (queued with retry)
           if (this.dbGameId) {
@@ -940,12 +1610,56 @@ export class Room {
           }
 
           const timeRemaining = this.getTimeRemaining();
-          console.log(`Phase change to ${toPhase}: timeRemaining=${timeRemaining}, phaseStartedAt=${this.phaseStartedAt}, phaseDurationMs=${this.phaseDurationMs}`);
+          this.logger.info('Phase changed', {
+            phase: toPhase,
+            timeRemaining,
+            phaseStartedAt: this.phaseStartedAt,
+            phaseDurationMs: this.phaseDurationMs
+          });
 
           this.broadcast({
             type: 'phaseChange',
             phase: toPhase,
+            description: PHASE_DESCRIPTIONS[toPhase],
             timeRemaining,
+            phaseEndsAt: this.phaseClock.getEndsAt(),
+            revealedCards: toPhase === GamePhase.DAY ? this.getRevealedCardsRecord(game) : undefined,
+            artifacts: toPhase === GamePhase.DAY
+              ? game.getArtifactPlayerIds().map(id => this.gameToRoomPlayerMap.get(id) || id)
+              : undefined,
+            timestamp: Date.now()
+          });
+
+          if (toPhase === GamePhase.DAY) {
+            this.sendDawnSummaries(game);
+          }
+
+          this.emitEvent('phaseChanged', { phase: toPhase });
+        } else if (event.type === 'VOTE_CAST' && event.data) {
+          this.emitEvent('voteCast', {
+            voterId: this.gameToRoomPlayerMap.get(event.data.voterId as string),
+            targetId: this.gameToRoomPlayerMap.get(event.data.targetId as string)
+          });
+        } else if (event.type === 'VOTE_SUBMITTED' && event.data) {
+          // Targets stay hidden until voting closes; a secret ballot hides voters too
+          const voterId = this.gameToRoomPlayerMap.get(event.data.voterId as string);
+          this.broadcast({
+            type: 'voteProgress',
+            votedCount: game.getSubmittedVoters().size,
+            totalVoters: game.getPlayerIds().length,
+            ...(this.getVoteVisibility() === 'public' && { voterId }),
+            timestamp: Date.now()
+          });
+        } else if (event.type === 'REVOTE_STARTED' && event.data) {
+          // The new round gets the full voting time again
+          this.phaseStartedAt = Date.now();
+          this.phaseClock.start(this.phaseDurationMs);
+
+          const tiedIds = event.data.tiedPlayerIds as string[];
+          this.broadcast({
+            type: 'revoteStarted',
+            tiedPlayerIds: tiedIds.map(id => this.gameToRoomPlayerMap.get(id) || id),
+            phaseEndsAt: this.phaseClock.getEndsAt(),
             timestamp: Date.now()
           });
         } else if (event.type === 'NIGHT_ACTION_EXECUTED' && event.data) {
@@ -956,13 +1670,56 @@ export class Room {
           const details = event.data.details as Record<string, unknown>;
 
           this.enqueueNightActionSave(actorId, roleName as RoleName, actionType, details);
+          this.emitEvent('nightActionCompleted', { role: roleName, durationMs: event.data.durationMs });
         }
       }
     });
+  }
 
-    this.runGameAsync(playerList);
+  /**
+   * @summary Maps the cards the Revealer left face up to room player IDs.
+   *
+   * @param {Game} game - Game that just reached dawn
+   *
+   * @returns {Record<string, RoleName>} Revealed role by room player ID
+   *
+   * @private
+   */
+  private getRevealedCardsRecord(game: Game): Record<string, RoleName> {
+    const record: Record<string, RoleName> = {};
+    for (const [gameId, role] of game.getRevealedCards()) {
+      const roomId = this.gameToRoomPlayerMap.get(gameId) || gameId;
+      record[roomId] = role;
+    }
+    return record;
+  }
 
-    return this.game;
+  /**
+   * @summary Sends each human player what they learned overnight.
+   *
+   * @description
+   * Summaries go straight to each player's own connection, never through
+   * broadcast(), so private results (the lone werewolf's center peek, the
+   * Seer's look) cannot reach other players or spectators. Players who
+   * are offline get the same information in their reconnection view.
+   *
+   * @param {Game} game - Game that just reached dawn
+   *
+   * @private
+   */
+  private sendDawnSummaries(game: Game): void {
+    for (const player of this.players.values()) {
+      const gamePlayerId = this.roomToGamePlayerMap.get(player.id);
+      if (player.isAI || !gamePlayerId || !player.connection.isConnected()) {
+        continue;
+      }
+
+      player.connection.send({
+        type: 'dawnSummary',
+        nightInfo: PlayerViewFactory.createDawnSummary(game, gamePlayerId),
+        timestamp: Date.now()
+      });
+    }
   }
 
   /**
@@ -971,11 +1728,10 @@ export class Room {
   private async runGameAsync(playerList: RoomPlayerInfo[]): Promise<void> {
     if (!this.game) return;
 
-    console.log('runGameAsync starting...');
+    this.logger.debug('Running game');
     try {
-      console.log('Calling game.run()...');
       const result = await this.game.run();
-      console.log('game.run() completed with result:', result.winningTeams);
+      this.logger.info('Game completed', { winningTeams: result.winningTeams });
 
       // Convert maps to records for JSON serialization
       const finalRolesRecord: Record<string, RoleName> = {};
@@ -984,6 +1740,12 @@ export class Room {
         finalRolesRecord[roomId] = role;
       }
 
+      const originalRolesRecord: Record<string, RoleName> = {};
+      for (const [gameId, role] of result.originalRoles) {
+        const roomId = this.gameToRoomPlayerMap.get(gameId) || gameId;
+        originalRolesRecord[roomId] = role;
+      }
+
       const votesRecord: Record<string, string> = {};
       for (const [voterId, targetId] of result.votes) {
         const roomVoterId = this.gameToRoomPlayerMap.get(voterId) || voterId;
@@ -991,6 +1753,20 @@ export class Room {
         votesRecord[roomVoterId] = roomTargetId;
       }
 
+      const voteLog = result.voteLog.map(vote => ({
+        voterId: this.gameToRoomPlayerMap.get(vote.voterId) || vote.voterId,
+        targetId: this.gameToRoomPlayerMap.get(vote.targetId) || vote.targetId,
+        timestamp: vote.timestamp
+      }));
+
+      // A secret ballot keeps the tally but not who cast which vote
+      const anonymousVotes = this.getVoteVisibility() === 'secret';
+      const voteBreakdown = buildVoteBreakdown(
+        votesRecord,
+        new Map(playerList.map(p => [p.id, p.name])),
+        anonymousVotes
+      );
+
       // Map winning/eliminated players to room IDs
       const winningPlayers = result.winningPlayers.map(
         gameId => this.gameToRoomPlayerMap.get(gameId) || gameId
@@ -1000,7 +1776,10 @@ export class Room {
       );
 
       // Build game summary for post-game review
-      const gameSummary = this.buildGameSummary(playerList, votesRecord);
+      const gameSummary = this.buildGameSummary(playerList, anonymousVotes ? {} : votesRecord);
+
+      // Get final center cards
+      const centerCards = this.game.getCenterCards();
 
       // Game completed - send results to all players
       // Create serializable result (Maps -> Records for JSON)
@@ -1008,9 +1787,22 @@ export class Room {
         winningTeams: [...result.winningTeams],
         winningPlayers,
         eliminatedPlayers,
+        originalRoles: originalRolesRecord,
         finalRoles: finalRolesRecord,
-        votes: votesRecord
+        votes: anonymousVotes ? {} : votesRecord,
+        ...(!anonymousVotes && { voteLog }),
+        voteBreakdown,
+        revealEmphasis: result.revealEmphasis,
+        revealSequence: [...result.revealSequence],
+        originalCenterCards: [...result.originalCenterCards],
+        centerCards,
+        nightActions: gameSummary.nightActions
       };
+      this.finalResult = serializableResult;
+
+      for (const playerId of winningPlayers) {
+        this.scores.set(playerId, (this.scores.get(playerId) ?? 0) + 1);
+      }
 
       // Save votes to database (queued with retry)
       for (const [voterId, targetId] of result.votes) {
@@ -1020,27 +1812,41 @@ export class Room {
       // Save game results to database (queued with retry)
       this.enqueueGameResultsSave(serializableResult, playerList);
 
-      // Get final center cards
-      const centerCards = this.game.getCenterCards();
+      const gameEndMessage: ServerMessage = {
+        type: 'gameEnd',
+        result: serializableResult,
+        finalRoles: finalRolesRecord,
+        centerCards,
+        summary: gameSummary,
+        timestamp: Date.now()
+      };
 
       for (const roomPlayer of playerList) {
         if (roomPlayer.connection.isConnected()) {
-          const message: ServerMessage = {
-            type: 'gameEnd',
-            result: serializableResult,
-            finalRoles: finalRolesRecord,
-            centerCards,
-            summary: gameSummary,
-            timestamp: Date.now()
-          };
-          roomPlayer.connection.send(message);
+          roomPlayer.connection.send(gameEndMessage);
         }
       }
 
+      // The reveal is public, but spectators see it on the stream delay
+      this.spectators.send(gameEndMessage);
+
       this.status = RoomStatus.ENDED;
       this.emitEvent('gameEnded', { result });
+
+      // Lets clients show the updated scores and offer the next round
+      this.broadcastRoomState();
     } catch (error) {
-      console.error('Game error:', error);
+      if (error instanceof GameCancelledError) {
+        // Stopped on purpose. If the game was cancelled directly rather
+        // than by closing the room, close it now so it is cleaned up.
+        this.logger.info('Game stopped', { reason: error.message });
+        if (this.status !== RoomStatus.CLOSED) {
+          this.close(error.reason);
+        }
+        return;
+      }
+
+      this.logger.error('Game error', { error });
       // Notify players of error
       for (const roomPlayer of playerList) {
         if (roomPlayer.connection.isConnected()) {
@@ -1053,7 +1859,35 @@ export class Room {
           roomPlayer.connection.send(message);
         }
       }
+
+      // The game cannot continue; close this room so it is cleaned up
+      // rather than left playing forever
+      if (this.status !== RoomStatus.CLOSED) {
+        this.close('Game error');
+      }
+    }
+  }
+
+  /**
+   * @summary Builds the full night action log for the reveal.
+   *
+   * @param {Map<string, string>} playerNames - Map of room player IDs to names
+   *
+   * @returns {NightActionSummary[]} Every night action, in the order it happened
+   *
+   * @private
+   */
+  private buildNightActionLog(playerNames: Map<string, string>): NightActionSummary[] {
+    if (!this.game) {
+      return [];
     }
+
+    const players = new Map<string, NightLogPlayer>();
+    for (const [gameId, roomId] of this.gameToRoomPlayerMap) {
+      players.set(gameId, { id: roomId, name: playerNames.get(roomId) || roomId });
+    }
+
+    return buildNightActionLog(this.game.getAllNightResults(), players, this.game.getNightOrder());
   }
 
   /**
@@ -1092,28 +1926,8 @@ export class Room {
       playerNames.set(player.id, player.name);
     }
 
-    // Get all night actions and create summaries
-    const nightResults = this.game.getAllNightResults();
-    const nightActions: NightActionSummary[] = nightResults.map(result => {
-      const roomPlayerId = this.gameToRoomPlayerMap.get(result.actorId) || result.actorId;
-      const playerName = playerNames.get(roomPlayerId) || roomPlayerId;
-
-      return {
-        playerId: roomPlayerId,
-        playerName,
-        roleName: result.roleName,
-        description: this.describeNightAction(result, playerNames)
-      };
-    });
-
-    // Sort night actions by wake order
-    const wakeOrderMap = new Map<RoleName, number>();
-    NIGHT_WAKE_ORDER.forEach((role, index) => wakeOrderMap.set(role, index));
-    nightActions.sort((a, b) => {
-      const orderA = wakeOrderMap.get(a.roleName as RoleName) ?? 999;
-      const orderB = wakeOrderMap.get(b.roleName as RoleName) ?? 999;
-      return orderA - orderB;
-    });
+    // Every night action, in the order it happened
+    const nightActions = this.buildNightActionLog(playerNames);
 
     // Get statements and map player IDs to names
     const rawStatements = this.game.getStatements();
@@ -1195,209 +2009,7 @@ export class Room {
   }
 
   /**
-   * @summary Creates a human-readable description of a night action.
-   *
-   * @param {NightActionResult} result - The night action result
-   * @param {Map<string, string>} playerNames - Map of player IDs to names
-   *
-   * @returns {string} Human-readable description
-   *
-   * @private
-   */
-  private describeNightAction(
-    result: { roleName: RoleName; actionType: string; info: unknown },
-    playerNames: Map<string, string>
-  ): string {
-    const info = result.info as Record<string, unknown> || {};
-
-    switch (result.roleName) {
-      case RoleName.DOPPELGANGER: {
-        const copied = info.copied as { fromPlayerId: string; role: string } | undefined;
-        if (copied) {
-          const roomId = this.gameToRoomPlayerMap.get(copied.fromPlayerId) || copied.fromPlayerId;
-          const targetName = playerNames.get(roomId) || roomId;
-          let description = `Looked at ${targetName}'s card and became ${copied.role}`;
-
-          // Add details of the copied role's action
-          const copiedRole = copied.role;
-          const swapped = info.swapped as { from: { playerId?: string; centerIndex?: number }; to: { playerId?: string; centerIndex?: number } } | undefined;
-          const viewed = info.viewed as Array<{ playerId?: string; centerIndex?: number; role: string }> | undefined;
-
-          if (copiedRole === 'ROBBER' && swapped && viewed && viewed.length > 1) {
-            const robbedRoomId = this.gameToRoomPlayerMap.get(swapped.to.playerId || '') || swapped.to.playerId;
-            const robbedName = playerNames.get(robbedRoomId || '') || robbedRoomId;
-            const newRole = viewed[1]?.role || 'unknown';
-            description += `. Then robbed ${robbedName} and got ${newRole}`;
-          } else if (copiedRole === 'SEER' && viewed && viewed.length > 1) {
-            const seerViews = viewed.slice(1);
-            if (seerViews[0]?.playerId) {
-              const viewedRoomId = this.gameToRoomPlayerMap.get(seerViews[0].playerId) || seerViews[0].playerId;
-              const viewedName = playerNames.get(viewedRoomId) || viewedRoomId;
-              description += `. Then viewed ${viewedName}'s card: ${seerViews[0].role}`;
-            } else if (seerViews.length >= 2) {
-              const cards = seerViews.map(v => `Card ${(v.centerIndex || 0) + 1} = ${v.role}`).join(', ');
-              description += `. Then viewed center: ${cards}`;
-            }
-          } else if (copiedRole === 'TROUBLEMAKER' && swapped) {
-            const name1RoomId = this.gameToRoomPlayerMap.get(swapped.from.playerId || '') || swapped.from.playerId;
-            const name2RoomId = this.gameToRoomPlayerMap.get(swapped.to.playerId || '') || swapped.to.playerId;
-            const name1 = playerNames.get(name1RoomId || '') || name1RoomId;
-            const name2 = playerNames.get(name2RoomId || '') || name2RoomId;
-            description += `. Then swapped ${name1} and ${name2}'s cards`;
-          } else if (copiedRole === 'DRUNK' && swapped && swapped.to.centerIndex !== undefined) {
-            description += `. Then swapped with center card ${swapped.to.centerIndex + 1}`;
-          } else if (copiedRole === 'WEREWOLF') {
-            const werewolves = info.werewolves as string[] | undefined;
-            if (werewolves && werewolves.length > 0) {
-              const names = werewolves.map(id => {
-                const wRoomId = this.gameToRoomPlayerMap.get(id) || id;
-                return playerNames.get(wRoomId) || wRoomId;
-              });
-              description += `. Saw Werewolf(s): ${names.join(', ')}`;
-            } else if (viewed && viewed.length > 1 && viewed[1].centerIndex !== undefined) {
-              description += `. Lone wolf - peeked at center card ${viewed[1].centerIndex + 1}: ${viewed[1].role}`;
-            }
-          } else if (copiedRole === 'MINION') {
-            const werewolves = info.werewolves as string[] | undefined;
-            if (werewolves && werewolves.length > 0) {
-              const names = werewolves.map(id => {
-                const wRoomId = this.gameToRoomPlayerMap.get(id) || id;
-                return playerNames.get(wRoomId) || wRoomId;
-              });
-              description += `. Saw Werewolf(s): ${names.join(', ')}`;
-            } else {
-              description += `. No Werewolves among players`;
-            }
-          } else if (copiedRole === 'MASON') {
-            const masons = info.masons as string[] | undefined;
-            if (masons && masons.length > 0) {
-              const names = masons.map(id => {
-                const mRoomId = this.gameToRoomPlayerMap.get(id) || id;
-                return playerNames.get(mRoomId) || mRoomId;
-              });
-              description += `. Saw fellow Mason(s): ${names.join(', ')}`;
-            } else {
-              description += `. No other Masons`;
-            }
-          }
-
-          return description;
-        }
-
-        // Handle Doppel-Insomniac end-of-night wake (no copied info, just viewed)
-        const viewed = info.viewed as Array<{ playerId?: string; role: string }> | undefined;
-        if (viewed && viewed.length > 0 && viewed[0].playerId) {
-          const finalRole = viewed[0].role;
-          return `Woke as Doppel-Insomniac and saw final card: ${finalRole}`;
-        }
-
-        return 'Copied another player\'s role';
-      }
-
-      case RoleName.WEREWOLF: {
-        const werewolves = info.werewolves as string[] | undefined;
-        if (werewolves && werewolves.length > 0) {
-          const names = werewolves.map(id => {
-            const roomId = this.gameToRoomPlayerMap.get(id) || id;
-            return playerNames.get(roomId) || roomId;
-          });
-          return `Saw fellow Werewolf(s): ${names.join(', ')}`;
-        }
-        // Lone wolf - check if they viewed a center card
-        const viewed = info.viewed as Array<{ centerIndex: number; role: string }> | undefined;
-        if (viewed && viewed.length > 0) {
-          const card = viewed[0];
-          return `Lone wolf - peeked at center card ${card.centerIndex + 1}: ${card.role}`;
-        }
-        return 'Woke up (no other Werewolves)';
-      }
-
-      case RoleName.MINION: {
-        const werewolves = info.werewolves as string[] | undefined;
-        if (werewolves && werewolves.length > 0) {
-          const names = werewolves.map(id => {
-            const roomId = this.gameToRoomPlayerMap.get(id) || id;
-            return playerNames.get(roomId) || roomId;
-          });
-          return `Saw Werewolf(s): ${names.join(', ')}`;
-        }
-        return 'No Werewolves among players';
-      }
-
-      case RoleName.MASON: {
-        const masons = info.masons as string[] | undefined;
-        if (masons && masons.length > 0) {
-          const names = masons.map(id => {
-            const roomId = this.gameToRoomPlayerMap.get(id) || id;
-            return playerNames.get(roomId) || roomId;
-          });
-          return `Saw fellow Mason(s): ${names.join(', ')}`;
-        }
-        return 'No other Masons';
-      }
-
-      case RoleName.SEER: {
-        const viewed = info.viewed as Array<{ playerId?: string; centerIndex?: number; role: string }> | undefined;
-        if (viewed && viewed.length > 0) {
-          if (viewed[0].playerId) {
-            const roomId = this.gameToRoomPlayerMap.get(viewed[0].playerId) || viewed[0].playerId;
-            const name = playerNames.get(roomId) || roomId;
-            return `Viewed ${name}'s card: ${viewed[0].role}`;
-          } else {
-            return `Viewed center cards: ${viewed.map(v => `Card ${(v.centerIndex || 0) + 1} = ${v.role}`).join(', ')}`;
-          }
-        }
-        return 'Viewed cards';
-      }
-
-      case RoleName.ROBBER: {
-        const swapped = info.swapped as { from: { playerId: string }; to: { playerId: string } } | undefined;
-        const viewed = info.viewed as Array<{ playerId: string; role: string }> | undefined;
-        if (swapped && viewed && viewed.length > 0) {
-          const targetId = swapped.to.playerId;
-          const stolenRole = viewed[0].role;
-          const roomId = this.gameToRoomPlayerMap.get(targetId) || targetId;
-          const name = playerNames.get(roomId) || roomId;
-          return `Robbed ${name} and became ${stolenRole}`;
-        }
-        return 'Robbed a player';
-      }
-
-      case RoleName.TROUBLEMAKER: {
-        const swapped = info.swapped as { from: { playerId: string }; to: { playerId: string } } | undefined;
-        if (swapped) {
-          const roomId1 = this.gameToRoomPlayerMap.get(swapped.from.playerId) || swapped.from.playerId;
-          const roomId2 = this.gameToRoomPlayerMap.get(swapped.to.playerId) || swapped.to.playerId;
-          const name1 = playerNames.get(roomId1) || roomId1;
-          const name2 = playerNames.get(roomId2) || roomId2;
-          return `Swapped ${name1} and ${name2}'s cards`;
-        }
-        return 'Swapped two players\' cards';
-      }
-
-      case RoleName.DRUNK: {
-        const centerIndex = info.centerCardIndex as number | undefined;
-        if (centerIndex !== undefined) {
-          return `Swapped with center card ${centerIndex + 1}`;
-        }
-        return 'Swapped with a center card';
-      }
-
-      case RoleName.INSOMNIAC: {
-        const finalRole = info.currentRole as string | undefined;
-        if (finalRole) {
-          return `Checked their card: still ${finalRole}`;
-        }
-        return 'Checked their final card';
-      }
-
-      default:
-        return result.actionType || 'No action';
-    }
-  }
-
-  /**
-   * @summary Ends the current game.
+   * @summary Ends the current game.
    *
    * @param {Record<string, unknown>} [result] - Game result data
    */
@@ -1407,6 +2019,7 @@ export class Room {
     }
 
     this.status = RoomStatus.ENDED;
+    this.clearDayDeadline();
 
     this.emitEvent('gameEnded', {
       result: result ?? {}
@@ -1419,8 +2032,10 @@ export class Room {
    * @summary Closes the room.
    *
    * @param {string} [reason] - Reason for closing
+   * @param {CloseCode} [code] - Close code for the players' connections
    */
-  close(reason?: string): void {
+  close(reason?: string, code?: CloseCode): void {
+    this.cancelGame(reason ?? 'Room closed');
     this.status = RoomStatus.CLOSED;
 
     this.emitEvent('roomClosed', {
@@ -1434,15 +2049,105 @@ export class Room {
       timestamp: Date.now()
     };
     this.broadcast(closeMessage);
+    this.spectators.sendImmediate(closeMessage);
+    this.spectators.dispose();
 
     // Disconnect all players
     for (const player of this.players.values()) {
-      player.connection.close(reason);
+      player.connection.close(reason, code);
     }
 
     this.players.clear();
   }
 
+  /**
+   * @summary Stops a running game.
+   *
+   * @description
+   * Cancels the game's run loop and disposes its network agents so any
+   * request still waiting on a player is released immediately instead
+   * of running out its timeout.
+   *
+   * @param {string} reason - Why the game is stopping
+   *
+   * @private
+   */
+  private cancelGame(reason: string): void {
+    if (!this.game || this.status !== RoomStatus.PLAYING) {
+      return;
+    }
+
+    this.clearDayDeadline();
+    this.game.cancel(reason);
+
+    for (const agent of this.networkAgents.values()) {
+      agent.dispose();
+    }
+  }
+
+  /**
+   * @summary Adds a spectator to the room.
+   *
+   * @description
+   * Spectators receive every public broadcast, delayed by the room's
+   * spectatorDelayMs. They never receive private messages (roles,
+   * night results, action requests) because those are sent directly
+   * to player connections rather than broadcast.
+   *
+   * @param {PlayerId} spectatorId - Spectator ID
+   * @param {IClientConnection} connection - Spectator connection
+   *
+   * @throws {Error} If spectators are disabled, the room is closed, or the ID is a player
+   */
+  addSpectator(spectatorId: PlayerId, connection: IClientConnection): void {
+    if (!this.config.allowSpectators) {
+      throw new Error('This room does not allow spectators');
+    }
+
+    if (this.status === RoomStatus.CLOSED) {
+      throw new Error('Room is closed');
+    }
+
+    if (this.players.has(spectatorId)) {
+      throw new Error('Players cannot spectate their own room');
+    }
+
+    this.spectators.addSpectator(spectatorId, connection);
+  }
+
+  /**
+   * @summary Removes a spectator from the room.
+   *
+   * @param {PlayerId} spectatorId - Spectator ID
+   *
+   * @returns {boolean} True if the spectator was watching
+   */
+  removeSpectator(spectatorId: PlayerId): boolean {
+    return this.spectators.removeSpectator(spectatorId);
+  }
+
+  /**
+   * @summary Gets the spectator broadcast delay.
+   *
+   * @returns {number} Delay in milliseconds
+   */
+  getSpectatorDelayMs(): number {
+    return this.spectators.getDelayMs();
+  }
+
+  /**
+   * @summary Gets the room state as spectators currently see it.
+   *
+   * @description
+   * With a spectator delay this lags the live state by that delay, so a
+   * new spectator doesn't learn anything the stream hasn't shown yet.
+   *
+   * @returns {RoomState} Delayed room state
+   */
+  getSpectatorState(): RoomState {
+    return this.spectators.getReleasedState() ?? this.getState();
+  }
+
   /**
    * @summary Registers an event handler.
    *
@@ -1475,7 +2180,7 @@ export class Room {
       try {
         handler(event);
       } catch (error) {
-        console.error('Error in room event handler:', error);
+        this.logger.error('Error in room event handler', { error });
       }
     }
   }
@@ -1491,29 +2196,153 @@ export class Room {
         try {
           player.connection.send(message);
         } catch (error) {
-          console.error(`Failed to send to ${player.id}:`, error);
+          this.logger.warn('Failed to send message', { playerId: player.id, error });
+          this.emitEvent('broadcastFailed', { playerId: player.id });
         }
       }
     }
+
+    // Spectators get the same public stream, on a delay
+    this.spectators.send(message);
   }
 
   /**
    * @summary Gets the time remaining in the current phase.
    *
    * @description
-   * Computes remaining time based on phase start time and duration.
+   * Computes remaining time from the phase deadline. While the clock is
+   * paused this is the time that was left when the last human dropped.
    * Returns null if phase has no time limit or timing info is not available.
    *
    * @returns {number | null} Remaining time in seconds, or null
    */
   getTimeRemaining(): number | null {
-    if (this.phaseStartedAt === null || this.phaseDurationMs === null) {
-      return null;
+    return this.phaseClock.getTimeRemaining();
+  }
+
+  /**
+   * @summary Gets the absolute deadline of the current phase.
+   *
+   * @description
+   * Clients compare this against their own clock so every player's
+   * countdown ends at the same moment, including after a reconnect.
+   *
+   * @returns {number | null} Deadline as epoch milliseconds, or null if untimed
+   */
+  getPhaseEndsAt(): number | null {
+    return this.phaseClock.getEndsAt();
+  }
+
+  /**
+   * @summary Records that a player's connection dropped.
+   *
+   * @description
+   * Mid-game, pauses the phase clock once no human player is left
+   * connected, so the remaining discussion or voting time is kept for
+   * whoever comes back first, and emits 'gameAbandoned' so the room can
+   * be reclaimed if nobody does. In the lobby, a dropped host emits
+   * 'hostDisconnected' so the host role can be passed on if they don't
+   * come back.
+   *
+   * @param {PlayerId} playerId - Player who disconnected
+   */
+  handlePlayerDisconnected(playerId: PlayerId): void {
+    if (!this.players.has(playerId)) {
+      return;
+    }
+
+    // The lobby waits a grace period for its host before passing the role on
+    if (this.status === RoomStatus.WAITING && playerId === this.hostId) {
+      this.emitEvent('hostDisconnected', { playerId });
+      this.broadcastRoomState();
+      return;
+    }
+
+    if (this.status !== RoomStatus.PLAYING) {
+      return;
+    }
+
+    this.broadcastConnectionChange(playerId, false);
+    this.checkAbandoned();
+    this.endDayIfAllReadyToVote();
+  }
+
+  /**
+   * @summary Tells everyone a player's connection dropped or came back.
+   *
+   * @description
+   * Sends playerDisconnected or playerReconnected, then the room state,
+   * so the table can decide whether to wait for a missing player.
+   *
+   * @param {PlayerId} playerId - Player whose connection changed
+   * @param {boolean} connected - Whether the player is now connected
+   *
+   * @private
+   */
+  private broadcastConnectionChange(playerId: PlayerId, connected: boolean): void {
+    const player = this.players.get(playerId);
+    if (!player) {
+      return;
+    }
+
+    const message: ServerMessage = connected
+      ? {
+        type: 'playerReconnected',
+        playerId,
+        playerName: player.name,
+        timestamp: Date.now()
+      }
+      : {
+        type: 'playerDisconnected',
+        playerId,
+        playerName: player.name,
+        aiTakeover: false,
+        timestamp: Date.now()
+      };
+    this.broadcast(message);
+    this.broadcastRoomState();
+  }
+
+  /**
+   * @summary Gets the result of the room's finished game.
+   *
+   * @returns {SerializableGameResult | null} Result, or null until the game has ended
+   */
+  getFinalResult(): SerializableGameResult | null {
+    return this.finalResult;
+  }
+
+  /**
+   * @summary Checks whether a running game has no human player connected.
+   *
+   * @returns {boolean} True while abandoned
+   */
+  isAbandoned(): boolean {
+    return this.abandoned;
+  }
+
+  /**
+   * @summary Marks the game abandoned if no human player is connected.
+   *
+   * @description
+   * Pauses the phase clock and emits 'gameAbandoned' the first time the
+   * last human drops. Called again on each phase change so a new phase
+   * that starts while everyone is away starts paused.
+   *
+   * @private
+   */
+  private checkAbandoned(): void {
+    const humans = Array.from(this.players.values()).filter(p => !p.isAI);
+    if (humans.length === 0 || humans.some(p => p.connection.isConnected())) {
+      return;
     }
 
-    const elapsed = Date.now() - this.phaseStartedAt;
-    const remaining = Math.max(0, this.phaseDurationMs - elapsed);
-    return Math.ceil(remaining / 1000); // Return seconds
+    this.phaseClock.pause();
+
+    if (!this.abandoned) {
+      this.abandoned = true;
+      this.emitEvent('gameAbandoned', {});
+    }
   }
 
   /**
@@ -1554,6 +2383,11 @@ export class Room {
   /**
    * @summary Gets the current room state for clients.
    *
+   * @description
+   * Sent to everyone in the room on every lobby change (joins, leaves,
+   * ready toggles), so it carries only what all players may see: seats,
+   * readiness and configuration, never dealt roles.
+   *
    * @returns {RoomState} Room state
    */
   getState(): RoomState {
@@ -1570,12 +2404,297 @@ export class Room {
       roomCode: this.code,
       hostId: this.hostId,
       players,
+      // Host is always ready, as in canStart
+      readyCount: players.filter(p => p.isReady || p.isHost).length,
+      playerCount: players.length,
       config: this.config,
       status: this.getProtocolStatus(),
-      createdAt: this.createdAt
+      createdAt: this.createdAt,
+      round: this.round,
+      scores: Object.fromEntries(players.map(p => [p.id, this.scores.get(p.id) ?? 0]))
+    };
+  }
+
+  /**
+   * @summary Gets the room's public state for sharing outside the game.
+   *
+   * @description
+   * Unlike getState(), this leaves out player IDs and the room config
+   * beyond its name and roles, so it can be served to anyone holding
+   * the room code. No dealt roles are included at any point.
+   *
+   * @returns {PublicGameState} Sanitized room and game state
+   */
+  getPublicState(): PublicGameState {
+    const players = Array.from(this.players.values()).map(p => ({
+      name: p.name,
+      isReady: p.isReady,
+      isHost: p.id === this.hostId,
+      isConnected: p.connection.isConnected()
+    }));
+
+    // Votes are only recorded once everyone has voted
+    const tally = new Map<string, number>();
+    for (const targetId of this.game?.getVotes().values() ?? []) {
+      const roomId = this.gameToRoomPlayerMap.get(targetId);
+      const name = roomId ? this.players.get(roomId)?.name : undefined;
+      if (name) {
+        tally.set(name, (tally.get(name) ?? 0) + 1);
+      }
+    }
+
+    return {
+      roomCode: this.code,
+      roomName: this.config.roomName,
+      status: this.getProtocolStatus(),
+      players,
+      roles: this.config.roles,
+      phase: this.game ? this.game.getPhase() : null,
+      timeRemaining: this.getTimeRemaining(),
+      voteCounts: Array.from(tally, ([name, votes]) => ({ name, votes }))
+    };
+  }
+
+  // ==========================================================================
+  // PERSISTENCE
+  // ==========================================================================
+
+  /**
+   * @summary Captures the room and its game for persistence.
+   *
+   * @returns {RoomSnapshot} JSON-serializable snapshot
+   *
+   * @throws {Error} If no game is in progress
+   */
+  toSnapshot(): RoomSnapshot {
+    if (!this.game) {
+      throw new Error('Room has no game in progress');
+    }
+
+    return {
+      code: this.code,
+      hostId: this.hostId,
+      config: { ...this.config },
+      createdAt: this.createdAt,
+      gameStartedAt: this.gameStartedAt,
+      players: Array.from(this.players.values()).map(p => ({
+        id: p.id,
+        name: p.name,
+        isAI: p.isAI,
+        joinedAt: p.joinedAt,
+        userId: p.userId,
+        gamePlayerId: this.roomToGamePlayerMap.get(p.id)!,
+        token: p.token
+      })),
+      phaseEndsAt: this.phaseClock.getEndsAt(),
+      dbGameId: this.dbGameId,
+      dbPlayerIds: Object.fromEntries(this.dbPlayerIds),
+      game: this.game.toSnapshot(),
+      round: this.round,
+      scores: Object.fromEntries(this.scores),
+      savedAt: Date.now()
     };
   }
 
+  /**
+   * @summary Restores a room from a snapshot and resumes its game.
+   *
+   * @description
+   * AI players get fresh agents and carry on immediately. Human players
+   * are seated with a DetachedConnection until they authenticate again,
+   * at which point reattachPlayer() moves them onto their new socket.
+   * The game resumes from the start of the snapshot's phase.
+   *
+   * @param {RoomSnapshot} snapshot - Snapshot from toSnapshot()
+   *
+   * @returns {Room} Restored room, already running
+   *
+   * @example
+   * ```typescript
+   * for (const snapshot of store.loadGames()) {
+   *   rooms.set(snapshot.code, Room.fromSnapshot(snapshot));
+   * }
+   * ```
+   */
+  static fromSnapshot(snapshot: RoomSnapshot): Room {
+    const room = new Room(snapshot.hostId, snapshot.config, snapshot.code);
+    room.createdAt = snapshot.createdAt;
+    room.gameStartedAt = snapshot.gameStartedAt;
+    room.phaseClock.restore(snapshot.phaseEndsAt, snapshot.savedAt);
+    room.dbGameId = snapshot.dbGameId;
+    room.dbPlayerIds = new Map(Object.entries(snapshot.dbPlayerIds));
+    room.round = snapshot.round ?? 1;
+    for (const [playerId, score] of Object.entries(snapshot.scores ?? {})) {
+      room.scores.set(playerId, score);
+    }
+
+    for (const saved of snapshot.players) {
+      room.players.set(saved.id, {
+        id: saved.id,
+        name: saved.name,
+        connection: saved.isAI ? NullConnection.create(saved.id) : DetachedConnection.create(saved.id),
+        isReady: true,
+        isAI: saved.isAI,
+        joinedAt: saved.joinedAt,
+        token: saved.token ?? createPlayerToken(),
+        userId: saved.userId
+      });
+      room.roomToGamePlayerMap.set(saved.id, saved.gamePlayerId);
+      room.gameToRoomPlayerMap.set(saved.gamePlayerId, saved.id);
+    }
+
+    const game = Game.fromSnapshot(snapshot.game);
+    room.game = game;
+    room.status = RoomStatus.PLAYING;
+
+    // Nobody is attached yet; the manager reclaims the room if no one returns
+    room.checkAbandoned();
+
+    const playerList = Array.from(room.players.values());
+    game.registerAgents(room.createAgents(playerList));
+    room.observeGame(game);
+    room.runGameAsync(playerList);
+
+    return room;
+  }
+
+  /**
+   * @summary Checks whether a player is seated but has no live socket.
+   *
+   * @description
+   * True for human players of a restored room who have not yet
+   * reconnected since the server restarted.
+   *
+   * @param {PlayerId} playerId - Player ID
+   *
+   * @returns {boolean} True if the player is waiting to be re-attached
+   */
+  isPlayerDetached(playerId: PlayerId): boolean {
+    const player = this.players.get(playerId);
+    return player !== undefined && player.connection instanceof DetachedConnection;
+  }
+
+  /**
+   * @summary Moves a player onto a new connection mid-game.
+   *
+   * @description
+   * Updates the player's room connection and points their NetworkAgent
+   * at it, re-sending any action request they had not answered yet.
+   * If the phase clock was paused because everyone had left, it resumes
+   * with the time that was left and 'gameResumed' is emitted. Everyone
+   * else is told the player is back.
+   *
+   * @param {PlayerId} playerId - Player ID
+   * @param {IClientConnection} connection - Player's new connection
+   *
+   * @returns {boolean} True if the player was found and re-attached
+   */
+  reattachPlayer(playerId: PlayerId, connection: IClientConnection): boolean {
+    const player = this.players.get(playerId);
+    if (!player || player.isAI) {
+      return false;
+    }
+
+    player.connection = connection;
+    this.networkAgents.get(playerId)?.setConnection(connection);
+    this.phaseClock.resume();
+    this.scheduleDayDeadline();
+
+    if (this.status === RoomStatus.PLAYING) {
+      this.broadcastConnectionChange(playerId, true);
+    }
+
+    if (this.abandoned) {
+      this.abandoned = false;
+      this.emitEvent('gameResumed', { playerId });
+    }
+    return true;
+  }
+
+  /**
+   * @summary Re-sends a player's most recent night result.
+   *
+   * @description
+   * A nightResult sent while the player's socket was down is lost, and
+   * clients show the Seer's look or the Robber's new card from that
+   * message. Called after a reconnecting player has been sent the game
+   * state; goes to the player's own connection only.
+   *
+   * @param {PlayerId} playerId - Room player ID
+   *
+   * @returns {boolean} True if a result was sent
+   */
+  resendLatestNightResult(playerId: PlayerId): boolean {
+    const player = this.players.get(playerId);
+    const gamePlayerId = this.roomToGamePlayerMap.get(playerId);
+    if (!this.game || !player || !gamePlayerId) {
+      return false;
+    }
+
+    const results = this.game.getPlayerNightInfo(gamePlayerId);
+    if (results.length === 0) {
+      return false;
+    }
+
+    player.connection.send({
+      type: 'nightResult',
+      result: results[results.length - 1],
+      timestamp: Date.now()
+    });
+    return true;
+  }
+
+  /**
+   * @summary Gets the game player ID for a room player.
+   *
+   * @param {PlayerId} playerId - Room player ID
+   *
+   * @returns {string | undefined} Game player ID, if the game has started
+   */
+  getGamePlayerId(playerId: PlayerId): string | undefined {
+    return this.roomToGamePlayerMap.get(playerId);
+  }
+
+  /**
+   * @summary Builds a player's private view of the running game.
+   *
+   * @description
+   * The same sanitized view sent in gameState messages: the player's own
+   * role and night results, public player info, and the current phase
+   * with its deadline. Nothing belonging to other players is included.
+   *
+   * @param {PlayerId} playerId - Room player ID
+   *
+   * @returns {SerializablePlayerGameView | undefined} View, or undefined if
+   *   no game is running or the player has no seat in it
+   */
+  getPlayerView(playerId: PlayerId): SerializablePlayerGameView | undefined {
+    const gamePlayerId = this.roomToGamePlayerMap.get(playerId);
+    if (!this.game || !gamePlayerId) {
+      return undefined;
+    }
+
+    return PlayerViewFactory.createView(
+      this.game,
+      gamePlayerId,
+      this.getTimeRemaining(),
+      this.getPhaseEndsAt(),
+      this.getVoteVisibility()
+    );
+  }
+
+  /**
+   * @summary Gets who may see how others voted, from the room's settings.
+   *
+   * @returns {VoteVisibility} 'public' unless the room uses a secret ballot
+   */
+  getVoteVisibility(): VoteVisibility {
+    if (!this.config.anonymousVotes) {
+      return 'public';
+    }
+    return this.config.revealVotesAtEnd ? 'secretUntilEnd' : 'secret';
+  }
+
   // ==========================================================================
   // DATABASE INTEGRATION
   // ==========================================================================
@@ -1603,7 +2722,7 @@ export class Room {
   private async saveGameToDatabase(playerList: RoomPlayerInfo[]): Promise<void> {
     const db = getDatabase();
     if (!db.isConnected()) {
-      console.log('Database not connected, skipping game save');
+      this.logger.debug('Database not connected, skipping game save');
       return;
     }
 
@@ -1618,14 +2737,17 @@ export class Room {
         hostUserId: hostUserId,
         roomCode: this.code,
         playerCount: playerList.length,
-        selectedRoles: [...this.config.roles], // Spread to create mutable array
+        selectedRoles: this.game
+          ? (this.game.getRolesInGame() as RoleName[])
+          : [...this.config.roles], // Resolved roles (fill mode may differ from config)
         dayDurationSeconds: Math.floor(this.dayDurationMs / 1000),
         voteDurationSeconds: Math.floor(this.votingDurationMs / 1000),
         isPrivate: true,
-        allowSpectators: false
+        allowSpectators: false,
+        voteVisibility: this.getVoteVisibility()
       });
 
-      console.log(`Game saved to database with ID: ${this.dbGameId}`);
+      this.logger.info('Game saved to database', { dbGameId: this.dbGameId });
 
       // Add players to database
       for (let i = 0; i < playerList.length; i++) {
@@ -1656,9 +2778,9 @@ export class Room {
       // Update game status
       await this.gameRepository.updateStatus(this.dbGameId, 'night');
 
-      console.log(`Game ${this.dbGameId}: ${playerList.length} players saved`);
+      this.logger.debug('Players saved to database', { dbGameId: this.dbGameId, players: playerList.length });
     } catch (error) {
-      console.error('Error saving game to database:', error);
+      this.logger.error('Error saving game to database', { error });
       this.dbGameId = null;
     }
   }
@@ -1712,7 +2834,7 @@ export class Room {
         teammates: this.extractTeammates(details)
       });
     } catch (error) {
-      console.error('Error saving night action to database:', error);
+      this.logger.error('Error saving night action to database', { error });
     }
   }
 
@@ -1753,7 +2875,7 @@ export class Room {
         sequenceOrder: this.statementSequence++
       });
     } catch (error) {
-      console.error('Error saving statement to database:', error);
+      this.logger.error('Error saving statement to database', { error });
     }
   }
 
@@ -1801,7 +2923,7 @@ export class Room {
         isFinal: true
       });
     } catch (error) {
-      console.error('Error saving vote to database:', error);
+      this.logger.error('Error saving vote to database', { error });
     }
   }
 
@@ -1912,9 +3034,9 @@ export class Room {
         winConditions
       });
 
-      console.log(`Game ${this.dbGameId}: Results saved to database`);
+      this.logger.info('Game results saved to database', { dbGameId: this.dbGameId });
     } catch (error) {
-      console.error('Error saving game results to database:', error);
+      this.logger.error('Error saving game results to database', { error });
     }
   }
 
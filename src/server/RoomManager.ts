@@ -10,6 +10,7 @@
  * - Looking up rooms by code
  * - Tracking room lifecycles
  * - Cleaning up inactive rooms
+ * - Cancelling games every human player has walked away from
  *
  * @pattern Repository Pattern - Central store for room instances
  * @pattern Factory Pattern - Creates room instances
@@ -29,8 +30,34 @@
  * ```
  */
 
-import { Room, RoomStatus, generateRoomCode, RoomEvent } from './Room';
-import { RoomCode, RoomConfig, PlayerId, RoomSummary, DebugOptions } from '../network/protocol';
+import {
+  Room,
+  RoomStatus,
+  generateRoomCode,
+  RoomEvent,
+  validateRoleConfig,
+  validatePlayerLimits,
+  validateTieBreak
+} from './Room';
+import {
+  RoomCode,
+  RoomConfig,
+  PlayerId,
+  RoomSummary,
+  PublicRoomInfo,
+  DebugOptions,
+  ErrorCodes,
+  PublicGameState,
+  CloseCodes,
+  createErrorMessage
+} from '../network/protocol';
+import { GamePhase } from '../enums';
+import { NullConnection } from '../network/IClientConnection';
+import { IGameSnapshotStore } from './GameSnapshotStore';
+import { IRoomStore, InMemoryRoomStore } from './RoomStore';
+import { GameMetrics, NULL_METRICS } from './Metrics';
+import { GameTracer, NOOP_TRACER, traceRoom } from './Tracing';
+import { getLogger, Logger } from '../utils/logger';
 
 /**
  * @summary Room manager configuration.
@@ -39,14 +66,26 @@ export interface RoomManagerConfig {
   /** Maximum rooms allowed */
   maxRooms: number;
 
-  /** Room timeout in milliseconds (for inactive waiting rooms) */
+  /** How long a waiting room with no connected players may live (milliseconds) */
   roomTimeoutMs: number;
 
-  /** How often to check for inactive rooms (milliseconds) */
+  /** How often the sweeper checks for stale rooms (milliseconds) */
   cleanupIntervalMs: number;
 
   /** Maximum room code generation attempts */
   maxCodeAttempts: number;
+
+  /** How long a game may run with no human connected before it is cancelled (milliseconds) */
+  abandonedGameTimeoutMs: number;
+
+  /** How long a lobby waits for a disconnected host before passing the role on (milliseconds) */
+  hostDisconnectGraceMs: number;
+
+  /** Where game lifecycle metrics are reported */
+  metrics: GameMetrics;
+
+  /** Where game, phase and night action spans are sent */
+  tracer: GameTracer;
 }
 
 /**
@@ -56,7 +95,11 @@ export const DEFAULT_ROOM_MANAGER_CONFIG: RoomManagerConfig = {
   maxRooms: 100,
   roomTimeoutMs: 3600000, // 1 hour
   cleanupIntervalMs: 60000, // 1 minute
-  maxCodeAttempts: 10
+  maxCodeAttempts: 10,
+  abandonedGameTimeoutMs: 300000, // 5 minutes
+  hostDisconnectGraceMs: 30000, // 30 seconds
+  metrics: NULL_METRICS,
+  tracer: NOOP_TRACER
 };
 
 /**
@@ -69,8 +112,10 @@ export type RoomManagerEventHandler = (event: RoomManagerEvent) => void;
  */
 export type RoomManagerEventType =
   | 'roomCreated'
+  | 'roomRestored'
   | 'roomClosed'
-  | 'roomCleanedUp';
+  | 'roomCleanedUp'
+  | 'roomAbandoned';
 
 /**
  * @summary Room manager event.
@@ -81,6 +126,99 @@ export interface RoomManagerEvent {
   timestamp: number;
 }
 
+/**
+ * @summary Outcome of a host's request to delete a room.
+ */
+export type DeleteRoomResult = 'deleted' | 'notFound' | 'notHost';
+
+/**
+ * @summary Outcome of a player's request to leave a room's lobby.
+ */
+export type LeaveRoomResult = 'left' | 'notFound' | 'notInRoom' | 'notWaiting';
+
+/**
+ * @summary Outcome of a host's request to remove a player from a room's lobby.
+ */
+export type KickPlayerResult = 'kicked' | 'notFound' | 'notHost' | 'notWaiting' | 'notInRoom' | 'invalidTarget';
+
+/**
+ * @summary Outcome of a host's request to hand the host role to another player.
+ */
+export type TransferHostResult = 'transferred' | 'notFound' | 'notHost' | 'invalidTarget';
+
+/**
+ * @summary Outcome of a host's request to start the next round of a match.
+ */
+export type NextRoundResult = 'started' | 'notFound' | 'notHost' | 'notEnded' | 'cannotStart';
+
+/**
+ * @summary Outcome of a host's request to fill seats with AI players.
+ */
+export type AddBotsResult = 'added' | 'notFound' | 'notHost' | 'notWaiting' | 'roomFull';
+
+/**
+ * @summary Names given to AI players, in the order they are used.
+ */
+const BOT_NAMES = [
+  'Bot Alice', 'Bot Bob', 'Bot Charlie', 'Bot Diana', 'Bot Eve', 'Bot Frank',
+  'Bot Grace', 'Bot Henry', 'Bot Ivy', 'Bot Jack'
+];
+
+/**
+ * @summary Snapshot of how busy the server is.
+ */
+export interface RoomManagerStats {
+  /** Rooms currently held */
+  totalRooms: number;
+
+  /** Number of rooms in each status */
+  roomsByStatus: Record<RoomStatus, number>;
+
+  /** Human players with a live connection, across all rooms */
+  connectedPlayers: number;
+
+  /** Rooms still held that were created in the last hour */
+  roomsCreatedLastHour: number;
+}
+
+/**
+ * @summary One room as seen by a server operator.
+ */
+export interface AdminRoomSummary {
+  /** Room code */
+  roomCode: RoomCode;
+
+  /** Room status, including ended rooms not yet reclaimed */
+  status: RoomStatus;
+
+  /** Host's display name */
+  hostName: string;
+
+  /** Seated players, humans and bots */
+  playerCount: number;
+
+  /** Human players with a live connection */
+  connectedPlayers: number;
+
+  /** Seats allowed by the room config */
+  maxPlayers: number;
+
+  /** Whether the room is hidden from the public list */
+  isPrivate: boolean;
+
+  /** Current game phase, or null before the first game starts */
+  phase: GamePhase | null;
+
+  /** Seconds left in the current phase, or null if untimed */
+  timeRemaining: number | null;
+
+  /** When the room was created, as epoch milliseconds */
+  createdAt: number;
+
+  /** Milliseconds since the room was created */
+  ageMs: number;
+}
+
 /**
  * @summary Manages all game rooms.
  *
@@ -110,8 +248,8 @@ export class RoomManager {
   /** Configuration */
   private readonly config: RoomManagerConfig;
 
-  /** Active rooms by code */
-  private readonly rooms: Map<RoomCode, Room> = new Map();
+  /** Active rooms, keyed by code */
+  private readonly rooms: IRoomStore;
 
   /** Event handlers */
   private readonly eventHandlers: Set<RoomManagerEventHandler> = new Set();
@@ -119,21 +257,83 @@ export class RoomManager {
   /** Cleanup interval handle */
   private cleanupInterval: ReturnType<typeof setInterval> | null = null;
 
+  /** Where in-progress games are persisted (optional) */
+  private readonly store: IGameSnapshotStore | null;
+
+  /** Set while shutting down so closing rooms keeps their snapshots */
+  private isShuttingDown: boolean = false;
+
+  /** Pending cancellations for games with no human connected, by room code */
+  private readonly abandonTimers: Map<RoomCode, ReturnType<typeof setTimeout>> = new Map();
+
+  /** Lobbies waiting for their disconnected host to come back */
+  private readonly hostGraceTimers: Map<RoomCode, ReturnType<typeof setTimeout>> = new Map();
+
+  /** Server logger */
+  private readonly logger: Logger = getLogger();
+
+  /** Counter for AI player IDs */
+  private botCounter: number = 0;
+
   /**
    * @summary Creates a new room manager.
    *
    * @param {Partial<RoomManagerConfig>} [config] - Configuration options
+   * @param {IGameSnapshotStore} [store] - Persists in-progress games across restarts
+   * @param {IRoomStore} [rooms] - Backend for live rooms (defaults to in-memory)
    *
    * @example
    * ```typescript
    * const manager = new RoomManager({
    *   maxRooms: 50,
    *   roomTimeoutMs: 1800000
-   * });
+   * }, new JsonFileGameSnapshotStore('./data/games'));
    * ```
    */
-  constructor(config: Partial<RoomManagerConfig> = {}) {
+  constructor(
+    config: Partial<RoomManagerConfig> = {},
+    store?: IGameSnapshotStore,
+    rooms: IRoomStore = new InMemoryRoomStore()
+  ) {
     this.config = { ...DEFAULT_ROOM_MANAGER_CONFIG, ...config };
+    this.store = store ?? null;
+    this.rooms = rooms;
+  }
+
+  /**
+   * @summary Reloads in-progress games from the store.
+   *
+   * @description
+   * Called once at startup. Each saved room is rebuilt and its game
+   * resumed; snapshots that cannot be restored are logged and deleted
+   * so they do not fail again on the next boot.
+   *
+   * @returns {number} Number of rooms restored
+   */
+  restoreRooms(): number {
+    if (!this.store) {
+      return 0;
+    }
+
+    let restored = 0;
+
+    for (const snapshot of this.store.loadGames()) {
+      if (this.rooms.get(snapshot.code)) {
+        continue;
+      }
+
+      try {
+        const room = Room.fromSnapshot(snapshot);
+        this.trackRoom(room);
+        this.emitEvent('roomRestored', snapshot.code);
+        restored++;
+      } catch (error) {
+        this.logger.error('Failed to restore room', { roomCode: snapshot.code, error });
+        this.store.deleteGame(snapshot.code);
+      }
+    }
+
+    return restored;
   }
 
   /**
@@ -184,10 +384,25 @@ export class RoomManager {
    * ```
    */
   createRoom(hostId: PlayerId, config: RoomConfig, debugOptions?: DebugOptions): Room {
-    if (this.rooms.size >= this.config.maxRooms) {
+    if (this.rooms.list().length >= this.config.maxRooms) {
       throw new Error('Maximum number of rooms reached');
     }
 
+    const roleErrors = validateRoleConfig(config);
+    if (roleErrors.length > 0) {
+      throw new Error(`Invalid role list: ${roleErrors.join(', ')}`);
+    }
+
+    const limitErrors = validatePlayerLimits(config);
+    if (limitErrors.length > 0) {
+      throw new Error(`Invalid player limits: ${limitErrors.join(', ')}`);
+    }
+
+    const tieBreakErrors = validateTieBreak(config);
+    if (tieBreakErrors.length > 0) {
+      throw new Error(`Invalid tie break: ${tieBreakErrors.join(', ')}`);
+    }
+
     // Generate unique room code
     let code: RoomCode;
     let attempts = 0;
@@ -199,25 +414,188 @@ export class RoomManager {
       if (attempts > this.config.maxCodeAttempts) {
         throw new Error('Failed to generate unique room code');
       }
-    } while (this.rooms.has(code));
+    } while (this.rooms.get(code));
 
     // Create room (with debug options if provided)
     const room = new Room(hostId, config, code, debugOptions);
 
-    // Track room events
+    // Store room and track its events
+    this.trackRoom(room);
+
+    // Emit event
+    this.emitEvent('roomCreated', code);
+    this.config.metrics.gameCreated();
+    this.logger.with({ roomCode: code, playerId: hostId }).info('Room created for host');
+
+    return room;
+  }
+
+  /**
+   * @summary Stores a room and subscribes to its lifecycle events.
+   *
+   * @param {Room} room - Room to track
+   *
+   * @private
+   */
+  private trackRoom(room: Room): void {
+    const code = room.getCode();
+
     room.onEvent((event) => {
-      if (event.type === 'roomClosed') {
-        this.handleRoomClosed(code);
+      switch (event.type) {
+        case 'phaseChanged':
+        case 'voteCast':
+          this.persistRoom(room);
+          break;
+
+        case 'gameAbandoned':
+          this.startAbandonTimer(room);
+          break;
+
+        case 'hostDisconnected':
+          this.startHostGraceTimer(room, event.data.playerId as PlayerId);
+          break;
+
+        case 'gameResumed':
+          this.clearAbandonTimer(code);
+          break;
+
+        case 'gameEnded':
+          this.clearAbandonTimer(code);
+          this.store?.deleteGame(code);
+          this.config.metrics.gameCompleted();
+          break;
+
+        case 'playerJoined':
+          this.config.metrics.playerJoined();
+          break;
+
+        case 'nightActionCompleted':
+          if (typeof event.data.durationMs === 'number') {
+            this.config.metrics.nightActionCompleted(event.data.role as string, event.data.durationMs);
+          }
+          break;
+
+        case 'broadcastFailed':
+          this.config.metrics.broadcastError();
+          break;
+
+        case 'roomClosed':
+          this.handleRoomClosed(code);
+          break;
       }
     });
 
-    // Store room
-    this.rooms.set(code, room);
+    traceRoom(room, this.config.tracer);
+    this.rooms.put(room);
 
-    // Emit event
-    this.emitEvent('roomCreated', code);
+    // Restored rooms start with nobody attached
+    if (room.isAbandoned()) {
+      this.startAbandonTimer(room);
+    }
+  }
 
-    return room;
+  /**
+   * @summary Schedules cancellation of a game nobody is connected to.
+   *
+   * @description
+   * If no human player comes back within abandonedGameTimeoutMs, the
+   * room is closed, which cancels its game and removes it (and any
+   * saved snapshot) from the manager.
+   *
+   * @param {Room} room - Abandoned room
+   *
+   * @private
+   */
+  private startAbandonTimer(room: Room): void {
+    const code = room.getCode();
+    if (this.abandonTimers.has(code)) {
+      return;
+    }
+
+    const timer = setTimeout(() => {
+      this.abandonTimers.delete(code);
+      if (!room.isAbandoned()) {
+        return;
+      }
+
+      this.logger.with({ roomCode: code }).info('No players reconnected, cancelling game');
+      this.emitEvent('roomAbandoned', code);
+      room.close('All players left the game');
+    }, this.config.abandonedGameTimeoutMs);
+
+    this.abandonTimers.set(code, timer);
+  }
+
+  /**
+   * @summary Passes the host role on if a lobby's host stays away.
+   *
+   * @description
+   * After hostDisconnectGraceMs, a host who hasn't rejoined is removed
+   * from the lobby, which makes the earliest-joined remaining player
+   * host, or closes the lobby if no human is left.
+   *
+   * @param {Room} room - Lobby whose host dropped
+   * @param {PlayerId} hostId - Host who disconnected
+   *
+   * @private
+   */
+  private startHostGraceTimer(room: Room, hostId: PlayerId): void {
+    const code = room.getCode();
+    if (this.hostGraceTimers.has(code)) {
+      return;
+    }
+
+    const timer = setTimeout(() => {
+      this.hostGraceTimers.delete(code);
+      const host = room.getPlayer(hostId);
+      if (
+        room.getStatus() !== RoomStatus.WAITING ||
+        room.getHostId() !== hostId ||
+        !host ||
+        host.connection.isConnected()
+      ) {
+        return;
+      }
+
+      this.logger.with({ roomCode: code, playerId: hostId }).info('Host did not return, passing host on');
+      room.removePlayer(hostId);
+    }, this.config.hostDisconnectGraceMs);
+
+    this.hostGraceTimers.set(code, timer);
+  }
+
+  /**
+   * @summary Cancels a pending abandoned-game cancellation.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @private
+   */
+  private clearAbandonTimer(code: RoomCode): void {
+    const timer = this.abandonTimers.get(code);
+    if (timer) {
+      clearTimeout(timer);
+      this.abandonTimers.delete(code);
+    }
+  }
+
+  /**
+   * @summary Writes a room's snapshot to the store.
+   *
+   * @param {Room} room - Room to persist
+   *
+   * @private
+   */
+  private persistRoom(room: Room): void {
+    if (!this.store) {
+      return;
+    }
+
+    try {
+      this.store.saveGame(room.toSnapshot());
+    } catch (error) {
+      this.logger.error('Failed to persist room', { roomCode: room.getCode(), error });
+    }
   }
 
   /**
@@ -239,6 +617,17 @@ export class RoomManager {
     return this.rooms.get(code);
   }
 
+  /**
+   * @summary Gets the public state of a game.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {PublicGameState | undefined} Sanitized state if the room exists
+   */
+  getPublicGame(code: RoomCode): PublicGameState | undefined {
+    return this.rooms.get(code)?.getPublicState();
+  }
+
   /**
    * @summary Checks if a room exists.
    *
@@ -247,7 +636,7 @@ export class RoomManager {
    * @returns {boolean} True if room exists
    */
   hasRoom(code: RoomCode): boolean {
-    return this.rooms.has(code);
+    return this.rooms.get(code) !== undefined;
   }
 
   /**
@@ -256,7 +645,7 @@ export class RoomManager {
    * @returns {Room[]} Array of rooms
    */
   getAllRooms(): Room[] {
-    return Array.from(this.rooms.values());
+    return this.rooms.list();
   }
 
   /**
@@ -265,7 +654,7 @@ export class RoomManager {
    * @returns {number} Room count
    */
   getRoomCount(): number {
-    return this.rooms.size;
+    return this.rooms.list().length;
   }
 
   /**
@@ -274,9 +663,7 @@ export class RoomManager {
    * @returns {Room[]} Waiting rooms
    */
   getWaitingRooms(): Room[] {
-    return Array.from(this.rooms.values()).filter(
-      room => room.getStatus() === RoomStatus.WAITING
-    );
+    return this.rooms.listWaiting();
   }
 
   /**
@@ -291,9 +678,43 @@ export class RoomManager {
    * @pattern Information Hiding - Only exposes joinable public rooms
    */
   getPublicRooms(): Room[] {
-    return Array.from(this.rooms.values()).filter(
-      room => room.getStatus() === RoomStatus.WAITING && !room.getConfig().isPrivate
-    );
+    return this.rooms.listWaiting().filter(room => !room.getConfig().isPrivate);
+  }
+
+  /**
+   * @summary Gets the public room list as sent to the room browser.
+   *
+   * @description
+   * Lists the rooms from getPublicRooms() with their capacity. A room is
+   * joinable while it is waiting and has a free seat; full rooms are
+   * still listed, so players can see them fill up, unless minOpenSlots
+   * asks for free seats.
+   *
+   * @param {number} [minOpenSlots=0] - Only include rooms with at least this many free seats
+   *
+   * @returns {PublicRoomInfo[]} Public rooms
+   *
+   * @example
+   * ```typescript
+   * // Rooms a group of three can join together
+   * const rooms = manager.getPublicRoomSummaries(3);
+   * ```
+   */
+  getPublicRoomSummaries(minOpenSlots: number = 0): PublicRoomInfo[] {
+    return this.getPublicRooms()
+      .map(room => {
+        const { maxPlayers, roles } = room.getConfig();
+        const playerCount = room.getPlayerCount();
+        return {
+          roomCode: room.getCode(),
+          hostName: room.getHostName(),
+          playerCount,
+          maxPlayers,
+          joinable: room.getStatus() === RoomStatus.WAITING && playerCount < maxPlayers,
+          roles
+        };
+      })
+      .filter(room => room.maxPlayers - room.playerCount >= minOpenSlots);
   }
 
   /**
@@ -302,11 +723,50 @@ export class RoomManager {
    * @returns {Room[]} Playing rooms
    */
   getPlayingRooms(): Room[] {
-    return Array.from(this.rooms.values()).filter(
+    return this.rooms.list().filter(
       room => room.getStatus() === RoomStatus.PLAYING
     );
   }
 
+  /**
+   * @summary Counts rooms and connected players for monitoring.
+   *
+   * @description
+   * Reads each room's status and connections once, without touching any
+   * game, so it stays cheap enough to poll. Closed rooms are dropped
+   * from the store, so the last-hour count only covers rooms still held.
+   *
+   * @param {number} [now=Date.now()] - Current time in milliseconds
+   *
+   * @returns {RoomManagerStats} Current counts
+   */
+  getStats(now: number = Date.now()): RoomManagerStats {
+    const rooms = this.rooms.list();
+    const roomsByStatus: Record<RoomStatus, number> = {
+      [RoomStatus.WAITING]: 0,
+      [RoomStatus.PLAYING]: 0,
+      [RoomStatus.ENDED]: 0,
+      [RoomStatus.CLOSED]: 0
+    };
+    let connectedPlayers = 0;
+    let roomsCreatedLastHour = 0;
+
+    for (const room of rooms) {
+      roomsByStatus[room.getStatus()]++;
+      connectedPlayers += room.getConnectedHumanCount();
+      if (now - room.getCreatedAt() < 60 * 60 * 1000) {
+        roomsCreatedLastHour++;
+      }
+    }
+
+    return {
+      totalRooms: rooms.length,
+      roomsByStatus,
+      connectedPlayers,
+      roomsCreatedLastHour
+    };
+  }
+
   /**
    * @summary Gets room summaries for lobby display.
    *
@@ -319,8 +779,7 @@ export class RoomManager {
    * ```
    */
   getRoomSummaries(): RoomSummary[] {
-    return Array.from(this.rooms.values())
-      .filter(room => room.getStatus() === RoomStatus.WAITING)
+    return this.rooms.listWaiting()
       .map(room => ({
         roomCode: room.getCode(),
         hostName: room.getPlayer(room.getHostId())?.name ?? 'Unknown',
@@ -330,6 +789,36 @@ export class RoomManager {
       }));
   }
 
+  /**
+   * @summary Gets a summary of every room held, for operators.
+   *
+   * @description
+   * Unlike getRoomSummaries(), rooms are listed whatever their status,
+   * private ones included, so stuck or long-running games can be found.
+   * Oldest rooms come first.
+   *
+   * @param {number} [now=Date.now()] - Current time in milliseconds
+   *
+   * @returns {AdminRoomSummary[]} Summary of each room
+   */
+  getAllRoomSummaries(now: number = Date.now()): AdminRoomSummary[] {
+    return this.rooms.list()
+      .map(room => ({
+        roomCode: room.getCode(),
+        status: room.getStatus(),
+        hostName: room.getHostName(),
+        playerCount: room.getPlayerCount(),
+        connectedPlayers: room.getConnectedHumanCount(),
+        maxPlayers: room.getConfig().maxPlayers,
+        isPrivate: room.getConfig().isPrivate,
+        phase: room.getGame()?.getPhase() ?? null,
+        timeRemaining: room.getTimeRemaining(),
+        createdAt: room.getCreatedAt(),
+        ageMs: now - room.getCreatedAt()
+      }))
+      .sort((a, b) => a.createdAt - b.createdAt);
+  }
+
   /**
    * @summary Closes a room.
    *
@@ -348,6 +837,251 @@ export class RoomManager {
     return true;
   }
 
+  /**
+   * @summary Deletes a room at its host's request.
+   *
+   * @description
+   * Every player still connected is sent a ROOM_CLOSED error before the
+   * room is closed, and their connections are closed with GAME_DELETED,
+   * so clients can tell a deleted game apart from a dropped connection. Closing cancels any running game; the room and
+   * its saved snapshot are then removed by the roomClosed handler.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} requesterId - ID of the player asking to delete
+   *
+   * @returns {DeleteRoomResult} Whether the room was deleted, and why not
+   */
+  deleteRoom(code: RoomCode, requesterId: PlayerId): DeleteRoomResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (requesterId !== room.getHostId()) {
+      return 'notHost';
+    }
+
+    room.broadcast({
+      type: 'error',
+      code: ErrorCodes.ROOM_CLOSED,
+      message: 'The host deleted this game',
+      timestamp: Date.now()
+    });
+    room.close('Host deleted the game', CloseCodes.GAME_DELETED);
+    this.logger.with({ roomCode: code, playerId: requesterId }).info('Host deleted room');
+    return 'deleted';
+  }
+
+  /**
+   * @summary Removes a player from a room that hasn't started yet.
+   *
+   * @description
+   * The room passes the host on if the host leaves, and closes itself
+   * once no human is left, which removes it through the roomClosed
+   * handler.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} playerId - ID of the leaving player
+   *
+   * @returns {LeaveRoomResult} Whether the player left, and why not
+   */
+  leaveRoom(code: RoomCode, playerId: PlayerId): LeaveRoomResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (!room.getPlayer(playerId)) {
+      return 'notInRoom';
+    }
+
+    if (room.getStatus() !== RoomStatus.WAITING) {
+      return 'notWaiting';
+    }
+
+    room.removePlayer(playerId);
+    this.logger.with({ roomCode: code, playerId }).info('Player left room lobby');
+    return 'left';
+  }
+
+  /**
+   * @summary Removes a player from a room's lobby at the host's request.
+   *
+   * @description
+   * Only before the game starts. The removed player is told why and
+   * their connection is closed with KICKED, so they can't keep acting on the room;
+   * everyone left sees the updated lobby. The host can't remove
+   * themselves this way.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} hostId - ID of the player asking, who must be host
+   * @param {PlayerId} targetId - ID of the player to remove
+   *
+   * @returns {KickPlayerResult} Whether the player was removed, and why not
+   */
+  kickPlayer(code: RoomCode, hostId: PlayerId, targetId: PlayerId): KickPlayerResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (hostId !== room.getHostId()) {
+      return 'notHost';
+    }
+
+    if (room.getStatus() !== RoomStatus.WAITING) {
+      return 'notWaiting';
+    }
+
+    const target = room.getPlayer(targetId);
+    if (!target) {
+      return 'notInRoom';
+    }
+
+    if (targetId === hostId) {
+      return 'invalidTarget';
+    }
+
+    room.removePlayer(targetId);
+    target.connection.send(createErrorMessage(
+      ErrorCodes.REMOVED_FROM_ROOM,
+      'You were removed from the room',
+      { roomCode: code }
+    ));
+    target.connection.close('Removed from the room', CloseCodes.KICKED);
+
+    this.logger.with({ roomCode: code, playerId: targetId }).info('Player kicked from room lobby');
+    return 'kicked';
+  }
+
+  /**
+   * @summary Hands a room's host role to another player.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} fromId - ID of the player asking, who must be host
+   * @param {PlayerId} toId - ID of the human player to become host
+   *
+   * @returns {TransferHostResult} Whether the host changed, and why not
+   */
+  transferHost(code: RoomCode, fromId: PlayerId, toId: PlayerId): TransferHostResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (fromId !== room.getHostId()) {
+      return 'notHost';
+    }
+
+    const target = room.getPlayer(toId);
+    if (!target || target.isAI) {
+      return 'invalidTarget';
+    }
+
+    room.transferHost(fromId, toId);
+    return 'transferred';
+  }
+
+  /**
+   * @summary Seats one AI player in a room.
+   *
+   * @description
+   * AI players are always ready and play through a RandomAgent, which
+   * picks valid night targets and votes at random. Unless a name is
+   * given, the bot takes the first name not already used in the room.
+   *
+   * @param {Room} room - Room to seat the bot in
+   * @param {string} [name] - Display name for the bot
+   *
+   * @returns {PlayerId} The bot's player ID
+   *
+   * @throws {Error} If the room is full or not accepting players
+   */
+  addBot(room: Room, name?: string): PlayerId {
+    const botId = `ai-${++this.botCounter}`;
+    const usedNames = new Set(room.getPlayers().map(p => p.name));
+    const botName = name
+      ?? BOT_NAMES.find(candidate => !usedNames.has(candidate))
+      ?? `Bot Player ${this.botCounter}`;
+
+    room.addPlayer(botId, botName, NullConnection.create(botId), true);
+    return botId;
+  }
+
+  /**
+   * @summary Fills seats in a room's lobby with AI players.
+   *
+   * @description
+   * Either every bot is seated or none is: a request that would take
+   * the room past maxPlayers is refused as a whole.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} requesterId - ID of the player asking, who must be host
+   * @param {number} count - Number of bots to add
+   *
+   * @returns {AddBotsResult} Whether the bots were added, and why not
+   */
+  addBots(code: RoomCode, requesterId: PlayerId, count: number): AddBotsResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (requesterId !== room.getHostId()) {
+      return 'notHost';
+    }
+
+    if (room.getStatus() !== RoomStatus.WAITING) {
+      return 'notWaiting';
+    }
+
+    if (room.getPlayerCount() + count > room.getConfig().maxPlayers) {
+      return 'roomFull';
+    }
+
+    for (let i = 0; i < count; i++) {
+      this.addBot(room);
+    }
+
+    this.logger.with({ roomCode: code }).info('Bots added to lobby', { count });
+    return 'added';
+  }
+
+  /**
+   * @summary Starts the next round of a finished room's match.
+   *
+   * @param {RoomCode} code - Room code
+   * @param {PlayerId} requesterId - ID of the player asking, who must be host
+   *
+   * @returns {NextRoundResult} Whether the round started, and why not
+   */
+  startNextRound(code: RoomCode, requesterId: PlayerId): NextRoundResult {
+    const room = this.rooms.get(code);
+    if (!room) {
+      return 'notFound';
+    }
+
+    if (requesterId !== room.getHostId()) {
+      return 'notHost';
+    }
+
+    if (room.getStatus() !== RoomStatus.ENDED) {
+      return 'notEnded';
+    }
+
+    try {
+      room.startNextRound(requesterId);
+    } catch (error) {
+      this.logger.with({ roomCode: code }).info('Next round could not start', {
+        reason: error instanceof Error ? error.message : String(error)
+      });
+      return 'cannotStart';
+    }
+
+    this.logger.with({ roomCode: code }).info('Next round started');
+    return 'started';
+  }
+
   /**
    * @summary Handles room closed event.
    *
@@ -356,6 +1090,18 @@ export class RoomManager {
    * @private
    */
   private handleRoomClosed(code: RoomCode): void {
+    this.clearAbandonTimer(code);
+    const hostTimer = this.hostGraceTimers.get(code);
+    if (hostTimer) {
+      clearTimeout(hostTimer);
+      this.hostGraceTimers.delete(code);
+    }
+
+    // Rooms closed by a shutdown keep their snapshot so they come back on restart
+    if (!this.isShuttingDown) {
+      this.store?.deleteGame(code);
+    }
+
     this.rooms.delete(code);
     this.emitEvent('roomClosed', code);
   }
@@ -364,8 +1110,12 @@ export class RoomManager {
    * @summary Cleans up inactive rooms.
    *
    * @description
-   * Removes rooms that have been in waiting state too long
-   * or ended rooms.
+   * Removes ended rooms nobody is connected to, empty waiting rooms,
+   * and waiting rooms older than roomTimeoutMs with no human player
+   * connected. An ended room stays while a player is connected so its
+   * host can start the next round. Closing a stale
+   * room frees its code and lets its host create a new one. Runs every
+   * cleanupIntervalMs once startCleanupTimer() has been called.
    *
    * @returns {number} Number of rooms cleaned up
    */
@@ -373,11 +1123,15 @@ export class RoomManager {
     const now = Date.now();
     let cleaned = 0;
 
-    for (const [code, room] of this.rooms.entries()) {
+    for (const room of this.rooms.list()) {
+      const code = room.getCode();
       const status = room.getStatus();
 
-      // Remove ended or closed rooms
-      if (status === RoomStatus.ENDED || status === RoomStatus.CLOSED) {
+      // Remove closed rooms, and ended rooms no one is waiting in
+      if (
+        status === RoomStatus.CLOSED ||
+        (status === RoomStatus.ENDED && room.getConnectedHumanCount() === 0)
+      ) {
         this.rooms.delete(code);
         this.emitEvent('roomCleanedUp', code);
         cleaned++;
@@ -386,12 +1140,19 @@ export class RoomManager {
 
       // Check for timeout on waiting rooms
       if (status === RoomStatus.WAITING) {
-        const state = room.getState();
-        // This is approximate since we don't track room creation time
-        // In a real implementation, Room would expose createdAt
         if (room.getPlayerCount() === 0) {
           room.close('Room inactive');
           cleaned++;
+          continue;
+        }
+
+        const ageMs = now - room.getCreatedAt();
+        if (ageMs >= this.config.roomTimeoutMs && room.getConnectedHumanCount() === 0) {
+          this.logger.with({ roomCode: code }).info('Reclaiming stale room with no connected players', {
+            waitingMinutes: Math.round(ageMs / 60000)
+          });
+          room.close('Room expired');
+          cleaned++;
         }
       }
     }
@@ -407,7 +1168,7 @@ export class RoomManager {
    * @returns {Room | undefined} Room if player found
    */
   findPlayerRoom(playerId: PlayerId): Room | undefined {
-    for (const room of this.rooms.values()) {
+    for (const room of this.rooms.list()) {
       if (room.hasPlayer(playerId)) {
         return room;
       }
@@ -446,7 +1207,7 @@ export class RoomManager {
       try {
         handler(event);
       } catch (error) {
-        console.error('Error in room manager event handler:', error);
+        this.logger.error('Error in room manager event handler', { event: type, roomCode, error });
       }
     }
   }
@@ -455,15 +1216,24 @@ export class RoomManager {
    * @summary Shuts down the room manager.
    *
    * @description
-   * Closes all rooms and stops cleanup timer.
+   * Saves every game in progress, then closes all rooms (cancelling
+   * their games) and stops the cleanup timer. Saved games are left in
+   * the store so they can be restored on the next start.
    */
   shutdown(): void {
     this.stopCleanupTimer();
+    this.isShuttingDown = true;
 
-    for (const room of this.rooms.values()) {
-      room.close('Server shutting down');
-    }
+    for (const room of this.rooms.list()) {
+      // A night in progress is only consistent at the snapshot taken
+      // when it began; saving mid-night would replay actions on restore
+      if (room.getStatus() === RoomStatus.PLAYING &&
+          room.getGame()?.getPhase() !== GamePhase.NIGHT) {
+        this.persistRoom(room);
+      }
 
-    this.rooms.clear();
+      room.close('Server shutting down', CloseCodes.SERVER_SHUTDOWN);
+      this.rooms.delete(room.getCode());
+    }
   }
 }
@@ -0,0 +1,134 @@
+/**
+ * @fileoverview Storage backends for live rooms.
+ * @module server/RoomStore
+ *
+ * @summary Where RoomManager keeps the rooms it is serving.
+ *
+ * @description
+ * RoomManager used to own a plain Map of rooms, which tied every room
+ * lookup to process memory. The IRoomStore interface pulls that map out
+ * so another backend (Redis, SQL, a shared cache) can be dropped in later
+ * without touching room or game logic.
+ *
+ * This is separate from IGameSnapshotStore: a room store holds the rooms
+ * being served right now, while the snapshot store keeps serialized games
+ * so they survive a restart.
+ *
+ * @pattern Repository Pattern - Abstracts where live rooms are kept
+ *
+ * @example
+ * ```typescript
+ * const manager = new RoomManager({}, undefined, new InMemoryRoomStore());
+ * ```
+ */
+
+import { RoomCode } from '../network/protocol';
+import { Room, RoomStatus } from './Room';
+
+/**
+ * @summary Storage backend for live rooms.
+ *
+ * @description
+ * A put replaces any room already stored under the same code.
+ */
+export interface IRoomStore {
+  /**
+   * @summary Gets a room by code.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {Room | undefined} Room if stored
+   */
+  get(code: RoomCode): Room | undefined;
+
+  /**
+   * @summary Stores (or replaces) a room under its code.
+   *
+   * @param {Room} room - Room to store
+   */
+  put(room: Room): void;
+
+  /**
+   * @summary Removes a room.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {boolean} True if a room was removed
+   */
+  delete(code: RoomCode): boolean;
+
+  /**
+   * @summary Lists every stored room.
+   *
+   * @returns {Room[]} All rooms
+   */
+  list(): Room[];
+
+  /**
+   * @summary Lists rooms still waiting for players.
+   *
+   * @returns {Room[]} Rooms in WAITING status
+   */
+  listWaiting(): Room[];
+}
+
+/**
+ * @summary Keeps rooms in a process-local Map.
+ *
+ * @description
+ * The default backend, and the behavior RoomManager always had. Rooms
+ * are only visible to the process that created them.
+ */
+export class InMemoryRoomStore implements IRoomStore {
+  /** Rooms by code */
+  private readonly rooms: Map<RoomCode, Room> = new Map();
+
+  /**
+   * @summary Gets a room by code.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {Room | undefined} Room if stored
+   */
+  get(code: RoomCode): Room | undefined {
+    return this.rooms.get(code);
+  }
+
+  /**
+   * @summary Stores (or replaces) a room under its code.
+   *
+   * @param {Room} room - Room to store
+   */
+  put(room: Room): void {
+    this.rooms.set(room.getCode(), room);
+  }
+
+  /**
+   * @summary Removes a room.
+   *
+   * @param {RoomCode} code - Room code
+   *
+   * @returns {boolean} True if a room was removed
+   */
+  delete(code: RoomCode): boolean {
+    return this.rooms.delete(code);
+  }
+
+  /**
+   * @summary Lists every stored room.
+   *
+   * @returns {Room[]} All rooms
+   */
+  list(): Room[] {
+    return Array.from(this.rooms.values());
+  }
+
+  /**
+   * @summary Lists rooms still waiting for players.
+   *
+   * @returns {Room[]} Rooms in WAITING status
+   */
+  listWaiting(): Room[] {
+    return this.list().filter(room => room.getStatus() === RoomStatus.WAITING);
+  }
+}
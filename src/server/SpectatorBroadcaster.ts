@@ -0,0 +1,258 @@
+/**
+ * @fileoverview Delayed broadcast channel for room spectators.
+ * @module server/SpectatorBroadcaster
+ *
+ * @summary Relays public room messages to spectators on a configurable lag.
+ *
+ * @description
+ * When a game is streamed, anyone watching the stream sees exactly what
+ * the streamer's spectator client sees. If that feed is real-time, players
+ * in the same game can watch the stream to learn things they shouldn't
+ * (who has spoken, when voting opened, the final reveal before everyone
+ * else). The SpectatorBroadcaster holds every spectator-bound message for
+ * `delayMs` before releasing it, while players keep receiving messages
+ * directly from the Room with no delay.
+ *
+ * The broadcaster also remembers the last room state it released, so a
+ * spectator who joins mid-game starts from the delayed picture rather
+ * than the live one.
+ *
+ * @pattern Observer Pattern - Spectators subscribe to the room's broadcast stream
+ * @pattern Proxy Pattern - Stands in front of spectator connections, adding a delay
+ *
+ * @example
+ * ```typescript
+ * const spectators = new SpectatorBroadcaster(60000); // 60s stream delay
+ * spectators.addSpectator('viewer-1', connection);
+ *
+ * // Called from Room.broadcast()
+ * spectators.send(phaseChangeMessage); // Delivered 60s later
+ * ```
+ */
+
+import { IClientConnection } from '../network/IClientConnection';
+import { PlayerId, RoomState, ServerMessage } from '../network/protocol';
+
+/** A message waiting for its release time */
+interface QueuedMessage {
+  message: ServerMessage;
+  releaseAt: number;
+}
+
+/**
+ * @summary Buffers and relays messages to spectator connections.
+ *
+ * @description
+ * Messages are released in the order they were sent. A delay of 0
+ * delivers immediately. Changing the delay only affects messages sent
+ * after the change; already-buffered messages keep their release time,
+ * and a message sent after the delay is shortened still waits for the
+ * ones queued before it.
+ */
+export class SpectatorBroadcaster {
+  /** Spectator connections by ID */
+  private readonly spectators: Map<PlayerId, IClientConnection> = new Map();
+
+  /** Messages not yet released, oldest first */
+  private readonly queue: QueuedMessage[] = [];
+
+  /** Timer releasing the head of the queue */
+  private releaseTimer: ReturnType<typeof setTimeout> | null = null;
+
+  /** Room state in the last released roomUpdate (or the seed) */
+  private releasedState: RoomState | null = null;
+
+  /** Delay applied to each message in milliseconds */
+  private delayMs: number;
+
+  /**
+   * @summary Creates a new spectator broadcaster.
+   *
+   * @param {number} [delayMs=0] - Broadcast delay in milliseconds
+   */
+  constructor(delayMs: number = 0) {
+    this.delayMs = Math.max(0, delayMs);
+  }
+
+  /**
+   * @summary Gets the current broadcast delay.
+   *
+   * @returns {number} Delay in milliseconds
+   */
+  getDelayMs(): number {
+    return this.delayMs;
+  }
+
+  /**
+   * @summary Changes the broadcast delay for subsequent messages.
+   *
+   * @description
+   * Messages already queued keep their release time and their order.
+   *
+   * @param {number} delayMs - New delay in milliseconds
+   */
+  setDelayMs(delayMs: number): void {
+    this.delayMs = Math.max(0, delayMs);
+  }
+
+  /**
+   * @summary Adds a spectator.
+   *
+   * @param {PlayerId} id - Spectator ID
+   * @param {IClientConnection} connection - Spectator connection
+   */
+  addSpectator(id: PlayerId, connection: IClientConnection): void {
+    this.spectators.set(id, connection);
+  }
+
+  /**
+   * @summary Removes a spectator.
+   *
+   * @param {PlayerId} id - Spectator ID
+   *
+   * @returns {boolean} True if the spectator was present
+   */
+  removeSpectator(id: PlayerId): boolean {
+    return this.spectators.delete(id);
+  }
+
+  /**
+   * @summary Checks whether an ID is spectating.
+   *
+   * @param {PlayerId} id - Spectator ID
+   *
+   * @returns {boolean} True if spectating
+   */
+  hasSpectator(id: PlayerId): boolean {
+    return this.spectators.has(id);
+  }
+
+  /**
+   * @summary Gets the number of spectators.
+   *
+   * @returns {number} Spectator count
+   */
+  getSpectatorCount(): number {
+    return this.spectators.size;
+  }
+
+  /**
+   * @summary Sets the room state spectators see before any update is released.
+   *
+   * @param {RoomState} state - Room state as it was when the room opened
+   */
+  seedState(state: RoomState): void {
+    this.releasedState = state;
+  }
+
+  /**
+   * @summary Gets the room state as spectators currently see it.
+   *
+   * @returns {RoomState | null} State in the last released room update, or null if none
+   */
+  getReleasedState(): RoomState | null {
+    return this.releasedState;
+  }
+
+  /**
+   * @summary Queues a message for all spectators.
+   *
+   * @description
+   * The message is delivered to whoever is spectating at release time,
+   * so a viewer who joins mid-delay still sees it and one who leaves
+   * does not. Messages are queued even with nobody watching, so the
+   * released state is right for whoever joins later.
+   *
+   * @param {ServerMessage} message - Message to relay
+   */
+  send(message: ServerMessage): void {
+    if (this.delayMs === 0 && this.queue.length === 0) {
+      this.deliver(message);
+      return;
+    }
+
+    // Never release ahead of a message queued earlier under a longer delay
+    const last = this.queue[this.queue.length - 1];
+    const releaseAt = Math.max(Date.now() + this.delayMs, last?.releaseAt ?? 0);
+    this.queue.push({ message, releaseAt });
+    this.scheduleRelease();
+  }
+
+  /**
+   * @summary Sends a message to all spectators immediately.
+   *
+   * @description
+   * Used for messages that carry no game information, such as the room
+   * closing, where a delay would only leave spectators hanging.
+   *
+   * @param {ServerMessage} message - Message to send
+   */
+  sendImmediate(message: ServerMessage): void {
+    this.deliver(message);
+  }
+
+  /**
+   * @summary Drops all buffered messages and spectators.
+   */
+  dispose(): void {
+    if (this.releaseTimer) {
+      clearTimeout(this.releaseTimer);
+      this.releaseTimer = null;
+    }
+    this.queue.length = 0;
+    this.spectators.clear();
+  }
+
+  /**
+   * @summary Arms the timer for the head of the queue, if not already armed.
+   *
+   * @private
+   */
+  private scheduleRelease(): void {
+    if (this.releaseTimer || this.queue.length === 0) {
+      return;
+    }
+
+    this.releaseTimer = setTimeout(() => {
+      this.releaseTimer = null;
+      this.releaseDue();
+    }, Math.max(0, this.queue[0].releaseAt - Date.now()));
+  }
+
+  /**
+   * @summary Delivers every queued message whose release time has come.
+   *
+   * @private
+   */
+  private releaseDue(): void {
+    const now = Date.now();
+    while (this.queue.length > 0 && this.queue[0].releaseAt <= now) {
+      this.deliver(this.queue.shift()!.message);
+    }
+    this.scheduleRelease();
+  }
+
+  /**
+   * @summary Delivers a message to every connected spectator.
+   *
+   * @param {ServerMessage} message - Message to deliver
+   *
+   * @private
+   */
+  private deliver(message: ServerMessage): void {
+    if (message.type === 'roomUpdate') {
+      this.releasedState = message.state;
+    }
+
+    for (const [id, connection] of this.spectators) {
+      if (!connection.isConnected()) {
+        continue;
+      }
+      try {
+        connection.send(message);
+      } catch (error) {
+        console.error(`Failed to send to spectator ${id}:`, error);
+      }
+    }
+  }
+}
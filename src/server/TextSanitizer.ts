@@ -0,0 +1,143 @@
+/**
+ * @fileoverview Cleanup of player-written text before it is shown to others.
+ * @module server/TextSanitizer
+ *
+ * @summary Strips control characters and masks blocked words.
+ *
+ * @description
+ * Player names (at join) and day statements (at relay) are typed by one
+ * player and rendered for everyone else, so both pass through a
+ * TextSanitizer first:
+ * - Control characters are removed
+ * - Words on the blocklist are replaced with asterisks of the same length,
+ *   matching whole words regardless of case
+ *
+ * The text is otherwise stored as typed. Escaping depends on where it is
+ * rendered, so it is left to the output layer; escapeHtml is provided
+ * for renderers that build HTML.
+ *
+ * The blocklist is empty unless CHAT_BLOCKLIST_FILE names a file with one
+ * word per line.
+ *
+ * @example
+ * ```typescript
+ * const sanitizer = createSanitizerFromEnv();
+ * sanitizer.sanitize('O\'Brien\u0007'); // "O'Brien"
+ * ```
+ */
+
+import * as fs from 'fs';
+
+/**
+ * @summary C0 and C1 control characters plus the Unicode line separators.
+ *
+ * @private
+ */
+const CONTROL_CHARACTERS = /[\u0000-\u001f\u007f-\u009f\u2028\u2029]/g;
+
+/**
+ * @summary HTML entity for each character that needs escaping.
+ *
+ * @private
+ */
+const HTML_ENTITIES: Record<string, string> = {
+  '&': '&amp;',
+  '<': '&lt;',
+  '>': '&gt;',
+  '"': '&quot;',
+  "'": '&#39;'
+};
+
+/**
+ * @summary Escapes the characters HTML treats specially.
+ *
+ * @param {string} text - Raw text
+ *
+ * @returns {string} Text safe to place in HTML content or attributes
+ */
+export function escapeHtml(text: string): string {
+  return text.replace(/[&<>"']/g, char => HTML_ENTITIES[char]);
+}
+
+/**
+ * @summary Removes control characters, including line breaks.
+ *
+ * @param {string} text - Raw text
+ *
+ * @returns {string} Text without control characters
+ */
+export function stripControlCharacters(text: string): string {
+  return text.replace(CONTROL_CHARACTERS, '');
+}
+
+/**
+ * @summary Reads a blocklist file.
+ *
+ * @description
+ * One word per line. Blank lines and lines starting with # are ignored.
+ *
+ * @param {string} filePath - Path to the blocklist
+ *
+ * @returns {string[]} Blocked words
+ *
+ * @throws {Error} If the file cannot be read
+ */
+export function loadBlocklist(filePath: string): string[] {
+  return fs.readFileSync(filePath, 'utf-8')
+    .split(/\r?\n/)
+    .map(line => line.trim())
+    .filter(line => line.length > 0 && !line.startsWith('#'));
+}
+
+/**
+ * @summary Sanitizes text written by one player for display to others.
+ */
+export class TextSanitizer {
+  /** Matches any blocked word, or null when the blocklist is empty */
+  private readonly blockedWords: RegExp | null;
+
+  /**
+   * @summary Creates a sanitizer.
+   *
+   * @param {readonly string[]} [blocklist=[]] - Words to mask
+   */
+  constructor(blocklist: readonly string[] = []) {
+    const words = blocklist
+      .map(word => word.trim())
+      .filter(word => word.length > 0)
+      .map(word => word.replace(/[.*+?^${}()|[\]\\]/g, '\\$&'));
+
+    this.blockedWords = words.length > 0
+      ? new RegExp(`(?<![\\p{L}\\p{N}_])(?:${words.join('|')})(?![\\p{L}\\p{N}_])`, 'giu')
+      : null;
+  }
+
+  /**
+   * @summary Strips control characters and masks blocked words.
+   *
+   * @param {string} text - Text as sent by the client
+   *
+   * @returns {string} Text safe to relay, not yet escaped for any output
+   */
+  sanitize(text: string): string {
+    const clean = stripControlCharacters(text);
+    if (!this.blockedWords) {
+      return clean;
+    }
+    return clean.replace(this.blockedWords, word => '*'.repeat([...word].length));
+  }
+}
+
+/**
+ * @summary Creates a sanitizer using the blocklist named by CHAT_BLOCKLIST_FILE.
+ *
+ * @param {NodeJS.ProcessEnv} [env=process.env] - Environment to read
+ *
+ * @returns {TextSanitizer} Sanitizer with an empty blocklist if none is set
+ *
+ * @throws {Error} If CHAT_BLOCKLIST_FILE is set but cannot be read
+ */
+export function createSanitizerFromEnv(env: NodeJS.ProcessEnv = process.env): TextSanitizer {
+  const filePath = env.CHAT_BLOCKLIST_FILE;
+  return new TextSanitizer(filePath ? loadBlocklist(filePath) : []);
+}
@@ -0,0 +1,411 @@
+/**
+ * @fileoverview Optional OpenTelemetry tracing for game rounds.
+ * @module server/Tracing
+ *
+ * @summary Records a span per game, per phase and per night action.
+ *
+ * @description
+ * Each game gets a long-lived span from the moment it is dealt until it
+ * ends or its room closes. Every phase is a child of the game span, and
+ * every night action a child of the night phase, so a trace viewer shows
+ * exactly how long each part of a round took.
+ *
+ * Spans are exported as OTLP/HTTP JSON to the collector named by the
+ * standard OTEL_EXPORTER_OTLP_* environment variables. With none set,
+ * createTracerFromEnv returns NOOP_TRACER and tracing costs nothing.
+ *
+ * @example
+ * ```typescript
+ * const tracer = createTracerFromEnv();
+ * const manager = new RoomManager({ tracer });
+ * // ...
+ * await tracer.shutdown();
+ * ```
+ */
+
+import { randomBytes } from 'crypto';
+import { Room } from './Room';
+import { getLogger } from '../utils/logger';
+
+/**
+ * @summary Attribute values a span can carry.
+ */
+export type SpanAttributes = Record<string, string | number | boolean>;
+
+/**
+ * @summary A unit of work being timed.
+ */
+export interface TraceSpan {
+  /** Adds or replaces an attribute */
+  setAttribute(key: string, value: string | number | boolean): void;
+
+  /** Finishes the span; later calls do nothing */
+  end(endTime?: number): void;
+}
+
+/**
+ * @summary Options for starting a span.
+ */
+export interface StartSpanOptions {
+  /** Span this one is part of */
+  parent?: TraceSpan;
+
+  /** Attributes to start with */
+  attributes?: SpanAttributes;
+
+  /** Start time (epoch ms) if the work began before the span was created */
+  startTime?: number;
+}
+
+/**
+ * @summary Starts spans and ships them somewhere.
+ */
+export interface GameTracer {
+  /** Starts a span */
+  startSpan(name: string, options?: StartSpanOptions): TraceSpan;
+
+  /** Sends any finished spans still buffered and stops exporting */
+  shutdown(): Promise<void>;
+}
+
+const NOOP_SPAN: TraceSpan = {
+  setAttribute: () => {},
+  end: () => {}
+};
+
+/**
+ * @summary Tracer that records nothing.
+ */
+export const NOOP_TRACER: GameTracer = {
+  startSpan: () => NOOP_SPAN,
+  shutdown: async () => {}
+};
+
+/**
+ * @summary A span that has ended.
+ */
+export interface FinishedSpan {
+  readonly traceId: string;
+  readonly spanId: string;
+  readonly parentSpanId?: string;
+  readonly name: string;
+  readonly startTime: number;
+  readonly endTime: number;
+  readonly attributes: SpanAttributes;
+}
+
+/**
+ * @summary Span kept by a RecordingTracer.
+ *
+ * @private
+ */
+class RecordedSpan implements TraceSpan {
+  readonly spanId: string = randomBytes(8).toString('hex');
+  readonly traceId: string;
+  private readonly tracer: RecordingTracer;
+  private readonly name: string;
+  private readonly startTime: number;
+  private readonly attributes: SpanAttributes;
+  private readonly parent?: RecordedSpan;
+  private ended = false;
+
+  constructor(
+    tracer: RecordingTracer,
+    name: string,
+    startTime: number,
+    attributes: SpanAttributes,
+    parent?: RecordedSpan
+  ) {
+    this.tracer = tracer;
+    this.name = name;
+    this.startTime = startTime;
+    this.attributes = { ...attributes };
+    this.parent = parent;
+    this.traceId = parent?.traceId ?? randomBytes(16).toString('hex');
+  }
+
+  setAttribute(key: string, value: string | number | boolean): void {
+    this.attributes[key] = value;
+  }
+
+  end(endTime: number = Date.now()): void {
+    if (this.ended) {
+      return;
+    }
+    this.ended = true;
+
+    this.tracer.record({
+      traceId: this.traceId,
+      spanId: this.spanId,
+      parentSpanId: this.parent?.spanId,
+      name: this.name,
+      startTime: this.startTime,
+      endTime,
+      attributes: { ...this.attributes }
+    });
+  }
+}
+
+/**
+ * @summary Tracer that keeps finished spans in memory.
+ *
+ * @description
+ * The base of OtlpHttpTracer, and handy in tests for checking which
+ * spans a game produced.
+ *
+ * @implements {GameTracer}
+ */
+export class RecordingTracer implements GameTracer {
+  /** Finished spans, oldest first */
+  readonly finished: FinishedSpan[] = [];
+
+  startSpan(name: string, options: StartSpanOptions = {}): TraceSpan {
+    const parent = options.parent instanceof RecordedSpan ? options.parent : undefined;
+    return new RecordedSpan(this, name, options.startTime ?? Date.now(), options.attributes ?? {}, parent);
+  }
+
+  /**
+   * @summary Stores a finished span.
+   *
+   * @param {FinishedSpan} span - Span that just ended
+   */
+  record(span: FinishedSpan): void {
+    this.finished.push(span);
+  }
+
+  async shutdown(): Promise<void> {}
+}
+
+/**
+ * @summary Exporter settings for OtlpHttpTracer.
+ */
+export interface OtlpExporterConfig {
+  /** Full URL spans are POSTed to, e.g. http://collector:4318/v1/traces */
+  endpoint: string;
+
+  /** Extra request headers, e.g. for collector authentication */
+  headers: Record<string, string>;
+
+  /** service.name resource attribute */
+  serviceName: string;
+
+  /** How often buffered spans are sent (milliseconds) */
+  flushIntervalMs: number;
+}
+
+/**
+ * @summary Tracer that exports spans as OTLP/HTTP JSON.
+ *
+ * @description
+ * Finished spans are buffered and sent in one request per flush
+ * interval. A failed export is logged and its spans dropped, so a
+ * missing collector never holds up a game.
+ */
+export class OtlpHttpTracer extends RecordingTracer {
+  private readonly config: OtlpExporterConfig;
+  private readonly flushTimer: ReturnType<typeof setInterval>;
+
+  constructor(config: OtlpExporterConfig) {
+    super();
+    this.config = config;
+    this.flushTimer = setInterval(() => {
+      void this.flush();
+    }, config.flushIntervalMs);
+    this.flushTimer.unref?.();
+  }
+
+  /**
+   * @summary Sends every buffered span to the collector.
+   */
+  async flush(): Promise<void> {
+    const spans = this.finished.splice(0);
+    if (spans.length === 0) {
+      return;
+    }
+
+    try {
+      const response = await fetch(this.config.endpoint, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json', ...this.config.headers },
+        body: JSON.stringify(toOtlpJson(spans, this.config.serviceName))
+      });
+      if (!response.ok) {
+        getLogger().warn('Trace export rejected', { status: response.status, spans: spans.length });
+      }
+    } catch (error) {
+      getLogger().warn('Trace export failed', { error, spans: spans.length });
+    }
+  }
+
+  async shutdown(): Promise<void> {
+    clearInterval(this.flushTimer);
+    await this.flush();
+  }
+}
+
+/**
+ * @summary Builds an OTLP/JSON trace export request body.
+ *
+ * @param {readonly FinishedSpan[]} spans - Spans to send
+ * @param {string} serviceName - service.name resource attribute
+ *
+ * @returns {object} ExportTraceServiceRequest as JSON
+ */
+export function toOtlpJson(spans: readonly FinishedSpan[], serviceName: string): object {
+  const toNanos = (ms: number): string => (BigInt(Math.round(ms)) * 1000000n).toString();
+  const toAttributes = (attributes: SpanAttributes) => Object.entries(attributes).map(([key, value]) => ({
+    key,
+    value: typeof value === 'string'
+      ? { stringValue: value }
+      : typeof value === 'boolean'
+        ? { boolValue: value }
+        : Number.isInteger(value) ? { intValue: value } : { doubleValue: value }
+  }));
+
+  return {
+    resourceSpans: [{
+      resource: { attributes: toAttributes({ 'service.name': serviceName }) },
+      scopeSpans: [{
+        scope: { name: 'onuw' },
+        spans: spans.map(span => ({
+          traceId: span.traceId,
+          spanId: span.spanId,
+          ...(span.parentSpanId ? { parentSpanId: span.parentSpanId } : {}),
+          name: span.name,
+          kind: 1,
+          startTimeUnixNano: toNanos(span.startTime),
+          endTimeUnixNano: toNanos(span.endTime),
+          attributes: toAttributes(span.attributes)
+        }))
+      }]
+    }]
+  };
+}
+
+/**
+ * @summary Parses an OTEL_EXPORTER_OTLP_HEADERS value.
+ *
+ * @param {string | undefined} value - Comma-separated key=value pairs
+ *
+ * @returns {Record<string, string>} Headers
+ *
+ * @private
+ */
+function parseOtlpHeaders(value: string | undefined): Record<string, string> {
+  const headers: Record<string, string> = {};
+  for (const pair of (value ?? '').split(',')) {
+    const separator = pair.indexOf('=');
+    if (separator > 0) {
+      headers[decodeURIComponent(pair.slice(0, separator).trim())] =
+        decodeURIComponent(pair.slice(separator + 1).trim());
+    }
+  }
+  return headers;
+}
+
+/**
+ * @summary Creates a tracer from the standard OpenTelemetry environment variables.
+ *
+ * @description
+ * Reads OTEL_EXPORTER_OTLP_TRACES_ENDPOINT (used as-is) or
+ * OTEL_EXPORTER_OTLP_ENDPOINT (with /v1/traces appended),
+ * OTEL_EXPORTER_OTLP_HEADERS, OTEL_SERVICE_NAME and OTEL_BSP_SCHEDULE_DELAY.
+ * Returns NOOP_TRACER when no endpoint is set or OTEL_TRACES_EXPORTER
+ * is 'none'.
+ *
+ * @param {NodeJS.ProcessEnv} [env=process.env] - Environment to read
+ *
+ * @returns {GameTracer} Tracer to use
+ */
+export function createTracerFromEnv(env: NodeJS.ProcessEnv = process.env): GameTracer {
+  if (env.OTEL_TRACES_EXPORTER === 'none') {
+    return NOOP_TRACER;
+  }
+
+  const base = env.OTEL_EXPORTER_OTLP_ENDPOINT?.replace(/\/+$/, '');
+  const endpoint = env.OTEL_EXPORTER_OTLP_TRACES_ENDPOINT || (base ? `${base}/v1/traces` : '');
+  if (!endpoint) {
+    return NOOP_TRACER;
+  }
+
+  const flushIntervalMs = parseInt(env.OTEL_BSP_SCHEDULE_DELAY ?? '5000', 10);
+
+  return new OtlpHttpTracer({
+    endpoint,
+    headers: parseOtlpHeaders(env.OTEL_EXPORTER_OTLP_TRACES_HEADERS ?? env.OTEL_EXPORTER_OTLP_HEADERS),
+    serviceName: env.OTEL_SERVICE_NAME || 'onuw-server',
+    flushIntervalMs: Number.isFinite(flushIntervalMs) && flushIntervalMs > 0 ? flushIntervalMs : 5000
+  });
+}
+
+/**
+ * @summary Traces every game played in a room.
+ *
+ * @description
+ * Starts a game span when a game is dealt, a child span for each phase
+ * as the game enters it, and a child of the current phase for each
+ * night action (back-dated to when the player was woken). The spans end
+ * when the game ends or the room closes.
+ *
+ * @param {Room} room - Room to trace
+ * @param {GameTracer} tracer - Where spans go
+ */
+export function traceRoom(room: Room, tracer: GameTracer): void {
+  if (tracer === NOOP_TRACER) {
+    return;
+  }
+
+  let gameSpan: TraceSpan | null = null;
+  let phaseSpan: TraceSpan | null = null;
+
+  const endGame = (outcome: string): void => {
+    phaseSpan?.end();
+    gameSpan?.setAttribute('game.outcome', outcome);
+    gameSpan?.end();
+    phaseSpan = null;
+    gameSpan = null;
+  };
+
+  room.onEvent((event) => {
+    switch (event.type) {
+      case 'gameStarted':
+        endGame('restarted');
+        gameSpan = tracer.startSpan('game', {
+          attributes: {
+            'game.id': room.getCode(),
+            'game.players': (event.data.playerIds as unknown[] | undefined)?.length ?? 0
+          }
+        });
+        break;
+
+      case 'phaseChanged':
+        if (gameSpan) {
+          phaseSpan?.end();
+          phaseSpan = tracer.startSpan(`phase ${event.data.phase}`, {
+            parent: gameSpan,
+            attributes: { 'game.id': room.getCode(), 'game.phase': String(event.data.phase) }
+          });
+        }
+        break;
+
+      case 'nightActionCompleted':
+        if (phaseSpan && typeof event.data.durationMs === 'number') {
+          const now = Date.now();
+          tracer.startSpan(`night action ${event.data.role}`, {
+            parent: phaseSpan,
+            startTime: now - event.data.durationMs,
+            attributes: { 'game.id': room.getCode(), 'game.role': String(event.data.role) }
+          }).end(now);
+        }
+        break;
+
+      case 'gameEnded':
+        endGame('ended');
+        break;
+
+      case 'roomClosed':
+        endGame('closed');
+        break;
+    }
+  });
+}
@@ -0,0 +1,80 @@
+/**
+ * @fileoverview Post-game breakdown of the vote.
+ * @module server/VoteBreakdown
+ *
+ * @summary Turns a finished game's votes into who voted for whom and the tally.
+ *
+ * @description
+ * The reveal lists who died and who won, but the table also wants to
+ * argue about how the vote went. The breakdown resolves every vote to
+ * player names and counts the votes each player received.
+ *
+ * With anonymous voting the tally is still shown, but who cast which
+ * vote is left out.
+ *
+ * @example
+ * ```typescript
+ * const breakdown = buildVoteBreakdown(votesRecord, playerNames, false);
+ * // { anonymous: false, votes: [{ voterName: 'Alice', targetName: 'Bob', ... }],
+ * //   tally: [{ playerName: 'Bob', votes: 3, ... }], abstentions: 0 }
+ * ```
+ */
+
+import { ABSTAIN_VOTE } from '../types';
+import { NamedVote, PlayerId, VoteBreakdown, VoteTally } from '../network/protocol';
+
+/**
+ * @summary Builds the vote breakdown for the end of a game.
+ *
+ * @description
+ * The tally lists every player who received a vote, most votes first;
+ * players with the same count keep their seating order. Abstentions
+ * count toward no one.
+ *
+ * @param {Readonly<Record<PlayerId, PlayerId>>} votes - Target by voter, as room player IDs
+ * @param {ReadonlyMap<PlayerId, string>} playerNames - Name of each player, in seating order
+ * @param {boolean} [anonymous=false] - Leave out who voted for whom
+ *
+ * @returns {VoteBreakdown} Breakdown to send with the result
+ */
+export function buildVoteBreakdown(
+  votes: Readonly<Record<PlayerId, PlayerId>>,
+  playerNames: ReadonlyMap<PlayerId, string>,
+  anonymous: boolean = false
+): VoteBreakdown {
+  const nameOf = (playerId: PlayerId): string => playerNames.get(playerId) ?? playerId;
+  const seats = [...playerNames.keys()];
+  const seatOf = (playerId: PlayerId): number => {
+    const seat = seats.indexOf(playerId);
+    return seat === -1 ? seats.length : seat;
+  };
+
+  const counts = new Map<PlayerId, number>();
+  let abstentions = 0;
+  for (const targetId of Object.values(votes)) {
+    if (targetId === ABSTAIN_VOTE) {
+      abstentions++;
+    } else {
+      counts.set(targetId, (counts.get(targetId) ?? 0) + 1);
+    }
+  }
+
+  const tally: VoteTally[] = [...counts]
+    .sort(([a, aVotes], [b, bVotes]) => bVotes - aVotes || seatOf(a) - seatOf(b))
+    .map(([playerId, count]) => ({ playerId, playerName: nameOf(playerId), votes: count }));
+
+  if (anonymous) {
+    return { anonymous, tally, abstentions };
+  }
+
+  const named: NamedVote[] = Object.entries(votes)
+    .sort(([a], [b]) => seatOf(a) - seatOf(b))
+    .map(([voterId, targetId]) => ({
+      voterId,
+      voterName: nameOf(voterId),
+      targetId,
+      targetName: targetId === ABSTAIN_VOTE ? 'Abstained' : nameOf(targetId)
+    }));
+
+  return { anonymous, votes: named, tally, abstentions };
+}
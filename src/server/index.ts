@@ -33,6 +33,7 @@ export {
   RoomEventType,
   RoomEvent,
   RoomEventHandler,
+  RoomSnapshot,
   generateRoomCode
 } from './Room';
 
@@ -42,9 +43,123 @@ export {
   DEFAULT_ROOM_MANAGER_CONFIG,
   RoomManagerEventHandler,
   RoomManagerEventType,
-  RoomManagerEvent
+  RoomManagerEvent,
+  DeleteRoomResult,
+  LeaveRoomResult,
+  KickPlayerResult,
+  TransferHostResult,
+  NextRoundResult,
+  AddBotsResult,
+  RoomManagerStats,
+  AdminRoomSummary
 } from './RoomManager';
 
+// Room storage
+export {
+  IRoomStore,
+  InMemoryRoomStore
+} from './RoomStore';
+
+// Game persistence
+export {
+  IGameSnapshotStore,
+  JsonFileGameSnapshotStore
+} from './GameSnapshotStore';
+
+// Spectator broadcasting
+export { SpectatorBroadcaster } from './SpectatorBroadcaster';
+
+// Phase timing
+export { PhaseClock } from './PhaseClock';
+
+// Post-game night log
+export { buildNightActionLog, describeNightAction, NightLogPlayer } from './NightActionLog';
+
+// Post-game vote breakdown
+export { buildVoteBreakdown } from './VoteBreakdown';
+
+// HTTP middleware
+export {
+  withRecovery,
+  withRequestLogging,
+  getClientIp,
+  HttpHandler,
+  RequestLoggingConfig,
+  DEFAULT_REQUEST_LOGGING_CONFIG
+} from './HttpMiddleware';
+
+// Load balancer probes
+export { handleHealthCheck, HealthSource, HEALTH_PATH, READY_PATH } from './HealthCheck';
+
+// Prometheus metrics
+export {
+  GameMetrics,
+  PrometheusMetrics,
+  NULL_METRICS,
+  METRICS_PATH,
+  NIGHT_ACTION_BUCKETS,
+  handleMetricsRequest
+} from './Metrics';
+
+// OpenTelemetry tracing
+export {
+  GameTracer,
+  TraceSpan,
+  SpanAttributes,
+  StartSpanOptions,
+  FinishedSpan,
+  NOOP_TRACER,
+  RecordingTracer,
+  OtlpHttpTracer,
+  OtlpExporterConfig,
+  createTracerFromEnv,
+  toOtlpJson,
+  traceRoom
+} from './Tracing';
+
+// Client log uploads
+export {
+  parseClientLogs,
+  sanitizeLogMessage,
+  ClientLogRateLimiter,
+  ClientLog,
+  ClientLogConfig,
+  ClientLogParseResult,
+  DEFAULT_CLIENT_LOG_CONFIG
+} from './ClientLogs';
+
+// Room create/join throttling
+export {
+  TokenBucketRateLimiter,
+  RateLimitConfig,
+  DEFAULT_RATE_LIMIT_CONFIG
+} from './RateLimiter';
+
+// Player display names
+export {
+  normalizePlayerName,
+  PlayerNameResult,
+  MAX_PLAYER_NAME_LENGTH
+} from './PlayerNames';
+
+// Player-written text cleanup
+export {
+  TextSanitizer,
+  escapeHtml,
+  stripControlCharacters,
+  loadBlocklist,
+  createSanitizerFromEnv
+} from './TextSanitizer';
+
+// Allowed browser origins
+export { OriginPolicy, parseAllowedOrigins } from './OriginPolicy';
+export {
+  ListenConfig,
+  DEFAULT_LISTEN_CONFIG,
+  parseListenAddress,
+  parseListenConfig
+} from './ListenConfig';
+
 // Reconnection manager
 export {
   ReconnectionManager,
@@ -43,7 +43,7 @@ import { GamePhase, Team, RoleName } from '../enums';
  * const seerRole: IRole = {
  *   name: RoleName.SEER,
  *   team: Team.VILLAGE,
- *   nightOrder: 5,
+ *   nightOrder: 7,
  *   description: 'Look at one player card or two center cards'
  * };
  * ```
@@ -55,7 +55,7 @@ export interface IRole {
   /** Which team this role belongs to */
   readonly team: Team;
 
-  /** Night wake order (1-9), or -1 if no night action */
+  /** Night wake order (1-based), or -1 if no night action */
   readonly nightOrder: number;
 
   /** Human-readable description of the role's ability */
@@ -173,9 +173,9 @@ export interface GameState {
  * @description
  * Defines the parameters needed to initialize a game:
  * - Which players are participating
- * - Which roles are in the game (must be players + 3 for center)
+ * - Which roles are in the game (must be players + center cards)
  *
- * @throws {Error} If roles.length !== players.length + 3
+ * @throws {Error} If roles.length !== players.length + centerCardCount
  *
  * @example
  * ```typescript
@@ -213,9 +213,24 @@ export interface GameConfig {
   /** Names of players participating in the game */
   readonly players: ReadonlyArray<string>;
 
-  /** Roles to use in this game (must be players.length + 3) */
+  /** Roles to use in this game (must be players.length + centerCardCount) */
   readonly roles: ReadonlyArray<RoleName>;
 
+  /**
+   * Number of cards dealt to the center.
+   *
+   * @default 3
+   */
+  readonly centerCardCount?: number;
+
+  /**
+   * House wake order: every role with a night action, in the order they
+   * wake. Roles not dealt in this game are skipped.
+   *
+   * @default NIGHT_WAKE_ORDER
+   */
+  readonly nightOrder?: ReadonlyArray<RoleName>;
+
   /**
    * Debug option: Force specific players to receive specific roles.
    * Map of player index (0-based) to role name.
@@ -239,6 +254,47 @@ export interface GameConfig {
    * Only used in debug/testing mode.
    */
   readonly forceWerewolvesToCenter?: boolean;
+
+  /**
+   * Which roles the end-of-game reveal leads with.
+   * Determines GameResult.revealSequence.
+   *
+   * @default 'final'
+   */
+  readonly revealEmphasis?: RevealEmphasis;
+
+  /**
+   * How long players have to vote, in milliseconds. When it runs out
+   * the game resolves with the votes cast so far; anyone who hasn't
+   * voted abstains. null waits for every vote.
+   *
+   * @default 60000
+   */
+  readonly votingTimeoutMs?: number | null;
+
+  /**
+   * House rule: players may vote ABSTAIN_VOTE instead of naming a
+   * player. An abstention counts as a vote cast but adds to no tally.
+   *
+   * @default false
+   */
+  readonly allowAbstain?: boolean;
+
+  /**
+   * What happens when players tie for the most votes.
+   *
+   * @default 'all-die'
+   */
+  readonly tieBreak?: TieBreakMode;
+
+  /**
+   * Fewest votes a player needs before they can be killed. The
+   * standard rule is 2: if no one gets more than one vote, no one dies.
+   * 1 kills whoever has the most votes, even one each.
+   *
+   * @default 2
+   */
+  readonly minVotesToKill?: number;
 }
 
 // ============================================================================
@@ -288,8 +344,51 @@ export interface NightActionResult {
 
   /** Error message if action failed */
   readonly error?: string;
+
+  /** Machine-readable reason the action did not succeed */
+  readonly failureCode?: NightActionFailureCode;
+
+  /**
+   * Whether the input was rejected (ask the player again) or the rules
+   * prevented the action (just tell the player).
+   */
+  readonly failureKind?: NightActionFailureKind;
+
+  /** When the engine recorded the action (epoch ms) */
+  readonly timestamp?: number;
 }
 
+/**
+ * @summary Reason a night action did not succeed.
+ *
+ * @description
+ * Rejections - the player's input was invalid and can be corrected:
+ * - `WRONG_TARGET_COUNT`: Too many or too few targets
+ * - `INVALID_TARGET`: Target is not one of the offered options
+ * - `DUPLICATE_TARGET`: The same target was chosen twice
+ * - `SELF_TARGET`: The player targeted their own card
+ *
+ * Failures - the input was valid but the rules prevented the action:
+ * - `NO_VALID_TARGETS`: Nothing the role could act on
+ * - `TARGET_SHIELDED`: The target's card is protected
+ */
+export type NightActionFailureCode =
+  | 'WRONG_TARGET_COUNT'
+  | 'INVALID_TARGET'
+  | 'DUPLICATE_TARGET'
+  | 'SELF_TARGET'
+  | 'NO_VALID_TARGETS'
+  | 'TARGET_SHIELDED';
+
+/**
+ * @summary Category of a night action failure.
+ *
+ * @description
+ * - `rejected`: Validation error; clients should re-prompt
+ * - `failed`: Rule-based outcome; clients should inform the player
+ */
+export type NightActionFailureKind = 'rejected' | 'failed';
+
 /**
  * @summary Information structure for night action results.
  *
@@ -301,6 +400,12 @@ export interface NightActionResult {
  * - `viewed`: For Seer, Werewolf (lone wolf), Insomniac, Mason, Minion
  * - `swapped`: For Robber, Troublemaker, Drunk
  * - `copied`: For Doppelganger
+ * - `shielded`: For Sentinel
+ * - `rotated`: For Village Idiot
+ * - `revealed`: For Revealer
+ * - `artifact`: For Curator
+ * - `seers`: For Beholder
+ * - `turned`: For Alpha Wolf
  *
  * @example
  * ```typescript
@@ -327,13 +432,52 @@ export interface NightActionInfo {
     readonly role: RoleName;
   };
 
-  /** Other werewolves seen (Werewolf/Minion only) */
+  /** Other werewolves seen (Werewolf/Alpha Wolf/Mystic Wolf/Minion/Squire only) */
   werewolves?: ReadonlyArray<string>;
 
   /** Other masons seen (Mason only) */
   masons?: ReadonlyArray<string>;
+
+  /** Players who are the Seer (Beholder only) */
+  seers?: ReadonlyArray<string>;
+
+  /** Player whose card was shielded (Sentinel only) */
+  shielded?: string;
+
+  /** Rotation of player cards, if one was made (Village Idiot only) */
+  rotated?: {
+    readonly direction: RotationDirection;
+    readonly playerIds: ReadonlyArray<string>;
+  };
+
+  /** Player whose card stays face up for the day (Revealer only) */
+  revealed?: string;
+
+  /** Player whose card got an artifact (Curator only) */
+  artifact?: string;
+
+  /** Player given the center Werewolf card (Alpha Wolf only) */
+  turned?: string;
+
+  /** Set when the player passed on an optional action */
+  skipped?: boolean;
 }
 
+/**
+ * @summary Direction the Village Idiot moves every player's card.
+ *
+ * @description
+ * Players are taken in seat order. LEFT passes each card to the previous
+ * player (the first player's card goes to the last); RIGHT passes each
+ * card to the next player.
+ */
+export type RotationDirection = 'LEFT' | 'RIGHT';
+
+/**
+ * @summary The Village Idiot's answer: a direction, or NONE to leave the cards alone.
+ */
+export type RotationChoice = RotationDirection | 'NONE';
+
 /**
  * @summary Information about a card that was viewed.
  *
@@ -469,7 +613,8 @@ export interface BaseAgentContext {
  *   myStartingRole: RoleName.SEER,
  *   allPlayerIds: ['player-1', 'player-2', 'player-3', 'player-4', 'player-5'],
  *   rolesInGame: [RoleName.SEER, RoleName.WEREWOLF, ...],
- *   previousResults: []
+ *   previousResults: [],
+ *   centerCardCount: 3
  * };
  * ```
  */
@@ -479,8 +624,19 @@ export interface NightActionContext extends BaseAgentContext {
 
   /** Previous night results this player has received (Doppelganger may have multiple) */
   readonly previousResults: ReadonlyArray<NightActionResult>;
+
+  /** Number of center cards; valid center indices are 0 to centerCardCount - 1 */
+  readonly centerCardCount: number;
+
+  /** Whether SKIP_NIGHT_ACTION is accepted in place of a choice */
+  readonly canSkip?: boolean;
 }
 
+/**
+ * @summary Answer meaning "I pass", accepted when NightActionContext.canSkip is set.
+ */
+export const SKIP_NIGHT_ACTION = 'SKIP';
+
 /**
  * @summary Context provided to an agent during the day discussion phase.
  *
@@ -546,6 +702,16 @@ export interface PlayerStatement {
   readonly timestamp: number;
 }
 
+/**
+ * @summary Number of center cards in a standard game.
+ */
+export const DEFAULT_CENTER_CARD_COUNT = 3;
+
+/**
+ * @summary Vote target meaning "no one", accepted when GameConfig.allowAbstain is set.
+ */
+export const ABSTAIN_VOTE = 'ABSTAIN';
+
 /**
  * @summary Context provided to an agent when voting.
  *
@@ -575,6 +741,12 @@ export interface VotingContext extends BaseAgentContext {
 
   /** Player IDs that can be voted for */
   readonly eligibleTargets: ReadonlyArray<string>;
+
+  /** Time left to vote in milliseconds; the player abstains after it */
+  readonly timeLimitMs?: number;
+
+  /** Whether ABSTAIN_VOTE is accepted as a vote */
+  readonly canAbstain?: boolean;
 }
 
 /**
@@ -711,7 +883,8 @@ export interface CircularCheckResult {
  * Contains all information about how the game ended:
  * - Which team(s) won
  * - Who was killed
- * - Final role positions
+ * - Original (dealt) and final role positions, for players and center
+ * - The order in which clients should reveal them
  *
  * @example
  * ```typescript
@@ -719,8 +892,14 @@ export interface CircularCheckResult {
  *   winningTeams: [Team.VILLAGE],
  *   winningPlayers: ['player-1', 'player-3', 'player-4'],
  *   eliminatedPlayers: ['player-2'],
+ *   originalRoles: new Map([...]),
  *   finalRoles: new Map([...]),
- *   votes: new Map([...])
+ *   originalCenterCards: [RoleName.SEER, RoleName.VILLAGER, RoleName.DRUNK],
+ *   finalCenterCards: [RoleName.SEER, RoleName.VILLAGER, RoleName.ROBBER],
+ *   votes: new Map([...]),
+ *   voteLog: [...],
+ *   revealEmphasis: 'original',
+ *   revealSequence: ['originalRoles', 'nightActions', 'finalRoles']
  * };
  * ```
  */
@@ -734,11 +913,98 @@ export interface GameResult {
   /** Player IDs who were eliminated */
   readonly eliminatedPlayers: ReadonlyArray<string>;
 
+  /** Role card each player was dealt (never changed by swaps) */
+  readonly originalRoles: ReadonlyMap<string, RoleName>;
+
   /** Final role card for each player (after all swaps) */
   readonly finalRoles: ReadonlyMap<string, RoleName>;
 
+  /** Center cards as dealt, in index order */
+  readonly originalCenterCards: ReadonlyArray<RoleName>;
+
+  /** Center cards after all swaps, in index order */
+  readonly finalCenterCards: ReadonlyArray<RoleName>;
+
   /** How each player voted */
   readonly votes: ReadonlyMap<string, string>;
+
+  /** Every vote cast, with when it arrived */
+  readonly voteLog: ReadonlyArray<VoteRecord>;
+
+  /** Which roles the reveal leads with */
+  readonly revealEmphasis: RevealEmphasis;
+
+  /** Recommended order of reveal stages */
+  readonly revealSequence: ReadonlyArray<RevealStage>;
+}
+
+/**
+ * @summary One vote as it was cast.
+ *
+ * @description
+ * Unlike the votes map, which keeps only each player's final vote, the
+ * vote log keeps every vote in the order it arrived, including the
+ * first round of a revote.
+ */
+export interface VoteRecord {
+  /** Player who voted */
+  readonly voterId: string;
+
+  /** Player voted for */
+  readonly targetId: string;
+
+  /** When the vote arrived (epoch ms) */
+  readonly timestamp: number;
+}
+
+/**
+ * @summary Which roles the end-of-game reveal leads with.
+ *
+ * @description
+ * - `original`: Show the dealt roles first, then animate the night's
+ *   changes into the final roles (the more dramatic reveal)
+ * - `final`: Show the final roles (who actually won) first, then how
+ *   the night got there
+ */
+export type RevealEmphasis = 'original' | 'final';
+
+/**
+ * @summary What happens when players tie for the most votes.
+ *
+ * @description
+ * - `all-die`: Every tied player dies (the standard rule)
+ * - `no-kill`: No one dies
+ * - `revote`: One more round where only the tied players can be voted
+ *   for; if that ties too, every player tied in it dies
+ */
+export type TieBreakMode = 'all-die' | 'no-kill' | 'revote';
+
+/**
+ * @summary One stage of the end-of-game reveal.
+ *
+ * @description
+ * - `originalRoles`: Everyone's dealt card
+ * - `nightActions`: The night's actions, in wake order
+ * - `finalRoles`: Everyone's card after all swaps
+ */
+export type RevealStage = 'originalRoles' | 'nightActions' | 'finalRoles';
+
+/**
+ * @summary Gets the recommended reveal order for an emphasis.
+ *
+ * @param {RevealEmphasis} emphasis - Which roles to lead with
+ *
+ * @returns {RevealStage[]} Reveal stages in order
+ *
+ * @example
+ * ```typescript
+ * getRevealSequence('original'); // ['originalRoles', 'nightActions', 'finalRoles']
+ * ```
+ */
+export function getRevealSequence(emphasis: RevealEmphasis): RevealStage[] {
+  return emphasis === 'original'
+    ? ['originalRoles', 'nightActions', 'finalRoles']
+    : ['finalRoles', 'originalRoles', 'nightActions'];
 }
 
 /**
@@ -783,6 +1049,8 @@ export type GameEventType =
   | 'NIGHT_ACTION_EXECUTED'
   | 'STATEMENT_MADE'
   | 'VOTE_CAST'
+  | 'VOTE_SUBMITTED'
+  | 'REVOTE_STARTED'
   | 'GAME_ENDED'
   | 'ERROR';
 
@@ -876,3 +1144,26 @@ export function isPlayerPosition(pos: CardPosition): pos is CardPosition & { pla
 export function isCenterPosition(pos: CardPosition): pos is CardPosition & { centerIndex: number } {
   return pos.centerIndex !== undefined;
 }
+
+/**
+ * @summary Gets the category of a night action failure code.
+ *
+ * @param code The failure code
+ * @returns 'rejected' for input errors, 'failed' for rule-based outcomes
+ *
+ * @example
+ * ```typescript
+ * if (result.failureCode && getNightActionFailureKind(result.failureCode) === 'rejected') {
+ *   // Ask the player to choose again
+ * }
+ * ```
+ */
+export function getNightActionFailureKind(code: NightActionFailureCode): NightActionFailureKind {
+  switch (code) {
+    case 'NO_VALID_TARGETS':
+    case 'TARGET_SHIELDED':
+      return 'failed';
+    default:
+      return 'rejected';
+  }
+}
@@ -0,0 +1,428 @@
+/**
+ * @fileoverview Leveled server logger with text and JSON output.
+ * @module utils/logger
+ *
+ * @description
+ * Plain text lines are easy to read locally but hard to ingest into a
+ * log aggregator. The logger writes either format from the same entry:
+ * text by default, or one JSON object per line when LOG_FORMAT=json
+ * (or after setFormat('json')).
+ *
+ * Entries below the minimum level (LOG_LEVEL, default debug) are
+ * dropped before they are encoded. Output goes to stdout, with WARN and
+ * ERROR on stderr, unless setOutput() redirects every level to one
+ * destination.
+ *
+ * with() returns a child logger that adds the same fields to every
+ * entry, so all lines about one room can be filtered by its code.
+ *
+ * @example
+ * ```typescript
+ * const logger = getLogger().with({ roomCode: 'ABCD' });
+ * logger.info('Room created');
+ * // text: 2024-01-01T00:00:00.000Z INFO  Room created roomCode=ABCD
+ * // json: {"level":"info","timestamp":"2024-01-01T00:00:00.000Z","message":"Room created","roomCode":"ABCD"}
+ * ```
+ */
+
+// =============================================================================
+// TYPES
+// =============================================================================
+
+/**
+ * @summary Severity of a log entry.
+ */
+export enum LogLevel {
+  DEBUG = 'debug',
+  INFO = 'info',
+  WARN = 'warn',
+  ERROR = 'error'
+}
+
+/**
+ * @summary Output format for log entries.
+ */
+export type LogFormat = 'text' | 'json';
+
+/**
+ * @summary Extra key/value pairs attached to a log entry.
+ */
+export type LogFields = Record<string, unknown>;
+
+/**
+ * @summary Destination for encoded log lines.
+ *
+ * @description
+ * Matches the write() of a Node stream, so process.stdout or a file
+ * stream can be passed directly.
+ */
+export interface LogOutput {
+  write(chunk: string): unknown;
+}
+
+/**
+ * @summary Logger configuration.
+ */
+export interface LoggerConfig {
+  /** Output format */
+  format: LogFormat;
+
+  /** Entries below this level are dropped */
+  level: LogLevel;
+
+  /** Destination for every level, or null for stdout/stderr */
+  output: LogOutput | null;
+}
+
+/**
+ * @summary A single log entry, before encoding.
+ */
+export interface LogEntry {
+  readonly level: LogLevel;
+  readonly timestamp: Date;
+  readonly message: string;
+  readonly fields: LogFields;
+}
+
+/**
+ * @summary Severity rank of each level, lowest first.
+ * @private
+ */
+const LEVEL_RANK: Record<LogLevel, number> = {
+  [LogLevel.DEBUG]: 0,
+  [LogLevel.INFO]: 1,
+  [LogLevel.WARN]: 2,
+  [LogLevel.ERROR]: 3
+};
+
+// =============================================================================
+// LOGGER
+// =============================================================================
+
+/**
+ * @summary Writes leveled log entries as text or JSON.
+ *
+ * @description
+ * All four level methods build a LogEntry and pass it through encode(),
+ * so both formats see exactly the same data. Unless an output is set,
+ * WARN and ERROR entries go to stderr and the rest to stdout.
+ */
+export class Logger {
+  /** Format, level and output, shared with every child logger */
+  private settings: LoggerConfig;
+
+  /** Fields added to every entry */
+  private context: LogFields = {};
+
+  /**
+   * @summary Creates a logger.
+   *
+   * @param {Partial<LoggerConfig>} [config] - Settings (defaults from LOG_FORMAT and LOG_LEVEL)
+   */
+  constructor(config: Partial<LoggerConfig> = {}) {
+    this.settings = {
+      format: config.format ?? parseLogFormat(process.env.LOG_FORMAT),
+      level: config.level ?? parseLogLevel(process.env.LOG_LEVEL),
+      output: config.output ?? null
+    };
+  }
+
+  /**
+   * @summary Creates a child logger that adds fields to every entry.
+   *
+   * @description
+   * The child shares this logger's settings, so a later setFormat(),
+   * setLevel() or setOutput() on either applies to both. Fields passed
+   * to a single call override the child's fields of the same name.
+   *
+   * @param {LogFields} fields - Fields to add, e.g. { roomCode, playerId }
+   *
+   * @returns {Logger} Child logger
+   */
+  with(fields: LogFields): Logger {
+    const child = new Logger(this.settings);
+    child.settings = this.settings;
+    child.context = { ...this.context, ...fields };
+    return child;
+  }
+
+  /**
+   * @summary Switches the output format.
+   *
+   * @param {LogFormat} format - Output format
+   */
+  setFormat(format: LogFormat): void {
+    this.settings.format = format;
+  }
+
+  /**
+   * @summary Gets the output format.
+   *
+   * @returns {LogFormat} Current format
+   */
+  getFormat(): LogFormat {
+    return this.settings.format;
+  }
+
+  /**
+   * @summary Sets the minimum level written.
+   *
+   * @param {LogLevel} level - Entries below this level are dropped
+   */
+  setLevel(level: LogLevel): void {
+    this.settings.level = level;
+  }
+
+  /**
+   * @summary Gets the minimum level written.
+   *
+   * @returns {LogLevel} Current minimum level
+   */
+  getLevel(): LogLevel {
+    return this.settings.level;
+  }
+
+  /**
+   * @summary Redirects every level to one destination.
+   *
+   * @param {LogOutput | null} output - Destination, or null to go back to stdout/stderr
+   */
+  setOutput(output: LogOutput | null): void {
+    this.settings.output = output;
+  }
+
+  /**
+   * @summary Checks whether entries at a level would be written.
+   *
+   * @param {LogLevel} level - Level to check
+   *
+   * @returns {boolean} True if the level is at or above the minimum
+   */
+  isEnabled(level: LogLevel): boolean {
+    return LEVEL_RANK[level] >= LEVEL_RANK[this.settings.level];
+  }
+
+  /**
+   * @summary Logs detail useful while developing.
+   *
+   * @param {string} message - Log message
+   * @param {LogFields} [fields] - Extra fields
+   */
+  debug(message: string, fields?: LogFields): void {
+    this.log(LogLevel.DEBUG, message, fields);
+  }
+
+  /**
+   * @summary Logs normal operation.
+   *
+   * @param {string} message - Log message
+   * @param {LogFields} [fields] - Extra fields
+   */
+  info(message: string, fields?: LogFields): void {
+    this.log(LogLevel.INFO, message, fields);
+  }
+
+  /**
+   * @summary Logs something unexpected that the server recovered from.
+   *
+   * @param {string} message - Log message
+   * @param {LogFields} [fields] - Extra fields
+   */
+  warn(message: string, fields?: LogFields): void {
+    this.log(LogLevel.WARN, message, fields);
+  }
+
+  /**
+   * @summary Logs a failure.
+   *
+   * @param {string} message - Log message
+   * @param {LogFields} [fields] - Extra fields
+   */
+  error(message: string, fields?: LogFields): void {
+    this.log(LogLevel.ERROR, message, fields);
+  }
+
+  /**
+   * @summary Encodes an entry in the current format.
+   *
+   * @param {LogEntry} entry - Entry to encode
+   *
+   * @returns {string} A single line, without the trailing newline
+   */
+  encode(entry: LogEntry): string {
+    const fields: LogFields = {};
+    for (const [key, value] of Object.entries(entry.fields)) {
+      fields[key] = value instanceof Error ? value.stack ?? value.message : value;
+    }
+
+    if (this.settings.format === 'json') {
+      const header = {
+        level: entry.level,
+        timestamp: entry.timestamp.toISOString(),
+        message: entry.message
+      };
+      // The header goes after the caller fields so one named "level" or
+      // "message" can't overwrite it, and before them too so its keys
+      // stay at the front of the line
+      return JSON.stringify({ ...header, ...fields, ...header });
+    }
+
+    const pairs = Object.entries(fields).map(([key, value]) => `${key}=${formatTextValue(value)}`);
+    return [
+      entry.timestamp.toISOString(),
+      entry.level.toUpperCase().padEnd(5),
+      entry.message,
+      ...pairs
+    ].join(' ');
+  }
+
+  /**
+   * @summary Builds, encodes and writes an entry.
+   *
+   * @param {LogLevel} level - Entry level
+   * @param {string} message - Log message
+   * @param {LogFields} [fields] - Extra fields
+   *
+   * @private
+   */
+  private log(level: LogLevel, message: string, fields: LogFields = {}): void {
+    if (!this.isEnabled(level)) {
+      return;
+    }
+
+    const line = this.encode({
+      level,
+      timestamp: new Date(),
+      message,
+      fields: { ...this.context, ...fields }
+    });
+
+    if (this.settings.output) {
+      this.settings.output.write(line + '\n');
+    } else if (level === LogLevel.WARN || level === LogLevel.ERROR) {
+      process.stderr.write(line + '\n');
+    } else {
+      process.stdout.write(line + '\n');
+    }
+  }
+}
+
+// =============================================================================
+// HELPERS
+// =============================================================================
+
+/**
+ * @summary Reads a log format setting, falling back to text.
+ *
+ * @param {string | undefined} value - Setting such as LOG_FORMAT
+ *
+ * @returns {LogFormat} Parsed format
+ */
+export function parseLogFormat(value: string | undefined): LogFormat {
+  return value?.toLowerCase() === 'json' ? 'json' : 'text';
+}
+
+/**
+ * @summary Reads a minimum level setting, falling back to debug.
+ *
+ * @param {string | undefined} value - Setting such as LOG_LEVEL
+ *
+ * @returns {LogLevel} Parsed level
+ */
+export function parseLogLevel(value: string | undefined): LogLevel {
+  const level = value?.toLowerCase();
+  return Object.values(LogLevel).find(l => l === level) ?? LogLevel.DEBUG;
+}
+
+/**
+ * @summary Formats a field value for a text line.
+ *
+ * @description
+ * Strings without spaces are written bare; anything else is written as
+ * JSON so the line stays unambiguous.
+ *
+ * @param {unknown} value - Field value
+ *
+ * @returns {string} Formatted value
+ *
+ * @private
+ */
+function formatTextValue(value: unknown): string {
+  if (typeof value === 'string' && value !== '' && !/[\s"=]/.test(value)) {
+    return value;
+  }
+  return JSON.stringify(value) ?? String(value);
+}
+
+// =============================================================================
+// TEST OUTPUT
+// =============================================================================
+
+/**
+ * @summary In-memory log destination.
+ */
+export class LogBuffer implements LogOutput {
+  /** Everything written so far */
+  private content = '';
+
+  write(chunk: string): void {
+    this.content += chunk;
+  }
+
+  /**
+   * @summary Gets the written lines.
+   *
+   * @returns {string[]} One string per log entry
+   */
+  lines(): string[] {
+    return this.content.split('\n').filter(line => line !== '');
+  }
+
+  toString(): string {
+    return this.content;
+  }
+}
+
+/**
+ * @summary Creates a fresh logger writing into a buffer.
+ *
+ * @description
+ * For tests that need to check what was logged without touching the
+ * shared logger or the process streams.
+ *
+ * @param {Partial<LoggerConfig>} [config] - Settings (output is always the buffer)
+ *
+ * @returns {{ logger: Logger; buffer: LogBuffer }} The logger and its buffer
+ *
+ * @example
+ * ```typescript
+ * const { logger, buffer } = createBufferedLogger({ format: 'json' });
+ * logger.info('Hello');
+ * JSON.parse(buffer.lines()[0]).message; // 'Hello'
+ * ```
+ */
+export function createBufferedLogger(
+  config: Partial<LoggerConfig> = {}
+): { logger: Logger; buffer: LogBuffer } {
+  const buffer = new LogBuffer();
+  const logger = new Logger({ ...config, output: buffer });
+  return { logger, buffer };
+}
+
+// =============================================================================
+// SINGLETON INSTANCE
+// =============================================================================
+
+let loggerInstance: Logger | null = null;
+
+/**
+ * @summary Gets the shared server logger.
+ *
+ * @returns {Logger} The logger instance
+ */
+export function getLogger(): Logger {
+  if (!loggerInstance) {
+    loggerInstance = new Logger();
+  }
+  return loggerInstance;
+}
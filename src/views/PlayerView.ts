@@ -110,6 +110,14 @@ export class PlayerView {
     // Votes are only visible after voting ends
     const votes = PlayerView.getVisibleVotes(game, gameToRoomMap);
 
+    // Cards the Revealer left face up are public once the night is over
+    const revealedCards = PlayerView.getRevealedCards(game, gameToRoomMap);
+
+    // So are the Curator's artifacts, which hide the player's own role until the end
+    const artifacts = PlayerView.getArtifacts(game, gameToRoomMap);
+    const isRoleHidden = artifacts !== undefined && !game.isGameEnded() &&
+      game.hasArtifact(gamePlayerId);
+
     // End-game info only visible after resolution
     const endGameInfo = PlayerView.getEndGameInfo(game, gamePlayerId, gameToRoomMap);
 
@@ -117,13 +125,15 @@ export class PlayerView {
       myPlayerId: roomPlayerId,
       gameId: game.getId?.() || 'game',
       phase,
-      myStartingRole: startingRole,
+      myStartingRole: isRoleHidden ? null : startingRole,
       myNightInfo,
       players,
       statements,
       votes: votes,
       eliminatedPlayers: endGameInfo.eliminatedPlayers,
       finalRoles: endGameInfo.finalRoles,
+      revealedCards,
+      artifacts,
       winningTeams: endGameInfo.winningTeams,
       winningPlayers: endGameInfo.winningPlayers,
       timeRemaining,
@@ -212,6 +222,63 @@ export class PlayerView {
     }
   }
 
+  /**
+   * @summary Gets the cards the Revealer left face up.
+   *
+   * @description
+   * Revealed cards are flipped during the night, so they only become
+   * visible once the day begins.
+   *
+   * @param {Game} game - The game instance
+   * @param {Map<string, string>} gameToRoomMap - Maps game IDs to room IDs
+   *
+   * @returns {Record<string, RoleName> | undefined} Revealed roles by room ID, or undefined during the night
+   *
+   * @private
+   */
+  private static getRevealedCards(
+    game: Game,
+    gameToRoomMap: Map<string, string>
+  ): Record<string, RoleName> | undefined {
+    const phase = game.getPhase();
+    if (phase === GamePhase.SETUP || phase === GamePhase.NIGHT) {
+      return undefined;
+    }
+
+    const result: Record<string, RoleName> = {};
+    for (const [gameId, role] of game.getRevealedCards()) {
+      const roomId = gameToRoomMap.get(gameId) || gameId;
+      result[roomId] = role;
+    }
+    return result;
+  }
+
+  /**
+   * @summary Gets the players whose cards carry a Curator's artifact.
+   *
+   * @description
+   * Artifacts are placed during the night, so they only become visible
+   * once the day begins.
+   *
+   * @param {Game} game - The game instance
+   * @param {Map<string, string>} gameToRoomMap - Maps game IDs to room IDs
+   *
+   * @returns {string[] | undefined} Room IDs with an artifact, or undefined during the night
+   *
+   * @private
+   */
+  private static getArtifacts(
+    game: Game,
+    gameToRoomMap: Map<string, string>
+  ): string[] | undefined {
+    const phase = game.getPhase();
+    if (phase === GamePhase.SETUP || phase === GamePhase.NIGHT) {
+      return undefined;
+    }
+
+    return game.getArtifactPlayerIds().map(gameId => gameToRoomMap.get(gameId) || gameId);
+  }
+
   /**
    * @summary Gets votes if they should be visible.
    *